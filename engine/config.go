@@ -13,6 +13,15 @@
 
 package engine
 
+import (
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/aligncheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/modules"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/untested"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/vuln"
+)
+
 // UnitTest is a struct that contains AvgCover, PackagesTestDetail and
 // PackagesRaceDetail. The type of AvgCover MUST string that represents
 // the code coverage of the entire project. The type of PackagesTestDetail
@@ -27,12 +36,33 @@ type UnitTest struct {
 	PackagesRaceDetail map[string][]string    `json:"packages_race_detail"`
 }
 
-// PackageTest is a struct that contains IsPass, Coverage and Time. The
-// type of Time MUST float64.
+// PackageTest is a struct that contains IsPass, Coverage, Time and Quality.
+// The type of Time MUST float64.
 type PackageTest struct {
-	IsPass   bool    `json:"is_pass"`
-	Coverage string  `json:"coverage"`
-	Time     float64 `json:"time"`
+	IsPass   bool        `json:"is_pass"`
+	Coverage string      `json:"coverage"`
+	Time     float64     `json:"time"`
+	Quality  TestQuality `json:"quality"`
+}
+
+// TestQuality is a struct that describes what the tests of one package check.
+// NoFailurePath contains the tests that can never fail and ExamplesNoOutput the
+// examples without an output comment. TestLines and CodeLines are the lines of
+// code in the _test.go files and in the other go files.
+type TestQuality struct {
+	TestFuncs        int      `json:"test_funcs"`
+	SubTests         int      `json:"sub_tests"`
+	Skips            int      `json:"skips"`
+	NoFailurePath    []string `json:"no_failure_path"`
+	ExamplesNoOutput []string `json:"examples_no_output"`
+	TestLines        int      `json:"test_lines"`
+	CodeLines        int      `json:"code_lines"`
+}
+
+// PackageTestQuality is the TestQuality of the package Pkg.
+type PackageTestQuality struct {
+	Pkg string `json:"pkg"`
+	TestQuality
 }
 
 // Cycloi is a struct that contains Average and Result. The Average is
@@ -137,25 +167,31 @@ type Depth struct {
 type DepthInfo CycloInfo
 
 // CodeTest is a struct that contains Summary and Content. It represents the result data
-// of the project unit test.
+// of the project unit test. NoTest lists the packages without tests and Failed
+// the packages whose tests failed.
 type CodeTest struct {
 	Summary struct {
-		CodeCover    float64 `json:"code_cover"`
-		PackageCover float64 `json:"pkg_cover"`
-		TotalTime    float64 `json:"total_time"`
+		CodeCover     float64 `json:"code_cover"`
+		PackageCover  float64 `json:"pkg_cover"`
+		TotalTime     float64 `json:"total_time"`
+		TestCodeRatio float64 `json:"test_code_ratio"`
 	} `json:"summary"`
 	Content struct {
-		Pkg    []string  `json:"pkg"`
-		Cover  []float64 `json:"cover"`
-		Time   []float64 `json:"time"`
-		NoTest []string  `json:"no_test"`
+		Pkg     []string             `json:"pkg"`
+		Cover   []float64            `json:"cover"`
+		Time    []float64            `json:"time"`
+		NoTest  []string             `json:"no_test"`
+		Failed  []string             `json:"failed"`
+		Quality []PackageTestQuality `json:"quality"`
 	} `json:"content"`
 }
 
 // Item is a struct that contains File and Content. It is the code-based infrastructure.
+// Diff is the unified diff of the fix of File, if any.
 type Item struct {
 	File    string   `json:"rep"`
 	Content []string `json:"content"`
+	Diff    string   `json:"diff,omitempty"`
 }
 
 // CodeTest is a struct that contains Summary and Content. It represents the result data
@@ -178,24 +214,96 @@ type CodeStyle struct {
 		Quality   string `json:"quality"`
 	} `json:"summary"`
 	Content struct {
-		GoFmt     StyleItem `json:"go_fmt"`
-		GoVet     StyleItem `json:"go_vet"`
-		GoLint    StyleItem `json:"go_lint"`
-		MissSpell StyleItem `json:"miss_spell"`
+		GoFmt      StyleItem `json:"go_fmt"`
+		GoVet      StyleItem `json:"go_vet"`
+		GoLint     StyleItem `json:"go_lint"`
+		MissSpell  StyleItem `json:"miss_spell"`
+		ErrorCheck StyleItem `json:"error_check"`
 	} `json:"content"`
 }
 
 // CodeTest is a struct that contains Summary and Content. It represents the result data
 // of the project unit test.
 type CopyItem struct {
+	Label       string          `json:"label"`
+	Score       int             `json:"score"`
+	Detail      [][]string      `json:"detail"`
+	Groups      []CopyGroup     `json:"groups"`
+	Duplication CopyDuplication `json:"duplication"`
+
+	filesNum  int
+	issuesNum int
+}
+
+// CopyGroup is a clone group: the locations of its fragments, its similarity
+// in percent and the side-by-side diffs of the first fragment with every
+// other one whose code is known. Corpus tells whether the fragments after the
+// first one are copies in other repositories.
+type CopyGroup struct {
+	Files      []string   `json:"files"`
+	Similarity int        `json:"similarity"`
+	Gapped     bool       `json:"gapped"`
+	Corpus     bool       `json:"corpus"`
+	Diffs      []CopyDiff `json:"diffs"`
+}
+
+// CopyDuplication is the number of lines of code of the project, the number
+// of them in a clone and their percentage, for the project and by package.
+type CopyDuplication struct {
+	Lines      int           `json:"lines"`
+	Duplicated int           `json:"duplicated"`
+	Percentage float64       `json:"percentage"`
+	Packages   []CopyPackage `json:"packages"`
+}
+
+// CopyPackage is the number of lines of code of a package, the number of them
+// in a clone and their percentage.
+type CopyPackage struct {
+	Package    string  `json:"package"`
+	Lines      int     `json:"lines"`
+	Duplicated int     `json:"duplicated"`
+	Percentage float64 `json:"percentage"`
+}
+
+// CopyDiff is the side-by-side diff of the fragments Left and Right, the
+// lines numbered as in their files.
+type CopyDiff struct {
+	Left  string               `json:"left"`
+	Right string               `json:"right"`
+	Lines []copycheck.DiffLine `json:"lines"`
+}
+
+// AlignItem is the struct types whose fields may be reordered to take less
+// memory, the most wasteful first, and the bytes they waste in all.
+type AlignItem struct {
+	Label   string              `json:"label"`
+	Wasted  int64               `json:"wasted"`
+	Structs []aligncheck.Struct `json:"structs"`
+
+	filesNum  int
+	issuesNum int
+}
+
+// CheckItem is the catalog of the checks of staticcheck and gosimple that
+// were run and their problems, grouped by check.
+type CheckItem struct {
 	Label  string     `json:"label"`
-	Score  int        `json:"score"`
-	Detail [][]string `json:"detail"`
+	Checks []CheckDoc `json:"checks"`
+	Detail []Item     `json:"detail"`
 
 	filesNum  int
 	issuesNum int
 }
 
+// CheckDoc describes the check ID, with the severity and the number of its
+// problems.
+type CheckDoc struct {
+	ID       string `json:"id"`
+	Doc      string `json:"doc"`
+	Severity string `json:"severity"`
+	Issues   int    `json:"issues"`
+}
+
 // CodeTest is a struct that contains Summary and Content. It represents the result data
 // of the project unit test.
 type CodeOptimization struct {
@@ -207,38 +315,64 @@ type CodeOptimization struct {
 	Content struct {
 		DeadCode       StyleItem `json:"dead_code"`
 		SimpleCode     StyleItem `json:"simple_code"`
-		StaticCode     StyleItem `json:"static_code"`
+		StaticCode     CheckItem `json:"static_code"`
 		CopyCode       CopyItem  `json:"copy_code"`
 		InterfacerCode StyleItem `json:"interfacer_code"`
+		AlignCode      AlignItem `json:"align_code"`
 	} `json:"content"`
 }
 
+// CodeCountItem is the number of files and of code, comment and blank lines
+// of a language or a package. Code counts the lines of code that is neither
+// test nor generated, Test and Generated the lines of the Go test and
+// generated files.
+type CodeCountItem struct {
+	Name      string `json:"name"`
+	Files     int    `json:"files"`
+	Code      int    `json:"code"`
+	Test      int    `json:"test"`
+	Generated int    `json:"generated"`
+	Comment   int    `json:"comment"`
+	Blank     int    `json:"blank"`
+	Total     int    `json:"total"`
+}
+
 // CodeCount is a struct that contains Summary and Content. t represents the code
 // statistics of the data, you can understand the project from the perspective
-// of the number of lines.
+// of the number of lines. The charts are drawn from the Go files, Languages
+// and Packages from the files of every known language.
 type CodeCount struct {
 	Summary struct {
-		LineCount     int `json:"line_count"`
-		CommentCount  int `json:"comment_count"`
-		FunctionCount int `json:"function_count"`
-		FileCount     int `json:"file_count"`
+		LineCount      int `json:"line_count"`
+		CommentCount   int `json:"comment_count"`
+		FunctionCount  int `json:"function_count"`
+		FileCount      int `json:"file_count"`
+		BlankCount     int `json:"blank_count"`
+		TestCount      int `json:"test_count"`
+		GeneratedCount int `json:"generated_count"`
+		LanguageCount  int `json:"language_count"`
 	} `json:"summary"`
 	Content struct {
-		Pkg               []string `json:"pkg"`
-		PkgLineCount      []int    `json:"pkg_line_count"`
-		PkgCommentCount   []int    `json:"pkg_comment_count"`
-		PkgFunctionCount  []int    `json:"pkg_function_count"`
-		File              []string `json:"file"`
-		FileLineCount     []int    `json:"file_line_count"`
-		FileCommentCount  []int    `json:"file_comment_count"`
-		FileFunctionCount []int    `json:"file_function_count"`
+		Pkg               []string        `json:"pkg"`
+		PkgLineCount      []int           `json:"pkg_line_count"`
+		PkgCommentCount   []int           `json:"pkg_comment_count"`
+		PkgFunctionCount  []int           `json:"pkg_function_count"`
+		File              []string        `json:"file"`
+		FileLineCount     []int           `json:"file_line_count"`
+		FileCommentCount  []int           `json:"file_comment_count"`
+		FileFunctionCount []int           `json:"file_function_count"`
+		Languages         []CodeCountItem `json:"languages"`
+		Packages          []CodeCountItem `json:"packages"`
 	} `json:"content"`
 }
 
-// CodeSmellItem is a struct that contains path and cyclo.
+// CodeSmellItem is a struct that contains path, cyclo, cognitive complexity
+// and max depth of a function.
 type CodeSmellItem struct {
-	Path  string `json:"path"`
-	Cyclo int    `json:"cyclo"`
+	Path      string `json:"path"`
+	Cyclo     int    `json:"cyclo"`
+	Cognitive int    `json:"cognitive"`
+	Depth     int    `json:"depth"`
 }
 
 // CodeSmell is a struct that contains Summary and Content. It represents the taste of
@@ -248,11 +382,269 @@ type CodeSmell struct {
 		CycloAvg   int `json:"cyclo_avg"`
 		CycloHigh  int `json:"cyclo_high"`
 		CycloGrave int `json:"cyclo_grave"`
+
+		CognitiveAvg   int `json:"cognitive_avg"`
+		CognitiveHigh  int `json:"cognitive_high"`
+		CognitiveGrave int `json:"cognitive_grave"`
 	} `json:"summary"`
 	Content struct {
 		Percentage map[string]int  `json:"percentage"`
 		Pkg        []string        `json:"pkg"`
 		Cyclo      []int           `json:"cyclo"`
 		List       []CodeSmellItem `json:"list"`
+
+		CognitiveThresholds CognitiveThresholds `json:"cognitive_thresholds"`
+	} `json:"content"`
+}
+
+// BenchmarkItem is the mean result of one benchmark.
+type BenchmarkItem struct {
+	Pkg         string  `json:"pkg"`
+	Name        string  `json:"name"`
+	NsPerOp     float64 `json:"ns_per_op"`
+	BytesPerOp  float64 `json:"bytes_per_op"`
+	AllocsPerOp float64 `json:"allocs_per_op"`
+}
+
+// BenchmarkDeltaItem is the significant change of one benchmark unit compared
+// with the baseline report. Change is in percent.
+type BenchmarkDeltaItem struct {
+	Pkg        string  `json:"pkg"`
+	Name       string  `json:"name"`
+	Unit       string  `json:"unit"`
+	Old        float64 `json:"old"`
+	New        float64 `json:"new"`
+	Change     float64 `json:"change"`
+	P          float64 `json:"p"`
+	Regression bool    `json:"regression"`
+}
+
+// CodeBenchmark is a struct that contains Summary and Content. It represents the
+// benchmark results of the project and their changes since the baseline report.
+type CodeBenchmark struct {
+	Summary struct {
+		BenchmarkNum   int `json:"benchmark_num"`
+		RegressionNum  int `json:"regression_num"`
+		ImprovementNum int `json:"improvement_num"`
+	} `json:"summary"`
+	Content struct {
+		List   []BenchmarkItem      `json:"list"`
+		Deltas []BenchmarkDeltaItem `json:"deltas"`
+	} `json:"content"`
+}
+
+// MutantItem is a surviving mutant of the package Pkg, Diff shows the change.
+type MutantItem struct {
+	Pkg      string `json:"pkg"`
+	File     string `json:"file"`
+	Line     int    `json:"line"`
+	Operator string `json:"operator"`
+	Diff     string `json:"diff"`
+}
+
+// CodeMutation is a struct that contains Summary and Content. It represents the
+// result of the mutation testing, Score is the percentage of killed mutants.
+type CodeMutation struct {
+	Summary struct {
+		Score     float64 `json:"score"`
+		Killed    int     `json:"killed"`
+		Survived  int     `json:"survived"`
+		NotViable int     `json:"not_viable"`
+	} `json:"summary"`
+	Content struct {
+		Pkg       []string     `json:"pkg"`
+		Score     []float64    `json:"score"`
+		Survivors []MutantItem `json:"survivors"`
+	} `json:"content"`
+}
+
+// UntestedItem is the tested exported API of the package Pkg. Ratio is the
+// percentage of the Exported functions and methods that are Tested.
+type UntestedItem struct {
+	Pkg      string          `json:"pkg"`
+	HasTests bool            `json:"has_tests"`
+	Exported int             `json:"exported"`
+	Tested   int             `json:"tested"`
+	Ratio    float64         `json:"ratio"`
+	Untested []untested.Func `json:"untested"`
+}
+
+// CodeUntested is a struct that contains Summary and Content. It represents the
+// packages without tests and the exported API that no test calls, Ratio is the
+// percentage of the exported API of the project that is tested.
+type CodeUntested struct {
+	Summary struct {
+		NoTestNum int     `json:"no_test_num"`
+		Exported  int     `json:"exported"`
+		Tested    int     `json:"tested"`
+		Ratio     float64 `json:"ratio"`
+	} `json:"summary"`
+	Content struct {
+		NoTest   []string       `json:"no_test"`
+		Packages []UntestedItem `json:"packages"`
+	} `json:"content"`
+}
+
+// FuncLenItem is a function of Size lines declared at Line of File.
+type FuncLenItem struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+	Size int    `json:"size"`
+}
+
+// FuncLenPackage contains functions of the package Pkg, whose functions may
+// have at most MaxLines lines.
+type FuncLenPackage struct {
+	Pkg      string        `json:"pkg"`
+	MaxLines int           `json:"max_lines"`
+	Funcs    []FuncLenItem `json:"funcs"`
+}
+
+// CodeFuncLen is a struct that contains Summary and Content. It represents the
+// length of the functions of the project: the histogram of their lengths,
+// whose bars are BucketSize lines wide, the longest functions of every package
+// and the functions implemented outside Go.
+type CodeFuncLen struct {
+	Summary struct {
+		Funcs      int     `json:"funcs"`
+		TooLong    int     `json:"too_long"`
+		Average    float64 `json:"average"`
+		Longest    int     `json:"longest"`
+		BucketSize int     `json:"bucket_size"`
+	} `json:"summary"`
+	Content struct {
+		Histogram []int            `json:"histogram"`
+		Packages  []FuncLenPackage `json:"packages"`
+		External  []FuncLenPackage `json:"external"`
+	} `json:"content"`
+}
+
+// MaintainabilityItem is a function with its Halstead metrics and its
+// maintainability index MI.
+type MaintainabilityItem struct {
+	Pkg        string  `json:"pkg"`
+	Name       string  `json:"name"`
+	File       string  `json:"file"`
+	Line       int     `json:"line"`
+	Lines      int     `json:"lines"`
+	Cyclo      int     `json:"cyclo"`
+	Volume     float64 `json:"volume"`
+	Difficulty float64 `json:"difficulty"`
+	Effort     float64 `json:"effort"`
+	Bugs       float64 `json:"bugs"`
+	MI         float64 `json:"mi"`
+}
+
+// MaintainabilityPackage is the maintainability index of the package Pkg and
+// of its files.
+type MaintainabilityPackage struct {
+	Pkg   string                `json:"pkg"`
+	MI    float64               `json:"mi"`
+	Files []FileMaintainability `json:"files"`
+}
+
+// CodeMaintainability is a struct that contains Summary and Content. It
+// represents the maintainability index of the project, of its packages and
+// files for the heat-map and of its least maintainable functions. Functions
+// whose index is below Low are hard to maintain, below Moderate moderately
+// maintainable.
+type CodeMaintainability struct {
+	Summary struct {
+		MI       float64 `json:"mi"`
+		Funcs    int     `json:"funcs"`
+		Low      int     `json:"low"`
+		Moderate int     `json:"moderate"`
+		Bugs     float64 `json:"bugs"`
+	} `json:"summary"`
+	Content struct {
+		Low      int                      `json:"low"`
+		Moderate int                      `json:"moderate"`
+		Packages []MaintainabilityPackage `json:"packages"`
+		Funcs    []MaintainabilityItem    `json:"funcs"`
+	} `json:"content"`
+}
+
+// PackageMetricsItem is the coupling, instability, abstractness and distance
+// from the main sequence of the package Pkg.
+type PackageMetricsItem struct {
+	Pkg string `json:"pkg"`
+	depend.PackageMetrics
+}
+
+// CodePackageMetrics is a struct that contains Summary and Content. It
+// represents the package metrics of Robert C. Martin for every package of the
+// project, the farthest from the main sequence first, and the number of
+// packages in the zones of pain and uselessness.
+type CodePackageMetrics struct {
+	Summary struct {
+		Packages    int     `json:"packages"`
+		Pain        int     `json:"pain"`
+		Uselessness int     `json:"uselessness"`
+		Distance    float64 `json:"distance"`
+	} `json:"summary"`
+	Content struct {
+		Packages []PackageMetricsItem `json:"packages"`
+	} `json:"content"`
+}
+
+// CodeDependGraph is a struct that contains Summary and Content. It
+// represents the exports of the dependency graph to dot, Mermaid and json,
+// and the number of its nodes and links.
+type CodeDependGraph struct {
+	Summary struct {
+		Nodes int `json:"nodes"`
+		Links int `json:"links"`
+	} `json:"summary"`
+	Content struct {
+		DOT     string `json:"dot"`
+		Mermaid string `json:"mermaid"`
+		JSON    string `json:"json"`
+	} `json:"content"`
+}
+
+// CodeArchitecture is a struct that contains Summary and Content. It
+// represents the imports that break the architecture rules of the config
+// file or close an import cycle, and the number of packages declaring them.
+type CodeArchitecture struct {
+	Summary struct {
+		Violations int `json:"violations"`
+		Packages   int `json:"packages"`
+	} `json:"summary"`
+	Content struct {
+		Violations []ArchitectureViolation `json:"violations"`
+	} `json:"content"`
+}
+
+// CodeModules is a struct that contains Summary and Content. It represents
+// the inventory of the third-party modules, the direct ones first, and the
+// number of direct, indirect and replaced modules and of those whose license
+// is missing or unknown.
+type CodeModules struct {
+	Summary struct {
+		Modules    int `json:"modules"`
+		Direct     int `json:"direct"`
+		Indirect   int `json:"indirect"`
+		Replaced   int `json:"replaced"`
+		Unlicensed int `json:"unlicensed"`
+	} `json:"summary"`
+	Content struct {
+		Modules []modules.Module `json:"modules"`
+	} `json:"content"`
+}
+
+// CodeVuln is a struct that contains Summary and Content. It represents the
+// known vulnerabilities of the third-party modules, the reachable ones first,
+// and the number of findings, of those reachable from the project code, of
+// the modules concerned and of the findings with a fixed version.
+type CodeVuln struct {
+	Summary struct {
+		Findings  int `json:"findings"`
+		Reachable int `json:"reachable"`
+		Modules   int `json:"modules"`
+		Fixable   int `json:"fixable"`
+	} `json:"summary"`
+	Content struct {
+		Findings []vuln.Finding `json:"findings"`
 	} `json:"content"`
 }
@@ -15,6 +15,8 @@ package engine
 
 import (
 	"log"
+	"math"
+	"reflect"
 	"sync"
 	"testing"
 
@@ -73,3 +75,37 @@ func Test_Engine(t *testing.T) {
 		glog.Errorln(err)
 	}
 }
+
+func Test_GetFinalScore(t *testing.T) {
+	reporter := NewReporter("foo", "foo", "foo", "baz")
+	if score := reporter.GetFinalScore(); score != 0 {
+		t.Errorf("want 0 without metrics, but got %v", score)
+	}
+	reporter.Metrics["ATips"] = Metric{Weight: 0.6, Percentage: 100}
+	reporter.Metrics["BTips"] = Metric{Weight: 0.55, Percentage: 100}
+	if score := math.Round(reporter.GetFinalScore()); score != 100 {
+		t.Errorf("want 100 for a clean project, but got %v", score)
+	}
+	reporter.Metrics["BTips"] = Metric{Weight: 0.2, Percentage: 40}
+	if score := math.Round(reporter.GetFinalScore()); score != 85 {
+		t.Errorf("want 85, but got %v", score)
+	}
+}
+
+func Test_ImportPaths(t *testing.T) {
+	parameters := StrategyParameter{AllDirs: map[string]string{
+		"foo/b":                    "/go/src/foo/b",
+		"foo/a":                    "/go/src/foo/a",
+		"foo/testdata/fixture":     "/go/src/foo/testdata/fixture",
+		"foo/mytestdata":           "/go/src/foo/mytestdata",
+		"foo/a/testdata/src/fixed": "/go/src/foo/a/testdata/src/fixed",
+	}}
+	want := []string{"foo/a", "foo/b", "foo/mytestdata"}
+	if got := parameters.ImportPaths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+	want = []string{"/go/src/foo/a", "/go/src/foo/b", "/go/src/foo/mytestdata"}
+	if got := parameters.PackagePaths(); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
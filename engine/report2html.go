@@ -23,6 +23,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -30,6 +31,18 @@ import (
 	"github.com/golang/glog"
 	"github.com/json-iterator/go"
 
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/aligncheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/benchmark"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/cognitive"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/countcode"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/gofmt"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/halstead"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/modules"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/mutation"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/untested"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/vuln"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
@@ -37,6 +50,10 @@ var (
 	issues int
 )
 
+// maintainabilityTop is the number of least maintainable functions listed in
+// the html report.
+const maintainabilityTop = 50
+
 // UnitTest is a struct that contains some features in a report of html.
 //         GoReporter HTML Report Features
 //
@@ -61,6 +78,26 @@ var (
 //    +-----------------------+----------------------------------------------+
 //    | DepGraph              | Depend graph of all packages in the project  |
 //    +-----------------------+----------------------------------------------+
+//    | DepGraphExport        | Depend graph in dot, Mermaid and json        |
+//    +-----------------------+----------------------------------------------+
+//    | Modules               | Third-party modules and their licenses       |
+//    +-----------------------+----------------------------------------------+
+//    | Vuln                  | Known vulnerabilities of the modules         |
+//    +-----------------------+----------------------------------------------+
+//    | Benchmark             | Benchmark results and regressions            |
+//    +-----------------------+----------------------------------------------+
+//    | Mutation              | Mutation score and surviving mutants         |
+//    +-----------------------+----------------------------------------------+
+//    | Untested              | Packages and exported API without tests      |
+//    +-----------------------+----------------------------------------------+
+//    | FuncLen               | Function lengths and the longest functions   |
+//    +-----------------------+----------------------------------------------+
+//    | Maintainability       | Maintainability index and Halstead metrics   |
+//    +-----------------------+----------------------------------------------+
+//    | PackageMetrics        | Coupling and abstractness of all packages    |
+//    +-----------------------+----------------------------------------------+
+//    | Architecture          | Imports breaking the architecture rules      |
+//    +-----------------------+----------------------------------------------+
 //    | Date                  | Date assessment of the project               |
 //    +-----------------------+----------------------------------------------+
 //    | LastRefresh           | Last refresh time of one project             |
@@ -80,6 +117,16 @@ type HtmlData struct {
 	CodeCount        string
 	CodeSmell        string
 	DepGraph         template.HTML
+	DepGraphExport   string
+	Benchmark        string
+	Mutation         string
+	Untested         string
+	FuncLen          string
+	Maintainability  string
+	PackageMetrics   string
+	Architecture     string
+	Modules          string
+	Vuln             string
 
 	Date                 string
 	LastRefresh          time.Time `json:"last_refresh"`
@@ -91,14 +138,40 @@ type HtmlData struct {
 // need to convert the data.The result will be saved in the hd's attributes.
 func (hd *HtmlData) converterCodeTest(structData Reporter) {
 	var codeTestHtmlData CodeTest
+	codeTestHtmlData.Content.NoTest = make([]string, 0)
+	codeTestHtmlData.Content.Failed = make([]string, 0)
+	codeTestHtmlData.Content.Quality = make([]PackageTestQuality, 0)
+	// UnitTest only runs the packages that have test files, Untested knows
+	// the others of all the packages of the project.
+	for pkgName, summary := range structData.Metrics["UntestedTips"].Summaries {
+		if _, ok := structData.Metrics["UnitTestTips"].Summaries[pkgName]; ok {
+			continue
+		}
+		var pkg untested.Package
+		if err := jsoniter.Unmarshal([]byte(summary.Description), &pkg); err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		if !pkg.HasTests {
+			codeTestHtmlData.Content.NoTest = append(codeTestHtmlData.Content.NoTest, pkgName)
+		}
+	}
+	sort.Strings(codeTestHtmlData.Content.NoTest)
 	if result, ok := structData.Metrics["UnitTestTips"]; ok {
 		var totalTime float64
+		var testLines, codeLines int
 		for pkgName, testRes := range result.Summaries {
 			var packageUnitTestResult PackageTest
 			jsoniter.Unmarshal([]byte(testRes.Description), &packageUnitTestResult)
 			srcLastIndex := strings.LastIndex(pkgName, hd.Project)
+			codeTestHtmlData.Content.Quality = append(codeTestHtmlData.Content.Quality, PackageTestQuality{
+				Pkg:         pkgName,
+				TestQuality: packageUnitTestResult.Quality,
+			})
+			testLines = testLines + packageUnitTestResult.Quality.TestLines
+			codeLines = codeLines + packageUnitTestResult.Quality.CodeLines
 			if !packageUnitTestResult.IsPass {
-				codeTestHtmlData.Content.NoTest = append(codeTestHtmlData.Content.NoTest, pkgName)
+				codeTestHtmlData.Content.Failed = append(codeTestHtmlData.Content.Failed, pkgName)
 			} else if srcLastIndex < len(pkgName) && srcLastIndex >= 0 {
 				codeTestHtmlData.Content.Pkg = append(codeTestHtmlData.Content.Pkg, pkgName[srcLastIndex:])
 				codeTestHtmlData.Content.Time = append(codeTestHtmlData.Content.Time, packageUnitTestResult.Time)
@@ -110,11 +183,19 @@ func (hd *HtmlData) converterCodeTest(structData Reporter) {
 			}
 		}
 		codeTestHtmlData.Summary.TotalTime, _ = strconv.ParseFloat(strconv.FormatFloat(totalTime, 'f', 1, 64), 64)
+		if codeLines > 0 {
+			codeTestHtmlData.Summary.TestCodeRatio, _ = strconv.ParseFloat(strconv.FormatFloat(float64(testLines)/float64(codeLines), 'f', 2, 64), 64)
+		}
+		sort.Slice(codeTestHtmlData.Content.Quality, func(i, j int) bool {
+			return codeTestHtmlData.Content.Quality[i].Pkg < codeTestHtmlData.Content.Quality[j].Pkg
+		})
 		codeTestHtmlData.Summary.CodeCover, _ = strconv.ParseFloat(strconv.FormatFloat(result.Percentage, 'f', 1, 64), 64)
-		if (len(codeTestHtmlData.Content.Pkg) + len(codeTestHtmlData.Content.NoTest)) == 0 {
+		sort.Strings(codeTestHtmlData.Content.Failed)
+		allPackages := len(codeTestHtmlData.Content.Pkg) + len(codeTestHtmlData.Content.Failed) + len(codeTestHtmlData.Content.NoTest)
+		if allPackages == 0 {
 			codeTestHtmlData.Summary.PackageCover = 0
 		} else {
-			codeTestHtmlData.Summary.PackageCover, _ = strconv.ParseFloat(strconv.FormatFloat(100*float64(len(codeTestHtmlData.Content.Pkg))*1.0/float64(len(codeTestHtmlData.Content.Pkg)+len(codeTestHtmlData.Content.NoTest)), 'f', 1, 64), 64)
+			codeTestHtmlData.Summary.PackageCover, _ = strconv.ParseFloat(strconv.FormatFloat(100*float64(len(codeTestHtmlData.Content.Pkg))*1.0/float64(allPackages), 'f', 1, 64), 64)
 		}
 	}
 
@@ -150,6 +231,11 @@ func (hd *HtmlData) converterCodeStyle(structData Reporter) {
 	codeStyleHtmlData.Summary.IssuesNum = codeStyleHtmlData.Summary.IssuesNum + codeVetHtmlData.issuesNum
 	codeStyleHtmlData.Content.GoVet = codeVetHtmlData
 
+	codeErrorCheckHtmlData := converterErrorCheck(structData)
+	codeStyleHtmlData.Summary.FilesNum = codeStyleHtmlData.Summary.FilesNum + codeErrorCheckHtmlData.filesNum
+	codeStyleHtmlData.Summary.IssuesNum = codeStyleHtmlData.Summary.IssuesNum + codeErrorCheckHtmlData.issuesNum
+	codeStyleHtmlData.Content.ErrorCheck = codeErrorCheckHtmlData
+
 	stringCodeStyleJson, err := jsoniter.Marshal(codeStyleHtmlData)
 	if err != nil {
 		glog.Errorln(err)
@@ -182,6 +268,16 @@ func (hd *HtmlData) converterCodeOptimization(structData Reporter) {
 	codeOptimizationHtmlData.Summary.IssuesNum = codeOptimizationHtmlData.Summary.IssuesNum + codeInterfacerHtmlData.issuesNum
 	codeOptimizationHtmlData.Content.InterfacerCode = codeInterfacerHtmlData
 
+	staticCodeHtmlData := converterStaticCheck(structData)
+	codeOptimizationHtmlData.Summary.FilesNum = codeOptimizationHtmlData.Summary.FilesNum + staticCodeHtmlData.filesNum
+	codeOptimizationHtmlData.Summary.IssuesNum = codeOptimizationHtmlData.Summary.IssuesNum + staticCodeHtmlData.issuesNum
+	codeOptimizationHtmlData.Content.StaticCode = staticCodeHtmlData
+
+	alignCodeHtmlData := converterAlignCode(structData)
+	codeOptimizationHtmlData.Summary.FilesNum = codeOptimizationHtmlData.Summary.FilesNum + alignCodeHtmlData.filesNum
+	codeOptimizationHtmlData.Summary.IssuesNum = codeOptimizationHtmlData.Summary.IssuesNum + alignCodeHtmlData.issuesNum
+	codeOptimizationHtmlData.Content.AlignCode = alignCodeHtmlData
+
 	stringCodeOptimizationJson, err := jsoniter.Marshal(codeOptimizationHtmlData)
 	if err != nil {
 		glog.Errorln(err)
@@ -252,6 +348,48 @@ func (hd *HtmlData) converterCodeSmell(structData Reporter) {
 		codeSmellHtmlData.Summary.CycloGrave = codeSmellHtmlData.Content.Percentage["50+"]
 	}
 
+	// Cognitive complexity and depth are measured at the same positions as
+	// the cyclo, they are added to the functions of the list.
+	depths := make(map[string]int)
+	if result, ok := structData.Metrics["DepthTips"]; ok {
+		for _, summary := range result.Summaries {
+			for _, erroru := range summary.Errors {
+				depths[erroru.ErrorString] = erroru.LineNumber
+			}
+		}
+	}
+	cognitives := make(map[string]int)
+	if result, ok := structData.Metrics["CognitiveTips"]; ok {
+		var compNum, compSum int
+		thresholds := &codeSmellHtmlData.Content.CognitiveThresholds
+		thresholds.High, thresholds.Grave = cognitive.DefaultHigh, cognitive.DefaultGrave
+		for _, summary := range result.Summaries {
+			if summary.Description != "" {
+				if err := jsoniter.Unmarshal([]byte(summary.Description), thresholds); err != nil {
+					glog.Errorln(err)
+				}
+			}
+			for _, erroru := range summary.Errors {
+				cognitives[erroru.ErrorString] = erroru.LineNumber
+				compNum++
+				compSum = compSum + erroru.LineNumber
+				if erroru.LineNumber >= thresholds.Grave {
+					codeSmellHtmlData.Summary.CognitiveGrave++
+				} else if erroru.LineNumber >= thresholds.High {
+					codeSmellHtmlData.Summary.CognitiveHigh++
+				}
+			}
+		}
+		if compNum > 0 {
+			codeSmellHtmlData.Summary.CognitiveAvg = compSum / compNum
+		}
+	}
+	for i := range codeSmellHtmlData.Content.List {
+		item := &codeSmellHtmlData.Content.List[i]
+		item.Cognitive = cognitives[item.Path]
+		item.Depth = depths[item.Path]
+	}
+
 	stringCodeSmellJson, err := jsoniter.Marshal(codeSmellHtmlData)
 	if err != nil {
 		glog.Errorln(err)
@@ -259,6 +397,155 @@ func (hd *HtmlData) converterCodeSmell(structData Reporter) {
 	hd.CodeSmell = string(stringCodeSmellJson)
 }
 
+// converterBenchmark provides function that convert benchmark data into the
+// format required in the html template.Only significant changes are listed,
+// regressions first.
+func (hd *HtmlData) converterBenchmark(structData Reporter) {
+	var benchmarkHtmlData CodeBenchmark
+	benchmarkHtmlData.Content.List = make([]BenchmarkItem, 0)
+	benchmarkHtmlData.Content.Deltas = make([]BenchmarkDeltaItem, 0)
+	if result, ok := structData.Metrics["BenchmarkTips"]; ok {
+		for pkgName, summary := range result.Summaries {
+			var packageBenchmark PackageBenchmark
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &packageBenchmark); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			for _, res := range packageBenchmark.Results {
+				benchmarkHtmlData.Content.List = append(benchmarkHtmlData.Content.List, BenchmarkItem{
+					Pkg:         pkgName,
+					Name:        res.Name,
+					NsPerOp:     res.Mean(benchmark.UnitNsPerOp),
+					BytesPerOp:  res.Mean(benchmark.UnitBytesPerOp),
+					AllocsPerOp: res.Mean(benchmark.UnitAllocsPerOp),
+				})
+			}
+			for _, delta := range packageBenchmark.Deltas {
+				if !delta.Significant {
+					continue
+				}
+				if delta.Regression {
+					benchmarkHtmlData.Summary.RegressionNum++
+				} else if delta.Change < 0 {
+					benchmarkHtmlData.Summary.ImprovementNum++
+				}
+				benchmarkHtmlData.Content.Deltas = append(benchmarkHtmlData.Content.Deltas, BenchmarkDeltaItem{
+					Pkg:        pkgName,
+					Name:       delta.Name,
+					Unit:       delta.Unit,
+					Old:        delta.Old,
+					New:        delta.New,
+					Change:     delta.Change,
+					P:          delta.P,
+					Regression: delta.Regression,
+				})
+			}
+		}
+		benchmarkHtmlData.Summary.BenchmarkNum = len(benchmarkHtmlData.Content.List)
+		sort.SliceStable(benchmarkHtmlData.Content.Deltas, func(i, j int) bool {
+			return benchmarkHtmlData.Content.Deltas[i].Change > benchmarkHtmlData.Content.Deltas[j].Change
+		})
+	}
+
+	stringBenchmarkJson, err := jsoniter.Marshal(benchmarkHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.Benchmark = string(stringBenchmarkJson)
+}
+
+// converterMutation provides function that convert mutation testing data into
+// the format required in the html template.
+func (hd *HtmlData) converterMutation(structData Reporter) {
+	var mutationHtmlData CodeMutation
+	mutationHtmlData.Content.Pkg = make([]string, 0)
+	mutationHtmlData.Content.Score = make([]float64, 0)
+	mutationHtmlData.Content.Survivors = make([]MutantItem, 0)
+	if result, ok := structData.Metrics["MutationTips"]; ok {
+		for pkgName, summary := range result.Summaries {
+			var packageMutation mutation.Result
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &packageMutation); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			mutationHtmlData.Summary.Killed = mutationHtmlData.Summary.Killed + packageMutation.Killed
+			mutationHtmlData.Summary.Survived = mutationHtmlData.Summary.Survived + packageMutation.Survived
+			mutationHtmlData.Summary.NotViable = mutationHtmlData.Summary.NotViable + packageMutation.NotViable
+			if packageMutation.Killed+packageMutation.Survived > 0 {
+				mutationHtmlData.Content.Pkg = append(mutationHtmlData.Content.Pkg, pkgName)
+				score, _ := strconv.ParseFloat(strconv.FormatFloat(packageMutation.Score, 'f', 1, 64), 64)
+				mutationHtmlData.Content.Score = append(mutationHtmlData.Content.Score, score)
+			}
+			for _, mutant := range packageMutation.Survivors {
+				mutationHtmlData.Content.Survivors = append(mutationHtmlData.Content.Survivors, MutantItem{
+					Pkg:      pkgName,
+					File:     mutant.File,
+					Line:     mutant.Line,
+					Operator: mutant.Operator,
+					Diff:     mutant.Diff,
+				})
+			}
+		}
+		mutationHtmlData.Summary.Score, _ = strconv.ParseFloat(strconv.FormatFloat(result.Percentage, 'f', 1, 64), 64)
+	}
+
+	stringMutationJson, err := jsoniter.Marshal(mutationHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.Mutation = string(stringMutationJson)
+}
+
+// converterUntested provides function that convert the untested packages and
+// exported API into the format required in the html template. Packages are
+// listed from the least tested one.
+func (hd *HtmlData) converterUntested(structData Reporter) {
+	var untestedHtmlData CodeUntested
+	untestedHtmlData.Content.NoTest = make([]string, 0)
+	untestedHtmlData.Content.Packages = make([]UntestedItem, 0)
+	if result, ok := structData.Metrics["UntestedTips"]; ok {
+		for pkgName, summary := range result.Summaries {
+			var pkg untested.Package
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &pkg); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			if !pkg.HasTests {
+				untestedHtmlData.Content.NoTest = append(untestedHtmlData.Content.NoTest, pkgName)
+			}
+			untestedHtmlData.Summary.Exported = untestedHtmlData.Summary.Exported + pkg.Exported
+			untestedHtmlData.Summary.Tested = untestedHtmlData.Summary.Tested + pkg.Tested
+			ratio, _ := strconv.ParseFloat(strconv.FormatFloat(pkg.Ratio, 'f', 1, 64), 64)
+			untestedHtmlData.Content.Packages = append(untestedHtmlData.Content.Packages, UntestedItem{
+				Pkg:      pkgName,
+				HasTests: pkg.HasTests,
+				Exported: pkg.Exported,
+				Tested:   pkg.Tested,
+				Ratio:    ratio,
+				Untested: pkg.Untested,
+			})
+		}
+		sort.Strings(untestedHtmlData.Content.NoTest)
+		sort.Slice(untestedHtmlData.Content.Packages, func(i, j int) bool {
+			a, b := untestedHtmlData.Content.Packages[i], untestedHtmlData.Content.Packages[j]
+			if a.Ratio != b.Ratio {
+				return a.Ratio < b.Ratio
+			}
+			return a.Pkg < b.Pkg
+		})
+		untestedHtmlData.Summary.NoTestNum = len(untestedHtmlData.Content.NoTest)
+		if untestedHtmlData.Summary.Exported > 0 {
+			untestedHtmlData.Summary.Ratio, _ = strconv.ParseFloat(strconv.FormatFloat(100*float64(untestedHtmlData.Summary.Tested)/float64(untestedHtmlData.Summary.Exported), 'f', 1, 64), 64)
+		}
+	}
+
+	stringUntestedJson, err := jsoniter.Marshal(untestedHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.Untested = string(stringUntestedJson)
+}
+
 // sortCycloByComp implements the quick sorting algorithm sort list by complexity.
 func sortCycloByComp(input []CodeSmellItem, l, u int) {
 	if l < u {
@@ -308,26 +595,43 @@ func (hd *HtmlData) converterCodeCount(structData Reporter) {
 	}
 	pkgCommentCount := make(map[string]int, 0)
 	pkgLineCount := make(map[string]int, 0)
+	languages := make(map[string]*CodeCountItem, 0)
+	packages := make(map[string]*CodeCountItem, 0)
 
 	if result, ok := structData.Metrics["CountCodeTips"]; ok {
 		for fileName, codeCount := range result.Summaries {
-			counts := strings.Split(codeCount.Description, ";")
-			if len(counts) == 4 {
-				codeCountHtmlData.Content.File = append(codeCountHtmlData.Content.File, fileName)
-				fileCommentCount, _ := strconv.Atoi(counts[2])
-				codeCountHtmlData.Content.FileCommentCount = append(codeCountHtmlData.Content.FileCommentCount, fileCommentCount)
-
-				codeCountHtmlData.Content.FileFunctionCount = append(codeCountHtmlData.Content.FileFunctionCount, fileFuncsCount[fileName])
-				// Add into summary.
-				codeCountHtmlData.Summary.FunctionCount = codeCountHtmlData.Summary.FunctionCount + fileFuncsCount[fileName]
-				fileLineCount, _ := strconv.Atoi(counts[1])
-				codeCountHtmlData.Content.FileLineCount = append(codeCountHtmlData.Content.FileLineCount, fileLineCount)
-
-				sepPkgIndex := strings.LastIndex(fileName, string(filepath.Separator))
-				if sepPkgIndex >= 0 && sepPkgIndex < len(fileName) {
-					pkgCommentCount[string(fileName[0:sepPkgIndex])] = pkgCommentCount[string(fileName[0:sepPkgIndex])] + fileCommentCount
-					pkgLineCount[string(fileName[0:sepPkgIndex])] = pkgLineCount[string(fileName[0:sepPkgIndex])] + fileLineCount
-				}
+			file, ok := countCodeFile(fileName, codeCount.Description)
+			if !ok {
+				continue
+			}
+			addCodeCount(languages, file.Language, file)
+			addCodeCount(packages, filepath.Dir(fileName), file)
+			if file.Language != "Go" {
+				continue
+			}
+			codeCountHtmlData.Summary.FileCount++
+			codeCountHtmlData.Summary.BlankCount = codeCountHtmlData.Summary.BlankCount + file.BlankLines
+			switch file.Kind {
+			case countcode.KindTest:
+				codeCountHtmlData.Summary.TestCount = codeCountHtmlData.Summary.TestCount + file.CodeLines
+			case countcode.KindGenerated:
+				codeCountHtmlData.Summary.GeneratedCount = codeCountHtmlData.Summary.GeneratedCount + file.CodeLines
+			}
+
+			codeCountHtmlData.Content.File = append(codeCountHtmlData.Content.File, fileName)
+			fileCommentCount := file.CommentLines
+			codeCountHtmlData.Content.FileCommentCount = append(codeCountHtmlData.Content.FileCommentCount, fileCommentCount)
+
+			codeCountHtmlData.Content.FileFunctionCount = append(codeCountHtmlData.Content.FileFunctionCount, fileFuncsCount[fileName])
+			// Add into summary.
+			codeCountHtmlData.Summary.FunctionCount = codeCountHtmlData.Summary.FunctionCount + fileFuncsCount[fileName]
+			fileLineCount := file.CodeLines
+			codeCountHtmlData.Content.FileLineCount = append(codeCountHtmlData.Content.FileLineCount, fileLineCount)
+
+			sepPkgIndex := strings.LastIndex(fileName, string(filepath.Separator))
+			if sepPkgIndex >= 0 && sepPkgIndex < len(fileName) {
+				pkgCommentCount[string(fileName[0:sepPkgIndex])] = pkgCommentCount[string(fileName[0:sepPkgIndex])] + fileCommentCount
+				pkgLineCount[string(fileName[0:sepPkgIndex])] = pkgLineCount[string(fileName[0:sepPkgIndex])] + fileLineCount
 			}
 		}
 
@@ -341,8 +645,10 @@ func (hd *HtmlData) converterCodeCount(structData Reporter) {
 			codeCountHtmlData.Summary.CommentCount = codeCountHtmlData.Summary.CommentCount + commentCount
 			codeCountHtmlData.Summary.FunctionCount = codeCountHtmlData.Summary.FunctionCount + pkgFuncsCount[pkgName]
 		}
-		codeCountHtmlData.Summary.FileCount = len(result.Summaries)
 	}
+	codeCountHtmlData.Content.Languages = sortedCodeCounts(languages)
+	codeCountHtmlData.Content.Packages = sortedCodeCounts(packages)
+	codeCountHtmlData.Summary.LanguageCount = len(languages)
 
 	stringCodeCountJson, err := jsoniter.Marshal(codeCountHtmlData)
 	if err != nil {
@@ -351,43 +657,196 @@ func (hd *HtmlData) converterCodeCount(structData Reporter) {
 	hd.CodeCount = string(stringCodeCountJson)
 }
 
-// converterCyclo provides function that convert cyclo data into the
-// format required in the html template.It will extract from the structData
-// need to convert the data.The result will be saved in the hd's attributes.
-// func converterFunctionDepth(structData Reporter) {
-// 	if result, ok := structData.Metrics["DepthTips"]; ok {
-// 		for pkgName, summary := range result.Summaries {
-// 			depthTips := summary.Errors
-// 			depth := Depth{
-// 				Pkg:  pkgName,
-// 				Size: len(depthTips),
-// 			}
-// 			var infos []DepthInfo
-// 			for i := 0; i < len(depthTips); i++ {
-// 				depthTip := strings.Split(depthTips[i].ErrorString, ":")
-// 				if len(depthTip) >= 3 {
-// 					depthInfo := DepthInfo{
-// 						Comp: depthTips[i].LineNumber,
-// 						Info: strings.Join(depthTip[0:], ":"),
-// 					}
-// 					if depthTips[i].LineNumber > 3 {
-// 						hd.CycloBigThan15 = hd.CycloBigThan15 + 1
-// 						issues = issues + 1
-// 					}
-// 					infos = append(infos, depthInfo)
-// 				}
-// 			}
-// 			depth.Info = infos
-// 			depthHtmlRes = append(depthHtmlRes, depth)
-// 		}
-// 	}
-
-// 	stringDepthJson, err := jsoniter.Marshal(depthHtmlRes)
-// 	if err != nil {
-// 		glog.Errorln(err)
-// 	}
-// 	hd.Depths = string(stringDepthJson)
-// }
+// countCodeFile returns the line statistics of the summary of fileName. The
+// json reports of earlier versions only hold the Go files, as
+// "files;code;comment;total".
+func countCodeFile(fileName, description string) (countcode.File, bool) {
+	var file countcode.File
+	if err := jsoniter.Unmarshal([]byte(description), &file); err == nil {
+		return file, true
+	}
+	counts := strings.Split(description, ";")
+	if len(counts) != 4 {
+		return file, false
+	}
+	file = countcode.File{Path: fileName, Language: "Go", Kind: countcode.KindCode}
+	file.FileCount, _ = strconv.Atoi(counts[0])
+	file.CodeLines, _ = strconv.Atoi(counts[1])
+	file.CommentLines, _ = strconv.Atoi(counts[2])
+	file.TotalLines, _ = strconv.Atoi(counts[3])
+	return file, true
+}
+
+// addCodeCount adds the lines of file to the item name of items.
+func addCodeCount(items map[string]*CodeCountItem, name string, file countcode.File) {
+	item, ok := items[name]
+	if !ok {
+		item = &CodeCountItem{Name: name}
+		items[name] = item
+	}
+	item.Files = item.Files + file.FileCount
+	switch file.Kind {
+	case countcode.KindTest:
+		item.Test = item.Test + file.CodeLines
+	case countcode.KindGenerated:
+		item.Generated = item.Generated + file.CodeLines
+	default:
+		item.Code = item.Code + file.CodeLines
+	}
+	item.Comment = item.Comment + file.CommentLines
+	item.Blank = item.Blank + file.BlankLines
+	item.Total = item.Total + file.TotalLines
+}
+
+// sortedCodeCounts returns the items, the most lines first.
+func sortedCodeCounts(items map[string]*CodeCountItem) []CodeCountItem {
+	sorted := make([]CodeCountItem, 0, len(items))
+	for _, item := range items {
+		sorted = append(sorted, *item)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Total != sorted[j].Total {
+			return sorted[i].Total > sorted[j].Total
+		}
+		return sorted[i].Name < sorted[j].Name
+	})
+	return sorted
+}
+
+// converterFuncLen provides function that convert function length data into
+// the format required in the html template. The histograms of the packages are
+// summed and the packages are ranked by their longest function.
+func (hd *HtmlData) converterFuncLen(structData Reporter) {
+	var funcLenHtmlData CodeFuncLen
+	funcLenHtmlData.Content.Histogram = make([]int, 0)
+	funcLenHtmlData.Content.Packages = make([]FuncLenPackage, 0)
+	funcLenHtmlData.Content.External = make([]FuncLenPackage, 0)
+	if result, ok := structData.Metrics["FuncLenTips"]; ok {
+		var lines int
+		for pkgName, summary := range result.Summaries {
+			var packageFuncLen PackageFuncLen
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &packageFuncLen); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			funcLenHtmlData.Summary.Funcs = funcLenHtmlData.Summary.Funcs + packageFuncLen.Funcs
+			funcLenHtmlData.Summary.TooLong = funcLenHtmlData.Summary.TooLong + packageFuncLen.TooLong
+			funcLenHtmlData.Summary.BucketSize = packageFuncLen.BucketSize
+			lines = lines + packageFuncLen.Lines
+			for i, count := range packageFuncLen.Histogram {
+				if i >= len(funcLenHtmlData.Content.Histogram) {
+					funcLenHtmlData.Content.Histogram = append(funcLenHtmlData.Content.Histogram, 0)
+				}
+				funcLenHtmlData.Content.Histogram[i] = funcLenHtmlData.Content.Histogram[i] + count
+			}
+			if len(packageFuncLen.Longest) > 0 {
+				if packageFuncLen.Longest[0].Size > funcLenHtmlData.Summary.Longest {
+					funcLenHtmlData.Summary.Longest = packageFuncLen.Longest[0].Size
+				}
+				funcLenHtmlData.Content.Packages = append(funcLenHtmlData.Content.Packages, FuncLenPackage{
+					Pkg:      pkgName,
+					MaxLines: packageFuncLen.MaxLines,
+					Funcs:    packageFuncLen.Longest,
+				})
+			}
+			if len(packageFuncLen.External) > 0 {
+				funcLenHtmlData.Content.External = append(funcLenHtmlData.Content.External, FuncLenPackage{
+					Pkg:   pkgName,
+					Funcs: packageFuncLen.External,
+				})
+			}
+		}
+		if funcLenHtmlData.Summary.Funcs > 0 {
+			funcLenHtmlData.Summary.Average, _ = strconv.ParseFloat(strconv.FormatFloat(float64(lines)/float64(funcLenHtmlData.Summary.Funcs), 'f', 1, 64), 64)
+		}
+		sort.Slice(funcLenHtmlData.Content.Packages, func(i, j int) bool {
+			a, b := funcLenHtmlData.Content.Packages[i], funcLenHtmlData.Content.Packages[j]
+			if a.Funcs[0].Size != b.Funcs[0].Size {
+				return a.Funcs[0].Size > b.Funcs[0].Size
+			}
+			return a.Pkg < b.Pkg
+		})
+		sort.Slice(funcLenHtmlData.Content.External, func(i, j int) bool {
+			return funcLenHtmlData.Content.External[i].Pkg < funcLenHtmlData.Content.External[j].Pkg
+		})
+	}
+
+	stringFuncLenJson, err := jsoniter.Marshal(funcLenHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.FuncLen = string(stringFuncLenJson)
+}
+
+// converterMaintainability provides function that convert maintainability
+// data into the format required in the html template.The packages are sorted
+// by index, the least maintainable first, and only the least maintainable
+// functions of the project are listed.
+func (hd *HtmlData) converterMaintainability(structData Reporter) {
+	var maintainabilityHtmlData CodeMaintainability
+	maintainabilityHtmlData.Content.Low = halstead.Low
+	maintainabilityHtmlData.Content.Moderate = halstead.Moderate
+	maintainabilityHtmlData.Content.Packages = make([]MaintainabilityPackage, 0)
+	maintainabilityHtmlData.Content.Funcs = make([]MaintainabilityItem, 0)
+	if result, ok := structData.Metrics["MaintainabilityTips"]; ok {
+		funcs := make([]halstead.Func, 0)
+		for pkgName, summary := range result.Summaries {
+			var packageMaintainability PackageMaintainability
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &packageMaintainability); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			maintainabilityHtmlData.Summary.Bugs = maintainabilityHtmlData.Summary.Bugs + packageMaintainability.Bugs
+			maintainabilityHtmlData.Content.Packages = append(maintainabilityHtmlData.Content.Packages, MaintainabilityPackage{
+				Pkg:   pkgName,
+				MI:    packageMaintainability.MI,
+				Files: packageMaintainability.Files,
+			})
+			for _, f := range packageMaintainability.Funcs {
+				if f.MI < halstead.Low {
+					maintainabilityHtmlData.Summary.Low++
+				} else if f.MI < halstead.Moderate {
+					maintainabilityHtmlData.Summary.Moderate++
+				}
+				funcs = append(funcs, f)
+				maintainabilityHtmlData.Content.Funcs = append(maintainabilityHtmlData.Content.Funcs, MaintainabilityItem{
+					Pkg:        pkgName,
+					Name:       f.Name,
+					File:       f.File,
+					Line:       f.Line,
+					Lines:      f.Lines,
+					Cyclo:      f.Cyclo,
+					Volume:     f.Halstead.Volume,
+					Difficulty: f.Halstead.Difficulty,
+					Effort:     f.Halstead.Effort,
+					Bugs:       f.Halstead.Bugs,
+					MI:         f.MI,
+				})
+			}
+		}
+		maintainabilityHtmlData.Summary.Funcs = len(funcs)
+		maintainabilityHtmlData.Summary.MI = halstead.Index(funcs)
+		sort.Slice(maintainabilityHtmlData.Content.Packages, func(i, j int) bool {
+			a, b := maintainabilityHtmlData.Content.Packages[i], maintainabilityHtmlData.Content.Packages[j]
+			if a.MI != b.MI {
+				return a.MI < b.MI
+			}
+			return a.Pkg < b.Pkg
+		})
+		sort.SliceStable(maintainabilityHtmlData.Content.Funcs, func(i, j int) bool {
+			return maintainabilityHtmlData.Content.Funcs[i].MI < maintainabilityHtmlData.Content.Funcs[j].MI
+		})
+		if len(maintainabilityHtmlData.Content.Funcs) > maintainabilityTop {
+			maintainabilityHtmlData.Content.Funcs = maintainabilityHtmlData.Content.Funcs[:maintainabilityTop]
+		}
+	}
+
+	stringMaintainabilityJson, err := jsoniter.Marshal(maintainabilityHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.Maintainability = string(stringMaintainabilityJson)
+}
 
 // converterSimple provides function that convert simplecode data into the
 // format required in the html template.It will extract from the structData
@@ -482,11 +941,21 @@ func converterCodeInterfacer(structData Reporter) (interfacerHtmlData StyleItem)
 // converterCopy provides function that convert copycode data into the
 // format required in the html template.It will extract from the structData
 // need to convert the data.The result will be saved in the hd's attributes.
+// The groups saved as json in the descriptions also get their similarity and
+// the side-by-side diffs of their fragments, the older ones only their files.
 func converterCopyCode(structData Reporter) (copyHtmlData CopyItem) {
-	copyHtmlData.Label = `Find code clones. So far it can find clones only in the Go source files. The method uses suffix tree for serialized ASTs. It ignores values of AST nodes.`
+	copyHtmlData.Label = `Find code clones. So far it can find clones only in the Go source files. The method uses suffix tree for serialized ASTs. Depending on the settings, it ignores the names of identifiers and merges the clones separated by small edits.`
+	copyHtmlData.Groups = make([]CopyGroup, 0)
+	copyHtmlData.Duplication.Packages = make([]CopyPackage, 0)
 	if result, ok := structData.Metrics["CopyCodeTips"]; ok {
 		filesMap := make(map[string]bool, 0)
-		for _, copyResult := range result.Summaries {
+		for key, copyResult := range result.Summaries {
+			if key == copyDuplicationSummary {
+				if err := jsoniter.Unmarshal([]byte(copyResult.Description), &copyHtmlData.Duplication); err != nil {
+					glog.Errorln(err)
+				}
+				continue
+			}
 			copyTips := copyResult.Errors
 			var copyCodePathList []string
 			for i := 0; i < len(copyTips); i++ {
@@ -498,7 +967,19 @@ func converterCopyCode(structData Reporter) (copyHtmlData CopyItem) {
 				}
 			}
 			copyHtmlData.Detail = append(copyHtmlData.Detail, copyCodePathList)
+
+			var group copycheck.Group
+			if copyResult.Description == "" || jsoniter.Unmarshal([]byte(copyResult.Description), &group) != nil || len(group.Fragments) == 0 {
+				if len(copyCodePathList) > 0 {
+					copyHtmlData.Groups = append(copyHtmlData.Groups, CopyGroup{Files: copyCodePathList, Diffs: make([]CopyDiff, 0)})
+				}
+				continue
+			}
+			copyHtmlData.Groups = append(copyHtmlData.Groups, copyGroup(group))
 		}
+		sort.Slice(copyHtmlData.Groups, func(i, j int) bool {
+			return copyHtmlData.Groups[i].Files[0] < copyHtmlData.Groups[j].Files[0]
+		})
 		copyHtmlData.filesNum = len(filesMap)
 		copyHtmlData.issuesNum = len(copyHtmlData.Detail)
 	}
@@ -506,6 +987,39 @@ func converterCopyCode(structData Reporter) (copyHtmlData CopyItem) {
 	return copyHtmlData
 }
 
+// copyGroup returns the clone group of the report, the first fragment diffed
+// with every other one, except the copies of an index without their code.
+func copyGroup(group copycheck.Group) CopyGroup {
+	files := make([]string, len(group.Fragments))
+	for i, f := range group.Fragments {
+		files[i] = fmt.Sprintf("%s:%d,%d", f.File, f.Start, f.End)
+	}
+	copyGroup := CopyGroup{
+		Files:      files,
+		Similarity: int(group.Similarity*100 + 0.5),
+		Gapped:     group.Gapped,
+		Diffs:      make([]CopyDiff, 0, len(files)-1),
+	}
+	first := group.Fragments[0]
+	for i, f := range group.Fragments[1:] {
+		copyGroup.Corpus = copyGroup.Corpus || f.Repo != ""
+		if f.Code == "" {
+			continue
+		}
+		lines := copycheck.SideBySide(first.Code, f.Code)
+		for j := range lines {
+			if lines[j].LeftLine > 0 {
+				lines[j].LeftLine += first.Start - 1
+			}
+			if lines[j].RightLine > 0 {
+				lines[j].RightLine += f.Start - 1
+			}
+		}
+		copyGroup.Diffs = append(copyGroup.Diffs, CopyDiff{Left: files[0], Right: files[i+1], Lines: lines})
+	}
+	return copyGroup
+}
+
 // converterDead provides function that convert deadcode data into the
 // format required in the html template.It will extract from the structData
 // need to convert the data.The result will be saved in the hd's attributes.
@@ -642,103 +1156,373 @@ func converterCodeLint(structData Reporter) (lintHtmlData StyleItem) {
 	return lintHtmlData
 }
 
-// converterCodeFmt provides function that convert spellcheck data into the
-// format required in the html template.It will extract from the structData
-// need to convert the data.The result will be saved in the hd's attributes.
+// converterCodeFmt provides function that convert gofmt data into the
+// format required in the html template. Every file that is not formatted is
+// an item with the lines that differ and the unified diff of its formatting.
 func converterCodeFmt(structData Reporter) (fmtHtmlData StyleItem) {
-	fmtHtmlData.Label = `Correct commonly misspelled English words... quickly`
+	fmtHtmlData.Label = `Format the files with gofmt, the diffs show how`
 	if result, ok := structData.Metrics["GoFmtTips"]; ok {
-		filesMap := make(map[string]bool, 0)
-		mapItem2DetailIndex := make(map[string]int, 0)
+		files := make([]gofmt.File, 0)
 		for _, summary := range result.Summaries {
-			fmtCodeTips := summary.Errors
-			for i := 0; i < len(fmtCodeTips); i++ {
-				fmtCodeTip := strings.Split(fmtCodeTips[i].ErrorString, ":")
-				if len(fmtCodeTip) == 3 {
-					if fileIndex, ok := mapItem2DetailIndex[strings.Join(fmtCodeTip[0:1], ":")]; ok {
-						fmtHtmlData.Detail[fileIndex].Content = append(fmtHtmlData.Detail[fileIndex].Content, strings.Join(fmtCodeTip[1:], ":"))
-					} else {
-						spellcode := Item{
-							File: strings.Join(fmtCodeTip[0:1], ":"),
-						}
-						spellcode.Content = append(spellcode.Content, strings.Join(fmtCodeTip[1:], ":"))
-						filesMap[spellcode.File] = true
-						fmtHtmlData.Detail = append(fmtHtmlData.Detail, spellcode)
-					}
-				} else if len(fmtCodeTip) == 4 {
-					if fileIndex, ok := mapItem2DetailIndex[strings.Join(fmtCodeTip[0:2], ":")]; ok {
-						fmtHtmlData.Detail[fileIndex].Content = append(fmtHtmlData.Detail[fileIndex].Content, strings.Join(fmtCodeTip[2:], ":"))
-					} else {
-						spellcode := Item{
-							File: strings.Join(fmtCodeTip[0:2], ":"),
-						}
-						spellcode.Content = append(spellcode.Content, strings.Join(fmtCodeTip[2:], ":"))
-						filesMap[spellcode.File] = true
-						mapItem2DetailIndex[strings.Join(fmtCodeTip[0:3], ":")] = len(fmtHtmlData.Detail)
-						fmtHtmlData.Detail = append(fmtHtmlData.Detail, spellcode)
-					}
-				}
+			var packageFiles []gofmt.File
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &packageFiles); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			files = append(files, packageFiles...)
+		}
+		sort.Slice(files, func(i, j int) bool {
+			return files[i].File < files[j].File
+		})
+		for _, file := range files {
+			item := Item{File: file.File, Diff: file.Diff}
+			for _, finding := range file.Findings {
+				item.Content = append(item.Content, fmt.Sprintf("%d: %s (%s): %s", finding.Line, goFmtMessage(finding.Rule), finding.Rule, strings.TrimSpace(finding.Text)))
 			}
+			fmtHtmlData.Detail = append(fmtHtmlData.Detail, item)
+			fmtHtmlData.issuesNum += len(file.Findings)
 		}
-		fmtHtmlData.filesNum = len(filesMap)
-		fmtHtmlData.issuesNum = len(fmtHtmlData.Detail)
+		fmtHtmlData.filesNum = len(files)
 	}
 
 	return fmtHtmlData
 }
 
-// converterCodeVet provides function that convert spellcheck data into the
-// format required in the html template.It will extract from the structData
-// need to convert the data.The result will be saved in the hd's attributes.
+// converterCodeVet provides function that convert go vet data into the
+// format required in the html template. The findings are grouped by the
+// analyzer that reported them.
 func converterCodeVet(structData Reporter) (vetHtmlData StyleItem) {
-	vetHtmlData.Label = `Correct commonly misspelled English words... quickly`
+	vetHtmlData.Label = `Report suspicious constructs, such as Printf calls whose arguments do not align with the format string, grouped by vet analyzer`
 	if result, ok := structData.Metrics["GoVetTips"]; ok {
 		fileMap := make(map[string]bool, 0)
-		mapItem2DetailIndex := make(map[string]int, 0)
+		rules := make(map[string][]string, 0)
 		for _, summary := range result.Summaries {
-			vetCodeTips := summary.Errors
-			for i := 0; i < len(vetCodeTips); i++ {
-				vetCodeTip := strings.Split(vetCodeTips[i].ErrorString, ":")
-				if len(vetCodeTip) == 4 {
-					if fileIndex, ok := mapItem2DetailIndex[strings.Join(vetCodeTip[0:2], ":")]; ok {
-						vetHtmlData.Detail[fileIndex].Content = append(vetHtmlData.Detail[fileIndex].Content, strings.Join(vetCodeTip[2:], ":"))
-					} else {
-						spellcode := Item{
-							File: strings.Join(vetCodeTip[0:2], ":"),
-						}
-						spellcode.Content = append(spellcode.Content, strings.Join(vetCodeTip[2:], ":"))
-						fileMap[spellcode.File] = true
-						mapItem2DetailIndex[strings.Join(vetCodeTip[0:2], ":")] = len(vetHtmlData.Detail)
-						vetHtmlData.Detail = append(vetHtmlData.Detail, spellcode)
-					}
-				} else if len(vetCodeTip) == 5 {
-					if fileIndex, ok := mapItem2DetailIndex[strings.Join(vetCodeTip[0:3], ":")]; ok {
-						vetHtmlData.Detail[fileIndex].Content = append(vetHtmlData.Detail[fileIndex].Content, strings.Join(vetCodeTip[3:], ":"))
-					} else {
-						spellcode := Item{
-							File: strings.Join(vetCodeTip[0:3], ":"),
-						}
-						spellcode.Content = append(spellcode.Content, strings.Join(vetCodeTip[3:], ":"))
-						fileMap[spellcode.File] = true
-						mapItem2DetailIndex[strings.Join(vetCodeTip[0:3], ":")] = len(vetHtmlData.Detail)
-						vetHtmlData.Detail = append(vetHtmlData.Detail, spellcode)
-					}
+			for _, erroru := range summary.Errors {
+				rules[erroru.Rule] = append(rules[erroru.Rule], erroru.ErrorString)
+				if i := strings.Index(erroru.ErrorString, ".go:"); i >= 0 {
+					fileMap[erroru.ErrorString[:i+3]] = true
 				}
-
+				vetHtmlData.issuesNum++
 			}
 		}
+		names := make([]string, 0, len(rules))
+		for name := range rules {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			sort.Strings(rules[name])
+			vetHtmlData.Detail = append(vetHtmlData.Detail, Item{File: name, Content: rules[name]})
+		}
 		vetHtmlData.filesNum = len(fileMap)
-		vetHtmlData.issuesNum = len(vetHtmlData.Detail)
 	}
 
 	return vetHtmlData
 }
 
+// converterStaticCheck provides function that convert the problems of
+// staticcheck and gosimple into the format required in the html template.
+// The checks that were run are listed by ID with their description, severity
+// and number of problems, and the problems are grouped by check.
+func converterStaticCheck(structData Reporter) (staticHtmlData CheckItem) {
+	staticHtmlData.Label = `Detect bugs and suggest simplifications with the checks of staticcheck and gosimple, grouped by check`
+	staticHtmlData.Checks = make([]CheckDoc, 0)
+	if result, ok := structData.Metrics["StaticCheckTips"]; ok {
+		fileMap := make(map[string]bool, 0)
+		for _, summary := range result.Summaries {
+			var check CheckDoc
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &check); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			check.Issues = len(summary.Errors)
+			staticHtmlData.Checks = append(staticHtmlData.Checks, check)
+			if len(summary.Errors) == 0 {
+				continue
+			}
+			content := make([]string, 0, len(summary.Errors))
+			for _, erroru := range summary.Errors {
+				content = append(content, erroru.ErrorString)
+				if i := strings.Index(erroru.ErrorString, ".go:"); i >= 0 {
+					fileMap[erroru.ErrorString[:i+3]] = true
+				}
+			}
+			sort.Strings(content)
+			staticHtmlData.Detail = append(staticHtmlData.Detail, Item{File: check.ID, Content: content})
+			staticHtmlData.issuesNum += len(summary.Errors)
+		}
+		sort.Slice(staticHtmlData.Checks, func(i, j int) bool {
+			return staticHtmlData.Checks[i].ID < staticHtmlData.Checks[j].ID
+		})
+		sort.Slice(staticHtmlData.Detail, func(i, j int) bool {
+			return staticHtmlData.Detail[i].File < staticHtmlData.Detail[j].File
+		})
+		staticHtmlData.filesNum = len(fileMap)
+	}
+
+	return staticHtmlData
+}
+
+// converterAlignCode provides function that convert the struct layouts into
+// the format required in the html template. The structs of all the packages
+// are ranked by the bytes they waste.
+func converterAlignCode(structData Reporter) (alignHtmlData AlignItem) {
+	alignHtmlData.Label = `Find the structs whose fields may be reordered to take less memory, with their size, padding and the suggested order of their fields.`
+	alignHtmlData.Structs = make([]aligncheck.Struct, 0)
+	if result, ok := structData.Metrics["AlignCheckTips"]; ok {
+		filesMap := make(map[string]bool, 0)
+		for _, summary := range result.Summaries {
+			var structs []aligncheck.Struct
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &structs); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			for _, s := range structs {
+				filesMap[s.File] = true
+				alignHtmlData.Wasted += s.Wasted
+			}
+			alignHtmlData.Structs = append(alignHtmlData.Structs, structs...)
+		}
+		sort.SliceStable(alignHtmlData.Structs, func(i, j int) bool {
+			a, b := alignHtmlData.Structs[i], alignHtmlData.Structs[j]
+			if a.Wasted != b.Wasted {
+				return a.Wasted > b.Wasted
+			}
+			if a.File != b.File {
+				return a.File < b.File
+			}
+			return a.Line < b.Line
+		})
+		alignHtmlData.filesNum = len(filesMap)
+		alignHtmlData.issuesNum = len(alignHtmlData.Structs)
+	}
+
+	return alignHtmlData
+}
+
+// converterErrorCheck provides function that convert the unchecked errors
+// into the format required in the html template. The errors are grouped by
+// the called function rather than by file.
+func converterErrorCheck(structData Reporter) (errorCheckHtmlData StyleItem) {
+	errorCheckHtmlData.Label = `Check that error return values are used`
+	if result, ok := structData.Metrics["ErrorCheckTips"]; ok {
+		fileMap := make(map[string]bool, 0)
+		names := make([]string, 0, len(result.Summaries))
+		for name := range result.Summaries {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			item := Item{File: name}
+			for _, erroru := range result.Summaries[name].Errors {
+				item.Content = append(item.Content, erroru.ErrorString)
+				if i := strings.Index(erroru.ErrorString, ".go:"); i >= 0 {
+					fileMap[erroru.ErrorString[:i+3]] = true
+				}
+				errorCheckHtmlData.issuesNum++
+			}
+			errorCheckHtmlData.Detail = append(errorCheckHtmlData.Detail, item)
+		}
+		errorCheckHtmlData.filesNum = len(fileMap)
+	}
+
+	return errorCheckHtmlData
+}
+
 // converterDependGraph provides function that convert depend graph data into the
 // format required in the html template.It will extract from the structData
 // need to convert the data.The result will be saved in the hd's attributes.
+// The svg is embedded as is and the exports of the graph are offered for
+// download.
 func (hd *HtmlData) converterDependGraph(structData Reporter) {
-	hd.DepGraph = template.HTML(structData.Metrics["DependGraphTips"].Summaries["graph"].Description)
+	summaries := structData.Metrics["DependGraphTips"].Summaries
+	hd.DepGraph = template.HTML(summaries["graph"].Description)
+
+	var dependGraphHtmlData CodeDependGraph
+	dependGraphHtmlData.Content.DOT = summaries["dot"].Description
+	dependGraphHtmlData.Content.Mermaid = summaries["mermaid"].Description
+	dependGraphHtmlData.Content.JSON = summaries["json"].Description
+	if summary, ok := summaries["json"]; ok {
+		var graph depend.Graph
+		if err := jsoniter.Unmarshal([]byte(summary.Description), &graph); err != nil {
+			glog.Errorln(err)
+		}
+		dependGraphHtmlData.Summary.Nodes = len(graph.Nodes)
+		dependGraphHtmlData.Summary.Links = len(graph.Links)
+	}
+
+	stringDependGraphJson, err := jsoniter.Marshal(dependGraphHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.DepGraphExport = string(stringDependGraphJson)
+}
+
+// converterPackageMetrics provides function that convert the package metrics
+// computed with the depend graph into the format required in the html
+// template.Packages are sorted by distance from the main sequence.
+func (hd *HtmlData) converterPackageMetrics(structData Reporter) {
+	var packageMetricsHtmlData CodePackageMetrics
+	packageMetricsHtmlData.Content.Packages = make([]PackageMetricsItem, 0)
+	if summary, ok := structData.Metrics["DependGraphTips"].Summaries["metrics"]; ok {
+		var metrics map[string]depend.PackageMetrics
+		if err := jsoniter.Unmarshal([]byte(summary.Description), &metrics); err != nil {
+			glog.Errorln(err)
+		}
+		var distance float64
+		for pkgName, m := range metrics {
+			switch m.Zone {
+			case depend.ZonePain:
+				packageMetricsHtmlData.Summary.Pain++
+			case depend.ZoneUselessness:
+				packageMetricsHtmlData.Summary.Uselessness++
+			}
+			distance = distance + m.Distance
+			packageMetricsHtmlData.Content.Packages = append(packageMetricsHtmlData.Content.Packages, PackageMetricsItem{
+				Pkg:            pkgName,
+				PackageMetrics: m,
+			})
+		}
+		packageMetricsHtmlData.Summary.Packages = len(metrics)
+		if len(metrics) > 0 {
+			packageMetricsHtmlData.Summary.Distance = distance / float64(len(metrics))
+		}
+		sort.Slice(packageMetricsHtmlData.Content.Packages, func(i, j int) bool {
+			a, b := packageMetricsHtmlData.Content.Packages[i], packageMetricsHtmlData.Content.Packages[j]
+			if a.Distance != b.Distance {
+				return a.Distance > b.Distance
+			}
+			return a.Pkg < b.Pkg
+		})
+	}
+
+	stringPackageMetricsJson, err := jsoniter.Marshal(packageMetricsHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.PackageMetrics = string(stringPackageMetricsJson)
+}
+
+// converterArchitecture provides function that convert the imports breaking
+// the architecture rules into the format required in the html template.The
+// imports are sorted by importing package and position.
+func (hd *HtmlData) converterArchitecture(structData Reporter) {
+	var architectureHtmlData CodeArchitecture
+	architectureHtmlData.Content.Violations = make([]ArchitectureViolation, 0)
+	if result, ok := structData.Metrics["ArchitectureTips"]; ok {
+		for _, summary := range result.Summaries {
+			var violations []ArchitectureViolation
+			if err := jsoniter.Unmarshal([]byte(summary.Description), &violations); err != nil {
+				glog.Errorln(err)
+				continue
+			}
+			architectureHtmlData.Summary.Packages++
+			architectureHtmlData.Content.Violations = append(architectureHtmlData.Content.Violations, violations...)
+		}
+		architectureHtmlData.Summary.Violations = len(architectureHtmlData.Content.Violations)
+		sort.Slice(architectureHtmlData.Content.Violations, func(i, j int) bool {
+			a, b := architectureHtmlData.Content.Violations[i], architectureHtmlData.Content.Violations[j]
+			if a.From != b.From {
+				return a.From < b.From
+			}
+			if a.File != b.File {
+				return a.File < b.File
+			}
+			if a.Line != b.Line {
+				return a.Line < b.Line
+			}
+			return a.Rule < b.Rule
+		})
+	}
+
+	stringArchitectureJson, err := jsoniter.Marshal(architectureHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.Architecture = string(stringArchitectureJson)
+}
+
+// converterModules provides function that convert the inventory of the
+// third-party modules into the format required in the html template.The
+// direct modules come first, each group sorted by path.
+func (hd *HtmlData) converterModules(structData Reporter) {
+	var modulesHtmlData CodeModules
+	modulesHtmlData.Content.Modules = make([]modules.Module, 0)
+	for _, summary := range structData.Metrics["ModulesTips"].Summaries {
+		var m modules.Module
+		if err := jsoniter.Unmarshal([]byte(summary.Description), &m); err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		if m.Direct {
+			modulesHtmlData.Summary.Direct++
+		} else {
+			modulesHtmlData.Summary.Indirect++
+		}
+		if m.Replace != nil {
+			modulesHtmlData.Summary.Replaced++
+		}
+		if m.License == "" || m.License == modules.UnknownLicense {
+			modulesHtmlData.Summary.Unlicensed++
+		}
+		modulesHtmlData.Content.Modules = append(modulesHtmlData.Content.Modules, m)
+	}
+	modulesHtmlData.Summary.Modules = len(modulesHtmlData.Content.Modules)
+	sort.Slice(modulesHtmlData.Content.Modules, func(i, j int) bool {
+		a, b := modulesHtmlData.Content.Modules[i], modulesHtmlData.Content.Modules[j]
+		if a.Direct != b.Direct {
+			return a.Direct
+		}
+		return a.Path < b.Path
+	})
+
+	stringModulesJson, err := jsoniter.Marshal(modulesHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.Modules = string(stringModulesJson)
+}
+
+// converterVuln provides function that convert the known vulnerabilities of
+// the third-party modules into the format required in the html template.The
+// reachable findings come first, then by advisory and module.
+func (hd *HtmlData) converterVuln(structData Reporter) {
+	var vulnHtmlData CodeVuln
+	vulnHtmlData.Content.Findings = make([]vuln.Finding, 0)
+	mods := make(map[string]bool)
+	for _, summary := range structData.Metrics["VulnTips"].Summaries {
+		var finding vuln.Finding
+		if err := jsoniter.Unmarshal([]byte(summary.Description), &finding); err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		if finding.Reachable {
+			vulnHtmlData.Summary.Reachable++
+		}
+		if finding.Fixed != "" {
+			vulnHtmlData.Summary.Fixable++
+		}
+		mods[finding.Module] = true
+		vulnHtmlData.Content.Findings = append(vulnHtmlData.Content.Findings, finding)
+	}
+	vulnHtmlData.Summary.Findings = len(vulnHtmlData.Content.Findings)
+	vulnHtmlData.Summary.Modules = len(mods)
+	sort.Slice(vulnHtmlData.Content.Findings, func(i, j int) bool {
+		a, b := vulnHtmlData.Content.Findings[i], vulnHtmlData.Content.Findings[j]
+		if a.Reachable != b.Reachable {
+			return a.Reachable
+		}
+		if a.ID != b.ID {
+			return a.ID < b.ID
+		}
+		return a.Module < b.Module
+	})
+
+	stringVulnJson, err := jsoniter.Marshal(vulnHtmlData)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	hd.Vuln = string(stringVulnJson)
 }
 
 // SaveAsHtml is a function that save HtmlData as a html report.And will receive
@@ -14,6 +14,7 @@
 package engine
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/json-iterator/go"
@@ -68,3 +69,32 @@ func Test_DisplayAsText(t *testing.T) {
 		t.Fail()
 	}
 }
+
+func Test_converterCodeTest(t *testing.T) {
+	r := Reporter{Project: "example.com/big", Metrics: map[string]Metric{
+		"UnitTestTips": {Summaries: map[string]Summary{
+			"example.com/big/p0": {Name: "example.com/big/p0", Description: `{"is_pass":true,"coverage":"50%"}`},
+		}},
+		"UntestedTips": {Summaries: map[string]Summary{
+			"example.com/big/p0": {Description: `{"has_tests":true}`},
+			"example.com/big/p1": {Description: `{"has_tests":false}`},
+			"example.com/big/p2": {Description: `{"has_tests":false}`},
+		}},
+		"ImportPackagesTips": {Summaries: map[string]Summary{
+			"example.com/big/p1": {},
+		}},
+	}}
+	var hd HtmlData
+	hd.Project = r.Project
+	hd.converterCodeTest(r)
+	var codeTest CodeTest
+	if err := jsoniter.Unmarshal([]byte(hd.CodeTest), &codeTest); err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"example.com/big/p1", "example.com/big/p2"}; !reflect.DeepEqual(codeTest.Content.NoTest, want) {
+		t.Errorf("want the packages without tests %v, but got %v", want, codeTest.Content.NoTest)
+	}
+	if codeTest.Summary.PackageCover != 33.3 {
+		t.Errorf("want 1 of 3 packages tested, but got %v%%", codeTest.Summary.PackageCover)
+	}
+}
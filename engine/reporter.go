@@ -17,7 +17,9 @@ import (
 	"errors"
 	"fmt"
 	"io/ioutil"
+	"math"
 	"path/filepath"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -26,6 +28,7 @@ import (
 	"github.com/golang/glog"
 	"github.com/json-iterator/go"
 
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/modules"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
@@ -78,6 +81,43 @@ type StrategyParameter struct {
 	ProjectPath, ExceptPackages string
 }
 
+// ImportPaths returns the sorted import paths of AllDirs, leaving out the
+// fixture packages of the testdata directories.
+func (p StrategyParameter) ImportPaths() []string {
+	importPaths := make([]string, 0, len(p.AllDirs))
+	for pkgName, pkgPath := range p.AllDirs {
+		if !inTestdata(pkgPath) {
+			importPaths = append(importPaths, pkgName)
+		}
+	}
+	sort.Strings(importPaths)
+	return importPaths
+}
+
+// PackagePaths returns the sorted directories of AllDirs, leaving out the
+// fixture packages of the testdata directories.
+func (p StrategyParameter) PackagePaths() []string {
+	packagePaths := make([]string, 0, len(p.AllDirs))
+	for _, pkgPath := range p.AllDirs {
+		if !inTestdata(pkgPath) {
+			packagePaths = append(packagePaths, pkgPath)
+		}
+	}
+	sort.Strings(packagePaths)
+	return packagePaths
+}
+
+// inTestdata reports whether one of the elements of path is testdata, which
+// the go tool ignores.
+func inTestdata(path string) bool {
+	for _, elem := range strings.Split(filepath.ToSlash(path), "/") {
+		if elem == "testdata" {
+			return true
+		}
+	}
+	return false
+}
+
 // Report is a important function of goreporter, it will run all linters and rebuild
 // metrics data in a golang project. And all linters' result will be as one metric
 // data for Reporter.
@@ -140,6 +180,8 @@ func (r *Reporter) Render() (err error) {
 		err = r.toJson()
 	case "text":
 		err = r.toText()
+	case "cyclonedx", "spdx":
+		err = r.toSBOM()
 	default:
 		glog.Infof(fmt.Sprintf("Generating HTML report,time consuming %vs", time.Since(r.StartTime).Seconds()))
 		err = r.toHtml()
@@ -160,17 +202,65 @@ func (r *Reporter) toJson() (err error) {
 		return
 	}
 
-	saveAbsPath := utils.AbsPath(r.ReportPath)
+	jsonpath := r.reportFile(".json")
+	if err = ioutil.WriteFile(jsonpath, jsonReport, 0666); err != nil {
+		return
+	}
+	glog.Info("Json report saved in:", jsonpath)
+	return
+}
+
+// reportFile returns the path of the report file with the extension ext, named
+// after the project and the time stamp in the report path.
+func (r *Reporter) reportFile(ext string) string {
 	projectName := utils.ProjectName(r.ProjectPath)
 
-	jsonpath := projectName + "-" + r.TimeStamp + ".json"
-	if saveAbsPath != "" && saveAbsPath != r.ReportPath {
-		jsonpath = strings.Replace(saveAbsPath+string(filepath.Separator)+projectName+"-"+r.TimeStamp+".json", string(filepath.Separator)+string(filepath.Separator), string(filepath.Separator), -1)
+	path := projectName + "-" + r.TimeStamp + ext
+	if saveAbsPath := utils.AbsPath(r.ReportPath); r.ReportPath != "" && saveAbsPath != "" {
+		path = strings.Replace(saveAbsPath+string(filepath.Separator)+path, string(filepath.Separator)+string(filepath.Separator), string(filepath.Separator), -1)
 	}
-	if err = ioutil.WriteFile(jsonpath, jsonReport, 0666); err != nil {
+	return path
+}
+
+// toSBOM saves the software bill of materials of the third-party modules
+// found by StrategyModules, in the CycloneDX or the SPDX json format.
+func (r *Reporter) toSBOM() (err error) {
+	glog.Infof("Generating %s report,time consuming %vs", r.ReportFormat, time.Since(r.StartTime).Seconds())
+	inventory := make([]modules.Module, 0)
+	for _, summary := range r.Metrics["ModulesTips"].Summaries {
+		var m modules.Module
+		if err = jsoniter.Unmarshal([]byte(summary.Description), &m); err != nil {
+			return
+		}
+		inventory = append(inventory, m)
+	}
+	sort.Slice(inventory, func(i, j int) bool {
+		return inventory[i].Path < inventory[j].Path
+	})
+
+	project, ok := modules.MainModule(r.ProjectPath)
+	if !ok {
+		project = r.Project
+	}
+	if project == "" {
+		project = utils.ProjectName(r.ProjectPath)
+	}
+	var sbom []byte
+	var sbompath string
+	if r.ReportFormat == "spdx" {
+		sbom, err = modules.SPDX(project, inventory, r.StartTime)
+		sbompath = r.reportFile(".spdx.json")
+	} else {
+		sbom, err = modules.CycloneDX(project, inventory, r.StartTime)
+		sbompath = r.reportFile(".cdx.json")
+	}
+	if err != nil {
 		return
 	}
-	glog.Info("Json report saved in:", jsonpath)
+	if err = ioutil.WriteFile(sbompath, sbom, 0666); err != nil {
+		return
+	}
+	glog.Info("SBOM saved in:", sbompath)
 	return
 }
 
@@ -179,7 +269,7 @@ func (r *Reporter) toText() (err error) {
 	color.Magenta(
 		headerTpl,
 		r.Project,
-		int(r.GetFinalScore()),
+		int(math.Round(r.GetFinalScore())),
 		r.Grade,
 		r.TimeStamp,
 		r.Issues,
@@ -215,7 +305,7 @@ func (r *Reporter) toHtml() (err error) {
 	issues := 0
 
 	htmlData.Project = r.Project
-	htmlData.Score = int(r.GetFinalScore())
+	htmlData.Score = int(math.Round(r.GetFinalScore()))
 	// convert all linter's data.
 	htmlData.converterCodeTest(*r)
 	htmlData.converterCodeSmell(*r)
@@ -223,15 +313,16 @@ func (r *Reporter) toHtml() (err error) {
 	htmlData.converterCodeStyle(*r)
 	htmlData.converterCodeCount(*r)
 	htmlData.converterDependGraph(*r)
+	htmlData.converterBenchmark(*r)
+	htmlData.converterMutation(*r)
+	htmlData.converterUntested(*r)
+	htmlData.converterFuncLen(*r)
+	htmlData.converterMaintainability(*r)
+	htmlData.converterPackageMetrics(*r)
+	htmlData.converterArchitecture(*r)
+	htmlData.converterModules(*r)
+	htmlData.converterVuln(*r)
 
-	noTestPackages := make([]string, 0)
-	importPackages := r.Metrics["ImportPackagesTips"].Summaries
-	unitTestPackages := r.Metrics["UnitTestTips"].Summaries
-	for packageName, _ := range importPackages {
-		if _, ok := unitTestPackages[packageName]; !ok {
-			noTestPackages = append(noTestPackages, packageName)
-		}
-	}
 	htmlData.IssuesNum = issues
 	htmlData.Date = r.TimeStamp
 
@@ -240,11 +331,19 @@ func (r *Reporter) toHtml() (err error) {
 	return
 }
 
+// GetFinalScore returns the score of the project, from 0 to 100: the mean of
+// the percentages of the metrics weighted by their weights, whatever linters
+// were run.
 func (r *Reporter) GetFinalScore() (score float64) {
+	weight := 0.0
 	for _, metric := range r.Metrics {
 		score = score + metric.Percentage*metric.Weight
+		weight = weight + metric.Weight
 	}
-	return
+	if weight == 0 {
+		return 0
+	}
+	return score / weight
 }
 
 func NewReporter(projectPath, reportPath, reportFormat, htmlTemplate string) *Reporter {
@@ -268,10 +367,14 @@ func (r *Reporter) Close() {
 }
 
 // Error contains the line number and the reason for
-// an error output from a command
+// an error output from a command, and the ID of the
+// rule that reported it and its severity if the linter
+// has rules
 type Error struct {
 	LineNumber  int    `json:"line_number"`
 	ErrorString string `json:"error_string"`
+	Rule        string `json:"rule,omitempty"`
+	Severity    string `json:"severity,omitempty"`
 }
 
 // FileSummary contains the filename, location of the file
@@ -0,0 +1,453 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/aligncheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/cognitive"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/deadcode"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/errorcheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/fix"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/flen"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/gofmt"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/govet"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint/lintutil"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/unittest"
+)
+
+// Settings is the content of the json config file given with -config. It is
+// injected into the reporter and every strategy that needs it, and its zero
+// value keeps the default behaviour, so all fields are optional.
+type Settings struct {
+	BuildTags    []string             `json:"build_tags"`
+	UnitTest     UnitTestSettings     `json:"unit_test"`
+	Benchmark    BenchmarkSettings    `json:"benchmark"`
+	Mutation     MutationSettings     `json:"mutation"`
+	FuncLen      FuncLenSettings      `json:"func_len"`
+	Cognitive    CognitiveSettings    `json:"cognitive"`
+	CopyCheck    CopyCheckSettings    `json:"copy_check"`
+	DeadCode     DeadCodeSettings     `json:"dead_code"`
+	ErrorCheck   ErrorCheckSettings   `json:"error_check"`
+	AlignCheck   AlignCheckSettings   `json:"align_check"`
+	Fix          FixSettings          `json:"fix"`
+	GoFmt        GoFmtSettings        `json:"go_fmt"`
+	GoVet        GoVetSettings        `json:"go_vet"`
+	StaticCheck  StaticCheckSettings  `json:"static_check"`
+	Architecture ArchitectureSettings `json:"architecture"`
+	DependGraph  DependGraphSettings  `json:"depend_graph"`
+	Vuln         VulnSettings         `json:"vuln"`
+}
+
+// UnitTestSettings configures go test. The fields apply to every package and
+// the entries of Packages whose pattern matches a package are applied on top,
+// in order. Race defaults to true.
+type UnitTestSettings struct {
+	Flags    []string                  `json:"flags"`
+	Env      []string                  `json:"env"`
+	Timeout  string                    `json:"timeout"`
+	Race     *bool                     `json:"race"`
+	Packages []UnitTestPackageSettings `json:"packages"`
+}
+
+// UnitTestPackageSettings configures go test for the packages matching
+// Pattern, either a glob or an import path prefix ending in "/...". Tags are
+// added to the global build tags.
+type UnitTestPackageSettings struct {
+	Pattern string   `json:"pattern"`
+	Tags    []string `json:"tags"`
+	Flags   []string `json:"flags"`
+	Env     []string `json:"env"`
+	Timeout string   `json:"timeout"`
+	Race    *bool    `json:"race"`
+}
+
+// BenchmarkSettings enables StrategyBenchmark. Baseline is the path of an
+// earlier json report whose benchmarks are compared with the current ones,
+// Threshold is the slowdown in percent that counts as a regression and Alpha
+// the significance level of the comparison.
+type BenchmarkSettings struct {
+	Enable    bool    `json:"enable"`
+	Count     int     `json:"count"`
+	Baseline  string  `json:"baseline"`
+	Threshold float64 `json:"threshold"`
+	Alpha     float64 `json:"alpha"`
+}
+
+// TestOptions returns the go test options of the package pkgName.
+func (s *Settings) TestOptions(pkgName string) unittest.Options {
+	config := s.UnitTest
+	options := unittest.Options{
+		Tags:    append([]string(nil), s.BuildTags...),
+		Flags:   append([]string(nil), config.Flags...),
+		Env:     append([]string(nil), config.Env...),
+		Timeout: config.Timeout,
+		Race:    config.Race == nil || *config.Race,
+	}
+	for _, p := range config.Packages {
+		if !matchPackage(p.Pattern, pkgName) {
+			continue
+		}
+		options.Tags = append(options.Tags, p.Tags...)
+		options.Flags = append(options.Flags, p.Flags...)
+		options.Env = append(options.Env, p.Env...)
+		if p.Timeout != "" {
+			options.Timeout = p.Timeout
+		}
+		if p.Race != nil {
+			options.Race = *p.Race
+		}
+	}
+	return options
+}
+
+// matchPackage reports whether pkgName matches pattern. A pattern ending in
+// "/..." matches the package and all packages below it, as with the go tool.
+func matchPackage(pattern, pkgName string) bool {
+	if strings.HasSuffix(pattern, "/...") {
+		prefix := strings.TrimSuffix(pattern, "/...")
+		return pkgName == prefix || strings.HasPrefix(pkgName, prefix+"/")
+	}
+	matched, err := path.Match(pattern, pkgName)
+	return err == nil && matched
+}
+
+// MutationSettings enables StrategyMutation. MaxMutants bounds the mutants
+// tested per package, Timeout every test run and Budget, a duration such as
+// "5m", the time spent on one package.
+type MutationSettings struct {
+	Enable     bool   `json:"enable"`
+	MaxMutants int    `json:"max_mutants"`
+	Timeout    string `json:"timeout"`
+	Budget     string `json:"budget"`
+}
+
+// FuncLenSettings configures StrategyFuncLen. Functions longer than MaxLines
+// are reported, the entries of Packages whose pattern matches a package
+// override it, in order. Top is the number of longest functions ranked per
+// package and BucketSize the width in lines of the histogram bars.
+type FuncLenSettings struct {
+	MaxLines     int                      `json:"max_lines"`
+	Top          int                      `json:"top"`
+	BucketSize   int                      `json:"bucket_size"`
+	IncludeTests bool                     `json:"include_tests"`
+	Packages     []FuncLenPackageSettings `json:"packages"`
+}
+
+// FuncLenPackageSettings sets the length limit of the functions of the
+// packages matching Pattern.
+type FuncLenPackageSettings struct {
+	Pattern  string `json:"pattern"`
+	MaxLines int    `json:"max_lines"`
+}
+
+// MaxFuncLines returns the length limit of the functions of the package
+// pkgName.
+func (s *Settings) MaxFuncLines(pkgName string) int {
+	maxLines := s.FuncLen.MaxLines
+	for _, p := range s.FuncLen.Packages {
+		if p.MaxLines > 0 && matchPackage(p.Pattern, pkgName) {
+			maxLines = p.MaxLines
+		}
+	}
+	if maxLines <= 0 {
+		maxLines = flen.DefaultMaxLines
+	}
+	return maxLines
+}
+
+// CognitiveSettings configures StrategyCognitive. Functions whose cognitive
+// complexity reaches High are reported as high, from Grave on as grave.
+type CognitiveSettings struct {
+	High  int `json:"high"`
+	Grave int `json:"grave"`
+}
+
+// CognitiveThresholds returns the high and grave cognitive complexities.
+func (s *Settings) CognitiveThresholds() (high, grave int) {
+	high, grave = s.Cognitive.High, s.Cognitive.Grave
+	if high <= 0 {
+		high = cognitive.DefaultHigh
+	}
+	if grave <= 0 {
+		grave = cognitive.DefaultGrave
+	}
+	return high, grave
+}
+
+// CopyCheckSettings configures StrategyCopyCheck. A clone covers at least
+// Threshold syntax nodes, 50 by default, and a group holds at least
+// MinGroupSize fragments, 2 by default; the vendor directories and the test
+// files are skipped unless Vendor and IncludeTests are set. Clones whose
+// exact parts are separated by at most Gap lines of edited code are reported
+// as one gapped clone, none if Gap is 0. Normalize, true by default, ignores
+// the names of identifiers, the values of literals and the operators. Corpus
+// lists other repositories, local checkouts or index files saved by
+// copycheck, whose copies of the project code are reported too; the index of
+// the checkouts is kept in the file CorpusIndex, if set, and only updated for
+// the files that changed.
+type CopyCheckSettings struct {
+	Threshold    int      `json:"threshold"`
+	MinGroupSize int      `json:"min_group_size"`
+	Vendor       bool     `json:"vendor"`
+	IncludeTests bool     `json:"include_tests"`
+	Gap          int      `json:"gap"`
+	Normalize    *bool    `json:"normalize"`
+	Corpus       []string `json:"corpus"`
+	CorpusIndex  string   `json:"corpus_index"`
+}
+
+// CopyCheckOptions returns the options of the search of clones.
+func (s *Settings) CopyCheckOptions() copycheck.Options {
+	return copycheck.Options{
+		Threshold:    s.CopyCheck.Threshold,
+		MinGroupSize: s.CopyCheck.MinGroupSize,
+		Vendor:       s.CopyCheck.Vendor,
+		IncludeTests: s.CopyCheck.IncludeTests,
+		Normalize:    s.CopyCheck.Normalize == nil || *s.CopyCheck.Normalize,
+		Gap:          s.CopyCheck.Gap,
+	}
+}
+
+// DeadCodeSettings configures StrategyDeadCode. The code of the project is
+// dead if it cannot be reached from the main functions, from the tests if
+// Tests is set and from the exported API of the packages other than main if
+// Exported is set; both default to true, set Exported to false for a command
+// whose packages are not imported by other projects.
+type DeadCodeSettings struct {
+	Tests    *bool `json:"tests"`
+	Exported *bool `json:"exported"`
+}
+
+// DeadCodeOptions returns the roots of the analysis of dead code.
+func (s *Settings) DeadCodeOptions() deadcode.Options {
+	return deadcode.Options{
+		Tests:    s.DeadCode.Tests == nil || *s.DeadCode.Tests,
+		Exported: s.DeadCode.Exported == nil || *s.DeadCode.Exported,
+		Tags:     s.BuildTags,
+	}
+}
+
+// ErrorCheckSettings configures StrategyErrorCheck. Exclude lists the full
+// names of the functions whose errors may be ignored, a trailing * matching
+// any suffix. The errors assigned to the blank identifier and the unchecked
+// type assertions are reported with Blank and Asserts; the errors of deferred
+// calls and the errors overwritten before being read are reported unless
+// Defer and Overwrite are set to false.
+type ErrorCheckSettings struct {
+	Exclude      []string `json:"exclude"`
+	Blank        bool     `json:"blank"`
+	Asserts      bool     `json:"asserts"`
+	Defer        *bool    `json:"defer"`
+	Overwrite    *bool    `json:"overwrite"`
+	IncludeTests bool     `json:"include_tests"`
+}
+
+// ErrorCheckOptions returns the options of the check of unchecked errors.
+func (s *Settings) ErrorCheckOptions() errorcheck.Options {
+	return errorcheck.Options{
+		Exclude:   s.ErrorCheck.Exclude,
+		Blank:     s.ErrorCheck.Blank,
+		Asserts:   s.ErrorCheck.Asserts,
+		Defer:     s.ErrorCheck.Defer == nil || *s.ErrorCheck.Defer,
+		Overwrite: s.ErrorCheck.Overwrite == nil || *s.ErrorCheck.Overwrite,
+		Tests:     s.ErrorCheck.IncludeTests,
+		Tags:      s.BuildTags,
+	}
+}
+
+// AlignCheckSettings configures StrategyAlignCheck. Arch is the GOARCH whose
+// sizes and alignments are used, the one of goreporter if empty, and the test
+// files are checked with IncludeTests.
+type AlignCheckSettings struct {
+	Arch         string `json:"arch"`
+	IncludeTests bool   `json:"include_tests"`
+}
+
+// AlignCheckOptions returns the options of the check of struct layouts.
+func (s *Settings) AlignCheckOptions() aligncheck.Options {
+	return aligncheck.Options{
+		Arch:  s.AlignCheck.Arch,
+		Tests: s.AlignCheck.IncludeTests,
+		Tags:  s.BuildTags,
+	}
+}
+
+// FixSettings configures the fix command. Rules are the names of the fixes
+// it applies, all of them if empty, and the test files are fixed with
+// IncludeTests. The struct layouts are those of the arch of AlignCheck.
+type FixSettings struct {
+	Rules        []string `json:"rules"`
+	IncludeTests bool     `json:"include_tests"`
+}
+
+// FixOptions returns the options of the fix command.
+func (s *Settings) FixOptions() fix.Options {
+	return fix.Options{
+		Rules: s.Fix.Rules,
+		Arch:  s.AlignCheck.Arch,
+		Tests: s.Fix.IncludeTests,
+		Tags:  s.BuildTags,
+	}
+}
+
+// GoFmtSettings configures StrategyGoFmt. The files are checked as gofmt -s
+// does unless Simplify is set to false, and their imports are checked to be
+// grouped as goimports does with Imports.
+type GoFmtSettings struct {
+	Simplify *bool `json:"simplify"`
+	Imports  bool  `json:"imports"`
+}
+
+// GoFmtOptions returns the options of the check of the formatting.
+func (s *Settings) GoFmtOptions() gofmt.Options {
+	return gofmt.Options{
+		Simplify: s.GoFmt.Simplify == nil || *s.GoFmt.Simplify,
+		Imports:  s.GoFmt.Imports,
+	}
+}
+
+// GoVetSettings configures StrategyGoVet. Enable are the names of the vet
+// analyzers to run, those go vet runs if empty, and Disable those not to run;
+// shadow is only run when enabled. The test files are checked with
+// IncludeTests.
+type GoVetSettings struct {
+	Enable       []string `json:"enable"`
+	Disable      []string `json:"disable"`
+	IncludeTests bool     `json:"include_tests"`
+}
+
+// GoVetOptions returns the options of the vet analyzers.
+func (s *Settings) GoVetOptions() govet.Options {
+	return govet.Options{
+		Enable:  s.GoVet.Enable,
+		Disable: s.GoVet.Disable,
+		Tests:   s.GoVet.IncludeTests,
+		Tags:    s.BuildTags,
+	}
+}
+
+// StaticCheckSettings configures StrategyStaticCheck. Enable are the IDs of
+// the checks of staticcheck and gosimple to run, those of staticcheck if
+// empty, as StrategySimpleCode already reports the simplifications of
+// gosimple, and Disable those not to run; both accept patterns like SA4*.
+// Severity maps patterns of IDs to the severity of their problems, the
+// longest matching pattern winning. Generated files are checked with
+// Generated and the test files with IncludeTests.
+type StaticCheckSettings struct {
+	Enable       []string          `json:"enable"`
+	Disable      []string          `json:"disable"`
+	Severity     map[string]string `json:"severity"`
+	Generated    bool              `json:"generated"`
+	IncludeTests bool              `json:"include_tests"`
+}
+
+// StaticCheckOptions returns the options of the staticcheck and gosimple
+// checks.
+func (s *Settings) StaticCheckOptions() lintutil.Options {
+	enable := s.StaticCheck.Enable
+	if len(enable) == 0 {
+		enable = []string{"SA*"}
+	}
+	return lintutil.Options{
+		Tags:      s.BuildTags,
+		LintTests: s.StaticCheck.IncludeTests,
+		Enable:    enable,
+		Disable:   s.StaticCheck.Disable,
+	}
+}
+
+// CheckSeverity returns the severity of the problems of the check id: that
+// of the longest pattern of the settings matching id or, by default, error
+// for the bugs found by staticcheck, warning for its suspicious constructs
+// (SA4 and SA9) and info for its performance issues (SA6) and the
+// simplifications of gosimple.
+func (s *Settings) CheckSeverity(id string) string {
+	severity, best := "", ""
+	for pattern, sev := range s.StaticCheck.Severity {
+		if ok, _ := path.Match(pattern, id); !ok {
+			continue
+		}
+		if severity == "" || len(pattern) > len(best) || len(pattern) == len(best) && pattern < best {
+			severity, best = sev, pattern
+		}
+	}
+	if severity != "" {
+		return severity
+	}
+	switch {
+	case strings.HasPrefix(id, "SA4"), strings.HasPrefix(id, "SA9"):
+		return "warning"
+	case strings.HasPrefix(id, "SA6"), !strings.HasPrefix(id, "SA"):
+		return "info"
+	}
+	return "error"
+}
+
+// ArchitectureSettings are the rules checked by StrategyArchitecture. Layers
+// are patterns of packages, the top layer first: with the layers api,
+// service and store, written api -> service -> store, a package may only
+// import packages of its own layer and of the layers below it. Forbidden
+// imports are never allowed and, if AllowedThirdParty is set, only the
+// packages matching one of its patterns may be imported from outside the
+// project and the standard library.
+type ArchitectureSettings struct {
+	Layers            []string                  `json:"layers"`
+	Forbidden         []ForbiddenImportSettings `json:"forbidden"`
+	AllowedThirdParty []string                  `json:"allowed_third_party"`
+}
+
+// ForbiddenImportSettings forbids the packages matching From to import the
+// packages matching To.
+type ForbiddenImportSettings struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// DependGraphSettings configures the dependency graph. The packages matching
+// one of the Collapse prefixes, import paths possibly followed by "/...", are
+// drawn as a single node.
+type DependGraphSettings struct {
+	Collapse []string `json:"collapse"`
+}
+
+// VulnSettings enables StrategyVuln. DB is the directory of a local copy of
+// the Go vulnerability database, a tree of OSV json entries; nothing is
+// downloaded.
+type VulnSettings struct {
+	DB string `json:"db"`
+}
+
+// LoadSettings reads the json config file of path. An empty path returns the
+// default settings.
+func LoadSettings(path string) (*Settings, error) {
+	settings := &Settings{}
+	if path == "" {
+		return settings, nil
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err = jsoniter.Unmarshal(data, settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/unittest"
+)
+
+func Test_TestOptions(t *testing.T) {
+	var settings Settings
+	err := jsoniter.Unmarshal([]byte(`{
+		"build_tags": ["integration"],
+		"unit_test": {
+			"timeout": "1m",
+			"packages": [
+				{"pattern": "foo/cgo/...", "race": false, "env": ["CGO_ENABLED=0"]},
+				{"pattern": "foo/*", "tags": ["slow"], "flags": ["-short"], "timeout": "5m"}
+			]
+		}
+	}`), &settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := unittest.Options{Tags: []string{"integration"}, Timeout: "1m", Race: true}
+	if got := settings.TestOptions("bar"); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v, but got %+v", want, got)
+	}
+	want = unittest.Options{Tags: []string{"integration"}, Env: []string{"CGO_ENABLED=0"}, Timeout: "1m"}
+	if got := settings.TestOptions("foo/cgo/sub"); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v, but got %+v", want, got)
+	}
+	want = unittest.Options{Tags: []string{"integration", "slow"}, Flags: []string{"-short"}, Timeout: "5m", Race: true}
+	if got := settings.TestOptions("foo/bar"); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %+v, but got %+v", want, got)
+	}
+}
+
+func Test_MaxFuncLines(t *testing.T) {
+	var settings Settings
+	if got := settings.MaxFuncLines("foo"); got != 50 {
+		t.Errorf("want the default limit 50, but got %d", got)
+	}
+	err := jsoniter.Unmarshal([]byte(`{
+		"func_len": {
+			"max_lines": 40,
+			"packages": [
+				{"pattern": "foo/...", "max_lines": 80},
+				{"pattern": "foo/gen", "max_lines": 200}
+			]
+		}
+	}`), &settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for pkgName, want := range map[string]int{"bar": 40, "foo": 80, "foo/bar": 80, "foo/gen": 200} {
+		if got := settings.MaxFuncLines(pkgName); got != want {
+			t.Errorf("%s: want %d, but got %d", pkgName, want, got)
+		}
+	}
+}
+
+func Test_StaticCheckOptions(t *testing.T) {
+	var settings Settings
+	if got := settings.StaticCheckOptions().Enable; !reflect.DeepEqual(got, []string{"SA*"}) {
+		t.Errorf("want only the checks of staticcheck by default, but got %v", got)
+	}
+	settings.StaticCheck.Enable = []string{"SA*", "S1002"}
+	if got := settings.StaticCheckOptions().Enable; !reflect.DeepEqual(got, settings.StaticCheck.Enable) {
+		t.Errorf("want the enabled checks %v, but got %v", settings.StaticCheck.Enable, got)
+	}
+}
+
+func Test_CheckSeverity(t *testing.T) {
+	var settings Settings
+	for id, want := range map[string]string{"SA1000": "error", "SA4006": "warning", "SA6000": "info", "S1002": "info"} {
+		if got := settings.CheckSeverity(id); got != want {
+			t.Errorf("%s: want the default severity %s, but got %s", id, want, got)
+		}
+	}
+	err := jsoniter.Unmarshal([]byte(`{
+		"static_check": {
+			"severity": {"SA*": "warning", "SA1*": "error", "SA1019": "info"}
+		}
+	}`), &settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for id, want := range map[string]string{"SA1000": "error", "SA1019": "info", "SA5000": "warning", "S1002": "info"} {
+		if got := settings.CheckSeverity(id); got != want {
+			t.Errorf("%s: want %s, but got %s", id, want, got)
+		}
+	}
+}
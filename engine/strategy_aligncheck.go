@@ -0,0 +1,88 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/aligncheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+type StrategyAlignCheck struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+}
+
+func (s *StrategyAlignCheck) GetName() string {
+	return "AlignCheck"
+}
+
+func (s *StrategyAlignCheck) GetDescription() string {
+	return "Find the structs whose fields may be reordered to take less memory."
+}
+
+func (s *StrategyAlignCheck) GetWeight() float64 {
+	return 0.05
+}
+
+// Compute provides a function that finds the struct types of the project
+// whose fields may be reordered to take less memory. The summary of every
+// package lists them and its description holds their layouts in json, the
+// current and optimal sizes, the padding and the suggested field order.
+func (s *StrategyAlignCheck) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	importPaths := parameters.ImportPaths()
+
+	structs, err := aligncheck.AlignCheck(importPaths, s.Settings.AlignCheckOptions())
+	if err != nil {
+		glog.Warningln(err)
+	}
+	packages := make(map[string][]aligncheck.Struct)
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(structs))
+	for _, st := range structs {
+		st.File = utils.AbsPath(st.File)
+		packages[st.Package] = append(packages[st.Package], st)
+		erroru := Error{
+			LineNumber: st.Line,
+			ErrorString: fmt.Sprintf("%s:%d:%d: struct %s is %d bytes, could be %d with fields %s",
+				st.File, st.Line, st.Column, st.Name, st.Size, st.OptimalSize, strings.Join(st.Order, ", ")),
+		}
+		summaries.Lock()
+		summary, ok := summaries.Summaries[st.Package]
+		if !ok {
+			summary = Summary{
+				Name:   st.Package,
+				Errors: make([]Error, 0),
+			}
+		}
+		summary.Errors = append(summary.Errors, erroru)
+		summaries.Summaries[st.Package] = summary
+		summaries.Unlock()
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+	for pkgName, pkgStructs := range packages {
+		description, err := jsoniter.Marshal(pkgStructs)
+		if err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		summary := summaries.Summaries[pkgName]
+		summary.Description = string(description)
+		summaries.Summaries[pkgName] = summary
+	}
+	return
+}
+
+func (s *StrategyAlignCheck) Percentage(summaries *Summaries) float64 {
+	summaries.Lock()
+	defer summaries.Unlock()
+	return utils.CountPercentage(len(summaries.Summaries))
+}
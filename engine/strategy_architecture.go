@@ -0,0 +1,225 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// ArchitectureViolation is an import of the package From, at Line of File,
+// of the package To that breaks Rule.
+type ArchitectureViolation struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+	Rule string `json:"rule"`
+}
+
+type StrategyArchitecture struct {
+	Sync       *Synchronizer `inject:""`
+	Settings   *Settings     `inject:""`
+	violations int
+}
+
+func (s *StrategyArchitecture) GetName() string {
+	return "Architecture"
+}
+
+func (s *StrategyArchitecture) GetDescription() string {
+	return "Check the imports of all packages against the layers, forbidden imports and allowed third-party packages of the config file, and find import cycles."
+}
+
+func (s *StrategyArchitecture) GetWeight() float64 {
+	return 0
+}
+
+// Compute walks the imports of all packages of the project and reports the
+// ones that break an architecture rule, grouped by importing package.
+func (s *StrategyArchitecture) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	violations := architectureViolations(s.Settings, parameters)
+	byPackage := make(map[string][]ArchitectureViolation)
+	for _, violation := range violations {
+		byPackage[violation.From] = append(byPackage[violation.From], violation)
+	}
+	s.violations = len(violations)
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(byPackage))
+	for pkgName, pkgViolations := range byPackage {
+		errors := make([]Error, 0, len(pkgViolations))
+		for _, violation := range pkgViolations {
+			errors = append(errors, Error{
+				LineNumber:  violation.Line,
+				ErrorString: fmt.Sprintf("%s:%d: %s imports %s: %s", violation.File, violation.Line, violation.From, violation.To, violation.Rule),
+			})
+		}
+		jsonStringViolations, err := jsoniter.Marshal(pkgViolations)
+		if err != nil {
+			glog.Errorln(err)
+		}
+		summaries.Summaries[pkgName] = Summary{
+			Name:        pkgName,
+			Description: string(jsonStringViolations),
+			Errors:      errors,
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+
+	return
+}
+
+func (s *StrategyArchitecture) Percentage(summaries *Summaries) float64 {
+	return utils.CountPercentage(s.violations)
+}
+
+// architectureViolations walks the imports of the packages of the project and
+// returns the ones that break the architecture rules of settings or close an
+// import cycle.
+func architectureViolations(settings *Settings, parameters StrategyParameter) []ArchitectureViolation {
+	importPaths := parameters.ImportPaths()
+	project := make(map[string]bool, len(importPaths))
+	for _, pkgName := range importPaths {
+		project[pkgName] = true
+	}
+
+	edges, err := depend.Edges(parameters.ProjectPath, parameters.ExceptPackages, importPaths, settings.BuildTags...)
+	if err != nil {
+		glog.Warningln(err)
+	}
+	return checkArchitecture(settings.Architecture, edges, project)
+}
+
+// checkArchitecture returns the imports of edges that break the rules of
+// config or are part of an import cycle between the packages of project.
+func checkArchitecture(config ArchitectureSettings, edges []depend.Edge, project map[string]bool) []ArchitectureViolation {
+	layer := func(pkgName string) int {
+		for i, pattern := range config.Layers {
+			if matchPackage(pattern, pkgName) {
+				return i
+			}
+		}
+		return -1
+	}
+	cycles := importCycles(edges, project)
+
+	violations := make([]ArchitectureViolation, 0)
+	for _, edge := range edges {
+		var rules []string
+		if from, to := layer(edge.From), layer(edge.To); from >= 0 && to >= 0 && to < from {
+			rules = append(rules, fmt.Sprintf("layer %s must not import layer %s", config.Layers[from], config.Layers[to]))
+		}
+		for _, forbidden := range config.Forbidden {
+			if matchPackage(forbidden.From, edge.From) && matchPackage(forbidden.To, edge.To) {
+				rules = append(rules, fmt.Sprintf("%s must not import %s", forbidden.From, forbidden.To))
+			}
+		}
+		if len(config.AllowedThirdParty) > 0 && !edge.Std && !project[edge.To] && !matchAny(config.AllowedThirdParty, edge.To) {
+			rules = append(rules, "third-party package is not allowed")
+		}
+		if cycle, ok := cycles[edge.From]; ok && cycles[edge.To] == cycle {
+			rules = append(rules, "import cycle between "+cycle)
+		}
+		for _, rule := range rules {
+			violations = append(violations, ArchitectureViolation{
+				From: edge.From,
+				To:   edge.To,
+				File: edge.File,
+				Line: edge.Line,
+				Rule: rule,
+			})
+		}
+	}
+	return violations
+}
+
+// matchAny reports whether pkgName matches one of patterns.
+func matchAny(patterns []string, pkgName string) bool {
+	for _, pattern := range patterns {
+		if matchPackage(pattern, pkgName) {
+			return true
+		}
+	}
+	return false
+}
+
+// importCycles returns the packages of project that are part of an import
+// cycle, mapped to the sorted list of the packages of their cycle. The cycles
+// are the strongly connected components of the import graph.
+func importCycles(edges []depend.Edge, project map[string]bool) map[string]string {
+	imports := make(map[string][]string)
+	for _, edge := range edges {
+		if project[edge.From] && project[edge.To] {
+			imports[edge.From] = append(imports[edge.From], edge.To)
+		}
+	}
+
+	// Tarjan's algorithm.
+	var (
+		index   = make(map[string]int)
+		lowlink = make(map[string]int)
+		onStack = make(map[string]bool)
+		stack   []string
+		cycles  = make(map[string]string)
+		visit   func(pkgName string)
+	)
+	visit = func(pkgName string) {
+		index[pkgName] = len(index)
+		lowlink[pkgName] = index[pkgName]
+		stack = append(stack, pkgName)
+		onStack[pkgName] = true
+		for _, imp := range imports[pkgName] {
+			if _, ok := index[imp]; !ok {
+				visit(imp)
+				if lowlink[imp] < lowlink[pkgName] {
+					lowlink[pkgName] = lowlink[imp]
+				}
+			} else if onStack[imp] && index[imp] < lowlink[pkgName] {
+				lowlink[pkgName] = index[imp]
+			}
+		}
+		if lowlink[pkgName] != index[pkgName] {
+			return
+		}
+		var component []string
+		for {
+			top := stack[len(stack)-1]
+			stack = stack[:len(stack)-1]
+			onStack[top] = false
+			component = append(component, top)
+			if top == pkgName {
+				break
+			}
+		}
+		if len(component) > 1 {
+			sort.Strings(component)
+			cycle := strings.Join(component, ", ")
+			for _, member := range component {
+				cycles[member] = cycle
+			}
+		}
+	}
+
+	pkgNames := make([]string, 0, len(imports))
+	for pkgName := range imports {
+		pkgNames = append(pkgNames, pkgName)
+	}
+	sort.Strings(pkgNames)
+	for _, pkgName := range pkgNames {
+		if _, ok := index[pkgName]; !ok {
+			visit(pkgName)
+		}
+	}
+	return cycles
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package engine
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend"
+)
+
+func Test_checkArchitecture(t *testing.T) {
+	config := ArchitectureSettings{
+		Layers:            []string{"p/api/...", "p/service/...", "p/store/..."},
+		Forbidden:         []ForbiddenImportSettings{{From: "p/store/...", To: "net/http"}},
+		AllowedThirdParty: []string{"github.com/pkg/errors"},
+	}
+	project := map[string]bool{"p/api": true, "p/service": true, "p/store": true, "p/a": true, "p/b": true}
+	edge := func(from, to string, line int) depend.Edge {
+		return depend.Edge{
+			Import: depend.Import{From: from, To: to},
+			File:   from + ".go",
+			Line:   line,
+			Std:    !strings.Contains(to, "."),
+		}
+	}
+	edges := []depend.Edge{
+		edge("p/api", "p/service/v2", 1),
+		edge("p/service", "p/store", 2),
+		edge("p/store", "p/api", 3),
+		edge("p/store", "net/http", 4),
+		edge("p/service", "github.com/pkg/errors", 5),
+		edge("p/service", "github.com/other/errors", 6),
+		edge("p/a", "p/b", 7),
+		edge("p/b", "p/a", 8),
+	}
+	want := map[int]int{3: 1, 4: 1, 6: 1, 7: 1, 8: 1}
+	got := make(map[int]int)
+	for _, violation := range checkArchitecture(config, edges, project) {
+		got[violation.Line]++
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want violations by line %v, but got %v", want, got)
+	}
+}
@@ -0,0 +1,122 @@
+package engine
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/benchmark"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// PackageBenchmark is the benchmark result of one package, saved as the
+// description of its summary so that a later report can be compared with it.
+type PackageBenchmark struct {
+	Results []benchmark.Result `json:"results"`
+	Deltas  []benchmark.Delta  `json:"deltas"`
+}
+
+type StrategyBenchmark struct {
+	Sync        *Synchronizer `inject:""`
+	Settings    *Settings     `inject:""`
+	regressions int
+}
+
+func (s *StrategyBenchmark) GetName() string {
+	return "Benchmark"
+}
+
+func (s *StrategyBenchmark) GetDescription() string {
+	return "Run all benchmarks in your golang package.And will compare them with the baseline report to find regressions."
+}
+
+func (s *StrategyBenchmark) GetWeight() float64 {
+	return 0
+}
+
+// Compute runs the benchmarks package by package. Unlike the other linters
+// they are not run concurrently, which would distort the timings.
+func (s *StrategyBenchmark) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+	config := s.Settings.Benchmark
+
+	baseline := loadBaselineBenchmarks(config.Baseline)
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(parameters.UnitTestDirs))
+
+	for pkgName, pkgPath := range parameters.UnitTestDirs {
+		results, err := benchmark.Benchmark("."+string(filepath.Separator)+pkgPath, config.Count)
+		if err != nil {
+			glog.Warningln(pkgName, err)
+		}
+		if len(results) > 0 {
+			packageBenchmark := PackageBenchmark{Results: results}
+			if old, ok := baseline[pkgName]; ok {
+				packageBenchmark.Deltas = benchmark.Compare(old.Results, results, config.Alpha, config.Threshold)
+			}
+			var errors []Error
+			for _, delta := range packageBenchmark.Deltas {
+				if delta.Regression {
+					errors = append(errors, Error{
+						ErrorString: fmt.Sprintf("%s %s: %.2f -> %.2f (%+.2f%%, p=%.3f)",
+							delta.Name, delta.Unit, delta.Old, delta.New, delta.Change, delta.P),
+					})
+				}
+			}
+			s.regressions += len(errors)
+			jsonStringPackageBenchmark, err := jsoniter.Marshal(packageBenchmark)
+			if err != nil {
+				glog.Errorln(err)
+			}
+			summaries.Summaries[pkgName] = Summary{
+				Name:        pkgName,
+				Description: string(jsonStringPackageBenchmark),
+				Errors:      errors,
+			}
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+
+	return
+}
+
+func (s *StrategyBenchmark) Percentage(summaries *Summaries) float64 {
+	return utils.CountPercentage(s.regressions)
+}
+
+// loadBaselineBenchmarks reads the benchmark results of every package from an
+// earlier json report. A missing or broken report only disables the comparison.
+func loadBaselineBenchmarks(path string) map[string]PackageBenchmark {
+	baseline := make(map[string]PackageBenchmark, 0)
+	if path == "" {
+		return baseline
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		glog.Warningln("read baseline report error:", err)
+		return baseline
+	}
+	var report struct {
+		Metrics map[string]Metric `json:"metrics"`
+	}
+	if err = jsoniter.Unmarshal(data, &report); err != nil {
+		glog.Warningln("parse baseline report error:", err)
+		return baseline
+	}
+	for pkgName, summary := range report.Metrics["BenchmarkTips"].Summaries {
+		var packageBenchmark PackageBenchmark
+		if err := jsoniter.Unmarshal([]byte(summary.Description), &packageBenchmark); err != nil {
+			glog.Warningln(pkgName, err)
+			continue
+		}
+		baseline[pkgName] = packageBenchmark
+	}
+	return baseline
+}
@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/cognitive"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+)
+
+// CognitiveThresholds are the cognitive complexities from which a function is
+// high and grave, stored in the description of every package so the report
+// can classify the functions.
+type CognitiveThresholds struct {
+	High  int `json:"high"`
+	Grave int `json:"grave"`
+}
+
+type StrategyCognitive struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+	compHigh int
+	allDirs  map[string]string
+}
+
+func (s *StrategyCognitive) GetName() string {
+	return "Cognitive"
+}
+
+func (s *StrategyCognitive) GetDescription() string {
+	return "Computing the cognitive complexity of all functions, which weighs nested control flow more than flat one."
+}
+
+func (s *StrategyCognitive) GetWeight() float64 {
+	return 0
+}
+
+// Compute the cognitive complexity of every function. Like StrategyCyclo the
+// complexity is stored as the line number of an error whose string is the
+// position of the function.
+func (s *StrategyCognitive) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	s.allDirs = parameters.AllDirs
+	high, grave := s.Settings.CognitiveThresholds()
+	thresholds, err := jsoniter.Marshal(CognitiveThresholds{High: high, Grave: grave})
+	if err != nil {
+		glog.Errorln(err)
+	}
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(s.allDirs))
+
+	for pkgName, pkgPath := range s.allDirs {
+		errSlice := make([]Error, 0)
+		stats, err := cognitive.Cognitive(pkgPath, parameters.ExceptPackages)
+		if err != nil {
+			glog.Warningln(pkgName, err)
+		}
+		average := cognitive.Average(stats)
+		for _, stat := range stats {
+			if stat.Complexity >= high {
+				s.compHigh = s.compHigh + 1
+			}
+			errSlice = append(errSlice, Error{
+				LineNumber:  stat.Complexity,
+				ErrorString: utils.AbsPath(stat.Pos.String()),
+			})
+		}
+		summaries.Lock()
+		summaries.Summaries[pkgName] = Summary{
+			Name:        pkgName,
+			Description: string(thresholds),
+			Errors:      errSlice,
+			Avg:         average,
+		}
+		summaries.Unlock()
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+	return
+}
+
+func (s *StrategyCognitive) Percentage(summaries *Summaries) float64 {
+	return utils.CountPercentage(s.compHigh)
+}
@@ -1,15 +1,26 @@
 package engine
 
 import (
+	"fmt"
+	"math"
+	"os"
 	"strconv"
-	"strings"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
 
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
+// copyDuplicationSummary is the key of the summary of the duplicated lines,
+// the other summaries are clone groups keyed by their rank.
+const copyDuplicationSummary = "duplication"
+
 type StrategyCopyCheck struct {
-	Sync *Synchronizer `inject:""`
+	Sync        *Synchronizer `inject:""`
+	Settings    *Settings     `inject:""`
+	duplication float64
 }
 
 func (s *StrategyCopyCheck) GetName() string {
@@ -26,37 +37,62 @@ func (s *StrategyCopyCheck) GetWeight() float64 {
 
 // linterCopy provides a function that scans all duplicate code in the project and give
 // duplicate code locations and rows.It will extract from the linter need to convert the
-// data.The result will be saved in the r's attributes.
+// data.The result will be saved in the r's attributes. The clone group, with its
+// similarity and the code of its fragments, is saved as json in the description.
+// The lines duplicated in the project and in every package are saved as json
+// in the description of the summary "duplication".
 func (s *StrategyCopyCheck) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
 
-	copyCodeList := copycheck.CopyCheck(parameters.ProjectPath, parameters.ExceptPackages+",_test.go")
+	options := s.Settings.CopyCheckOptions()
+	groups := copycheck.Clones(parameters.ProjectPath, parameters.ExceptPackages, options)
+	groups = append(groups, s.corpusClones(parameters, options)...)
+	project, packages := copycheck.Measure(parameters.ProjectPath, parameters.ExceptPackages, options, groups)
+	s.duplication = project.Percentage
+	duplication := CopyDuplication{
+		Lines:      project.Lines,
+		Duplicated: project.Duplicated,
+		Percentage: project.Percentage,
+		Packages:   make([]CopyPackage, 0, len(packages)),
+	}
+	for _, p := range packages {
+		duplication.Packages = append(duplication.Packages, CopyPackage{
+			Package:    utils.PackageAbsPath(p.Dir),
+			Lines:      p.Lines,
+			Duplicated: p.Duplicated,
+			Percentage: p.Percentage,
+		})
+	}
+	jsonStringDuplication, err := jsoniter.Marshal(duplication)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	summaries.Summaries[copyDuplicationSummary] = Summary{
+		Name:        copyDuplicationSummary,
+		Description: string(jsonStringDuplication),
+	}
 	sumProcessNumber := int64(7)
-	processUnit := utils.GetProcessUnit(sumProcessNumber, len(copyCodeList))
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(groups))
 
-	for i := 0; i < len(copyCodeList); i++ {
-		errorSlice := make([]Error, 0)
-		for j := 0; j < len(copyCodeList[i]); j++ {
-			line := 0
-			values := strings.Split(copyCodeList[i][j], ":")
-			if len(values) > 1 {
-				lines := strings.Split(strings.TrimSpace(values[1]), ",")
-				if len(lines) == 2 {
-					lineright, _ := strconv.Atoi(lines[1])
-					lineleft, _ := strconv.Atoi(lines[0])
-					if lineright-lineleft >= 0 {
-						line = lineright - lineleft + 1
-					}
-				}
-				values[0] = utils.AbsPath(values[0])
-			}
-
-			errorSlice = append(errorSlice, Error{LineNumber: line, ErrorString: strings.Join(values, ":")})
+	for i, group := range groups {
+		errorSlice := make([]Error, 0, len(group.Fragments))
+		for j := range group.Fragments {
+			fragment := &group.Fragments[j]
+			fragment.File = utils.AbsPath(fragment.File)
+			errorSlice = append(errorSlice, Error{
+				LineNumber:  fragment.End - fragment.Start + 1,
+				ErrorString: fmt.Sprintf("%s:%d,%d", fragment.File, fragment.Start, fragment.End),
+			})
+		}
+		description, err := jsoniter.Marshal(group)
+		if err != nil {
+			glog.Errorln(err)
 		}
 		summaries.Lock()
-		summaries.Summaries[string(i)] = Summary{
-			Name:   strconv.Itoa(len(errorSlice)),
-			Errors: errorSlice,
+		summaries.Summaries[strconv.Itoa(i)] = Summary{
+			Name:        strconv.Itoa(len(errorSlice)),
+			Description: string(description),
+			Errors:      errorSlice,
 		}
 		summaries.Unlock()
 		if sumProcessNumber > 0 {
@@ -67,8 +103,65 @@ func (s *StrategyCopyCheck) Compute(parameters StrategyParameter) (summaries *Su
 	return
 }
 
+// corpusClones returns the code of the project copied in the repositories of
+// the corpus. The checkouts are indexed, incrementally if the index is kept
+// in a file, and searched together with the saved index files.
+func (s *StrategyCopyCheck) corpusClones(parameters StrategyParameter, options copycheck.Options) []copycheck.Group {
+	settings := s.Settings.CopyCheck
+	if len(settings.Corpus) == 0 {
+		return nil
+	}
+	checkouts := copycheck.NewIndex(options.Threshold)
+	if settings.CorpusIndex != "" {
+		if saved, err := copycheck.LoadIndex(settings.CorpusIndex, options.Threshold); err == nil {
+			checkouts = saved
+		} else if !os.IsNotExist(err) {
+			glog.Warningln(err)
+		}
+	}
+	index := copycheck.NewIndex(options.Threshold)
+	dirs := make([]string, 0, len(settings.Corpus))
+	for _, path := range settings.Corpus {
+		info, err := os.Stat(path)
+		if err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		if info.IsDir() {
+			dirs = append(dirs, path)
+			continue
+		}
+		saved, err := copycheck.LoadIndex(path, options.Threshold)
+		if err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		if err = index.Add(saved); err != nil {
+			glog.Errorln(path, err)
+		}
+	}
+	checkouts.Retain(dirs)
+	for _, dir := range dirs {
+		updated, removed, err := checkouts.Update(dir)
+		if err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		glog.Infof("indexed %d files of %s, %d removed", updated, dir, removed)
+	}
+	if settings.CorpusIndex != "" {
+		if err := checkouts.Save(settings.CorpusIndex); err != nil {
+			glog.Errorln(err)
+		}
+	}
+	if err := index.Add(checkouts); err != nil {
+		glog.Errorln(err)
+	}
+	return index.Clones(parameters.ProjectPath, parameters.ExceptPackages, options)
+}
+
+// Percentage scores the ratio of duplicated lines of the project: 5 points
+// are lost per percent of duplicated code, none are left from 20 percent on.
 func (s *StrategyCopyCheck) Percentage(summaries *Summaries) float64 {
-	summaries.RLock()
-	defer summaries.RUnlock()
-	return utils.CountPercentage(len(summaries.Summaries))
+	return math.Max(0, 100-5*s.duplication)
 }
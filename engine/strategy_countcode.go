@@ -1,8 +1,8 @@
 package engine
 
 import (
-	"fmt"
-	"strconv"
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
 
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/countcode"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
@@ -17,27 +17,34 @@ func (s *StrategyCountCode) GetName() string {
 }
 
 func (s *StrategyCountCode) GetDescription() string {
-	return "Count lines and files of go project."
+	return "Count files and code, comment and blank lines of the project by language."
 }
 
 func (s *StrategyCountCode) GetWeight() float64 {
 	return 0.
 }
 
-// linterCount is a function that counts go files and go code lines of
-// project.It will extract from the linter need to convert the data.
-// The result will be saved in the r's attributes.
+// Compute counts the lines of every file of the project in a known language.
+// The statistics of every file, with its language and whether it is code,
+// test or generated code, are saved as json in the summary named after its
+// absolute path.
 func (s *StrategyCountCode) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
 
-	codeCounts := countcode.CountCode(parameters.ProjectPath, parameters.ExceptPackages)
-	for packageName, codeCount := range codeCounts {
-		if len(codeCount) == 4 {
-			absFilePath := utils.AbsPath(packageName)
-			summaries.Summaries[absFilePath] = Summary{
-				Name:        absFilePath,
-				Description: fmt.Sprintf("%s;%s;%s;%s", strconv.Itoa(codeCount[0]), strconv.Itoa(codeCount[1]), strconv.Itoa(codeCount[2]), strconv.Itoa(codeCount[3])),
-			}
+	files, err := countcode.CountFiles(parameters.ProjectPath, parameters.ExceptPackages)
+	if err != nil {
+		glog.Warningln(err)
+	}
+	for _, file := range files {
+		absFilePath := utils.AbsPath(file.Path)
+		file.Path = absFilePath
+		jsonStringFile, err := jsoniter.Marshal(file)
+		if err != nil {
+			glog.Errorln(err)
+		}
+		summaries.Summaries[absFilePath] = Summary{
+			Name:        absFilePath,
+			Description: string(jsonStringFile),
 		}
 	}
 	return
@@ -1,15 +1,17 @@
 package engine
 
 import (
-	"strconv"
-	"strings"
+	"fmt"
+
+	"github.com/golang/glog"
 
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/deadcode"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
 type StrategyDeadCode struct {
-	Sync *Synchronizer `inject:""`
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
 }
 
 func (s *StrategyDeadCode) GetName() string {
@@ -26,36 +28,36 @@ func (s *StrategyDeadCode) GetWeight() float64 {
 
 // linterDead provides a function that will scans all useless code, or never
 // obsolete obsolete code.It will extract from the linter need to convert
-// the data.The result will be saved in the r's attributes.
+// the data.The result will be saved in the r's attributes. The packages of
+// the project are analyzed as a whole program, the functions, methods,
+// types, fields and constants it never reaches are reported by package.
 func (s *StrategyDeadCode) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
 
-	deadcodes := deadcode.DeadCode(parameters.ProjectPath)
+	importPaths := parameters.ImportPaths()
+
+	unused, err := deadcode.Unreachable(importPaths, s.Settings.DeadCodeOptions())
+	if err != nil {
+		glog.Warningln(err)
+	}
 	sumProcessNumber := int64(10)
-	processUnit := utils.GetProcessUnit(sumProcessNumber, len(deadcodes))
-	for _, simpleTip := range deadcodes {
-		deadCodeTips := strings.Split(simpleTip, ":")
-		if len(deadCodeTips) == 4 {
-			packageName := utils.PackageNameFromGoPath(deadCodeTips[0])
-			line, _ := strconv.Atoi(deadCodeTips[1])
-			erroru := Error{
-				LineNumber:  line,
-				ErrorString: utils.AbsPath(deadCodeTips[0]) + ":" + strings.Join(deadCodeTips[1:], ":"),
-			}
-			summaries.Lock()
-			if summary, ok := summaries.Summaries[packageName]; ok {
-				summary.Errors = append(summary.Errors, erroru)
-				summaries.Summaries[packageName] = summary
-			} else {
-				summarie := Summary{
-					Name:   utils.PackageAbsPathExceptSuffix(deadCodeTips[0]),
-					Errors: make([]Error, 0),
-				}
-				summarie.Errors = append(summarie.Errors, erroru)
-				summaries.Summaries[packageName] = summarie
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(unused))
+	for _, u := range unused {
+		erroru := Error{
+			LineNumber:  u.Line,
+			ErrorString: fmt.Sprintf("%s:%d:%d: %s %s is unused", utils.AbsPath(u.File), u.Line, u.Column, u.Kind, u.Name),
+		}
+		summaries.Lock()
+		summary, ok := summaries.Summaries[u.Package]
+		if !ok {
+			summary = Summary{
+				Name:   u.Package,
+				Errors: make([]Error, 0),
 			}
-			summaries.Unlock()
 		}
+		summary.Errors = append(summary.Errors, erroru)
+		summaries.Summaries[u.Package] = summary
+		summaries.Unlock()
 		if sumProcessNumber > 0 {
 			s.Sync.LintersProcessChans <- processUnit
 			sumProcessNumber = sumProcessNumber - processUnit
@@ -1,11 +1,15 @@
 package engine
 
 import (
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend"
 )
 
 type StrategyDependGraph struct {
-	Sync *Synchronizer `inject:""`
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
 }
 
 func (s *StrategyDependGraph) GetName() string {
@@ -22,14 +26,57 @@ func (s *StrategyDependGraph) GetWeight() float64 {
 
 // linterDependGraph is a function that builds the dependency graph of all packages in the
 // project helps you optimize the project architecture.It will extract from the linter need
-// to convert the data.The result will be saved in the r's attributes.
+// to convert the data.The result will be saved in the r's attributes.The graph
+// is laid out and drawn as svg without Graphviz, the packages under the
+// prefixes of the config collapsed, and the imports breaking an architecture
+// rule are drawn in red. It is also exported to dot, Mermaid and json, and the
+// coupling metrics of every package are saved as json in the "metrics" summary.
 func (s *StrategyDependGraph) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
 
-	graph := depend.Depend(parameters.ProjectPath, parameters.ExceptPackages)
+	highlight := make(map[depend.Import]bool)
+	for _, violation := range architectureViolations(s.Settings, parameters) {
+		highlight[depend.Import{From: violation.From, To: violation.To}] = true
+	}
+	graph, err := depend.Depend(parameters.ProjectPath, parameters.ExceptPackages, highlight, s.Settings.BuildTags...)
+	if err != nil {
+		glog.Errorln(err)
+		graph = &depend.Graph{}
+	}
+	graph = graph.Collapse(s.Settings.DependGraph.Collapse)
+	jsonStringGraph, err := jsoniter.Marshal(graph)
+	if err != nil {
+		glog.Errorln(err)
+	}
 	summaries.Summaries["graph"] = Summary{
 		Name:        s.GetName(),
-		Description: graph,
+		Description: graph.SVG(),
+	}
+	summaries.Summaries["dot"] = Summary{
+		Name:        "DOT",
+		Description: graph.DOT(),
+	}
+	summaries.Summaries["mermaid"] = Summary{
+		Name:        "Mermaid",
+		Description: graph.Mermaid(),
+	}
+	summaries.Summaries["json"] = Summary{
+		Name:        "JSON",
+		Description: string(jsonStringGraph),
+	}
+
+	importPaths := parameters.ImportPaths()
+	metrics, err := depend.Metrics(importPaths, s.Settings.BuildTags...)
+	if err != nil {
+		glog.Warningln(err)
+	}
+	jsonStringMetrics, err := jsoniter.Marshal(metrics)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	summaries.Summaries["metrics"] = Summary{
+		Name:        "PackageMetrics",
+		Description: string(jsonStringMetrics),
 	}
 
 	return
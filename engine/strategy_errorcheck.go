@@ -0,0 +1,102 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/errorcheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// errorCheckAssertion is the name of the summary of the unchecked type
+// assertions, which call no function.
+const errorCheckAssertion = "type assertion"
+
+type StrategyErrorCheck struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+}
+
+func (s *StrategyErrorCheck) GetName() string {
+	return "ErrorCheck"
+}
+
+func (s *StrategyErrorCheck) GetDescription() string {
+	return "Check that error return values are used."
+}
+
+func (s *StrategyErrorCheck) GetWeight() float64 {
+	return 0.05
+}
+
+// Compute provides a function that finds the errors returned by the calls of
+// the project and never checked: ignored, assigned to the blank identifier,
+// discarded by a deferred call or overwritten before being read, and the
+// unchecked type assertions, as selected by the settings. The findings are
+// grouped by the full name of the called function.
+func (s *StrategyErrorCheck) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	importPaths := parameters.ImportPaths()
+
+	uncheckedErrors, err := errorcheck.ErrorCheck(importPaths, s.Settings.ErrorCheckOptions())
+	if err != nil {
+		glog.Warningln(err)
+	}
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(uncheckedErrors))
+	for _, u := range uncheckedErrors {
+		name := u.FuncName
+		if u.Kind == errorcheck.KindAssert {
+			name = errorCheckAssertion
+		} else if name == "" {
+			name = "func value"
+		}
+		erroru := Error{
+			LineNumber:  u.Pos.Line,
+			ErrorString: fmt.Sprintf("%s:%d:%d: %s", utils.AbsPath(u.Pos.Filename), u.Pos.Line, u.Pos.Column, errorCheckMessage(u)),
+		}
+		summaries.Lock()
+		summary, ok := summaries.Summaries[name]
+		if !ok {
+			summary = Summary{
+				Name:   name,
+				Errors: make([]Error, 0),
+			}
+		}
+		summary.Errors = append(summary.Errors, erroru)
+		summaries.Summaries[name] = summary
+		summaries.Unlock()
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+	return
+}
+
+// errorCheckMessage describes the unchecked error u.
+func errorCheckMessage(u errorcheck.UncheckedError) string {
+	switch u.Kind {
+	case errorcheck.KindBlank:
+		return "error assigned to the blank identifier: " + u.Line
+	case errorcheck.KindAssert:
+		return "unchecked type assertion: " + u.Line
+	case errorcheck.KindDefer:
+		return "error of deferred call discarded: " + u.Line
+	case errorcheck.KindOverwritten:
+		return "error overwritten before being checked: " + u.Line
+	}
+	return "error not checked: " + u.Line
+}
+
+func (s *StrategyErrorCheck) Percentage(summaries *Summaries) float64 {
+	summaries.Lock()
+	defer summaries.Unlock()
+	findings := 0
+	for _, summary := range summaries.Summaries {
+		findings += len(summary.Errors)
+	}
+	return utils.CountPercentage(findings)
+}
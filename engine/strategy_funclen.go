@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/flen"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// PackageFuncLen is the function length result of one package. Lines is the
+// total length of its Funcs functions, Longest ranks the longest ones and
+// External lists the functions implemented in assembly or by the runtime.
+type PackageFuncLen struct {
+	MaxLines   int           `json:"max_lines"`
+	BucketSize int           `json:"bucket_size"`
+	Funcs      int           `json:"funcs"`
+	Lines      int           `json:"lines"`
+	TooLong    int           `json:"too_long"`
+	Histogram  []int         `json:"histogram"`
+	Longest    []FuncLenItem `json:"longest"`
+	External   []FuncLenItem `json:"external"`
+}
+
+type StrategyFuncLen struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+	tooLong  int
+}
+
+func (s *StrategyFuncLen) GetName() string {
+	return "FuncLen"
+}
+
+func (s *StrategyFuncLen) GetDescription() string {
+	return "Measure the length of all functions in your golang package.And will rank the longest ones of every package."
+}
+
+func (s *StrategyFuncLen) GetWeight() float64 {
+	return 0
+}
+
+// Compute measures the functions package by package. Functions longer than
+// the limit of their package are reported as errors.
+func (s *StrategyFuncLen) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+	config := s.Settings.FuncLen
+	top := config.Top
+	if top <= 0 {
+		top = flen.DefaultTop
+	}
+	options := &flen.Options{
+		IncludeTests: config.IncludeTests,
+		BucketSize:   config.BucketSize,
+	}
+	if options.BucketSize <= 0 {
+		options.BucketSize = flen.DefaultBucketSize
+	}
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(parameters.AllDirs))
+
+	for pkgName, pkgPath := range parameters.AllDirs {
+		flens, err := flen.FuncLensOfDir(pkgPath, options)
+		if err != nil {
+			glog.Warningln(pkgName, err)
+		}
+		implemented, external := flens.Split()
+		packageFuncLen := PackageFuncLen{
+			MaxLines:   s.Settings.MaxFuncLines(pkgName),
+			BucketSize: options.BucketSize,
+			Funcs:      len(implemented),
+			Histogram:  implemented.Histogram(options.BucketSize),
+			Longest:    funcLenItems(implemented.Longest(top)),
+			External:   funcLenItems(external),
+		}
+		errors := make([]Error, 0)
+		for _, f := range implemented {
+			packageFuncLen.Lines = packageFuncLen.Lines + f.Size
+			if f.Size > packageFuncLen.MaxLines {
+				packageFuncLen.TooLong++
+				errors = append(errors, Error{
+					LineNumber:  f.Lbrace,
+					ErrorString: fmt.Sprintf("%s:%d: %s is %d lines long (max %d)", utils.AbsPath(f.Filepath), f.Lbrace, f.Name, f.Size, packageFuncLen.MaxLines),
+				})
+			}
+		}
+		s.tooLong = s.tooLong + packageFuncLen.TooLong
+		if len(flens) > 0 {
+			jsonStringPackageFuncLen, err := jsoniter.Marshal(packageFuncLen)
+			if err != nil {
+				glog.Errorln(err)
+			}
+			summaries.Summaries[pkgName] = Summary{
+				Name:        pkgName,
+				Description: string(jsonStringPackageFuncLen),
+				Errors:      errors,
+			}
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+
+	return
+}
+
+func (s *StrategyFuncLen) Percentage(summaries *Summaries) float64 {
+	return utils.CountPercentage(s.tooLong)
+}
+
+// funcLenItems converts the functions measured by flen.
+func funcLenItems(flens flen.FuncLens) []FuncLenItem {
+	items := make([]FuncLenItem, 0, len(flens))
+	for _, f := range flens {
+		items = append(items, FuncLenItem{
+			Name: f.Name,
+			File: filepath.Base(f.Filepath),
+			Line: f.Lbrace,
+			Size: f.Size,
+		})
+	}
+	return items
+}
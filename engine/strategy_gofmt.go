@@ -2,13 +2,18 @@ package engine
 
 import (
 	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
 
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/gofmt"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
 type StrategyGoFmt struct {
-	Sync *Synchronizer `inject:""`
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
 }
 
 func (s *StrategyGoFmt) GetName() string {
@@ -23,36 +28,39 @@ func (s *StrategyGoFmt) GetWeight() float64 {
 	return 0.05
 }
 
+// Compute provides a function that formats the files of the project in
+// process and finds those that are not formatted. Every line that differs
+// from the formatted file is an error of the summary of its package, whose
+// description holds the unified diffs of its files in json.
 func (s *StrategyGoFmt) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
-	slicePackagePaths := make([]string, 0)
-	for _, packagePath := range parameters.AllDirs {
-		slicePackagePaths = append(slicePackagePaths, packagePath)
-	}
-	lints, err := gofmt.GoFmt(slicePackagePaths)
+
+	files, err := gofmt.GoFmt(parameters.PackagePaths(), s.Settings.GoFmtOptions())
 	if err != nil {
-		fmt.Println(err)
+		glog.Warningln(err)
 	}
+	packages := make(map[string][]gofmt.File)
 	sumProcessNumber := int64(10)
-	processUnit := utils.GetProcessUnit(sumProcessNumber, len(lints))
-	for _, lintTip := range lints {
-		packageName := utils.PackageNameFromGoPath(lintTip)
-		erroru := Error{
-			LineNumber:  1,
-			ErrorString: utils.AbsPath(lintTip) + ":warning: file is not gofmted with -s (gofmt)",
-		}
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(files))
+	for _, file := range files {
+		packageName := utils.PackageNameFromGoPath(file.File)
+		file.File = utils.AbsPath(file.File)
+		packages[packageName] = append(packages[packageName], file)
 		summaries.Lock()
-		if summarie, ok := summaries.Summaries[packageName]; ok {
-			summarie.Errors = append(summarie.Errors, erroru)
-			summaries.Summaries[packageName] = summarie
-		} else {
-			summarie := Summary{
+		summary, ok := summaries.Summaries[packageName]
+		if !ok {
+			summary = Summary{
 				Name:   packageName,
 				Errors: make([]Error, 0),
 			}
-			summarie.Errors = append(summarie.Errors, erroru)
-			summaries.Summaries[packageName] = summarie
 		}
+		for _, finding := range file.Findings {
+			summary.Errors = append(summary.Errors, Error{
+				LineNumber:  finding.Line,
+				ErrorString: fmt.Sprintf("%s:%d:1: %s (%s): %s", file.File, finding.Line, goFmtMessage(finding.Rule), finding.Rule, strings.TrimSpace(finding.Text)),
+			})
+		}
+		summaries.Summaries[packageName] = summary
 		summaries.Unlock()
 
 		if sumProcessNumber > 0 {
@@ -60,10 +68,31 @@ func (s *StrategyGoFmt) Compute(parameters StrategyParameter) (summaries *Summar
 			sumProcessNumber = sumProcessNumber - processUnit
 		}
 	}
+	for packageName, packageFiles := range packages {
+		description, err := jsoniter.Marshal(packageFiles)
+		if err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		summary := summaries.Summaries[packageName]
+		summary.Description = string(description)
+		summaries.Summaries[packageName] = summary
+	}
 
 	return summaries
 }
 
+// goFmtMessage describes the findings of rule.
+func goFmtMessage(rule string) string {
+	switch rule {
+	case gofmt.RuleSimplify:
+		return "line can be simplified"
+	case gofmt.RuleImports:
+		return "imports are not grouped"
+	}
+	return "line is not formatted"
+}
+
 func (s *StrategyGoFmt) Percentage(summaries *Summaries) float64 {
 	summaries.RLock()
 	defer summaries.RUnlock()
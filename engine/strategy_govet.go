@@ -2,15 +2,16 @@ package engine
 
 import (
 	"fmt"
-	"strconv"
-	"strings"
+
+	"github.com/golang/glog"
 
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/govet"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
 type StrategyGoVet struct {
-	Sync *Synchronizer `inject:""`
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
 }
 
 func (s *StrategyGoVet) GetName() string {
@@ -25,41 +26,38 @@ func (s *StrategyGoVet) GetWeight() float64 {
 	return 0.1
 }
 
+// Compute provides a function that runs the vet analyzers selected by the
+// settings on the packages of the project. Every finding keeps the name of
+// its analyzer as its rule and the findings are grouped by package.
 func (s *StrategyGoVet) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
-	slicePackagePaths := make([]string, 0)
-	for _, packagePath := range parameters.AllDirs {
-		slicePackagePaths = append(slicePackagePaths, packagePath)
-	}
-	lints, err := govet.GoVet(slicePackagePaths)
+
+	importPaths := parameters.ImportPaths()
+
+	diagnostics, err := govet.GoVet(importPaths, s.Settings.GoVetOptions())
 	if err != nil {
-		fmt.Println(err)
+		glog.Warningln(err)
 	}
 	sumProcessNumber := int64(10)
-	processUnit := utils.GetProcessUnit(sumProcessNumber, len(lints))
-	for _, lintTip := range lints {
-		lintTips := strings.Split(lintTip, ":")
-		if len(lintTips) == 4 {
-			packageName := utils.PackageNameFromGoPath(lintTips[0])
-			line, _ := strconv.Atoi(lintTips[1])
-			erroru := Error{
-				LineNumber:  line,
-				ErrorString: utils.AbsPath(lintTips[0]) + ":" + strings.Join(lintTips[1:], ":"),
-			}
-			summaries.Lock()
-			if summarie, ok := summaries.Summaries[packageName]; ok {
-				summarie.Errors = append(summarie.Errors, erroru)
-				summaries.Summaries[packageName] = summarie
-			} else {
-				summarie := Summary{
-					Name:   packageName,
-					Errors: make([]Error, 0),
-				}
-				summarie.Errors = append(summarie.Errors, erroru)
-				summaries.Summaries[packageName] = summarie
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(diagnostics))
+	for _, d := range diagnostics {
+		packageName := utils.PackageNameFromGoPath(d.Pos.Filename)
+		erroru := Error{
+			LineNumber:  d.Pos.Line,
+			ErrorString: fmt.Sprintf("%s:%d:%d: %s", utils.AbsPath(d.Pos.Filename), d.Pos.Line, d.Pos.Column, d.Message),
+			Rule:        d.Analyzer,
+		}
+		summaries.Lock()
+		summary, ok := summaries.Summaries[packageName]
+		if !ok {
+			summary = Summary{
+				Name:   packageName,
+				Errors: make([]Error, 0),
 			}
-			summaries.Unlock()
 		}
+		summary.Errors = append(summary.Errors, erroru)
+		summaries.Summaries[packageName] = summary
+		summaries.Unlock()
 		if sumProcessNumber > 0 {
 			s.Sync.LintersProcessChans <- processUnit
 			sumProcessNumber = sumProcessNumber - processUnit
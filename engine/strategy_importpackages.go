@@ -6,7 +6,8 @@ import (
 )
 
 type StrategyImportPackages struct {
-	Sync *Synchronizer `inject:""`
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
 }
 
 func (s *StrategyImportPackages) GetName() string {
@@ -27,7 +28,7 @@ func (s *StrategyImportPackages) GetWeight() float64 {
 func (s *StrategyImportPackages) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
 
-	importPkgs := unittest.GoListWithImportPackages(parameters.ProjectPath)
+	importPkgs := unittest.GoListWithImportPackages(parameters.ProjectPath, s.Settings.BuildTags...)
 	for i := 0; i < len(importPkgs); i++ {
 		summaries.Lock()
 		summaries.Summaries[importPkgs[i]] = Summary{Name: importPkgs[i]}
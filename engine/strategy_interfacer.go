@@ -9,7 +9,8 @@ import (
 )
 
 type StrategyInterfacer struct {
-	Sync *Synchronizer `inject:""`
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
 }
 
 func (s *StrategyInterfacer) GetName() string {
@@ -30,7 +31,7 @@ func (s *StrategyInterfacer) GetWeight() float64 {
 func (s *StrategyInterfacer) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
 
-	interfacers := interfacer.Interfacer(parameters.AllDirs)
+	interfacers := interfacer.Interfacer(parameters.AllDirs, s.Settings.BuildTags...)
 	sumProcessNumber := int64(5)
 	processUnit := utils.GetProcessUnit(sumProcessNumber, len(interfacers))
 	for _, interfaceTip := range interfacers {
@@ -0,0 +1,120 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/halstead"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// PackageMaintainability is the maintainability of one package. MI is the
+// index of the package, Bugs the number of bugs Halstead estimates in it,
+// Files the index of its files and Funcs its functions, the least
+// maintainable first.
+type PackageMaintainability struct {
+	MI    float64               `json:"mi"`
+	Bugs  float64               `json:"bugs"`
+	Files []FileMaintainability `json:"files"`
+	Funcs []halstead.Func       `json:"funcs"`
+}
+
+// FileMaintainability is the maintainability index of one file.
+type FileMaintainability struct {
+	File  string  `json:"file"`
+	MI    float64 `json:"mi"`
+	Funcs int     `json:"funcs"`
+}
+
+type StrategyMaintainability struct {
+	Sync *Synchronizer `inject:""`
+	low  int
+}
+
+func (s *StrategyMaintainability) GetName() string {
+	return "Maintainability"
+}
+
+func (s *StrategyMaintainability) GetDescription() string {
+	return "Computing the Halstead metrics and the maintainability index of all functions, files and packages."
+}
+
+func (s *StrategyMaintainability) GetWeight() float64 {
+	return 0
+}
+
+// Compute measures the functions package by package. Functions whose index is
+// below halstead.Moderate are reported as errors.
+func (s *StrategyMaintainability) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(parameters.AllDirs))
+
+	for pkgName, pkgPath := range parameters.AllDirs {
+		funcs, err := halstead.Analyze(pkgPath, parameters.ExceptPackages)
+		if err != nil {
+			glog.Warningln(pkgName, err)
+		}
+		packageMaintainability := PackageMaintainability{
+			MI:    halstead.Index(funcs),
+			Files: make([]FileMaintainability, 0),
+			Funcs: funcs,
+		}
+		errors := make([]Error, 0)
+		byFile := make(map[string][]halstead.Func)
+		for i := range funcs {
+			f := &funcs[i]
+			f.File = utils.AbsPath(f.File)
+			byFile[f.File] = append(byFile[f.File], *f)
+			packageMaintainability.Bugs = packageMaintainability.Bugs + f.Halstead.Bugs
+			if f.MI < halstead.Low {
+				s.low++
+			}
+			if f.MI < halstead.Moderate {
+				errors = append(errors, Error{
+					LineNumber:  f.Line,
+					ErrorString: fmt.Sprintf("%s:%d: %s has a maintainability index of %.1f", f.File, f.Line, f.Name, f.MI),
+				})
+			}
+		}
+		for file, fileFuncs := range byFile {
+			packageMaintainability.Files = append(packageMaintainability.Files, FileMaintainability{
+				File:  file,
+				MI:    halstead.Index(fileFuncs),
+				Funcs: len(fileFuncs),
+			})
+		}
+		sort.Slice(packageMaintainability.Files, func(i, j int) bool {
+			return packageMaintainability.Files[i].File < packageMaintainability.Files[j].File
+		})
+		if len(funcs) > 0 {
+			jsonStringPackageMaintainability, err := jsoniter.Marshal(packageMaintainability)
+			if err != nil {
+				glog.Errorln(err)
+			}
+			summaries.Lock()
+			summaries.Summaries[pkgName] = Summary{
+				Name:        pkgName,
+				Description: string(jsonStringPackageMaintainability),
+				Errors:      errors,
+				Avg:         packageMaintainability.MI,
+			}
+			summaries.Unlock()
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+
+	return
+}
+
+// Percentage counts the functions that are hard to maintain.
+func (s *StrategyMaintainability) Percentage(summaries *Summaries) float64 {
+	return utils.CountPercentage(s.low)
+}
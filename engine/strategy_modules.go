@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/modules"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+type StrategyModules struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+	issues   int
+}
+
+func (s *StrategyModules) GetName() string {
+	return "Modules"
+}
+
+func (s *StrategyModules) GetDescription() string {
+	return "List the third-party modules of the project with their versions, licenses and the packages importing them."
+}
+
+func (s *StrategyModules) GetWeight() float64 {
+	return 0.
+}
+
+// Compute builds the inventory of the third-party modules from go.mod and
+// go.sum, or from the vendor directories and the GOPATH without go.mod. Every
+// module is saved as json in the summary named after its path, the modules
+// without a license file or with an unknown license are reported.
+func (s *StrategyModules) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	inventory, err := modules.Inventory(parameters.ProjectPath, s.Settings.BuildTags...)
+	if err != nil {
+		glog.Warningln(err)
+	}
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(inventory))
+	for _, m := range inventory {
+		errors := make([]Error, 0)
+		switch m.License {
+		case "":
+			errors = append(errors, Error{ErrorString: m.Path + ": no license file found"})
+		case modules.UnknownLicense:
+			errors = append(errors, Error{ErrorString: m.LicenseFile + ": unknown license"})
+		}
+		s.issues += len(errors)
+		jsonStringModule, err := jsoniter.Marshal(m)
+		if err != nil {
+			glog.Errorln(err)
+		}
+		summaries.Summaries[m.Path] = Summary{
+			Name:        m.Path,
+			Description: string(jsonStringModule),
+			Errors:      errors,
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+	return
+}
+
+func (s *StrategyModules) Percentage(summaries *Summaries) float64 {
+	return utils.CountPercentage(s.issues)
+}
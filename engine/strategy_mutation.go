@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/mutation"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/unittest"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+type StrategyMutation struct {
+	Sync       *Synchronizer `inject:""`
+	Settings   *Settings     `inject:""`
+	sumScore   float64
+	countScore int
+}
+
+func (s *StrategyMutation) GetName() string {
+	return "Mutation"
+}
+
+func (s *StrategyMutation) GetDescription() string {
+	return "Mutate the covered code of your golang package and rerun its tests.Mutants that no test detects show code whose behaviour is not asserted."
+}
+
+func (s *StrategyMutation) GetWeight() float64 {
+	return 0
+}
+
+// Compute tests the packages one after another, every mutant already runs
+// the tests of the whole package.
+func (s *StrategyMutation) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+	config := s.Settings.Mutation
+
+	var budget time.Duration
+	if config.Budget != "" {
+		var err error
+		if budget, err = time.ParseDuration(config.Budget); err != nil {
+			glog.Warningln("invalid mutation budget:", err)
+		}
+	}
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(parameters.UnitTestDirs))
+
+	for pkgName, pkgPath := range parameters.UnitTestDirs {
+		packagePath := "." + string(filepath.Separator) + pkgPath
+		covered, err := coveredLines(packagePath, s.Settings.TestOptions(pkgName))
+		if err != nil {
+			glog.Warningln(pkgName, err)
+		} else {
+			options := mutation.Options{
+				Test:       s.Settings.TestOptions(pkgName),
+				Covered:    covered,
+				MaxMutants: config.MaxMutants,
+				Budget:     budget,
+			}
+			if config.Timeout != "" {
+				options.Test.Timeout = config.Timeout
+			}
+			result, err := mutation.Mutation(packagePath, options)
+			if err != nil {
+				glog.Warningln(pkgName, err)
+			}
+			if result.Killed+result.Survived > 0 {
+				s.sumScore = s.sumScore + result.Score
+				s.countScore++
+			}
+			var errors []Error
+			for _, mutant := range result.Survivors {
+				errors = append(errors, Error{
+					LineNumber:  mutant.Line,
+					ErrorString: fmt.Sprintf("%s:%d: %s mutant survived", utils.AbsPath(mutant.File), mutant.Line, mutant.Operator),
+				})
+			}
+			jsonStringResult, err := jsoniter.Marshal(result)
+			if err != nil {
+				glog.Errorln(err)
+			}
+			summaries.Summaries[pkgName] = Summary{
+				Name:        pkgName,
+				Description: string(jsonStringResult),
+				Errors:      errors,
+			}
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+
+	return
+}
+
+func (s *StrategyMutation) Percentage(summaries *Summaries) float64 {
+	if s.countScore == 0 {
+		return 0.0
+	}
+	return s.sumScore / float64(s.countScore)
+}
+
+// coveredLines runs the tests of the package with a coverage profile, so
+// that only code executed by the tests is mutated.
+func coveredLines(packagePath string, options unittest.Options) (unittest.CoveredLines, error) {
+	profile, err := ioutil.TempFile("", "goreporter-cover")
+	if err != nil {
+		return nil, err
+	}
+	profile.Close()
+	defer os.Remove(profile.Name())
+
+	options.Race = false
+	options.CoverProfile = profile.Name()
+	if _, err = unittest.GoTest(packagePath, options); err != nil {
+		return nil, err
+	}
+	return unittest.ParseCoverProfile(profile.Name())
+}
@@ -9,7 +9,8 @@ import (
 )
 
 type StrategySimpleCode struct {
-	Sync *Synchronizer `inject:""`
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
 }
 
 func (s *StrategySimpleCode) GetName() string {
@@ -27,7 +28,7 @@ func (s *StrategySimpleCode) GetWeight() float64 {
 func (s *StrategySimpleCode) Compute(parameters StrategyParameter) (summaries *Summaries) {
 	summaries = NewSummaries()
 
-	simples := simplecode.Simple(parameters.AllDirs, parameters.ExceptPackages)
+	simples := simplecode.Simple(parameters.AllDirs, parameters.ExceptPackages, s.Settings.BuildTags...)
 	sumProcessNumber := int64(10)
 	processUnit := utils.GetProcessUnit(sumProcessNumber, len(simples))
 	for _, simpleTip := range simples {
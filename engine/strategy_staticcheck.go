@@ -0,0 +1,103 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint/lintutil"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/staticcheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+type StrategyStaticCheck struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+}
+
+func (s *StrategyStaticCheck) GetName() string {
+	return "StaticCheck"
+}
+
+func (s *StrategyStaticCheck) GetDescription() string {
+	return "Run the checks of staticcheck and gosimple, which detect bugs and suggest simplifications, and report their problems by check."
+}
+
+func (s *StrategyStaticCheck) GetWeight() float64 {
+	return 0.05
+}
+
+// Compute provides a function that runs the checks of staticcheck and
+// gosimple selected by the settings on the packages of the project. Every
+// enabled check has a summary, named by its ID and described by its
+// CheckDoc in json, holding its problems with their severity.
+func (s *StrategyStaticCheck) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	importPaths := parameters.ImportPaths()
+
+	staticChecker := staticcheck.NewChecker()
+	staticChecker.CheckGenerated = s.Settings.StaticCheck.Generated
+	simpleChecker := simpler.NewChecker()
+	simpleChecker.CheckGenerated = s.Settings.StaticCheck.Generated
+	checker := lint.Combine(staticChecker, simpleChecker)
+
+	opts := s.Settings.StaticCheckOptions()
+	for id, fn := range checker.Funcs() {
+		if fn == nil || !lint.Enabled(id, opts.Enable, opts.Disable) {
+			continue
+		}
+		doc, ok := staticcheck.Docs[id]
+		if !ok {
+			doc = simpler.Docs[id]
+		}
+		description, err := jsoniter.Marshal(CheckDoc{ID: id, Doc: doc, Severity: s.Settings.CheckSeverity(id)})
+		if err != nil {
+			glog.Errorln(err)
+		}
+		summaries.Summaries[id] = Summary{
+			Name:        id,
+			Description: string(description),
+			Errors:      make([]Error, 0),
+		}
+	}
+
+	diagnostics, err := lintutil.Diagnostics(checker, importPaths, &opts)
+	if err != nil {
+		glog.Warningln(err)
+	}
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(diagnostics))
+	for _, d := range diagnostics {
+		erroru := Error{
+			LineNumber:  d.Pos.Line,
+			ErrorString: fmt.Sprintf("%s:%d:%d: %s", utils.AbsPath(d.Pos.Filename), d.Pos.Line, d.Pos.Column, d.Message),
+			Rule:        d.Check,
+			Severity:    s.Settings.CheckSeverity(d.Check),
+		}
+		summaries.Lock()
+		summary := summaries.Summaries[d.Check]
+		summary.Errors = append(summary.Errors, erroru)
+		summaries.Summaries[d.Check] = summary
+		summaries.Unlock()
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+
+	return summaries
+}
+
+func (s *StrategyStaticCheck) Percentage(summaries *Summaries) float64 {
+	summaries.RLock()
+	defer summaries.RUnlock()
+	problems := 0
+	for _, summary := range summaries.Summaries {
+		problems += len(summary.Errors)
+	}
+	return utils.CountPercentage(problems)
+}
@@ -8,12 +8,14 @@ import (
 	"github.com/golang/glog"
 	"github.com/json-iterator/go"
 
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/testquality"
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/unittest"
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
 type StrategyUnitTest struct {
 	Sync       *Synchronizer `inject:""`
+	Settings   *Settings     `inject:""`
 	sumCover   float64
 	countCover int
 }
@@ -41,7 +43,7 @@ func (s *StrategyUnitTest) Compute(parameters StrategyParameter) (summaries *Sum
 	for pkgName, pkgPath := range parameters.UnitTestDirs {
 		pkg.Add(1)
 		go func(pkgName, pkgPath string) {
-			unitTestRes, _ := unittest.UnitTest("." + string(filepath.Separator) + pkgPath)
+			unitTestRes, _ := unittest.UnitTestWithOptions("."+string(filepath.Separator)+pkgPath, s.Settings.TestOptions(pkgName))
 			var packageTest PackageTest
 			if len(unitTestRes) >= 5 {
 				if unitTestRes[0] == "ok" {
@@ -70,6 +72,18 @@ func (s *StrategyUnitTest) Compute(parameters StrategyParameter) (summaries *Sum
 				packageTest.Coverage = "0%"
 				s.countCover++
 			}
+			quality, err := testquality.TestQuality(pkgPath)
+			if err != nil {
+				glog.Errorln(err)
+			}
+			packageTest.Quality = TestQuality(quality)
+			var errors []Error
+			for _, name := range quality.NoFailurePath {
+				errors = append(errors, Error{ErrorString: name + ": test has no failure path"})
+			}
+			for _, name := range quality.ExamplesNoOutput {
+				errors = append(errors, Error{ErrorString: name + ": example has no output comment and is never run"})
+			}
 			jsonStringPackageTest, err := jsoniter.Marshal(packageTest)
 			if err != nil {
 				glog.Errorln(err)
@@ -78,6 +92,7 @@ func (s *StrategyUnitTest) Compute(parameters StrategyParameter) (summaries *Sum
 			summaries.Summaries[pkgName] = Summary{
 				Name:        pkgName,
 				Description: string(jsonStringPackageTest),
+				Errors:      errors,
 			}
 			summaries.Unlock()
 			pkg.Done()
@@ -0,0 +1,86 @@
+package engine
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/untested"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+type StrategyUntested struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+	sumRatio float64
+	countPkg int
+}
+
+func (s *StrategyUntested) GetName() string {
+	return "Untested"
+}
+
+func (s *StrategyUntested) GetDescription() string {
+	return "Find the packages without tests and the exported functions and methods that are never called from any test."
+}
+
+func (s *StrategyUntested) GetWeight() float64 {
+	return 0
+}
+
+// Compute analyzes all packages of the project together, the call graph
+// starts from the tests of every package, so a function tested through
+// another package counts as tested.
+func (s *StrategyUntested) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	importPaths := parameters.ImportPaths()
+
+	packages, err := untested.Untested(importPaths, s.Settings.BuildTags...)
+	if err != nil {
+		glog.Warningln(err)
+	}
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(packages))
+	for pkgName, pkg := range packages {
+		errors := make([]Error, 0)
+		if !pkg.HasTests {
+			errors = append(errors, Error{ErrorString: pkgName + ": package has no test files"})
+		}
+		for _, fn := range pkg.Untested {
+			errors = append(errors, Error{
+				LineNumber:  fn.Line,
+				ErrorString: fmt.Sprintf("%s:%d: %s is never called from a test", fn.File, fn.Line, fn.Name),
+			})
+		}
+		if pkg.Exported > 0 {
+			s.sumRatio = s.sumRatio + pkg.Ratio
+			s.countPkg++
+		}
+		jsonStringPackage, err := jsoniter.Marshal(pkg)
+		if err != nil {
+			glog.Errorln(err)
+		}
+		summaries.Summaries[pkgName] = Summary{
+			Name:        pkgName,
+			Description: string(jsonStringPackage),
+			Errors:      errors,
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+	return
+}
+
+// Percentage is the average ratio of tested exported API of the packages that
+// export any.
+func (s *StrategyUntested) Percentage(summaries *Summaries) float64 {
+	if s.countPkg == 0 {
+		return 0.
+	}
+	return s.sumRatio / float64(s.countPkg)
+}
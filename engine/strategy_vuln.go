@@ -0,0 +1,107 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/modules"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/vuln"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+type StrategyVuln struct {
+	Sync     *Synchronizer `inject:""`
+	Settings *Settings     `inject:""`
+	issues   int
+}
+
+func (s *StrategyVuln) GetName() string {
+	return "Vuln"
+}
+
+func (s *StrategyVuln) GetDescription() string {
+	return "Match the third-party modules against a local copy of the Go vulnerability database and find the calls to the vulnerable symbols."
+}
+
+func (s *StrategyVuln) GetWeight() float64 {
+	return 0.
+}
+
+// Compute matches the modules of the inventory against the OSV entries of
+// the database configured in the settings, then looks for the call paths
+// from the project packages to the vulnerable symbols. Every finding is saved
+// as json in the summary named after its advisory and module, only the
+// reachable ones count as issues.
+func (s *StrategyVuln) Compute(parameters StrategyParameter) (summaries *Summaries) {
+	summaries = NewSummaries()
+
+	entries, err := vuln.Load(s.Settings.Vuln.DB)
+	if err != nil {
+		glog.Errorln(err)
+		return
+	}
+	inventory, err := modules.Inventory(parameters.ProjectPath, s.Settings.BuildTags...)
+	if err != nil {
+		glog.Warningln(err)
+	}
+	findings := vuln.Match(entries, inventory)
+
+	importPaths := parameters.ImportPaths()
+	if err := vuln.Reach(findings, importPaths, s.Settings.BuildTags...); err != nil {
+		glog.Warningln(err)
+	}
+
+	sumProcessNumber := int64(10)
+	processUnit := utils.GetProcessUnit(sumProcessNumber, len(findings))
+	for _, finding := range findings {
+		fixed := "not fixed"
+		if finding.Fixed != "" {
+			fixed = "fixed in " + finding.Fixed
+		}
+		errorString := fmt.Sprintf("%s@%s: %s %s, %s", finding.Module, finding.Version, finding.ID, finding.Summary, fixed)
+		switch {
+		case len(finding.CallPath) > 0:
+			calls := make([]string, 0, len(finding.CallPath))
+			for _, call := range finding.CallPath {
+				calls = append(calls, call.Func)
+			}
+			errorString += ", called through " + strings.Join(calls, " -> ")
+		case finding.Reachable:
+			errorString += ", imported by the project"
+		case finding.Imported:
+			errorString += ", imported but the vulnerable symbols are not called"
+		default:
+			errorString += ", not imported by the project"
+		}
+		errors := []Error{{ErrorString: errorString}}
+		if len(finding.CallPath) > 0 {
+			errors[0].LineNumber = finding.CallPath[0].Line
+		}
+		if finding.Reachable {
+			s.issues++
+		}
+		jsonStringFinding, err := jsoniter.Marshal(finding)
+		if err != nil {
+			glog.Errorln(err)
+		}
+		name := finding.ID + " " + finding.Module
+		summaries.Summaries[name] = Summary{
+			Name:        name,
+			Description: string(jsonStringFinding),
+			Errors:      errors,
+		}
+		if sumProcessNumber > 0 {
+			s.Sync.LintersProcessChans <- processUnit
+			sumProcessNumber = sumProcessNumber - processUnit
+		}
+	}
+	return
+}
+
+// Percentage counts the findings whose vulnerable code is reachable.
+func (s *StrategyVuln) Percentage(summaries *Summaries) float64 {
+	return utils.CountPercentage(s.issues)
+}
@@ -11,10 +11,10 @@ const DefaultTpl = `
 </style><style>
 @font-face{font-family:FontAwesome;font-weight:400;font-style:normal;src:url(data:application/font-woff;base64,d09GRgABAAAAAX7oAA0AAAAChqwABAAHAAAAAAAAAAAAAAAAAAAAAAAAAABGRlRNAAABMAAAABwAAAAca75HuUdERUYAAAFMAAAAHwAAACAC8AAET1MvMgAAAWwAAAA+AAAAYIgyekBjbWFwAAABrAAAAWkAAALyCr86f2dhc3AAAAMYAAAACAAAAAj//wADZ2x5ZgAAAyAAAV95AAJMvI/3rk1oZWFkAAFinAAAADMAAAA2EInlLWhoZWEAAWLQAAAAHwAAACQPAwq1aG10eAABYvAAAAL0AAAK8EV5GIVsb2NhAAFl5AAABxYAAAsQAvWiXG1heHAAAWz8AAAAHwAAACADLAIcbmFtZQABbRwAAAJEAAAEhuOXi6xwb3N0AAFvYAAAD4UAABp1r4+boQAAAAEAAAAAzD2izwAAAADLTzwwAAAAANQxaLl4nGNgZGBg4ANiCQYQYGJgZGBkOgQkWcA8BgAMuAD3AHicY2Bmy2ScwMDKwMDSw2LMwMDQBqGZihkYGLsY8ICCyqJiBgcGha8MbAz/gXw2BkaQMCOSEgUGRgDQywhuAAB4nM2S30ricRDF52dqZeb5PsAi6gNEvYDIPoAIe9NFiE8gPoH4BOITiJcbLCLRdche7KUIW1tb+cPdavtvc6b11l+/Teii6yU6MGc4MMwHhhGRBZnXB/FCF+8uTN5zjnrDsNekIDFZl4xsS1d25ZscZXO5dK6iKU1rXota1qrWtalt7eqODtTXic6YYpprzLPIMquss8k2u9zjgD4nnFnK0pa3opWtanVrWtu6tmcD820ylSAIyRn5/Ioo6jSrBS1pRWva0JZ2tKd9HepYlULHDNdZYIkV1thgix322OeQY6qJOctawUpWsZo1rGUd61nfhjb+RwzOgq1gM/gUfAw2/KvR/eiLW3VJl3DLbskturiLuahbcBFM8RePMBCKB0xwjzvc4gbXuMIl/uAC5zjDb/zCGD5GOMUJjvETRzjEDxxgH99Xv86v/bby4vKC9SKhRV4PzF/hPSgeSyxGk0vLK/957xNi+cPzAAAAAAAAAf//AAJ4nLy9CYBU1ZUw/O69b6l9e7V1dXV3VVfVq+pu6G5qbXotmp1udgQExBZFkUVBQRAXSiEqiBso4t5oRMkyYxbzJUacyqaTRWISYja/+dokJpm4jJPkNxG6Ht+591VVVzcN6Mz8H3S9d/f13HvPOfec8zjMbeY4YhPhwUkclwnag8QetA+hvJrdjAc3C4FTm0XuFEf/Ie6SM5z4jJDjasDjlJA9GHc7xVCwXkmmE0E7UlLJbpQIxmuR+ExT4S6U9SmKbzhHnyhbuKspHPMIOU8sLMwIQXSBU5IK/BEO72gKeap1umpaBwd1cFBHE3jsTguub8bJbpyIe+zCaG8ynUHpRNwtctPWXbXiqnXT4DXx6mWF0V6llmRNtlibEDg9GJ/X5HI1zbsCXlFc9X6hozKAvFaXMCCOb+Mwa0MO2iBxQei3jQvQH4Ku1kcRPMIKtjnS4QDvdrhgGNx8Tv1YvVf9GEnoOiL1J9Nh9dhX3rpPPX382muPIwHVIuH4tTejZREMCZCkJVZzyX4FLb15JMW1x9XT9731FfVYhM4GdyYncQLH+bgubi7HReyixEsW3AQjgKJKRInanW4Y67S9EzcTmAPR5fS4PbV8B453k0w6040ydm1yUnY6PTBQuUBE/duTieymVoRaN2UTT6p/iwRks5A3y0gQTbpTWbN88FtviO31mWYnQs7mTH27+Ma30pfkVveeyvauXt0r5HtXBwgXrj2xp6l10qTWpj0nasMFzizLfAw79HadQZDNz289/KwwyRdxOCK+ScKzh5seGDidp7l5WoY2x7RvOc7PcTwMaTOfghbGa7Gnm8CE0jEljyYdhfsNof7OFnWo+7ZrF4TDC669rXtIfafwQM6BV+jCl15x79S3/tE0OxsOZ2c3/eOt//1O4Xmt7C/C3A1x9RqMylAcnbeIAE8A0IxMwTQTkdNxjyzAmPjUh5Yil1N2qT1qD0yoCy9VH6xqQx+9LXfKb6OP2siNbp/6pGqSzK4a03vvmWpcogX9Da2pdkX0s9FrDQ3q5Nl6uj5wuW49hV49ihhhaklEKLXj3M3gt6C4uuL4cXUFis9GO9GN6DXWroZzNws7UUM3ulW9vVv9hbrytdeIodTM+HlaSduYE+jYu+gqjhQhJAkD7w5k4rWEs4kBxZYOCNwty4c/t/wWe/PMbf270cbd/dtmNtvPcG+r3377bdS9d9Pjj2+66OFHNk3P5aZveuRh8i0t/G0YByNdPxJdP1aujmvherj53KXctdwu7j7uKe6fOU5IJZUmVC/WIKe7AwEIX8CP7EmFQXgR5NHY+E+Z/kL1jV04KKf42C52jgfPKb4CRz0EnsPcSIxQkVPNVaa6UJmw5D5mi0aERZMtR6FHx3MWfJgVrNInPxJ+esRJKpOo45ZS4XzpFKtbYAuWp8AtVs4n3ZlHjVAVGjNiF4gnXH9S5ZL9/UnMniNukjtXDOboltmfRPSJf1ThGf7RuWI4tjDZXnM2LHLIpbWqC2mtso/xj43/n/aPrQ9zbTE1H2tri6EsfY64ca7SV8idO+6Tp6x0owBz0gf6ZdlZGHGScUMvmKCiMAChcefif3wWPvmoChAzzMIIhJ3mzh1X6f4vjtWooYBz6kbOIt7Jf5lzgw/OB0msb0FISfYgOBH08KhD4p3+woS7/Av8d6mH/H7qQAq+n/rJXxawKP9daD31+/3qr/AD4IVyrznzgeDgD3Ahjgs7rUisj+oRLVtJZvSjy3c7JT0SHKxk9dfqr7WSkAKuYm1IKZb+awg9b6y/XIqGu2j7RQjOwWnaDDdpDzotIW1uOmBbhkfcXYPg7EdFLIs7F5bFc7J5SDYDijIE6MaIcxTu1Zc6F+6Fh87KSZ1/qEDIXlzfdw6ErLJPVs7DtZ4FtZ+s/YU8rRVnP12rWXs/cUuLZ7xIl1sDl6JYEBb5ALQmlXRk0m6PW5Qs0PpawBMhSIk2I8AVPW4H3bO1HZri1DtPqL9X/1X9/YmdRw40XV0XsDau2bBw3/E3ju9buGFNozVQt77xwJFCrn9dP/zh3OM05c4TyP/411DvpoClqfHqwJw3b1wHySHXuhvfnBO4urHJEtikvoLnFNgGjdkGDf+EMj44si9wkTK4aEASsWt+2r7x/OhCfs5hyVsc7IFyn849UHI4rlOZE2Xh+ZcCc2PqRtcN05eF0CD0l1PMI1DPyHwweuIa8CeVetHpjlMIgvUpwYw4YUZCsEZFCf7TVsNyjUoUkJQoRRMBl4egZkQHAxZwphSagFWcBlyf9RAWtCcDaDRQARSFtiAJgmoB7g6dPHToJD5kM31DdoZmGfTV97tNln0TWmxmqebfLC7kn9Rwj8FqMd4alXTWWY5qy/8y22zGlyxVsakGve8Bt9k8OvG9eqvZdFuYJfZZITF20xoOoU3/ZnJjfzoSX27yGSL36jd6rHfF/Xbz122uDXrjdWmD2WR0rayKT6rGLjNL29w8eaHJZDCH7zNsqExs2J7QWbTErX7sYmcH4K0jOEgHN5W7SsNDKmdZuIBfBtrWWUtp1G6EgjC6QVESGKSVEZZQaU1nGC0LY8jOEIeFzSk80DncueGcxUpIllgthQGUb5UM6ncMErnWYRlY3TsM+NQAA53UDOs8esLMs85AKYuDBCrAyHIOd6GWfHW4H2DeHuHnbNNjrH8Igof7F9+4bTH5Oqv9uUgyGXnOoa1/HwzYlQLhZLb+Wdeg40X8K6VH7gwAWoidDFEKa5SSBlAq7scuuwc2FcBP1dwZwLkAV8U9uAf9n26dmZh1hf5Cv8lk1nXrsAH/OLA88De2NH5jwDigBihiSxFdNIR4hH6tKnjKHD2W8JTCv+gQ1s8xVOvwMp/vR9+hfVPXfY3S/NreSqdYhpbDuQVQ6xqDQHoke1CJwpmj9SJoF172x9pip9iZSnKxAf8etMNgUl8zocvVAUB8OH6PfyB2OkfjRTi7Y/5p6l01JjTZdMrBw9mOBhlTg5TXphP27gkjmK227xTBhrM1o4AF2WpRIM3ZMOymsLXDzk5gk9B2hCENHAYPnFJ/eerAgVModgpdd0J9Sl2tPnXiBLoMPY0uI0NqGW4oLBRUSHWgmANfWpn0xAk2j3HAl+bB9mgHaOdQijQjSqZIxCVqdI4zBNRNFIIptSMREaidetgYEIXcerq5sGR05wjRMURufpkXOc0vmZ3Iixymv5kc+KPmQtbsQE4IVj+EcCdymAvZZh86ogs70WIIsULIUUhihSRosTOsQ0d82M8jdjKped5kswFtKZsRZQOYz8Bzdrqbd8p+2aztm2Zwnn6vu0RHiBQJtHIRrgswlOJeWHrLo6bd44730NWH3BLFY5CSoWwmDSBc9mBc0DhISGGvowAODElDP7mz/fH2u9AbsTb1m/Y6NetIO9Rsnd3eiIA0Q5T44hqPJrVc9A8FRvC+u9rgD9sbatSsLKN8TUMU5RndlK2AFS8XZjiAs9yuMqi47AnYLorA0o1sCl8BL/yAQf2W0WtU81adzp1nCwf+flSGmQMHzoIaPGAyqd/S61HWJjsZ3FjUQQeOV0Da8bNAZ5y2anucthlqLAiKCaJzt3V1RQsNqAeajbLWn563qQ861UG2yQ04LCYT6tHr1bwNfXyepmIGExQFMLOVH2xGURIkcHgFPcHICDRkZG039shucgZ1IoJOFjpPwgt1XoqyeEDxnYKNquoDQ8pHsr6U4YMqnCVGjD5UbfDKP63WMi7kb7u7cKyqvr6q8MuuijGyctVcVMPD2aFLK0zD2Jxj2fODgcKQ1W6zBQLBOhw476LHz85xqHm9To7gXER2yGr+h+db9ajcpkR5L4oqPUgJ1Vsw4GyJOD3v4/Rgl0S+jGQm4jyc/YDacRRSG+32un0Pfr+EfG0/OVuyWQ179Ui3Sf3BF0ZQtYNI3nA7QLjAqVmfEovW7ttbRPHWXWrA+n26KsOeB2hK1Ib8J3Zeu/Y2WESV+EyYm8lWAeaC9WFAWEb2a6A84JiNl5GT0sJOsq6U8Zwu5OCCrO1wVv8RZdV16gcH1P/YcJucpNMFK0/eO/Orl93xpxnGRgBHs1xF+weh0L1i4GtmeQp6FMkHkHPD7ZANDQlY/Zv6lWuuvE3WilCS8t7eWbdfZ7/CIxOZZoeQfXu1ALOETGgudE1WKCjqzskv4NAYjDR1Af9YujR1Ab88hmsln8WF0giBcz14iB9mHsLIjPHdkOgU81Cu7yi+LhooF/fXcVyF8QIrohOEuYdpffzcSoYvW+O8xk+vo2s8RXd7VyWPiNKCcP5SStANy5mirCRbIroDSIc2I10g1ka4/PpDh9arQwW2X2OIzn8d6dR/fD3fRuEyW6Qj7FyGwWV5w4PtLq1hgxSrbsaheo0PS9c5xZkBZU7E6bUC1J5lHcr2re8T8lXVv3i065ZVd8/Oqx/abT6lztX+3jc2vHSrEk/vumSx2acI3CzltIV2nP+LMivV17etIFRVW7ZOSE44oFd8+A8Bj6VmR3uH3JhsVBjdX+Kl9dEWWjEg/q7ROGoN/GBBpJIYthrsctbR47yMmpVgDGgEDL0qEphirtP5Dffe5SPY6Mwb6qfVvKD+Qv2y+osXaqbV3zBzJG75Xvc3nJ13DKEk6kfJoTvwvqMPTgou3hAYQT4DMztNl655EImPP66eenDNpabOmYERpDSwYXFw0oNHH0be13fufF39k9avAOH4IcDh2L4Fx2IZduGgcRM4q2X1K+optg+LaC4sVX7wNF3haC6EUDRzrrYGKbwE+Bwra+L4pXHaRDLGdbKZsOsDz7h1oNxFMwxWn+Ktr/fSn+KzGmaMU7HqOLzbL0SqXTWuqpbelip4V0eEaga6sN99A+ZsJmvPbG7Dp2kTHKnFUHYnA/Q2I97GxgGFB4DosOEoJcjLKT5xj9BFn9tvNlUr0TbnnMWL5zjboorPbN6PPqf+zAxgGpXqpObwTfv23RRuBieL/NknH4WMekItdAiKL+qssaaf+fozaWuNMwrQ3/E1NanuWgkxYQ9v5qt8K5ENxZFtpa8KvJ4wJFnJmRiRT2Ge3jEaYWeVOQ+cuHVw4rfAOUfXqiuUkuEXhB9itIo9SN+A7ttRMRxot1TIHrIHXYkU0pLYUQ7+kRyQXpTsoD/C0ecZrpDjczkarebYuwD/BfjRIMLRbMMI7ULFfDQW51QWTvnMEIhZQhpMfxy7ByydDWf3I8o1FfvSQfnjiZA9If83fj3wLxBYXVf3BPx1d99aV9fD/p7o6YG/W9nf6p6e46tX02Q9PULu1G3Crv/Sj86LdqY/JLzL9uiaCh5FESMCCqJMiSE3ysPm2LeevyGiuqLJVKSQUlL9STSYyin4hxHeSCP71GwqojojEfyjSC6FBpP9KaWQjpZw04ekDcW6UheqTdBCgfqDPZHGhRKfoBUox4LDzbXozQiNy6WGPkH7kizQXweZoDL8AyWlNZtwBsB5boQ2L+Gu4LYCxAJNYqF0FyznTBLWrpLpxmwZK/Q51gFRokdiXSrmk0QPO+YBDY+6BZG5e1BaGSHlKvziVTG3+r58/ZThtXPv83vdIoIzEZtcomeCjgiY+ImrkUcSz4d5uYVHOowtblFnN8vOYNSPFDP+eM4Ct/pBeOYlw49VG40G7w7yWE1ahyZIWDn9Pm+y4AFzFe8CR2EQHOvOCuHrJ88aviG7bMO8qZ18s0VXLRqd1QZlg2KI6Yz1Ynhzvb5ZMIcE3zZFF9LrnD6dKRKMVrmRSPSb5wzfsH261VY9o85HfuMOWWvLaIuaLzu1u9uHheK9MIp7NC4AY4PpGVxoYAHnNb/f4wpGo0G5qjWkzlRnhls0v8sj5PTmtvpTf69vM+sC6Hl1eZD6BT349aW9PCdqe5EJaP5OjmvQNhPG9wmWQDFjL7KsNQwtVDqei2BZx1gUFF2A3WcYfoP0roXPaYSobB7ScJchs7xlPuAxeDA24D/sj2Xnb0Ec3XPaYoMFjfbMqgNmeZBiM4NAQg/O34IDlFlx2D8QO8NtKcoBaDRzkGuAHlCRC8Cji8jACAJVZlcV+dA2MvuDY8c+OEaGKMp0KkefQwl5bQpzqbVyonDVCD+ZDByjSfHsQ+uHWToCz7smzZw56a7TOVSWWRjhLWu43AKYJRIHxCmjQO18RkYdiBJoDpg5KoqAKB9SdNUDws9LgPjHu4VUEg63iAhYTS1JUC4ljRRDIv7554I/niwry4Z/gD29rQnF9D7y9qV05PXggQbr0hqnVd5nFVGPmu1X/xzldyOPzqU3C92LkNrtW+vvUPoJwu3/3q6LkAXkJ2o3jwvDN8yXjAY5WofX4ZMWSQ3MUx+5tP5/t080WWtERRbsvM2CmkJ+Ac5gg0lnO/JtgtvV96vcdQ6g1qJ6h1NnKdLR7OxywQ5/GcdF3ImAPRltBtpLgs45xVpEGO4IXcM0jPXZyRZ+N9+JUjZI24IoiQbJaonLaSESAA+8QmxkcNOcXrSjoXp676Wz22f7EUY6sXHqop1rEu1XbO2NL9Chwu+xdX9YMooCcvPhVHNC4Neg3+/2rPDM+MzNq9qCE5d0px59fca2p55fNeGFCevVa6wBNP+63gmdQTtvSJ1M6rbPuQS/Kfl6ti6ZcXWH3xz/QaJ6va95ePNq3ms11Ub8La64QN5s0pn1Ao8WYxn52pfc0pdcNrk94A29+tAVT1053S+6NdqUp+uzneNcdE+DtehD0VQzjmYoaQpdpncLEvRQxPCkHGlRqqebd4jOs909f0q134x2rkfernmyHPynW9pb197jFyy190V0JlGPq2+0Y7fDgpD9eWI2Nhlrtvr3TUt8/daLJFm2hHolnMTGUJXZKJCrsF4Q9DgaN0Ssckuw3fxg4e0l+jWLLrI6+OoJGeLEjhF4PQVtruZugdmLu63abRhdy9CuHu0mjDJHEKUBKC1Al1E3Bnh1MxAVJUDJcLSZ0H7QvdjjdMAclwAcygtTGIZdgo6IPYkpQUfhnBG6FgzZ7eIbQYfzVmc7/BzBBQsqPR//JG16DeYtfF8YRcRao8uia+SdPBaiNVU1xGZGokmWarD98vi8gB7xgmCIPR8WSH2/+vspMJPEfvFGrywizBPjw8EdTrk26Gu05CK+p33wF+G5kmuY489Uw/wiJJiNCG0eWlBj4Scs0c+bjnR6ghHi+YWZ1YWvHrFdOyvoarLFDBYrwk5HAumrAz5LI7poLXpw7TZc7fE7eZPXYt5+FfY50C5tjAnjB1zGPcRxcnEcw7zHPWYQUwodFDaIdSjlpMvgHOPYjZOAAzOBstEjiaiYEL0wgeXTDAOdCjrdTnp7AlOkAB5N6F0irMBgUoG8C7WxnYEuQ9z2oKdyYC0Gu9BVe+uCjY16BItu3HGV9AQJdMR448MNf7NpYyvUmjozWd7n47OZTpPZKpBhjghW89hQnoYKu2DMMeJRoGLI585AZhFjXliYOZzMvPr0rPGH3Lb1n+/8ApFqdNKcWQvTgqnaaNq+jo35qTPRCWnianOR9ISoK1wXwjhUF3aNG8hpfNdRPA12u/bfuWOXOMX3MZMWEYuSLaeZdInAmKuK7xTziVwxjqXk4ZkfETa58gLO/0ft1sQTSa7YbuYTStI6zIf/f2j3WBmFC/lHt7tytCvH+r880v9P2nxh96ds83l4dWNvj+0X8I8HN+eLv1DfESebGWp7jocI8aeYRwDk9xR3rphzuYfKpaHrx3MO/7Xs5McNHT8bu4s/a0w1PjS950hqErefdjTOGp2cbLbo1SG9HgX0FrMsgP9j1kORNeU0e/LZse6RNGSIilLQ7H76uHDPKjs5bh+LvH+Nn0MlZP67fRygHWScQQs0UTj2abuIT/hpCZq4CLhU/afoosZnZPLDdWz+GBVV6lOJuK5BiHGZJC5qNlU71E3Hthey248d247z24+hg45qkzlKmUSNdkFGB4+WYo5tfxYdAAS6TE9JGj1g4Wq5ZjqSlD5Jx4GsSiEYyAqWNlSseMawtXFu8+DmzYP85lM5lB3EgE18zPoh0pE4WCkFydtows2FvJrNs6QoAIPHBoyHLIHTjJXN54syi4C3vyts4ESg8qq4CMcFM1HJlXChJGDpCFB0oFuA9Ib22REgH4iygQETRBtWvrsyh29wG6TCbyV44lopjQaH8+qA8G7kqDpwNJxOKe9GINWGHBl001QGN031A3VgOI8G8VAqchQNPqsof44W8U9ek/3wjOZ0WBDlaSiM8U00IQ10KKg+aOuZ1WNVDwbRBPQ8mkCKshXcphnDp4KKEiTijE0n0QT15Ci5EplKiNezu6pRF9Tcg/SuiTw45lZqgM9qN1D4P8++O9T49ZyQB5qH8l+B2iFRpZ6h9S5ofDpC78op05IAlRMHBI543Jhzohq3X+KB1vMDZDn71vdhTj2pLldPLhS3XHyNXx9PJnT+ay7eIi5EuXAQNQUzHpvNkwk2oWA41df34kkV+nXygdv1z9z9q0tq6+trL/nV3c/od2nrVfwH9FMEGJvMdXOzoFXabHIKzKU7g+TRoE1lYKxUuKHyQgWWJqD7bsKmXIIJZzJwZMfWw1sHMBewq0/bA3a0euGx7cMMykm2J20lxDTJ4vC4hxkYEgAxfdYaG0CBwoA6xK9apQ6t8i8Ach0NQDFtAzhfLqfw41e0UrYfq5JsdihGFDVBkNW9t5qhFBt+XR0qQFHYvwoFVvmhlAXl8Wf35E3cirGytpPiGjpNj6fKnlFazOOWtfvLLhQKSKLsZqueStd3S/SGhUkHQZeFXKmL3Bmz7JvbZhA3l3rn8Ptssut9NcdW/6B6/PrtE4lHx9sMBvfkxpDkCnXMu3bfi+sHYcvwybCT45BaKPVTNlcLvnq+1Ms3ZYPZa9Pp0VtqDvaLxvzuveoLHiM2W+qvGtjTNmnJwILFU9qjbrbBQJJkqe+7YK5bmOSgfbxppV08e2LpTiZr9/GjpRxHulueUYOZiKPn1GAWRecfh3/q7fWqi7zea+CNJHwnvK7x4tXqt0dPpQGXp1KFqTQQHToJeb3on1gGr/oxZKWFaHozVB6eyrdMLZ4zjNVE2UclAQLGWgq6nGLplKWbM+NJla7pmYxSkF5jeRAs9zOcnAQcFVAh5qQPQIwAaWVOGXHsooBGUyd9QDSi0YjDj3669PLo2ir4AFQPKM34UNDs6BhZK5c9nSE/k30+udCu5yuk5fXC9bLJdyrrM8n4Vb2hsKKEcwPGvcKgr9APaRpb/jmqYYnSGbFc29l14ldl31k1t5+jCZDY5Cu0s7bsLPK7qsZpS7Jc8+LKmmX5PLXB6I4Uz/p6s7BL2EO1JvRIZN1ia3TdqTc8waBHaPXgywq1ZqdPyPucZnCFK2Q8izjMWfL4wljVH64o+c+0AIZzlT4hO0L1VFJASgl2S/WcVYs4imIaVc5IXlEbO0+5a55iDyXWW1GaSIcOBoinT5kOHwwdHTnosImOqQG/yhwwcvAw+fCrBn25/BKcnFW+xz76ypRWNV6No8Hk3LWD4+jIAOGjBn1lY0atidFtGduIcu2V9Y6ucUxFbL6hBhEJIsBJNcfJ2qbAZgNVzAitxzICYxT2hFcrpgVPLA2xr/AHTRZK8Z2Bpzaej555lD8q/AEwJk6P3Zr0eHE/ohspf7DwPpZl+SidCR9A+R/AcVTmf1Z4v/A+c2pB8KBptDJXQJlXFss8SxCdFroYitLyylAKKxwKwAdpDcwD/7UENOEo2Kf3hxzV7gkF7ZoKj8se1PR4EkG7psyTssMJMUp6J0+7zMb9DOs/0jxMMCw7VnwnW4w5Ow9qOluWqUKeqNiuUmvObkOFLtC4tRZp3rG1VPa/id2dJlsQFRdooZI1VsYss1L8tg5J7OlOxHsYbxNGfFQbbpFffFGWV8jVPurwVYPz7BC0e0zb0JPnS14MQSfOOTYeJudFWwtoOKCVrK0e2koqt1jRPoF3rIR5V9f9Fp4rHQ60nlaB6xzDY+Uq6/0OqFm9+rdQtcMPhMwhmaabM6YNlfJe7dwMwJjH6o0lmxEQByIbs6JgCJzJkgWVUsD5m+nmw2NEQMsy49y1R5f9NWf17JFMNn0qWJ9s7Yu19lzNIpuCgfr2uiqUG9P6wbJwOf6n5YcW/dzruEI0TfN6k0Gl2e3fNjVMo+Uu2eGa1DKnaywwjPSJ0l7tpT7ZR0CP8bnLQEjGdHmUxB/nsAyUBFoHNGllcFd0EJ/V+EEI5GgsONQ8eznIvYPFEMe3xrZ3BA5amO5PWRekGUXLPBcLkhIUAaL+WuQpq4l0I40vA/HltJCvXEY3ypTTQj4og//iJrqQNgWObGTLaeORwNgAdL3iuy/y7hHmPfJu5D4aPyYAc+fKXQ5AE86dvRgwWi4zxKTYOU3xR9I2xh5YEEntSqJInVhh5TrT55JDnH3A4DPs3QuPAwb6Nozxv34+yUT0/fEzlf1V5xdPPlt2Wl+Bfdeh4qFxTiHKg+oKurx/LctXwvsgopv8lfLO8wpT/gzyyEhhKVkWmvfUJ2znZzg952B6wckoYnd2ApOrBKCChmk6MkWNHSGwrGDZO3jt9w8sHa7Cf73zWSCjhcDO19Xfqf+q/o4KPcGW0IZqXse7j9xRsF687MAPX8Z/WXlg+MGnUY/6qvpbJmFZi9pRDXXRczB7JgVt6IORKuoOsdnV+GopjbHGVLIQQ6ymJAtZFFGUPiqGUNgWieC76X1In6Kov8H55BScy6X61F+HN4b7IW4/E1bYpyhzlPWQoE/DR1JCvlifxttiRy8q86i0iWIUoZCPFLZFk4kolI8ihWxyypQkzqu/gfqVZErBd0dwNh2hzeiDClCkLwW1IwVqhwyFbXRD51Iwxn1ClmrMo1LHyliPdvAXu0kRlz4oiWo9/ZoVxToCReG7Q5l0hFaXOk9baFs13CJ15kWoM1fS9S4NZrFbZdyrOLZQKe1lCp4wUtSBlP5kLtmPFDp+fRGch7itdDwpj6cvElF/DWPd30/nQoG+R0dwzjyF9yItR+WpLQIcYs6irnkzjmLoqyOYsJfoNZVSUENrHntky5rukCDYrTaTZLKSXamn8feHgMrCHAGqTKVkF+JMdemLtg2uzUwTQ3qr0673wUlZc/S1O9BBiolAKm7UedqitcTjHsHOS8uPyam1oBLeRbcXjen2V4P61ftlTZgWqr8f9cOiv454qFv9KnUbDKj//qIELXrfx9KXhXJpekg+m8ni0gyQ3scyJJWiDJ/5zD3CX4Xrtfadqx3najeTexunIedoN86O2xB8cNxmcyU5TEHTUSyuxzKwlldIGYAoRUV1ZweY/ibVL6EKJMyDBmNtJDBeKEtfrAtDXUSjocbwiWm5p5mYK58vllRSEtVoT0o/pZhOjBUOvuiI3psgaqo7E+EM7IGzzyOU2xtJU20wURKEHzRX+7K+q5rVjxikqx81XwX+6mZkAKcWhQzaIjAUo9SP0B8g+BqIfkR9nalSJx6B8Gsg/tFHSzEowbSzXy/HVJ4HlEaZyKQ4HaUdf6wOPpGTURoAOKqsheAWbcsubfn4yw5z3ux0wsOBHQaD5S2LwWB3Wr5hkYWxeMjp/3jFIjvNr5idMroSbzKJOp1oKhw0WK2luy1oV5Yzc26gludQLMmeCrrsriLel2A3zE53OMmQ50Rc0xur1AnTKCxm6YSdzgnN9EncTQbVfNif94fVtu/c6muCmcO/bIs1+W75dgy9AHgUTC9Mp4ZNff2S3bsv2dCVy3VtoC70dYvjq23oZD6vTmirqq4ma4/UtS1og7+6I4MUDSvBlKZxuPul3XOffXYuvBwan0zS7DjMY3zlUD0vMv4soK5U6CycoFxmkdN4gIjqD1AhOiqYqul90st1TOV2unlqe0MAHOcL6lu/2wmry+uqXu3ci6Sv+bDibFbf/c2bQw/usx7w2FqaumuaGqqwjpDuOd1+rF/28CubMl/9ypcfihqizvqoN9oTsBElqVx+7E6XF1acd7V88zokXrpmSP32po0twpxsfzbUyFtEsxSam26X+WmGROr6nz61PeywEn00YojaPfpVe7aWeBzQQ5GDdZOA1Tr2hsXJNt2ohzE4BdjBPdFant4ljdyTneEmzR8YmD9pKo9W7N+7IqP5eonmGyxLr/PyvD2XLJ41a2ViIIdQw5Ktt31hTSlk9e3FkCIuQcedpzLmQW4SrEslCru+xg8XJTcAO5sLjVHOpHg5OgsBjkonpOHtEXOH3+nSBK+63jn8GfQAOokeKLzod97yFX/Mv3Opk2x07lejhb+o0f1O5370K2xBv9qPs+9tW3fjN6jK8DduXLftvdf/+lc8Oeb/yi1Ov9+5dKf602mhP6jvIvc7oWmhd5Bb/fM7TK92UKIy2XquiuvipnIXAeRnmhFrqmNsOyO0nUXuKqSgYhe0xcE40yqlPH4ZaCHk5hn7mYeTOpxRohlAtHHTvGVroC/P4b0jvUB3ovXqqqsnGRymnbYJ9/3ncqfzEfQqMl+8Mm1wCL5wbZDYIk/ejrw6lHdGZxxSt/3bnJPo6huvf67n0n+e/P17evIbaD9VFV8z0s3/kPDxgunli20zoNi+Kb/cW9df9y6y2S+zmWSHjA1q693vxNFHE/fMqM8u/MIrexwfvPyV6zdnv3ypNnc22J8+ZPAUpBA1lv47e08iyC2VpTwRvezgK+5qYVcyG98ymou7kplwoYi9o/4UV99hj4QIZ++c0XkENibZQh9oD/qhSTIaJYuaMZjN5IVTuZ6emvr6Giq+WxcOF8+kjcJGqvcH27cVySVud1SPGOe7CVGxf6oQxLYhPdLcHgGWvDAwIdt/ZFCw5yQTT6yi+u9qISWYB/QWbNUfHzZiZAC3iL+NiMpbCDbmLDb8yGB/XhhI5vuPFGbJlgERETMaVgvftlsG9Ng4fFyymU2X6VEKEeTR2WzGnFl4arA/S0+yM9odxdmy0CUp6Pnc9RznKUpyR8a8UaW/zLwp7scV6TJj4iKjhB7L5F6wwpaAO4cC6hAaQFk1rw6OdeMh5s7RJ+FoiOZWB0dUaSBNORyx0gIjkSjXnzzFNNhzq3uzvauR9oIQrd5AlmXLZlFgGMpHee0NoTiAAkzqlRofGP4iS0Iz5CuC555mBk8EeA7Q64UB7dlfpGNgPQtDQMVkuC1Up09q5ivEFEp32F0IiJpmMZrO1PKJoKZKgBzlyCAcBbCELZUSDkyYr1ssp8aPds511yYSfROGmHrrKUHUq3l6nx1Y37Yi2R/vTbZXdxSTUC3okrofTXKGa53X2egNNNc0TO1adsmOaVoZYwJLufi6VS9OzMxqqGEshmGLn5YC6wshIlk89c1d0Uu+yuKpHqL6LbK9lKC2s6e5e1Pvih0LliaCLPOoEC35yP0LbIcUNQWEBFaUKMAepkRTSlqhh6CQoeYRuhFVpJO4D9Ur/jaj71X11KQp9mqeCMiATVhqdTV4a41PvHjvh6j/a39Dj5Nm9bPqrz6v++epFh12OxBv463EgnUpT1vzrNjFSDx0+/tfWPv50TR/gmnyupwMKyqdZLD/1JJ4NymfbBfk5n9PPaLOUo98T9PcaOlc1NzYvKizRfNSA0QqYyBSHz/Kh/O576uvvPgi6v2+xmJM9itunndTQojyh68cSVqZrcgfXsG5xKN8gPJyI1KlZZHSHdVBxho+ixv8+rMl7u6zckrG78hyoVpOlfjDQ+JR8m6JP3zW7Z14kPGHz+IG419CGbSsFBQqa4zpZ1mhGm6UgzM6QrWsNBtXzaQTdaFRmq+a3n+Q3fqXLuJS2k2cRq0ywx7ED6Q+vasTOKpHpzNKPAZawoqycqeMslbFl8dZm35Qwjmrmne2O9U8DSvkaRjVuSvlgDXOG0S76ESDaBBwLDvKud1qzu6lwmbGvAE95LWrOY8HsSCUM+X1xpEs6kAF/ygnaDrU7dTGiyZtwRffVGtQEugdcdk4H8PzqLSx1iHew6QumOUO8iP2+lHQe/o9s5ccpvM9DDSmzVaNv/QjjdFtq7KYeAnxX/IpSWbtQ/sjeZXzRsjOToOtlYqy+4wNdZMEkgG32VHnUqTSHVBR38159v1RDeN15PasOp1dtWfPKgRPPLhqDxksMD/J02dgT/lOXFoG5chco0bta+dySd2dSiVRTQkkJUeXLy2rU19oeqz3dL4+VYcWgIvP1qfUY8P51Se61H8WULHiAPxm1YXUrYmZvtq6ENoPb9Q+eOksdavI2/mKxlBeDofzIpOt4RgQjb3KHbm4xXlYZGOuaSuuWflfJ+l6rbiF5bnypas2figrcSSv1VW6Ox57Uzz6XnjcAkdufcfc8hZvdYt2WHQl/SYzYLguOmdBu6aFFbQn7CUfzsEIwE/g/sEBMGoeqkBF5XeGgeI6nYMd7xTQvAWOamSdpqtxhGfRymXZ6ZUGPFRDQj2AbtKXEgWE1ENxHsAr6Yvy6YBkiabP2hS5tinTqqZM71q17Cbhtt/Or1nZkrpido3b7HNtmLb1AZ/3wX/a/N39aycBbdx4bPswk2si+e3HyJNV+thcxdx707IaWdp6Wbztui5Uhfu2WXR8zyK0gqyeuf2xY0sc+okIj+Q6NuouNEz1U4qXevZEJkS3ikxKYXz2kCtRsrSR4Ido/pdfq32nZdrOnuvveuZf/7XwHg1iIglQOF78pwfb2tCP9YMHPv+nwhe1ujQSY8QmDsWrqIZZM9ddpPQqsPZ0SdoqmApyNiUg2twB6iZBABOpUoVeM7wGtCQV8nC0xSx/YTJHw4eofU8+VzTsN/w21YiDbg5/N1u4Wcz1pU5xqb6+lAhP/GW/Y3UvPctjbTomljT87RyqQ91v08w8zH/+hn253GmWQaBPNuezxIOMTp1ZlH+i08zIbdoFOsHMsmYzjkqeIgNNk8RLOsJFa5CZkjplLU+ymwc3yw2NCzYX3+Q7a+z6aH0TGXjLP68x5i9c9sLxZ15/BcUHn3l9N7p8gDTXB9bYzQZxwZKLJ5MXBjdvXtDYIG8uvlXOviYAhwNkjjXO8+Ondr/+zCCKv/L6M8dfUJ8YIE1wyNnXGMS5i1b0amwE7oxVygkfwgzZYV52cce509yIXJfWP+iZveyqsPPjOo+hn09v5qfCyA9iMkFMMogS+bA50HpYdoWKA1HxIFYWVXH2wF4B5WslQKvs/53MJMegiByCI6FvfZ/2VHMW/WNGV32bJHm2y0bD9ZGY0SR5XjI6kKe+4QbJbDTcLxm6bR7TYYOlnNS9gyatb6pMqjPRpKZOq8cISXHuIZMjwe/Eun6L0+m09OvwTj7hMD30kNme4PnutmJEokHkd/AJu/mhT5u+aMroDEPCAYD5VNGh3v8Ng4y8oYbWqUa9SardLq2QTRtbvFbDIwbXxZLuM9V6g2Wee4LiRXZjZVJd7Q3SCodlY3NFUp3R1u9urfdge2Fov81aXbWliiczV7swdq2eSXjwVlttEFHjoRE4HLgEomY24Bk0zlNjJR/+V3KV5UYYLhxhUq82kWHDzBwQTHYSMOFunrEI6D0ILEwJ8IVakUIaVVyOiqEAXbFhgEpYu9RM0MvqN/9l6YqbHw3HiVHGgLRjgYhICNtqXIab730ZTUe3oum4896bDa4aW1hAItVXhGROUzz86M0rlqr/+f322iMotvWWOzy3HSJ3q39+b69teUwPlCeRRJGXCBXbcEVi3lk/3X73e3v3Fvbu+MksbyziUkQEkbwoSsRiQ5I+tty2h1+xZNWHd8ztm/lmGe9munOd3KYRazOI3o4m0/R+vkwJwREOPaUkJvSrG8GBQ3lksCKdbGWwn9iE6SCN7Kd0UVLKieqcQAIqGq2ZpOGPzourgwPZAZ830uDO8ErVhHBD1BYImCM1LZ5W4We7b8wLtSFHymkNNOUm6RXATr9wT/iSgW/etNWtDtH9EznCa9sneT1KUzSx5I4ZrS+sO6zZrMG5xNz2H3asWe274TNNnmlCPJAKhR2FnChZdXY8+zlfrW32nEB8elWXHa0KXzwnGJ471eVeO/fuIxObYn0pnEv1eXf3papu3NMYmbJv2yWXH+bKNpiYLGk3pS0rdrQom2s2HmmNYyJZBG3EBKrnhz10I1dSVJmVnoilbY6JjVIbW+XjB6CGbmGSqzyk5fFqClidKUeoVlizLLf7Z0Krp6UmYg4EbNGG8IQqhc+4GyJeHwwoGojPyx1e90JrKHTHkkS0Pmb0yq0da8PqB2zQAu6tuVeu3rz/i6iTKPpJvKZkqXKhVcjeVTU9XqdEZttqfRctmo3tOqskFnKOcCgViAvTPE2fucG3ek3HD9vnxq86fPklN0ybPiUSXLN4qSs+d7dXG7fYhAlP7hXmrnW7ps4NB2cXcYIvkiyjyQFXOsu6L8mOtd4rDJ363tnmeSvXJtV/nUxvKZsJo9TpQNZbCBybQBNlinjmGJvJYq5p6sCqdTvWzvI6uh3eWWt3rFs1MLXpm3g6nvZy7p3CA45z2FMmX1h48+xmW2LuVL/b7Z86N2Frnn3zwue/WXgDt7z8PDWq7BjP3HIZJxcDsJfEKD4XcbotuBLXcBUDinKa7biWlG/Mysm0GzKcw0iwmlUmpUktSxW9lPeBqOVtu2jgyaBcGKKCiFlGmOTptVlggA+4fGZNMF02M8/q3kK2dzXmJSOOJ2kWSBwo2jgIALJbGCrpAWu4LrVFBXRjJmEPwc7HTm3tVoBKUdRLiVTITcDNDmLXWDT0/T/+8SM0Y+vsmZNRxyw8+48Hdtw1G/+RkD9K1s4JW9HJStRzJ/7am8lp05KJ6dOHn0P3PvrktrW9hf1oj+IITXoCX1+JbTLeN7OZYqQy9UhDJ+wMn6ANIBZqCixKGAWUTtiLxB2l+OywCw0Bhgd/GOhMdXEC202oWuhXN/qUJy4vm15MXv4EHkRMtIPZJVP/CQjRGpO9Gr2j+G76HuY0Ok/lvlemv+heGh3P/m+NZt+3UtC/bIVxvHu/EZFczBpQyJblj5l5NCp4+kJhq3b9h/e/IGuiinhAzZcEcVnCkhAuM8hIFlGhRpaP3QLSfPQ6csTGlIfC6TlgUF/uU1IBTKeorRAKNmKKfGpBbn48EETXH9tOFdkZzCLWE3WoCLPFMMD0Hx0fFFGikK2AXJzXIFengXWZ3qey72ZuNr1vSAH1546kgk4JTieXUzvBELv4Kc2DdkfCdmVqT6TIWEpVUMXoB3POcMf575zh5txzPLf4nte3NKaUmq6pfdsclmGYkm19U7tqlFTjltfvWdwWQwFoGWV1BmJt+J6nfzIw7/mPBn7ydM3zJ3Iz7986X0g31M9NpOesnK5ZmJm+ck46Mbe+IS3M33r/zFysTeNh0stQfYXOAqVs6gCeJnBx7jbuASpfG1WoWQTtmUlHi35PGrrB3sxfS1U4nBkakkZUe8LldIATzigLprcW0GF2IkNCZoCKzl9GydA7UZjnbuxx07PHQiRNVRsqcoyFZyzxkl6An0cAHEQSxBYsSYhIOjdGRNQJ4kps1PPwazYZurAbYye+XdN1+O6jDjsS5eSEJp2nHgtGYrSIjkaTrWlCwCL5Js2ZFU15a+SZVb72/e3GUL9c4035m7JdSgjZHY9+F3GV+wVaIEpQtyQ1S4TX6Qg/iecxLxAsIwlLOkmcKfFEgh9vs1mhxToeTWeqISefU/+/JLGZkk2IIH2dr8OKBKNO4qvdfr8ktrjFqtTlM+a3d88Rq202u11y14pzutvnT16WCtv4umxsDTbZSBIZ8Z2Ve1LJdkKezR3bB85vv48Z2kxnKLhp9+taFLVoVmTBncuC3+ddl3chrutyF/o8M+LXSIUvqeTlGY4aN0N5B8xZvk45hxG/tlmz2trwQKy0TGOAqeZlWc3Wls9Z4QzA4CTucnrOMtVkig+ya2Cmlg+EFdU4djGRDmdJMZwiMI6ME2uGfrS0LKPGY9MkBrW0DLTgdAYUeZfFaDLoDAZeL89zdv6po+mqqW17pwzsmlTl9rq9l1VNfnvyi1fd9vPtuf3Dj938g8m/bYOw2WvdVeHZuaXzHv32zs4/tsv9zoVz4AQ0YZsDvzrh7upa/0SfZ6U74kD6Vo/XnZ40+9//47bYYINn2YQad1144i+Q8+5n1W+ezkyoqbl2tne5J3ak4dqfn/jalI6uea2GtUs8Kzxmrz7Ax56olIWgun5ORpsCPc6QN44uJ75ovIjZlqV9wnTbKXbPU0s001nUiamGhpBzGl1rV6+qTvbULdCvmbtL/WB+a4jUGh1Soi1etazaIjlCRiVgJTWWyVMnGyQX6v/uXlxvqdY72uKdTktNI181eYY8QyQoVr2sKt6WkBzGWhJqnY8cu+au0S+o60lWr1q91mV0EhHSTa7iG2sszs54m0NfbanHe7/bj1ySAcq21BBrQDGGHFLpDCvbkOUupJjGD4zoh6z+txEVku3HBK507tC4wZEI7dzWbJiImj1DO8p4kHxeYya5YQ49d/HF6DnTOa2acKcVdOiii9T1worz2zcZ4bHN5JYxHJKPUrsU9PKfGjFAZQEA6hQAvWG2oIHy4Ty1AjPYdzajjQ9Map4oCn63wdoUbjBLsslNLr+3DZtFqWFSg8FJiNdX7TEYW1PN0wTBLDlwJ5r8WbHV0VAVtk0+6HKP2daWGQ2eap+XEKcB8kuiGWfuu5y4TbJkbgg3WQ1uvyBObJ4U4N2ug5Nt4aoGR6v4WfW1TuyQzIIwrTlFJlfuS4jKYolL4HyfxLiKsPawBfEapUrvsbVXF3J72N23m/cU7WtR/mNaXDL1UtT/2JvqT7+g/ufboaa3X7j6aF3Q39S4+eC0eb3zJtyIVr6qO37H/oFNA5GrL+HXrZlu8d+uFj74X5se4PfhWy4TjJ4vbeMVMuHexcv7HvqKQQnfcfxK1+TrewyMPrj0TI78C+BNjP/NOIRBEqL2ZuzaXRv5lyeWdqJIVFVPnOHOvPHFg8Lf1H/MmnVc/WVBj/+OYr9+6XWO6TqfeY7N6xJuFXcFt4G7ntvJ3c7dpUnZuJycJGpbUbSbp9QaHJhWKmLdDOiBh25FxEPRBCoBgloAya1FlG8EP9KD2CYHaz2VdMjlI7fyPcpLj+akVO9yZuIZGlcS3FF/86dqH0pOXnnZlIb5kYn+9VHlklcvsaWu80+MzG/IXrZyctTgau2d4pE7nE6XTTRJkrvJYDB3z5rq9iBf9Z/U35y4iBgMhBj0IUlvEOEX1ut1er0jrjOZdHqzaQqxAY1rnWq32W3t2GbjA0wS6Cen1WvnCl4HOdh12UTRm56/+6Lty1Zu0ce8Xp/PGJio37Jy2faLbl+Q9orhqQZDU0MgxhO9xSIIhjaPR2kxI55X1vIOrzAXPXD6J+iy4V2SQAQ4en2CUS8KRoMimcyS4AvrjCY9/GxGgXfzomTGRjN2GTHx6kbddURGWaZW6KQnRtvrodgYYC5iTvHBGXXo5KGBkY8MAFbObO6QfEnXgNrkybfFKqwefoOa5Cnx7IvfWqkq2iEr8abLdbkY1FF2h53pQ9BNL5OidtSCLnGI7mOakq1ZFnOy2Sx/DM8BxOUQlLu6d0StFoKHhszyaU4244HCoFmm5tJymkyMoOkAB6lV37IGsFtjctJjhHE1KQcTVp/bIZRjMBceiTMxO/SaQjDejGVHzZ1VYexWv/lOVdBl9wmDKLzlujuxGTsd/vt8EWT6svo79ZZfVIWcDh9BIvo/L33zTaRpCavf8ztdwap30HQ3DlfdWeOwm++8bov61tPVTmeo6hdoN6r5shlFqu4DQsn85jdfUoNFPVOueLdWxzVQDIcbc7/mGfttmWDJ/HLFvllhrZa3tfS2tPSiFvZ6qlJh+XScf/wJ3msZ/ovFy/Nf0kba9j37qgyxZFbZv2dDl/Vq2ejfhyWDy1TV+330W7Pdbi7cWiSRs1VxvDrV25sqPB1nZ8Buxkdo5pIMGihVCD8uYoE90ILgmLYgeq6nM2Vr5wEKNMTOCXZezFFWSn9SvVTd1t7LK07RMalFqXn2C83SRLmaGOw7WZ1D6Cvo9WR/Tr1B3YduJDnG9032o5VBefWGaHBKoqOhtj1e3ei5rfOGJVvSq3upjdFcf3I4TF5Sf9qg/qWR8Z2yZziR3qUZAX6nAGGeZDhVPaVnUJCzJ5sBMcAuGyNs2AcK6BDTPc6R0ax6UjaSg25w5H5bx0WBq2YXbhCc6ketKx556ZEVrXweOpKFBaZmk/3xRcu7on9+Rde2oE33yp+jXcsXvRC4qMNmm30VakUTsDOxcU1Pz5qNicJ76slkP111/cnGVQc/95e7DyPBLzvp8nPKfvX04bv/8rmDq9iax4BLqsItjDYDykK0sicV6ZeYzLXETKzTZw9jodJnJq0965jVR/r0uLUnzQ35hYF9tQZT7OWUqa6m4aVWQ4NJqnPeeae/scHQ+lJDTZ0p9XLMZKjdNyZVQ82dd9Y0jE6Dc2OyYTfNZmwYydboH110g8FUd/fdtUbDqDTlb5LRdZ7i1o3lpzKpQqo+IxVvNyiDEPa9Sn5qiUUoFhmqRU3eEq7RLVA8k9dufYJlbqpwdF68kK8N114809vrNcdmzaydPjMQmPXK9xYeL3JRUR9A4sNXH+ODjJP6meOf7SiyUQMGj9dVbfHiKSFzrL6lR7nlGTe6oZKZ6pycWtw0tevuCa7swoVVkwu5bLaSidqfuvpw92SNgzq9Q2ME6mW73+onczKuRd3Z0B07p3Ue5irGJwW74BaOiyTsml0i9p+aDGM0gYt9rA12D4p6eUR638mo9240hoxiVEYP0i5iNFIjEdRQFyqO56kVGX42EAiEpnTGanT8rJjFi2SH26WbeTEMVyEfn9efRH0aZ5W/bNmSV19B6zRSqy+lDnV89pVd976AUBcJ8seufvjwOnSD+5lblJ6W+pg5NAV7LdUur8eAAqm+HM55441BvbAw6wbCIKh4uqY2LU5Nds5NJPsZYzUwZ7bNG7hoUTarFAe2AOPUMf2x/UL/lW7X5O7DV191uHPazjtC2e5FrswcAuNnl/V9XKX9/yJc8aVhoKYamlE9uyOW7NrNp52Z79W+dsf+s6ONMerFilOvWShSLmntW4GMOQL4C8X6SmTn0VHTnDwLEjBAQo5OeWH8Kb9qBDBWaJ8y7KyEx3MB7dJPAJ1lUB41Pkmuk36vkeqpMSEAxvuh/y28BkE4YWfEaspOcV43rDbqw2WrE7Aviey+h92zUnXUosFaJv1VoUVKqbhstnCeWW+ePDLpuSIVX5zs9BQ62ek5N945ZrLZ2umYjrMAiLMuBLUhDWhJFxvawjQNUmul80NqEa5H00J1DCti+piZdFH1UBKddQjRLwzQkDH6mVQYWjUcl+WV9NsBh1Y6HCvRenCC4zj6iGqEjqexeVxTVKTpIal6CHKB4/j5dThZ27gk/fgT1YWERpV1RlkT3fEMylRqHAoCK1trjGpgGOJHxaai9SuReWzT1qZZ64uN8Y00FFKr59TTLLYrquloIq0pPaisVcs+zhAera95Vs/LlSHL2FZdyVrrOEdfChdqVwsbrrJwqKZI6vQg1qxRNlCoHuk4PXewUTm7XVeMzPI4MMCdOZ8enBH9Enu50XoPFiTFNevOcL4rlI3Sg0Ql6pSSihgtkeT1FhRSYDVDYkpppZVogkVJQKe53PR4oFFAh7kt2Eqzw3+J/mjqbpSi15AhN5P7hyPXnY66WQrRo1gQraGeFpmmBTLsz02N6YluidLGlBik0s1pJoIjaYV4Mm6PQoUCgH6M0iOd8n0ybinNsBPaLncGthTJA2+xyBRC4KHGHhkfKJPWDFnHa6EiFhuKuzVuEbP3RxkNUFRGi6OEuDuTTolRQPco45rlpaMkuurpJWw3URg/jspsUhq+G7FQ5GZCEiF3mtKkSsadYZXDrkfb2Y0A8UqmIIN2SxuNZ+oBV0/TrJS7TF/pJJuQdIixm2GM6FshaSb+Hk0X7T5KFuKhTEJm3VKBBBaeuqAltQzbozYh4W+sBguZhq0iFgQk2ixKvR17CPESbDIiUW/BBoOIsBUjQgRRJyEiEhETI7HaDKKeSAKyOokuCW8Jmf088QE5KmEkCjwxypQvLQrhqqAoSiaCiR6ZJBKyCmZeb5AFC9Gb9DxvsuoMyG7TIb2g0xG/Qa6WqkUBGQ1mbBGx2QA1CoKOSAED77ULPI8IbyHNraIo2HC9TrCIEnRIwrzVorOJBy6WBB4DYS6iJhkTM7IhIknQOkzsZnMQWu4wQZU67EGIIFJFEOZF7LNiImCsg1zEYHFi0abTu0VBxNhschKhWmcw2QWrXwrLWDBKWPAJkNCps9Q5BIIxr8ciQk4suAVihnHCSC9io0mWEL3yr5fMMhUmMPGYNh6GEUlNolUSsOAlVQKBngkGbNRJOkT/WSWDAVnsvEuUeATDrZcEQdCbdJJQRyRMeDe2E+IwG2zEpCd2bHXbj594gMjEISJJbyPYwBtFiU4VRi6rYNIbRQHDYhKIVW/hzRjmDsuYJ5JcjXmbDZ2loKR+D9mRwYQknSjqZOxGABZuZDMDSGEYer2XCNATSRQMBowQjCtGgsgj3ibyeh0W9Lyol4loESS7WWfjdS6R3QPA2FirBJ3ebNYLyGIloodOrNXEWwUvjKWBKlc4oAIAB+QBuKtCVp0FmawwZpJegkADj2BeeScvVPF6gqAFOmgGDLfVB03QI4sk2PQ8EUWTSCwwkgvulRCyQReMyG/nYc4sMI0oEOWRaSIhMR3ClF8SEkW/HjYzmgc7G6t4wcUTqE1y2dxYrHbpdWFRMosGDIPOQ1/reVmHzA4jER0iL+i8mNRYg0gPcCM5eJ2X6DFAMUAA4Ao2swlaIBOrjhDM6xpthqDdhq0EUfulAI1ELxrNyC5UOwhPAHyJYDHEwGU3Sjq9Xkccsh4JOl626aEmI7Fhk0GnkyQRw6gKOmTksRl6ACsNYYMoDN8efgTqAWTBRFurg2mmkEagAlhWWBQAiqtEWLlGrCe8DTpDDHFznb3K6ualah3TjnCdcYm3MprJRTUhSyi+vqiRS+VXawHMmcQEZ+PYtyickuDyaJ+j0FAr/LnCUqqjul5R8LHow/gtT8u792jKQO27Jths6m++JTx4k95qL96F/B6SRzZSLVZ8bM3DaH906h3PaUylYK2x3nhsaANZOdPJVX6TU9PjqIbTtQMol2AqiEq/C3zLdayf5yjur+Z4bhhcVJoQfyJLkMxMP/wNZ0tsL2r+4g/n8lDaWwDa+yaBY3Kqbqls5o4qHLNvRcWFm+x1qsys253hZFWmH4ESuEb+Vw01qlzwMcN2nOxDf0Dv1zRQpWK+fM9NmNxlC/teScUYBF0lm1MhV5B9h2Ds1SqmXxDg+OK3VegVPP0Q+sAZKPtjbnUvGtBYeGigd7XA5QqcGtDYKYO0a4MwBFTxJNe7WjMKXvpedpGnz+kxZRO4Rr4MpGcnUInxlKZKQVLpI0aazSwrBEW18aAZWaxA1CfQ5fdDp0sfDLpffUJ94n46QMWPAd2PLocA2WcyxegdGkuDLodM7EtaeZ/CLICR342frzY6Jhc1AEZz0RSsbpaC1i3Imlwlx+yc27lJ3GRuCreYW8m4+ZRAsWmchAw1rF2WaReo9It28ySUuHSlr1cz0xFMXIkJEENeXEyBFz591R2LNt8s9u3omNor8LkDNw4fuvGA5AqkZ6ztMvQuuOOuOxb0GrrWzkgHXNKwZpePLC1Kx5Lg5kV3XPX0QqF3aseOPvFmTfgRAxQunIcua2zyRGruLlh23H33jtTabVdcOjXWlGqCv9jUS6/YtlaIM9lCta74qezCU/MW3iRsu7sm4mlqROtZZElP7X5xs/AhF+SmclcXraUAKVzLM7INSLERwy5pVDL8UgrLlESDiCfNaZr42j4TLdoAKCqPUR6Lh7mEF/xv+GONtSRglKW2mLXKZ6ojQf+J6oaY/6C/MMV/wh+L1hz0+9+obhibiuy66ODiHTcuPrF4+fKlO3cseWPJGD/KxqD0AKkz+aqssTZJNoK7Meb/cbXvgB//CRz+6gP+KCSqrhudqPD2h4sPLL7ox4t33LR0+XIoebS3aOMyx2x7cxpccNRACzWpSD+IpV3DSrVIyr391Ok8bJf3bsVowsknEeqYMbD+UMNtz6PcU2/DHrrnN2m/9SSa8MK93YfW9/XU/gTojethzZmZfn2QWn1nUJfRJPuLkjZN9BgIomjKHrK7hL+3TV9/Ord+ehv6e7ZkWkvxZdX31A/xv6ofOnPLL96162JShe4ryqRtmaYuRl+si6D71C0RbdtBRdlMiZvHreLWczu4O7j9XNnmv4AYf5HtcQw5txSXOsPZE0wwl8lo1rNvyLDraIZtUyHh4qRT5mKameFm5EQiTrqZySAoi/qotRUohFlxRxLkiiKXxIz5gztDayUa4wxtRKf9RKjNmW12S2HeNToecOI1i/c8cNfSFUZpzaI9BxZP05t37jTrpy0+sGfRGkloaLpo7wN7Fq+RIKXuGvxli91mztUKxH96VXN84aor5kS1V/PCeHN0zhWrtBeyDAQt833EIgCe9IsBPAQ75qAecD4L7yMDucI/voSNWDskfep1znDIlgWUb3cvjya1zr0ntWTekpv6700tqTPrZ8/Wm+uWpO7t79gYnb8kee/c1kmI70W7dVLWFgo79zXuSXSE6aPQkdjTGGYPPNhuDDt1LT5iA7QI/XsAZ7Pqwi0DOszzNt6n5rPo8D7Ca/cw2rlRx9VzES5Bvywx6h6meEKWtFVc9nRCQkE9Csr0ECl+ojOZLnvEwdKNUGGIfhEC0U9CULsC0zpz6s9RU4E9v4s6VWaZAHMx8kvNyZdNCqBA8dsTkBnKUL8e+7n6c/x59efqZ1En1SmiX61AXGxg+B98TvMxnjZ/Zo9ws3AzswLtLFnV0Cx3FAX0i1obiDGbkhV+15j0ws1PbrvziuG/b3nrqSevx5cYumxmQ+Hp+VeuP9BPdD2Lskt6Ct/01dcoVehRQ7fNZFCv7Llu0fIuPP2Kh7c9eQXRXf/4U/+2pfC0wWTrMuBL5x5af3X/8N97lmQX9eDpXqUmUK1eCXHdBvRo1/JF10Fha0bJ9lEd7enaNz6YPB/7fsyIXr89UWJ5jdVBHatz56FYGv0gEEdyOadB/aOh1ardyOVguAkMt5qr0AzOlb9Nyobf64+xjxPlLJMMqMrgLCn2n+Y0SxGYq7jdkYdZrMC+Wqr+yT8wSvdkXDt8ldfr/MBotRXtfo7da2n2jj+1Ze/Rdv7O5a6w3v2H8ZzsjM9L1A6Ddr8W5TIUoylpsDlKt4ZjaufOEX62VWl2b6j9CR9W3rSdyo0TWOl+g2VD92sGhgfLhpTJ78aGoBFL09qwWplu6d+5Wljx/bBrb+Ruhu2ArYKMtjqkaDfOpOrFEPuQFZxHsivImK7afUm0m10OU2ZuInW2IfJgKpGk2KYoRTMJ+wUH4ZZNC9f3Tp40uabpap9uUli2TbGtR3MvTXRi9ZDY0tvbUlPVHLrIe2n77CumLZqOdgl/1sbBYdEGSv3SBoR1jTPvWi+8VxlTOVpLFqzqXT6xxp/VtRmmNjgQTh1efr1pDs4+FXYkliSbJniqqts7EpMXz4wvbs5Udarf0sbM4pDJDZdf3nCkwWSP9O9SN6q3lCPGjOvIXYqVS3Fr2V46SrgxoinHpDWjsNoHJKgyDTvYypcDJFi0llu6jdMUWijenMpo0kqeoq03Kv0lMkXlj5kUI/qO39N6x2cQH9/We63BaBFMSyzx1PKd102b2tv78+nr2iPvocekBk9rZNaC2Qtuum7h/slWHaUbr7TWWoXQxKbujtnZvrkTWxbW49zIt/eyoYlrVryY2yWbwsqCmzod1UBTPtS2sqN9+eypU7udzX7vGS6aunZtW2uoudXh8sRsJp3FvLG1VolMwPVzFN3kSNjlrvZ1dk1bMrumgi96OdW2l5UWzRAu61M8I3lcojYgbpdHruit1uNmbcisCEDL4854yoNF07tl98jIaXdYsOFElbF2DVsjOmKu7kzuqV+6aGttWy3CndlO2YyQRZwY6lp+8bplbU2t9rDdJVmB5pbrm66w4CWv9+8AWn9idLZoJTqL6LL6lDl9GzYdeG7b9s4ut81eJSx1WEY+oy4EMV6OeIkAjW/J6vVVlhvMUfEd9U83z+sItvgdwbC/rX324/PXHFzaMdUVQpgsNRAzVsyS14SMotUnxYyyeud3NvU3T2mfHAg2t/T1b1/wBJr7clX41O2luXFwnKEswzH2mwL3cU9pFiMq+24f4x87Nv/T/rH1jf1GKP1OecUn6ivco2NU7txxnzxlpZuSu0wWQaAicWWbhujeslMdcRLLeKEXTFBRGJpX+YVRug9Xn3msaI9CZvqSTdTCBxC+KMzkvVvKdkwjnv/L25sAtlGcfeM7s5fOlbSry5It67Akx2dsWZJvK7FzOHES507IZXI6DpCbQEKCCKGQcIUA4SbmKtCQQrl5Ca3aAqXc4YVSWmhNS3kLLUfblwKxtfnPzK4OHyG87//7Poi1s7uzuzOzszPPM8/z/H54pGgD4DRb5ocguEH+PSwTT54UY+KLoshyeHvylZUrPR70By56/vnmZvRH/0E9kr5TTdDPkmvfieFr0aUxfK344nXkpGelPESua34+vVw9Aj1qgqw9JLLyv5lyUjPyLOwYCxqreNmwHItVYEIBxSGC/CIBTFH8kCDTSmNAKAKPEckFe8uvguSdRu0vtazi2g+6NJLgM4RprJRiTTZBhw0+QdIgxR0wWsn4otTm7g+5GKTJKLEAEAmL6Hpj+sdkl0kNUSaHoKUBwL4S+A8AWis4TBjTVBOzV7v96CaulAIgkJNhplEZHAY8EGHVHocYEZAiGsf/KkYIlQVTESkxh15UjX110JwD4zVg6w6HLXEnNm5okrSV1r6WC3/au+NP16x/8uIl5d0zPBpogJwlcuLBmx7cv6FlmqAJOmK1rQsKVlmY1+UMeuhssk7rXTbF/5Nww/4vD295aU9jz+4ftPfe6TV4+fGcw9py1k3v3Xvpjz5f2BLYvri4duKW+Z018vLJG5aAiz45oViBcnXrypP7M7UTFXIwtXJk8P3OymXwppT44XT5fIe2wra++Ym/TN71ZF/vE7vPKp81w2hjdCxnqX3j/hvvv7yvGVfOHq1pme9c6bQ8lR9jvHOR/+FwPQj/ad4dF3Y29Oy6bOLa272sTqiwOKTWRYffufuSB/6+sNm/fWFxzYTNc6fWyCtX35oNRM7ZttxEXsPYiT5bRFDhBGodcVxqMxZ0gpFoIIpkHFvEFhkpodI3cvLh92j3+PmxVVddtWppS+85N/YPDPTf9wpYfO6556H/gJgvw8IdrtA+Z10scM1L1zStWY1XX97agbOdBy8bJt3i+e8eLcUuU7GArTCPFNvr4Ikrt5X0MDrui/rsQRsWwwLRSDRiY+/4sfzTN2+Uv3x+27bngflG4HntV9sf3nVi584Tu+ZeeVZ7MYf0qscN9KoTb5048Rbc+Kb87FM4IygD5ue3pX62+aJ3ht65qGrSopmBobY2nOfEiewaIsZoMFCFVAXRBAl1Ke+I4SCjEiTq+atgXSusRTqFRfmCcdiOzVc3akTH0fPJLTfMKDPidcWyGXsO75lRpmxgWd/hwST+7pjk4U9Drm/JigOPAYWTPSC1vztolQc+vurgRTNnXnRQ2chlkMIXyOSXTuT4gkIq1gCD9BvKmImSIXgGqBgMJckJjNaZkAhZEn0WSUsgJdVlcB6Q2kjRCeVaUgUVAQEDkAwRzIEUxhxIAeIrISkO+cq1CSoJMUKAMcusq0IbYM0+9yAmkX8fKcOnnIQJJq/MCpCgA8AEKbPyLBx+kyl8SH3u8NiaIoqK+IhvZBDzQY6eW/thTzopseemk7BHoc7OzndMcrDfKHmZnsGkxLyWz0OC+2eKUbDn3CNbVRzRTsPbODSi2X6X1xJjtCF5DnrcGd/dsBup19KUWsYzvDt65HNz8cQujEaS++7tDsbhgU2Q2L6DMQwdRvECUw5JYEEJseKqNFHKQnlFA+i7vGHK+REAIudPafgRmNpQvrJTvmKpbkJ5S8yBpudYS/kE3RL5R/7W8+bOYFMTVtCNQx8TL3xXTejfq8qqa2qqy3b9IQwWzDoYkQcTfHVRiSiWFFXzic+cZde3zexdTt75I2g8O4fE/ZWr+BZ2xVUXexOSFX2Fot5m8YnmauCzBUiIJVgmPwlWgHXz4JzV6364mrlWfmr2grb5Nr38FBL7QSe0lk1Z13b0TfraIR/9R1DbuXJl57Szzx76IP0SFNfvmBTxRNLvgmvBl+PHH/SOry/+c+a9KeNrHZkTcTh2STiEw/8jeNUN+/SQuYPjRyzzY4A/BqnmO1+XP7r9Ifnlc3mg2a8zmfnOt3f0Pndg9uwDz/WufHzy/ryV+b0bgHT97aDwdbpQfkn+6PWd1+3TFWgOaKFuRS/K/ia6asrEA3kr95es2bjzdVTG0lM27m/sb7FPm28YaC0OTvVwON6XVY+1MiQcmnWoXaiKw8gBrLp2JDAktIQNY+zbDBbs34IbCO/ujaHyU9QeoVSANsbMaOhC2q13iS5jaaHcW6jV2vUe2hPSmS06C2eFggCWjpUV3DxG1j2AKserVBuC0eA5wSDAlrFygJ4lQCuHMpl1IXSB3q7VkpUyI7qV3o1uqkE3t0H0GPSs0VlRqcbIuucUVY7qEs5heCj+xJi9FVs2pudiq7PCnBSrAjiKnfh7YC7hkhE5Mh5xwMwrdh9LhvkdJAkLMtArm6/XcO7aKn5N83KztfvWA1ZzBVxJzqRfIRuo5rvyailw8gcB6WqMZgXOAV1fXgPImelQpUc+Ava4KgW3S97LzmiecaC0e0bzFkHJ8QrZbFfypeTBPxQVfQC4J/FNrvlSfjwzLiiYW3Y8/1FIUEOyD4ak52MKGn1JzBxicmBcGH5gOBoXAYnuknvlO05cu3eh21l1867yhkktr4JVJ06A2XkYXazJOQqk60twO/gruJ1JXvn3/ZtemVbbs2R22zkhTnPl34H491/lgLtsljFwu34MwkeP5tYgcOxGI7U6vxbZOtSF8Fv4DhQF8N34CUj8oxfLr8v/vqOv5+yAv7AiOnP6LUB3xx3pOzFuwvEzoCuwjd8LVeEaJtn76No5N9fXz7NKxTqh99FXH/3r/r+fAWph8JszoyzsuuAEGh/AKYq+CI1hPsUOqxgg4hKrGCdUZ3g0StBBHPCyXdSnPzIWMTqLhXlB7mM0olFkf804zWCq5GKPgqs0jES/bHUO7iqAbKGZLl0D9CYn3SCIBRaNTq5ZCfO5P+YPXw9FSg+ST0eSI495jBhuA7kJXsHzsflUFEGeqi9VQgp7ZIqsyI6511UHlX0SeFjaAzFyX2l9fjhiKpXJPcZeXVcqcy0+muqqS9XnZJMU0mZnUYtVuSjj8I6RcyyxWmWVEatNXMYUhL3JwIhdMpkpuAQxCs8a2CQEbCE/T25HJ29+8+ZQXWjm6pm+VtonGfWGmkWNHReU8zZGbxH1jI0v33HFDrIrWsjuBR2Ni2oMeqMEKqlTYP5PrwLGgft8IE2VVZRh39/n08d7b765F4swtTNn1sIOfcgo6aqqpjXrSjiLhSvRNU/LT1dV6SQjC58Cliu6r//zAQjfWgnhSiyUMlm7igZpxG6sgbA+xZbiG7VY4svGcLcMJ0Uhq/c0kmzxurucxOyMabKaCVOoDhQol9+BVM7YUl/KoJTNhOeCJF7KB/3Am8WKTZ+L8s9Pk3feryzTY9OK0YTmg56sXEm4YMxUKbWU2CZJWLmqM6HmV6MarApTdiRG9N24FXu4ZaExsGZIArbIm8v8YXfyKFEdIByQXNI5dbhctaum90/aePmByzdO6tCN0yWNHxmTaNuRXFfZ1MxUFxRUGtuqrN3Lu61VbcbKgoJqprmpct3i65766VPXLabJymtVLbqbt6tu6kWzKitnXTR1zSx9hf6W6667BW1mrbltc03X1trCWNDtDtYVOZxVtRV1dRW1VU5HUR0+Fius3dpVs/m2VUc3T5iw+SgZ/xXsWReJQSHL1DnbkMIjSdwlzHm4lKFcoLoCZ2Y82S8ZDQb551otSBCqyB5MhkhQJk/2E5TfHgVFEvSgWqB/OpQPMy4mMEKkBH0ZsEiytJyFhMxgBBJuoiiJAS7PWYAytixMEMh+h12ZpURhgNx4AJNR9mAyyhU6mLE2X3U+tjbfDuimKSv6Do/bez/sEUTQQ+w8/YQBsx9Va4XhbWKD3vt+3GN8G1T8+GDr4b6u1uITo8sYJo7LCj5F1g83oiJCnLaM+DGoFe7S5RX2O8rYL+CaoPwGgyDKpI1BjyR/dppCZvq7Gv+1iOrJWXTYrK8GHUdfKQEpUEAJcBSmN446AP56M2hmYTIcDdsPheuwD6aHyTp2KKZeJqS4beiDjb0d9sbJm/o3TWko2Acm7yvoO+yt7673dvV2ke2kJgAYnaajtzGol1OqG8fviAl794UHDlzYsefw1iWmuo5XrKtbujdt6m5ZbX2ltbi3t7g1cbhvcVEZ/rjLihZjvIzcXscOv25CcV2ZZFqy9fAe+reqQ0c2tlxpixk5SS+O1B+LlfGWYMISlWKUmH3IF4HepTem+OKTNSHl7eFwYZtyhkgStdmwhSkNCnz0ve+HXJzO0hzAbu++4uNAc7zYh9OBZouOc4XevxcfapiCWodWnA4SrStt8vYjH354ZJ/1twcJpIanBElxonweWb07JKKdEg/E/GAHf2vdRw5eaVvZippG5fpU7KpYmw0qvlFsDh4d6U6RrCuUiqEeyXhEyf0E1ZHpH6KSigsUpPYtTaCDTBKDxu1bSqP0IJK3FM+ngaHU0n0stQ+1aS5GLDIiQuz7R4XRie8ZCPa9Ar8U2TChyvZ+8qZJZYFP6fSow5aP4Fvlkpu6E4nub7/kqcN9g1TfYT7x4ZHEvqUY7RIvwhyhx/dvkpPpFHo+o0V9yovbCw5gdq4cFnolNVGRBvhshKnSpUiXsSvYK8PTbDZnnrQDk1MaCPB/w5R8GAUcxUKR42iYOL4Pu9qxqXQSfRZDX+GPgNajDwUq8LA9xBmvf2T6W8KZAdHoTXv3HVfsvkr8ioRmAwUDdy5hNLCNNLLzFh/mRAXqDG/JAeQq+dgRVsCRVkH2OPZzUC3vydKewn3gAp1B/pUBrCLuDRQGHc5AzggiHMik8o+KArOvsKd0MInvwhErfId8RZEBNBhOigyFxYGTFN2TMRoJ/Tnr3ikql8YR31n8+tG2pIepn1FvUH+kvkASlAkUg0rQMpq3Ojpinx2xPzL/SN7qkefPtP//+voz5R9ZX4wIbsl4W47CYsK80lkxLYfXTeXSp/LS9GmOny79fyM/PM3x4WXG+Km4bgQYi8pnfx/I1vRfoyuedyz9rzEOjpX6P5VRHutg7ufk9Rh0dEAR4PLcgfEK5Hd8M09Rv6e++n//lfxvemnWLyOvvxaADN9AIDrc26gFRGyj8e0jvqwG83+ld3/f3ncKa8JoHMRppReSU3nlSar3y/RNkECjJObBSfwf66Nn6FFD1zNJLx6wvYNJ0q/olFLQnp6sY5WSrsx9PoBcIQ+EkNCRyPKYY9trM0YGyre+EgjXjDgnkdeXZY8IZCgkbNm3WRtTACCGGWhDxDobU2yz2WmYLLvJr4DknYLmlzxkKXLgFSStE1O3gp2fSWKuylTGXku+G5f0opRw9StWHHUJD2m4kP+lQZc+RvZp76j74CSswuafjOUW+3T2uxLobsRfPZTBllBw68NUDfoWO5UoyjNW/XtJhUR7GqOKaUVaTBLph0kNpvpz0qIXHQT9Y9fm8+8UIjP4HAQXHlsqOCPgA4oIXk5HI5YAHwhjq2A0HI1jQ2Y0HnGgo9EmqPj6goiDRdo6nwTyh3L/QEL+/STc/D39iUR/qsfrTaZSSa+3J4X3iTA0CQQTA6AneVADE170P1LDBK0X9A94U16NM+nUoO0A6PdqsSKY8BaO1xH9IaH6n3CoFxLrBBZzbb5onLRnOO6L+5CYhPG2p0cZNDEkk0c+THjBgJdOeRM43uIUFZ0uJ1Kp1IdHQCKRTKa8QwPDOFMx80mOLnWE36MCD0LwD0fhABE/PpnK8dbCDHNqvu02pdiuMAVGxoaFBwQZewHQ/zHCN3FEub4Pl+tY5ZJTStlSyrOUUiVGlkwhc00opRt+AWwcXjCI5OwZ9L+YCJLixmGNdiQXLq8FzFgH4VZdrc6lk6t0OvAWStTqdPIOsB8cGPPwMZIiR9CPkmWHvEM39mFSLiMq139mykXlfFtynLrMWAfhXPxw5b770RPITcFbqFxjHYYzlLKSvf1gv1riKt3Yh3G5ZlBXMxFm7rD2Gs4PIY51kImcqdbDDn82qqj4+eD8MQ9TSrmOoXJtzW+vERwT4lgHUblOW90xDsNjo18uyoELNsZhPBah/gW3kveIS6UFI+mWUUdScw/rN/RnYzcWGd9Q34Bzs/f83p3gdG+b3HMGMDIReq5yz//BCwTnnu6d4HtWontuzZXzezY+XXma5lTt0IrcWK3gpeaj9Ci2fKsnq5HXtYJo3hiClxq/JSIClyC2/fSA16uQpHu9aQKRxOFgLi9NZIohnJWegV3QgrNbjHgMEZq7Qzl3tDwfEBOJWMdj23BLQwDkYc/hsmIRUJUZI2xtHRoBrRHQn3VymzjYLxkZ8vjBFF4I7Vdgm/rpTWZzv9kMKAU9VEG/pXtyC9zS0FyyWN2DZqmsPzijyDoONLNn5ZzgmK2Wv2Sg4Dz8UG0BI600Vg4Lby1ZQBhQVpSHcAno14Y56jFKAci6iUPxRj/d0yFpgiYwksIAvEkAkahTSKqjSB3Rbwo3QT8Y31UnU8rqQ13XCgU3iTSBst5Pz/B6vUMkA4N/8+cfPSoPRalMta1AMU5mWZ5vyJLSHjo0ipaW6c8jrX1uLKwHdU73EfafXH1aYRPIEB1nacjy6X7GzkBTm7rlZPcmbOIns1mi73B96UD3Jjp5mhMwgQ9v6oYp7BpApr7DfUj4VbKPcZwas9wCzFNzkKxH5ul8mqLvzkBTowq2qRskcblPc4JJpRMjSwxIiU9zHBdZg2T5BFkv1FIWgoqGv78mNdZAwdGpzUYMZqILrMryay7eYOwcitceFKZH67qm98EWxbh+JdkwaUIV0Dd9qHn5vuXL9zFfqqZ3BdBs776lmPVx6b5f9k3HGeX/UqR1xZCevgbfcPp0+h/40uXpe5STSkiCvEW5MiPHZvsslY90wY1EJVF9G/M64zD+WmClMaSCCmKK/cHZ8uH+VzZLxCuh0fFi2mTgDWaThWUDrSs333LbSkxaK1MS1iHRBw9/fXcU9P9Q/jPvd2ktVpM2wHXE1/Rvnx8rNuCYXZIN/2AUV/ncH2SxZCny3dVQi/BMIAB/FagjLHt5aYeCKuUPK/6RHhrTldGSlReYgL+KCWcsY8q6OV5WJ4u/MFnQMq+lAP/AW7LJZw6cP+7WKQ9Nubn8/AOJlYd+MOeBOT84tDIx0BK6/PqfH146M3n/gSv6fK1XuCPn3Lvh+rtv2Lf+3g0R9xWgt3teR8e84T8XXfCATa+3PXDBokunVwpC5fRLgeaNi2Zsag5oOWlc6+oJu9787MicRdvWzpoX8M6ZuXbbwtn9w78rB34L6riHv5rvHH0VtiSkiqcTOfMzJo0dRaA0AMm5RBZSEP51JKOSwmO5ncU8lmEcIQXqFBA71MIEtBfEgr7oyIIhxZXNMS/ll4tYzB127qvoYKp0iUv+nRhlEqVLC0BIHLySpjLYhbjQgKo4yDZUye+VH2ofTGXLjTS7VOwsuwkuC5QXyzc6zYGKYrDB/nh/ripHQVN00j2tjfKN0Um5yiztr6ki8xqbx0deSJVQdYRliJhQQwRuhOBBtwIPGAnqR5mroFeAZg9EI7+YT1J+XvBl+eWgxukqqNYUXP7A5QWa8bVOWaf40kxXfGmmrz36mTz02dG1aAuYz45+PJJo/bULb7jhQnQDdJvuVau6XU5zNXijT7mafPoyvmxt7jZouB7x3Y5dNzuB8VPs/djjAn8u/4O6aZy149VaVRe4nBpcVzn+P6tbpKDanKmWBt0GVRVq/7d10xPf/XJs5c/4IeIu9v2rlAy50kTfhEmXHPqf1UQxCoIn/keFV+U8tFFmmfbvt0LCjPDvKjFTAX84wCkQEL5aOiEKKVFICqIS8ZBJwoRaGXUjv/126tD7h1Jvy2+Dirfp5NsgNeoanFxHqqN6eBGc8mQSVIAHAGYxN2XXRfBYjP2o8Vw5l1pBbaB2UJeSldd7qMeIFR/VCQ0HqB7xvHQ4L43yoPeG0qgWwdPnOePx06XZ/LQlm47ifYmwk420CZh7zOhf0jxgRv/UPYYyDyGBke4xp7PnyQaMvZvZypS6n9ui227CF3yLptXp0W8JdiZG0ASbSI4v837TX446JI+xo26AslH/yf0knxnHnw4l8R9+EI1/FZE6oa7V2akyagGW1jK+QbyF8IQQbAAwwmyoWgcz0XHY0ZTJokfEidtrJmIMDe7JB/fPaVv9wPJjH391PH72qni8sKLhgsFzA0XE3lUUQH2LTQV0/O9uWjS5MDF5U+Na+asVJtFs9hYHFl59b+emX2wKRXYet2uLi4vB32DvEm9N/OL0g5tNwQK3YKc3BxotgwKxv/3T0oiN2tvTbFhkmW0BwecpXNSo1UhB+HHAaitvCbXGpU0G1ixacexPpu4s6sFlVC01mdqCv0OOt8Uk8ovS4SgaKrWoOWykUg4bqhc6iepqs///ahY68cQrrz320Nvv0p/87UarxNYba6UqV0Wgwu5wSWuf2CBZy2ouOPbg/krfDYMP/a/aCjpT5jXP9IBHXtCc/9xGuf7pbZUDnJYu5Jy8xOkZhv5DY1TLHbdA/rklmufLwOf/u4bEa0tILiHrByUKG+eI9QO7dWT8Kewca0FBx1SKwhAxhNJ41Bo39iqKXJkXeYf7cOWp6/m5zGfk+Q0qx+jw5TW7VYtmdEyShgPpMYT1mMWE68dabdPBSfKVjMPQajQyYLuSgFePWYH9Y69EMb6TX6GLLYzDyOqVRLp37MrlfOOfpWwYUwfYMvA0uEIYt5IA1mEiCsVPUsTueiMy2dATkChLEGwUcbtoXFjeKkmc0V8eLeQ0Vo4ugOU3Jt65a3gecNvxB8GLkzG6iip7Y0fwSfIWHAkwo/Gm3bvrDRagcYGD902ZZRwckU8+WfjzY4qsCk8d4/awA5SOKkV1qERtT1scLB3WAongtwYJ5xFmPIphwiMkgUusBzB3AyDfPtFzpAm0NhvAV/KNC1i7w+KQ2+Q2tLGzC+QbvGIl+PeH1qJC24fg35UibD9Zp2sGE4daih8AqyaCqHynbPAFDX//uyHow1xJ3jiPqZLGyQ2dfJzKYO8miY8xlQPV9/kx4BtQsC/YC9NJSymrs7vTKXtAJ1pZymh2iyaeuWeQCkA2YIcJd0WpDiZ5SRiXwdrEsjlEo0k9QfDXAp9iAcya+XyqL4Wi6OZIqOOo9+F1POL0UgnnppPo7xiTzJgqhvqHWS7ouf9G/UWr/ZoYdlDW36G/njzrBt2TZ+H4WqtFuf89dEykVP4iJsejMj23jiKO8BnHvkcKR0wRIAtgRN2LZ8Y5Gx77kFjMRtUDAPtNZf7Bp8nm5roKONB+RXJuRR3SRusq1E1sdXxCV1nYQnad5BLmabKZSn576hYXyB9eHCovbZ3kKlhchxV3dIiuy6Vlk6vYUhAsa56lHlSw7pMkltOItPcgknSXUr3UNmqvyhCsrjzarQ7FJ5b4uITy5EU2G6MQxuBaaFDAzv9xOxoZAJ8Fy3EAPkScEdvUQAQm7xYg79bssIeCp05ReqdeqwUUfnn9CtPSQF4sLAsVCBz5UZvtC2Bxz3FfX1gofy4GbKB7XvqmL+QvVDgdIKJj8iMqYg6YaYPX5N0m/U/l1uCmYQ8E2lMU6QmAbCJ54bgDJH//BRgmB8yyBUT5czdQwHWA9IUNPWoBXC4CUQXckT//0oaKtOB8coH8E9t6hTSKyrvlfcMehseDHvSRDJF1zWbFr3OY5RuPZkL+UeLorsJCk7kUxEUfdl5NOZE04yQ/oLk4PKUiPC6O9ky2vTObape1TCgPTDWKBuO9RlbTD8Z33713DnBmLnDCqbHlTc1uu2NegaU4KFXOvT7gbqwuSxQVnGXW7NZ5jEDX2ntTRteG+Hv2YB6tfOQLhaY3M5HZ8DdLj5zdksoacMiVSGSosFEiqXDRKEBkWdgLkFQNY+lUiFlLDEtK4CukVqIfT5Z3ZMRDJFrRp8N0SI3hzr+9pxCE8W4YFIIgtswGgXcAn8Q/DJcmGWkCiIZHLIqV2Q3om5mG6xrA0f2E4tAEfNGIRAeiPgJ5EIm1QZ8tQEvA5iPuxEzmHYUVDhsSqROJ0pd8c8SpoWlAM0Bnuk2Wky88sx9Yr4Q2dJDWFFwFwO6nX4WfpmWaqZt51sy6pnGRKsG+3hWcu/68K2qmL+qK03+9//6hMq2B5rXQ6jx5PwgA8wMfMSGtQWso++gB+Sv5t/D+192FYqKvva2q1ReqCevdS4NFE3asql/e1Fje7OtW5iEW+5DRe1HdOr9f3djT143+/nX7e1pm6OF16z7nvCsmrVo9jTlz1d573V0JRtds4vr2xo5wN6kXQLrXxayCN0cFsQ+7HS/DkB4RIvMYXjlNgu40JT/CfWXSFwwlQ41pKtRmRmkapWmUJjh7TNQ/vXCIqhjnR1sGbZX1vvfJWNqrYGQR9GyMJ2vz85gUJhSty8UeY2IfNW6gGvjD/qgFY2RgQRcHMGcClgktEqaKseHmx1gbCgEQUhAWzxrXWdkRPM8L7Hr/xb1VLfMC4wLnzJ53vifoqQp2rzisDWqNAEJYHKQPr+gOVqHj58/vPgflmteS+Gs1YFngDFRU2htqusvnLAFPzsanLgrfHGaR2KGLNgQ7KjvHzVq8ZE55d02DvbIi4IQMhAAw1IhL1ZI0RD0jnqbKZUyScNlFyPdI8TZfhi2dOKCHKPx1kpV3yqum8ZTgJVOC184k5ffeIzCE6noDoN6T38PLBwRkESVOUcflb45j/1s6kfxAfsa5T3Gu3OcEUz5QhgwFv5Gg5KyVqX3Hj++D+Bd71yK5Zivxd23HMzu6YbY4WqB40fOo0fMKOaoCYT4f88BuDQIFBB2Y1uAQDWa9fNPxffFYz9nnPEPKO6o+u8+T0bg/R6dj3iJbeWf6+uP71t4HZ61Zt1GpQBR65JuS+45LPRG1Iq5hVTV2yDp0pQvfAm/RHXANz8/6SSs8SX7FzwF9nZLFKrCE8DKLRc4k5MSO5X9Lta/av21P1GwoNJije7btX9WuOLzABEwOXts27Wn6kTS14MFLL5rT6cKMba7OORdd+uACZWBU5SUqiw8RwLYAh8/iC47wfhi9PyJiSBX0sinUomhyOYk+v5zjJ53nBIoJL04StsXk8omYQU/ZoCNIeksBLwHRIPJdXnrmIDFRsTiSiDDnKb9dpN1oIvMOILmvK+dfHlRhHYNVONRpuGNtPIqRB9TPGselZR2kfehkkMDFQMWznP6hQWNgaDmhF05RG69TJr/dq7xNm6a0WBlLqdnosBhYqX7C+vqC5fuWC6BK0IMUzaCrWOWd98gps5YHPVDUr3U8snWITFW0t+9Bz8bqpmk+TYA31Dp13ukTJollFbhWvmK9CHsAr8V1Kznl5RS7ZGWubsCKpVcas/ARBB+kamRTuPzxWAk2PvUTQRU4b3p09hYrFOQkrzXoE0Z2vvxf8t9pTtAmLIYBnRns6uk+DuYBVrAyisQKkt/KNz7W3SNfZtYNMFr80qygYD7QJiQrSArQumX2s9dIGf8h7oSibwDah7mSyvHWh/5oH8G35k7cKz/6qLHQXf/gq/Kjr8p/wr+3MENrftLUXAYH0yydqPf6hqbQz+A/MGV2Z+fPhvvB4AGHCsZjdUjDymDVcyQaJd/UQ1+9VpLk10BEktZija5RksCLUh38wYhVzavxWRBB+eokfEWjkhm+e1p8deX56NFhFZDeoVXB3/OfD19Dj1Nuh24LIvJrpCD05JHPx6XCRVOK+RrKh6840/NBPJaJdlEg8LUjns9cnVcbKVdJMLIBgNICIwsLRhZgjHeQaX5tpiFGvoPKUfVSXsLIpeXPSCOMfGFwxxhtkCCxIxbSw+KoZ2FImoDERqJByRcGPpoNMn3moauq4Wr7C88bH7aDPgasq01fZJLr2WQy/dP0L+ijD6c//SgavUr+dDVYBb1PgHdOrrz7btJ/DacS3H+rGHI+LZR8PIvuK/niPiCxH8r/Hno/PXkKGFcEfgg+7hic2sg8Exqcioa3V+SvgB6svv6uu8BcMO5naluZeYWzY37et6qMQ9WAQ60UHoVD6wGOPLU5TwG1RTJWbksriGfAaumUMiqttWoYg37ZDnmzXCdv3rFMKzAaKxoxe+wajWl1+1c3KsJ24+TDbx+e3Kjs3PhV+2qTRmMHPYLIfEzGpqF+ud+ugdpl195//7XLtFA5aZXMq5fstsLLifR+j3/7ZOwNOXm7/x5yIH2hdfeS1WbJKirfP5EbAqM4trA/J2EiVZEECFsv482RenlVyUCl+8qZxAgWcILweT2DS46fLqeG27MUHZ9IKzmk25CXM9u9lJlS/05nE1EgbIFdjX0CZytEpWT5/6EzGEXgpwpk7dmg9UN8PZyXvbQiveeMlh2ynoJE9ySdwdMapTGO9J0e5UudrC8l7ZTCHqBjp2lvJjXmT9bnBeRwvUaVw3KG/fxyjPUDcmUAvx0rmc85zVNuKoqtrlnfF0y0SexEhCMBENkjBKtACWZxIMftjKicGM3QCBWXYfAjo/zMJ4LVYrz1fT0QjUmjFVzMrv3JJ/KHtwpanWh8FSw9wZMTOj0ozveMVCL6/Z+AKUZgRedFoH//VqPFarwVFH/yk7Us0OnIUf6EfO+rRlGnpV8b6S+Zs+FhnJN8BgwylBNyHqJLjGJJeBS7WBX7vF6z2WIahZyfvkmcJoKEJErBdDIoabToXcZORblX2JeJLIfepZbNzRZ4kFaWhGOobflwRgImK2EOuxUpCs3p5+XnwXrYhwZkzD2SPozG7T4xRl85tD24IbinflN//e5gkL4S7ezGO3uCTLP8fBpjreKr6nBufFUdvh5eO7QtiC7q34TybQjSB4LoIrSzO7hhWLsouv/IkOUxfFkVh1l6lF8t8V5VlhiGe6vm+Pfy+vawFYYz+HXhBcohsuZDK0huOYeuZD7vKRzIrtfLtYQWVclJ782nQEXjJCoRfZK9mCrEftblIAdWjr3BAzn6X/qkWJrCQVc2jcbQrzWDRKpUtLhAQmxFr9xN3xfEK6ai1ZTSw2QwWAySdruc9JK5DMnB6BkU7m1SZv1GdSXEVIIWH5EQY17s/pUqLXHLKXRTOeWyoEfKKUHfb9RqWUoShu6a5pXRfUGyOBSESX1KsErDZYGSPFkAhHOywKjP8Bhcq87ulf+pigNYJlqb/xY/g2tVWQDlUTLfKtE/yH+fuXGfQyO7TX2nDh47pRN4BdJ+WtoCVDIj02gfuRturqvvAW8JFvkDi1GwgIBFHoReeSA9QCeXFhbeXNhduBT2D2Nlfejmup568B9GfIlgxJekE9AL0LcpD8CepeiKmwsLl/ac7rsvwP61qt8lzxVnGIPiQFlAGNNr20vg4dOfKg0B7QdFt8EYHtHtewBSIsLjinA+0nIon8RaYFl+SXLlCOJYaW1m0CkCfoFVlijisTDEJMbK3iiEss9AD3op/Z5w6Y5fXHp2vU93v17gOTtd0Vf1wFWlBoMLhoY112MoPxoJerC5pD/ctqJn55rmJ/5ooLVOsHJHXXV/mYWFqWGNlRv/IXqzIuUh9hRgARY0eQPV83AYDRUO5MBBNzJFe/NcDEc5IIJUMglmpf90ikIa+QfESVHJDVeMmJJzeG4Y8apSxetQPhrUDCNHipGtxFwoOuSUNFGSUw7RUgqTpTerfp5G7PM5/M3RywLFcsLtBqniQCDtHeYUOmL8GlEmZbhQB4kzl8lSmk6WWkQHmiUmSiDh2H76MoF7AoFAMUi53XKiWP7d9y8T8VNW7L8xBzhjmRL4/gHlWb/Pt4WO6Nx35TWlBbdt+u80GYnJFfTrw3mOsSDzL1SmHjQiOeycCQh8wE+FsyJ1KJ5NxijC1o2EbmIuZTEIiCKEo4JyDiWJF54JxhTTho2N9JsB2qBnGaPkdKMXIH0q3922AjfQREi340KtbAdnD6xdqtdydDltNzKMyVrgLhb2vFQL3jZrdbSTdctOmgavmJCE4ISiXt49/pWLxZLiQpuZYY1Gw1+OGGyYpoVjWZaBgP1AMm42Sg3jRWGLIL4FKAd6vvEINs8CmqFpmNxkMAhbXMEOg8G0SW/avp9m0IUAsjyv6uP0EGqPtpxX7fCVfQXlBRsCcfgWR6iwOdVhTYFcV1dy6CHU5B2CKBnPXoFruuLrnz1zGKkI67RGo44t66mc3wtqSCDZG+BOUbgbvchr5etwzsOoi10sGS8VxD8e/cNuTYHuYj2AWrawZHnXu6JwqVGSL3tCATUGVN0pin4L6Q8rFZ71rIiJvRjbMPCTY7wC0YvXW+lwlQYb6rJrTZilW62GSiWJoYXot351RBQuN0oTd3V3FLAW0zrebNLCzXuDwdm7PMHuuli4cmb1xHFVBZbn75CMlwtiw4b2ZpGzGGZrTIKRdsRbF5atuMBSFpxeVR2t74lPCrrAils+cD2MW+NhbUVlxImedbkOQj1c5dIsmFVY6x/nsJnFgLtiXEPTtHEH3vQ8jmGiH+H8vjIzJ1oPmQCto8VAkWNBh6si7A5IotVRHWqdsEh9Z3vRO2vNyOAC4O0qU3CYCmedh+NZASaUkcMzoeDlwO7A1pq9ovCA4+0f3Q9KBJ3G9kuzVn4dY31s2neXXZ5P1tTuaPjP63DRaPL9fVJtOYq0wbK1gnjwceuj8q1mUTSAja9qjRcbpQVzRAGd2CwZL8N5UbJlrkhADZGogcqLpHVfQAXyV2FKst1NETlqMcIyUl8lkkbjaiTTzWy5Dmfl4JKHUKcgMYrAq2x/I/9Mo9GJv5B070pB3Tj+Zxrbzyw6rUb+1bukz/0B+JUtqgqYJgrrjNJ8Ueg1SnCi2WwW5YWhhc5FFnCvZBYs6eckY68gzpeM6wRRftIoqbz3it5RT3R13PExV0p+ybKdMffpZFPKqMZIe/twVFcf2Jh+SX4IfEsWLHnJeH/GRJ2xW0P3S/S6ly6SE+Auec9/nz/SkQ0duBGVfbsg5vEPaSgDknYK0Gh7HuoZUkCyWx11MSnuc/gi4QA+gJQg5YCiI9Kkx9ABWmGSprOlzY2HdOa9+KRhWztPZxcceGyrh7OPTAcAbAvI73vBXVcGJoMjM++ejY5s9MnvEvzud+7lnUec/A9P3I+2egvsfxPX52HfNXhz7mJWpzPvd7FngXVn8849Tn4lOHcZ69pv1unYJRtxluv8j6ExYz4oR+ozgxm+Hkomk2mkSsvvoB106Fgy6UW9NH2z0wl70a+gg71E1lZWlsEik9HglG8GvU7l12A0yQ+oGbB+W3+KYv6K2jFCTSWYQ3ZMfCIwvC0Q9YdtAYsffUZxJAVZIqGABTsoOmrj0YgthoFQPTRdV8X4CQhpbSuHd9DUgHZaOeZa8cbt24x8ZOa2i+fc2l12qzhVeql4Y63GzOmMXRvfTvhunVN666ydvS0nPBVTmhfVztJoGkMdNROqajzSlIKS5trO8gk82+SfWNEUKhHp5JNdhYevnHLO5Go7c2oQDFGnwFMRcAiA4o57ARj6Gn41xBc3nZ2+o6S+pMDAQfnHgGYNZpe/Cnzji/gcOg4A+TU0PWgER3GVgotBsCXUeEls5HewSsxg3pTMUHYB3CwI6QfqS6E3CxHhRergbwVB7hXs3tL6wYEM4oPC55G9byn6bqbiNnX4LBhUfniMttUunQGme+Q+exw9s9QudOQXpf6lsaAoRqaZEsGOi5x+PldajFmV9mZ1MyCMlcTypx/VaTeHUc5LqInUHFSjCKYGCvBoMgIKDlNGfVImHaJVsZjoKtYGMIUB9oLBLAYACR82nDEqYYaCcICP4K0UkZj7fzLVgKnwmPSXOvnnOqNBL6fwSlyK+LJgt5eO9NNgs0GLSdMM4l8vgHH5Ws6kF7S2b96SB6ZX/6t6uvzh5I/v/pjp/V21mbECv2HQkwGBMktWlkBvnOwXL/vkLGgRtVoa0Fv/sjj9uUbUQwh30Jf09R082NcHD6f7FNtPfr3rcL2DuXqzp603GFEz+jvb4XvU+45htZNO2wrZav9prFrLQ7nqMRePagIdkr92oP7rV3HTsF7WQHViDLngd7zi4SsGIx0hzrQPB8auMuPNX1nAqn6SdOQk2ZFJ5wQpsnOKIjvot2esWudBv//zDEllusvU35Sr/8hanr49Rq2gnGGfGVYB2Tt2a8D+EXUe1hq5dvJmq7JlrKYAW87cAKTPs6+rfb4dewQHiZGfWO5P3+eDVgztHQ6F44ocGg9gXkI16gl/ABjAAMkI2O0C85GwExc11bV2dtROTt95mkp/7qrv3j6ptcophk3mYGjeGjO0za7o+8HBc3fd65HL7weQ14itc1K7/tjWN21LV2zBWHWOt+44d06NWcNv5hnj9oWOwmvXrD/0HKzesgU8wjtZs8EoNi54Jr2FGlX3OPGGztX9u8e5EdWTvqs5vkfd38yv3y+/oyEYtfKDPxqr9kMjq8lGxmyPDG5kQl2HXZp564rDxsh1PxajDNp5O+ES43iMzQwIbS8xGxNIQgzHChVEX5sVk4JBHi8vUSGXOxh0u0L9IZdMbLzA6wox/XETXWWxmMLaxsRlJV2WibcvnLEr4AqVFDh7azp8okur5fWFVslV1VntM2mBJIm0oGGAbeYWYrVB94TubAAH+l3QVuHtaqlvaQhumtQFi92ucgCCLnhJQRDCLYmFPrE5WBauaLZKtuLa0maPM9RV4eecVmGLuuaPxv0EiTFzqziM2Zc3UoMP2m1EG4YO7ARD4Iwx+S9UaIzVJsHt0URjDjXyx1tP1xDr42DzTPlvjEagRdEKtCZfdWeVS7IW6nmt1iX6Omp6nQUlIVdg14yFt0+0dJVclmjUhk0WSxVNZ1oi/RelDUh7PNyyaOYWwerkgqUzQk5Pc2ltsU2yNleEy4LNom9hYguEwQJ4iSsIQLnLXQy7Jm0KNqCG6/JiFPrMWoaW2JHKqRbUGqupi6mrqDupR6lfEF4T7BmPV8kiGFotiARG9H+URX+qES+iLt9bWNVHCGXB4iNeZbBZMywxaEAkTrBFIGCzotx1sTrMaYSDNGpBHaGl83kJOqkKfukl/QyJ93w4QMAwbRFMdEo8tpC4pCzcYSAOi1qOgFqOUQt4NxVZzGZL0dMTJ6Zf6J42E/ykPRz0abmJAAhWO2jjDeMCvvZ2b8k4Az8IaYM7Wldksxatddsu8zs5IF+SSECbpJtYfoX8d/mzKyom6KxW3YTy/TC0vxyl08azpkeiM3mvJqCfBny2opqI22ZzR2qKbE+0txM463ZOj+4Ovs5f4PnkjlrzgPmoPxL562R5Mbh/8h75utLKQksQ+OV/OqGpGDg3HqqzlY0rAZ/dVVpme1JbJNjF0pC76ZImdyhU1NA1IeICBpuerr89Erm9Lk3/ZG5FE2sysU0VC489Mq+8Gaeby+fRTaD0l790LHWsi//6gr2NRejaRrJxN4Mt8l+KzdAJzPLvg6K7EmiGr+GirwONl38h8bKZ/rGEWkXtpvZTt1EPEz0doxSid80ioaeuNhjBeLqWiG+M15J5eVHUO6Lk5QWjAdJhWkBk1IuNY4YbP9qtJQy4POclXQRDhqNe4SU9BERodHcMnhyRMn1P6We47wXH6KH0K2GH3e4IgzlnnTXUuEF+af1q4F282OMWabBYY6gaHwPHtJZYbfnixZXjYxYtmLMEDWtVj7nD7R3hwqLwpKlIUYHp/gUL4BsuYVHj02nX042LjS6UbnoKfkzSQ661F64WqoOFfVPAk4WhjvZQYWGovSNUCGYtidZWGTVLAC26PaDkP9vtoNLeUVXVcXj58vSvwOfyD8pstBecI19Y4wy2LH+h01Ufey+9fnw87p5rjOhKJi1cNysYiQRnHUObqNutpX/x1qRJb01OL/x0W1M3Z7Nx3U2bPsdp3mrlUZoR5M3yP4Bp2oF18+RvJz88G10d6n64G99kjmyMtwadEXBAvs4H7eVgt+JLiXlz/01JOPofcIoGHZdqwxmFGa8K2zKLMiAG8EE4X/e1O/SFzapLA3CXQa91fFHqol/W69Nfgm69Tmf/oswpHxMhKAj/w06vEeVpVX7MW4BeoclUCVabbUNngfQtVoupEp7npa+pzIzRytgkZflF8HoPtiDYaM6BvbDigBwBdkD2YmGAxHDHKOPLHlvx06KG1+x+XqvVmJ8plug4b3nWI8lrkLpt9T4t8hqtPARu0fx+2CI1DT7w6w2W3wL5h4JgLKFnGwLpMJR9AaRgg/cB/E/zFaMxaygdTzH/RqlOsoYv1TLFAIPeK2z2RQBTc5qAgPQEX6ikGiozCerZLSBE+OZbuUgM/gp8JBc+8wBo6OwEXsHn9HoETgqjUgIg8SWCIHi8Th8aIQblK96Q3xhfU1ISnOAcnUPwgkFw88k0WKdlGZrmdGaHiStYGk9cN670iuuuiy9GE7LDpONoWsIs1Qyr8xaMOm/G50VKwcHiUuwBYlvFDMjFaFhgbMAW5qMg6kD/4jatASnsn8s/ku1shWxH+rjjerAAALAwPRsskEX5x2wVmCM75AfBQvCJ/GNZpFvkN+Q/gzb5o3Pk3xM+9uA5PaAQs6XJHzG/lf8svwkE+Z/yP+SfgyJ6j/xz+Z9gPBLe9Whc+or4mOjRyKSUB+M/ByzoLxhneUxJiv9owGux5xurHby7n72zf2iOjzb50ova4Tvt6f9eC9eufQ98kJQD6Udpbw8YSCdhsuKO+26HrkPysevgk7vSp3bRu9IX98BLTt515MgYvhezqHU5L5cMGG0G57bEH0JyEZaOaLuVU/qAh47V2rH0BOKtdIig2GI5gqbMeeOcOTfMZdw0vB/LT3/8MZgK5sS6YrEueYpw5dQL5xfVdln1Jha3HGvSW7tqi+ZfOPXK05+C57G6j95cJMcWvfmRjiVp8DJOQztx6AD3Kk/5mDwklvyetx1+Sj5v9P1Jeth3bSI4HyP9ZSLZyNdMtAqhOlK+NHDrRY9cdNEj8BGyyfAYKV/g0AP4mPov/zkQzV6YB1zysREtiMR9w1y1qF/L58HYcjkqR5f3Qh0YHImUcEh+fQA+lp7RD2rGik/uZi9h70H6BI6ubMd9Adi5MI4ziqF3V4XJctFLRG9TQu+5hEW9ATtOI2lRIvEQSIak0fzVBpC44wGcxBHchSA6zOAzmDMjXsJi3w+6WrM9Gi4qDJV0xjcKL65sm04z1y9dsvMj69SKGvkD+bPyqoToWRpv/uj9tujSBRqTsaJkwRsvrKuaMidhLfBy4h9hfMDGmZ9wzWcryn1D8q3fHDLZjCwPtQGbS0sX+etLPLuPg11g3G3NZgDva+vyWubMsYiGJsuGLRWFF05aktRoboY73QGtprqG1/ldhQEtX1So0QSGRNea9k7r+GraorH6o4Ge583aG27g/PX00/fLTk9doWVPyL3JUDTOXaetfWnXQ1NdlR6PSV8lBhdWdVlbCQ6s8q40ZLRvRDo5YbcOESriWJyEs5NQfQm3Dx4zsfKBRlWpLhYKo4/GBAiHIW7YGOZTYDleaWsPjY4zWFcRRwmG3XNKykF5eN40zaJ9fTSMV06+9klre7jitgcrQu02Y5Xf8+JbvpLaej1rukvuvdvAukzVd3z7mN9julxrKd/0W/kf+5aHyiOMxl7CAQ0nGtc/BugnnMXFzHhQOsyad2t5ld26XnTEWiaeZ1jaXrPIWjwHNNpcHGu1cnyBVXLySLFg+YI0zYcLmL4+znBr/Wx31SppQh/8VdQe97W5DX6Tdbyn46qXS9g6q1/fbS1cYrSGbEAPakfMQ4DqwDFgqFn92B6Ih5UqGkliUdSfCMKgz+azWD2oBelHuh2PLO49tmmm74GpWzrGW1nAM/8NZsiPGr3t42e+8VmgFcD6pRdc0Ai977oWLtu4sJLl5UVD6ZOeuqgHwHw7v8IgG0ZTWxWMWnxR7NCBBj4eCYT4Wa1glC10U2tFU0ldgQ6AU9RxDWALoms69pYvvG3VpMvB3fntN/0pO3CUjnOAa34BJusqFvQuKLhPXt6wrW8CBOOZ6uG2UPpUAqZR3TFqj31slR5+ZTbKd+uMgk6+w6jRWlW8QKS0meWkTgeSZkliiM1iMONTQsE0m8L3VP1WsrDJcTVIDKaz97GZjWA5vjtYZWQkaZA4cDMDITNAN5eTZuUdJQDNU3Sa3DODgp/BwHco4Bk8hUswolBwYPgzVgqkBoofMqC5lHpPxS49HD0fs+qkcBFGlApeipriTkGTXwXUQFl//M2oPUMk6lFV57CwHvDTMKpK21hmJxqfwhEKMtyqCtGdw2pnN4fnXZKsWbJgQsvs2ZGbb7x+8+ajU9f3+itXrp2yY3ld3azAhAPyh0Wetlgs2E5Pn/YIoNEMM2H37ue9Xp8f7bD//OjQQY/H759QkmiPLN980YvMzpbp09tiop678ZwN42gzzRiy/vwEi1yRDihgCVoIm5O6hT9KL8B/XHJoO3btgmJ6+3JYCf8rfS6MpncMfb4b3kifN/QxvAO7dSu4s+weMt8XIkl0BtKBKKo2RuYnRt2yyiymdG4FypIEVLZgdZcsLoSJjRAHWmLveuzJWozdGHCgOE++DPXDqLWDD7wOh9cOjnvtdq9jaLCsuWlBczMzK1E5vXlB84Hm8rJmMK0qAX+8ITm0KnnOFN5g5KeueHvFVN5o4MFhfL65rLyZKXLg+yj/3mguk+eUNzeXgx+XNUvptVWJP+O9Pyu/iSp4K7gx/sL27S/ELzXynGFfWdk+A8cb0zdmripvakLzKJa7viWcGybKDzRIFQiCCOgE/yB4KgFM6VTr4EI8qhQI4XGH5/D43Uo3gxAS4LHAo8g7eJkEncSSD5npQjF1OQUP8mjUj8fqougw57AGqlA3xsT0HOZAwpohTwKgHLV2jgSvkimWxmM/jacEoHCcoFkipMwIaPrEwSMCXrHB3oZWAZIh0Y6z4PdASkm8K8nVHmiLoRkGDVjoahLIjzMQA24Mz0GRVqRw4ALZ7I5ankO6L64So0xV4To05/tx0mFFF9dhYS4gYLEfTfv4DrUx4IG4OIBAs9AEvAgNk2GlKfADcCNg6RBESRFx4WjeihqSlBCvu5HVuBA+SdbhUL3jyvwYIWA2vJrXTiRPclvURrhZ1RurLe1h4U16LcNK7FLGpHNqaPk2pAXQNK/TMhYGQAggPT/O8DQNeaAFumkBp2+hTx8uNgG91iYajUDwF9gZxqoPm5o4DWcvCBbq9CKSKiwFdvMGEWjHFdDAX+gugkBr4XUco+ctAFidFisAdq0mDIysTrDr3PbqOCxze1mtnqW1BmuntsJVEEPTgrmgzBLy+9x2I4Qcp+eNdOGsmN1WZqeBp8goOmZpIOA0Ni8DOYaFsKSKLWWsD2jNdLFHUyZUhRkjB2irruqCyyocegNEz+RstANCC7SbSkD7zPRdtJ7TQlpH03oa3AO1Fo7VshykhTJRq39cZ6A5hqEFRgNjrJE2abUsDYEOMoxG0ACzAONWO+SdjqArpAmtKLSsDYkOnd9TsUDqslZMKYkUFt2bkBIl5U5W5wcADeE6YYHF47RFvRG/1ihCA8sAP037rZcEnKsnOMrLadGqu3B8R6WeQYOf6OE1QXvIep5gYGBdd3hCtK+kYRKL5IRV8cUmJG7odW53zC+6Ra0A7SHRbJV09WeVNrV0Rsfrw16fjxaAYHKZ3cwaIAHOgHZNtN7IyXOAxsKyGj1qXx2twS8cyreKTlOB21yk8/Pl7PjzrNa2u7eVQqZyZ1W4uVg0gNY5nhK7bYJfQ3sAqK0D9MQCycQzCdZTatPSmj0mpEDyDRMBaCg2VRRDWq8FRZLdA8pKGJNgcADBxWocJj2AFmDQWrQCh0pCc8WMxCAJlGFMDgAMZsmkZbSQZRmO5oHQ7DLoW4u1NF/QNr6jiHugQVyrcdqK2woLJQCYCWsMXsZxudZUVUqbmmqqnB0aswayWr7ObJoa0nBVBe1I3Za2eW3rF7vEoFdPl1lcEGpZYLL+QsPTDK3jeADNcQaIA3qLBjAMYNw0Cz+FnAaagNHIMUaWo1G7AebkS4YCh91usRpFRprmNvOitsiOejJ6S4XeAgCajahnGyx6x0K9eXywRGtgdKLf3+mzsrTRVMY5DXa9qUOwaLkCDecVaK6ibkLY8tO6aX6t02wvwnTea2Md1mvrNr141q5yGyhylx3pWLFj8/qmNxfWTCmF0B9Era6RDEVsUJgXn7x7whTWVxMoQNUq0OunTTEURzxuvUmNj8eymEB5kRxdRdVSrdQC7FUUDNEBbPTHHGN0KMz48CztUOiA0ViCBgovG+LxIAf8fIzF8zvaYaRQGF9FRpNWUOthHLFhEQRlKyE0x27Yc0XA9PSn+1psXvnX8mGwqLv2+gO7QkFGXHfBRQdSXlBFv//WrxaO23jD0D/QpA5nPfNN16xLt07aOaXZ9BF9CGit7dN3TyrAqxAlMyZ3NEfLPbqdI/SwEnwlZ5ux8JoZ+sPw+prWZbxw0YeLF9+2vEMwAvY379w34Z83fdFc/MXH0/9CnwvAdfdKP3rbNSnWbJP9f30UGAoSDZ2F0TLWiboXjbQDFr40Fh6j2n6t1HKsf1TR1QBzJ0dqPbTie4WZiCGOhy0GhFsex83SGTtKK1SItzjCNKugz2GpKIYJGUWMPcfcGG5cNKOm11NYJpoOlneUllS4qhs2PdTTkdzYHpq2oPnQWXZv94TI7Jqy2qLayH8/2PmDjRPBhg+P7O2d0XmtPPjcRnO3ugNYvAPeq50bq3DqnTxvNrssM5w+vzNRGV9cVdy2sbNlSXNQKLEL1tJwxFtZ6W2uXHppcPL2g0c+7DZvfA6w13bO6N2r7MiDeIfo5xVId3iFxLK0UR0k4ipjD4kTfPJaQlMcyrNyxuKcDruUEAdfgAntsvCpdMwF6L8G2UJbut5RzIGAw+P7wu6hnUam2Cb/Dq9Gg7NE/8emGa0Mx9ndtT75H0atRl5u7zTEu+bQF6xI2O9kWmcwM3/h8Putg4+hB/S4TEWmvS02dG1ZUdD9eae8W/6VxW6rsFt1WtldwGvtXeze+Iq+vqFPLaABXEqNWHdQNJVRnppnwDjFdmkiM4MB1WKb3esPuU4SkwyLflMMsfcOUYSYHBJLLrHn0kIuUygTV4b9rwYI/6NihQrTAZtkJ35Mw8hZ6uJSNECrbG0k9hvJ8pmYH5aqL40U/bnya23IlZpY1V81MeUKab+u/HNRpLTeDKjOdSC5rhNQZrnn0v+49NL/AAOl9eVg/j55jUl0heQvqyZOrALmkEs0gdv2yUfL60uLnCC5YYOcdNI9+IJLlbIyuKxB4omrCruB02yVNsvis1H13fWJiUsnkj+U3tQNk92b5AFSGjohKzx5PUObSEnelMfjLX1QJhh/oL970ybwWq4cynu0YVbBIOqSoXAow2qHF9vsjpL8BR4WLDdbiqpLF7Q4S5qbSpwtC8ZVFVnMzKIRA8yn4D37tJ5iF5JXSksL/cBV3DPNfs0YY0QF0i/eZk+hftSJV/4IYRsaEGpbQRANKzjOLRwkMdYscQsOhrALJ5Yz40HiI8zGCdk8wfBhiSOuw86mltz2zqfv3LZE2YCNjFl+32gS5Pcf13l1j8vvCyaj/L6ZYbWPP65lGTMoQSdByeNav/ZxUIJOghL1JNTnboM2URPbI79u1um45d8Yjd8s53Q6M6jtYU0WwzffGM3oLKhVzhoMyln5dXTWbPzmG4Oq+/2UvZgSUQ+lgnhcw8MaR0bASG1JkGPUoU6MlRBJGUN8YMdhIokzn8fqn5Rffrz316fWHv1s70E0X4aWy5cN3I4pZre+AMRbKiyib8GSQydvOP+8ccUC/wmqTezJ1H3N8o/f3fvZ0bW7fvnKv3a+DgpvvwU4Xt3NwXHjime+sfWGk4ciYrFQqmCbcSnVpl2uejASc75vlB//qNiWRB6aBlyb/wWjMyfJGQ7zYP1Qgf+jhgjSB7HCgh/mcDgIjof3VD/Xw6aoidgbjCL8DrzDbiXdAI2L6LPwV8HqDPViG1BJH5qAJYy/j2KCEKQCBAEfBhLgekKugfY3JUmMiS+y1sTEleOTkTWdTYLpKWuhU5Joy8uNCtzHMSlUJx2ju45JdSHp2IBLnpxOPgt0z8Kz6kJHd5yQ6iRJeoE1j/O6MDicOxw2Cm/YzGLU+uct/bhiIeVC5Tby7yB12bPPog/81CkK8LuZKdRlxGcQr6fhpUusWUCk6rFcCM2NNBr1HVZCgYGXffARpGYR4BwksuA5Ev966Np4K0OwI4jChXsK0mmsBA+GrIrj1TzF/gEdQaTD8Lsdx5zjSj3FvFTlZ8DVtTTPa8pCpyhnwmr1dDdMcNI6p2QCPMOIga1TDm9e5izQBc7pvbqZoxlTGRANdpY1a6x1JnNRrLy00Ag5UatjocBzBc1G0WyP/secqNUt8BAJ9JxF0Ij+stZgczWDRHLIWXXAG67l6G8SH3ujkbIGdxkSaeGlZ7GmkKeAYa0Gg23BpGoNYJ2BSeWmAo6VaGbchHanU1d6TT/grjbbWU5CsiZD6221GwqLmhfVFLJAU9LY21k60Wjwa6Fd0rsgMLCWYl9j3eKQvtVfXayFjKt8SWvvhToTBh+hAWRNWsIV/CPua3Y6pSMjXjU1n1pPXYy+xqxOjGdjkkT6pyOD94kaNVgFSniOwR9iPFYSRHovGhVxbK2IdrE66MFOa9gojz5bolpCD1ABQ2NIu1RUyiA5Rg6hE1hlxyo6vAebfmfa7GLH7G0arVEo4i0ewfNE5Z82bphdXX2ib+MKpCP2y6cO/VH+vaDtB+DQH0EQhKYd/Lmclj+W//udvVcmHwSLp02oZDjBxHFX/qaqshKygs7QsLRj27wCSVPuQAWzLmpzljGsy9kM5i+MhLW1MZemsKS19aGFheMNxYW7/jnkn2wSXD7/JK/7NqObZfXGYoHVL1/bU+J/ZsWype6iJ5p7bpgsOD47pGyu6bj20t7W9h1PnbMVMMkHfzAtcZ1gQL0ANrW0bTUKetShGtfDFct31aOnozK09RjR053jWOOsnvRWt0usdc95vGNSVOSK66s51/R82WILpaUkzBdP+G2Rpu3Ba56Qx6TMJcDMo4HSYmdE5tyjLzx/9MAv/YFfyrelX33iflDCRJ94Nf0YKLnfv3z5wm8OHvyGbZHdQ/LZq94FzmfBpN+ky+S/vrsKHBkCf/H8Rn5WWetDssNOJKdtwGsvNBZVOYonKB9oLBYgNh8A9HHFcJrFabYYxKJVLNL7GQFpOGh4wmsjAv6QOZxkd3oXLe9dtXxWs9myWT7ypuRyScdA+dqSqcsXrVww17flpcu3tBVEXbx9SseKOQsSldzki1cuaIn47Cxj0Lin1NcJoUjnuc0lLGcVNTxSj4Tq2KIVl3TAcMvM+fO6miwWRy3nnN69Y9s14Cfd21q8tOAp0Ok+kr8FrlABeOe4IGqMFdP2zK22BmZ2VVzaD2hIW4rqp22dXGiRxjW1tdWYzDs7OeukaZs2X91R0Nl91qK5k2MmE7PUxTvaoo3F0DHz4jktHhF9PvT1V/COpqoQrEFiiw3JLn9jKeJJbiXxVUTCAorPPrD5LPgvaMswMjF/2zq7QR5KfzF7K/ObwbLM39bZ9MzZW4F74vwd8r+Accf8iWDyKeoUmIp+rmpvn7djR56ciRHKatT4oDFpTO2nCe5ikiqRaYZkUyEyffC7Ar3gNWPwmR79roCvYTKxWtbhbKz5ZKziacuKOUtxAXNUrJjJtP87CzugFhG0YWZUhZFVPvWdpR0lvytrprlijpTfR1kbqJDLalHCzixWHEP7HQFqKexbZVDDxgyhoRe/RzwYj7794lwcvngaxgDVnlv2XbwBanQ98H4nfYDq474MyeQ2KoYjQYk4hqWxuAPPrVQEC6UOMhrRCpBYnJCLYkuD5LP5cLSXRJ9a2yi/+ezt8te3nfiRZechwD+z553t0N14ijKaSy1fyKXOIN0DNcKC2MTlvR1BcL+83gx+VWr5CCx79bE/3Aa0tz8Bylovjf3xsmfkb/d+4NqS5APgA5+T1lsKIm3LJ046m5f/mEwG5IZhOrbC6xMLh2j0+njsPqksauKlUYcSm4XtCpI4yjvRoDv6X7MrQvP1zFWB8rDR69nbtN59jruuS99Qa2o2dfTc8af3Tw57n3t/y2nkf0k9De8/GPv1cwZ+mbPH2V73WPz38cdACLjBxcMsaCqeAyoj1n+tkFHUsKyDURuI5qczYVhIcClCch9ryyQsMUo9ySSfk4//rF8Q36U5ndbo+CSzFQV0EOwwuRzyDnVzHDDkKEz9TD7+nCjAVRMBpzMnHZopy7Kpk1irfGIba8V7Fy7LJOQCI7D+FHv65mK/Ayr6tE0NM1Iqkx2Mslax7xkPLiuslnI/UX57lBiUnjNGh4/KT+70XdHialysBuN4hgnjX7difYsqSnAVUGiNsY896i0+IsEQV1TAx9Hk5sCRvj6O9yPxFAigHNCRWjogYYhe4GEirC8EzznvziT6pPnGGTMaecmYSN55HrO47BLz4p2VlTsXmy8p46LR2R0dg/Ppr9/7omGTu1AecC2u7FlWdMcdRct6qha5gJcRqms7S8BLQ9ptoD+RqPY5C6DFaYEFTl91IsHbaVOkoqQiYqLt/FDJphLP+BvGy78JlY13OrFXKHgTDIA3sYcoY/QV2LoT6veBsUTmEP9k/LFiDVGxIiGlMpfMEDS0ATqXDKturEjDzCVVeDvUEFI8BoI0y37ROnfZQ/X8vKbqGaa4/HJcM6+5ussUv6XI1jI7XnH7+ttd9uY58Yo7osqJGIjFNPNx5ujdNnvz/OaKO9bf6xwaArH18svwm9ktZ/ua7re5mhbEKu/ru9fpwIl7otruFnTt/0fbd8BHVWX/v3vfe/Omtze9ZvqkJzOZmfROgJCEEHpooXcJIB1haGIDFaWoKFERG3YsKLpZ+1pQF7fgz4K7uLu2tRcgc/nf+95MCMj+dD///z8w7936yn23nHPPOd8TA2Vx6Qhyldg+i7lydDy/Z24PKZLIuz0hGV6RP1QTRy+WStHpuaBi/oV7NdmCJtUFOiJAl3ZkXwbSruxD6R6b6cBpvAxJpJpNBKrBQCUS+oTb430pNq2+flrhc4XKHHlpmK4Nlyay+3rDpVWBwsdDtEPt4C1Gg9HC4xANFL6a83VNzpwAh3wG0zr/oEFZq7KkQSlqIc4UZmaXl4YDw61ZS2yQl+lkROkFn3j4oHk4lZElCrYHLO7Ng6kR1FRqMUXxeAULQgEhkxZEP0GNuKdBeCe+P8kb9MX9xIW3aOKJqX2WN5mFNRB/W8jx8VgJlcXgpRoSkJ0gXm7iVJY/juNB4gMEx03rG8CiF//NSlmN1M60oM8Kcng1z785bL1SJ6E1yvaV96B/pdO4LPlcMPLlG4BirjzRzDBKiR735hok+RIw6zZ0z6XXTHn7oc8r+u4AC0DL19u3f40OoRvRIRICo0EnqPrkiis+QS+gA+gFEoLJO3f18VPApUDKhyodnaqzFF1Os9DjBHIgA0o9rwZS9BSS0rWZ1J5n5nWNSCgtvF3jUvrZ+cdSqyRsXhbT8eAL76B9s+CBe+fnwJLzbtwiPMypJ6/4BFRd8AyZtUdofz3RFwM6NugnY8SfMEoYo4Ex6wAfSARDMcbMVKOvT6Jr/vwHMOn4cfQpiH1GPxBIfXfDituB8Q3iojRp2J/acc1P+20Hgyeu3fMPF9uOatDqJSObnAc9azM65oLfKSUVpIoI8oDRl+7Cvhjw6KK6Ab9z2HFsJhile+neZLbjtNyRnQR4TUpm/lc4sk/hjAoJDvyMAxIKJUUEkLNUCt/43E+EJyUe7ZIZX9FJmWi7TugF3iR0lATPmiKJtL+tALG6NRo4Pq3jiXPJZBtPBDJ+vST/1jMH0Z/RfvTng4weVptKTEy76UwPo2RSl+aWSmrKy6FcpunVyOSwvLxOMRY9ZjIxXTib6YJH0IuDlg/C/0Hl4xwHtQVShHnDo95bZvqHDgqi4WoF/lODR4KDhgbfWjNHWiAFXQCgHvz+C88m2RtEnRbAEwEFH6QgkWdgNktvrqYTJFhMAO3oCarq3OxaVRidfXhSeSSvoWbb73MC13euLIzHSssdtb42+Q7YkKpSKOALg8BLIHy1RrPoS/xkVZ/e8OZYtTo0vfxy3c9pnzjsx8IaSgEPGWXifhceWf5olpnDTyEQeZjOohMemoJ/Uj6BHnrvVnTy6KpVR4HjVpD3l3fWPLnhf5LJ/9kwdsfkJo8EtcB/N1QdR/f3kgKgHDiOrvrDH1Zs/Aj9/NHGoiETOwKiXpk4TxC7Vy/VJkgjTEQ5MCgo0ZP9tYg/DdgcYdMUpylBgG2CId5MPHwL2KaYnqIlXNrQw4wPTDTij5VgbtA/YJbAs4OJGazVVqN/V2u1Er2kaNXKYokeHStpjsWawe9izSU4dKZphn/j4zWvksRA3PYBLxl0aIOvJNIUcEuA5aWXgYVz+cGsi4xHsFirqa7WaCWS4mLJu/hiuC91Bsg1SzqKm/ydEmDPD5TEmmORYtaIXuU6A03FvnKN3bn9tde2Z1k1Zc9ccEEcOh8HSyN4cyLzqdBO3nQ7kWYKZJrJxPaHEkLjBEMJM/l2/6GpRGv70C/1luj7VarYFzGVitWyOUdzWC1CBdUF+bX5oEM8/6UyN8e9+Ob4/SDfTeQuRcZndUzlzYtcebmVWTb263vv+1pidYPoefgTu/FF8TUlkpwcyS53QYFQM30enFPpbmO+C2Xl4avn5rB69L2kNasyxxVRWc2rH3hgtdWiKgYnL86XuPDsQxCZE2nwsX61FOEFRZUTJ2BjaUWVKsCFjKQDnacmOa3z0p5LHUH77qUdI5baDbwdXLmLnDorL71jKRhxIf9y2F49vHvRcPSJwW43rFzdsWRxO8CLqYOPf7R6ncHu4NfYHGvalywBD1zI1ZA56k4uyU4SnlvARRIfWjSx73deLzw0x3oyOeaEmMUEK0dV9j3y6BkwBAdSDz3c9wK4Fgw58+gjfZtewCl06XKiHpPa+9DPZx4FcnQ6t6IiFy64/9vvD15Rfjv68dEzpx4Gyqpy9G1ORUXOQH6F4H1QAeJmXHSPehH6mO1N1aKsSZtgLzgxaVPtwO/bA07A3k2TUFaqdhPjPF9hT4p/NinF/AP3aBm+j06wdg8IuDlkefBYgU8H8EpBG6MxnmBT4H8BHU4bGB7yRuoLMGQNuOnNN9/sgMbU52AIeook3AwNOGcwOgwGr2H+0ZcND+O8xehaXGYwPAxcb7yB/tbXcWfHfjGxPzhgfMkEbNQi4luIEthuYvMxIKRNA3lzugSx7YBC3CdGfsGIm1piuXZHTgz9kA7AdQ9fZuDNibFrj0XrL7v7kcuaG54+lqi6jDafp0TZmOzUAKMOjEhOIOdUMVA+R7eVT5GkNmcf5eFcHPX3PYWD4Ofz21dOZZ+VcW/i+XQjdYR6jTpKvU/9nfon9Sn1JfUV4UFdNFHQV0OugPURTVIX5wYmHA2KBiQliWqIpwfCogqaN4xIbJMlEc/7AkdtzlDYUJJG6SCCkhCZQAQbOXNCTZsTBVyoAOYQ1yuYLHXBGmA0Y+JOWiPqLBGFVcyl0eSC+IkEyi5h5oAITB2qhlE8NEkmH8WpMaMG1EDm5WFXTp9dl+uZUDmoaNVef16lPVQwfahcwsgkeZyb1dMSAAAn1dG+zVkhD6RhRQKPRP/uKuvMbofEiFxurUWnBv+QKoy8nWXMEo2Nu1Oms+o0TwBwl6nwusJEobwxl+2ozkvkGIxyizJCh/N9oIrVcWqJnJMxnMamL1Svm6ANN9Y4B0uVWVkmpemntY68bKtX7VPkSjmYPbzvkLo0T0fn/hQ6HJfZnWYrXLWmqhadKlo4FNxO+8qipQxnHF7nQIO6JPJ8JX/MLc+mVwFI/k2hC5tWTB1SOi9R5UrUaAN7HziycypkWBkb4JxKlzVg8thqsltwn5Br3c0mVVmVEdpik9bdZGBs3SatxkzPU5tUcoaFQJWlC5h0GhMd1tqe7Cn2e2mDRavn84basrS0WuV31zqs4TBUaP7MGqUaCSbgIc2AXJfHVmAfKZPlOwBegaZMMfpD5nxdGd+ikcXG3PVyLi2Ty/g4p+gbZct1xwtK2XwF7Vc+UoTe1gBOo5ByIBeqOHipQQeUqbUjlZJiAIQrizyuHo+xf1NmTJNNojbhZS2Y3g0h+rNkI1+wthTUmsVRJqjUcbiTCLrlcVBC0GuI+h2RxwBBmY0oIwhacYLGlyG91sdKcL8TumwizR8x17K8a0nzhlpWqtBwQOqdPy2SPTaXU+bxBnOs0OIstqllOjOtkahlWjWvsPsUUjkrN4NOuTnf5Ulu9NuHDh/XnVi6H8IWZ0NT2a7lq7NsbXWDDb7CLIcztvZt9Dl6G/3jT8lQRcewjkJe3eyrcvnzpBvK8g7mGv2jG0YmQhFebfIWYw7DIM9y0DTjsXPKzYVqjVyZZzFIOQNUMXJGQkONWqOTMEpQaMrPd4wcBcLl5WEAbpnZXWLQ1bXWAlA1tBrQ3oLslUf3o3/+bsHSV4CjZ/zdaxcPq3XKpQFD2OIYP+KWoLPNrrIMGrJ83f3UQOwtF14lO6mVeD7QQDUIZex5E0HMVZs5iQGTEzU0bcaEgldicNNcISwAiQIRRwiPf5NoRBoi2+kJMyHACumEm0hUXIA2SDiTYDlMtEU1dKgGVhOFGlyRKejZ7ap7YLS2e+joleMHmQrqlLsVgUBgTsC1+/bnlHuUgTnNAeeent2373Y15tmbOleOblmqHHU/PXvl6OYl6jHPNCp2C2Vce3rwP2dtobFlJpzVYitoUOKM5jlCxu17nA1PjVEsbRu9ErzVs8dVW2Bs6lw1eki3dsyDdco9isCcYIAUhHpyx+a55I74n6vh8FgNfrBV05oNhWd2jl41ebAjr1EoMid9Q1ftA6MVSxlz66WK0U82pJ83ndWQbxs2a5Xot0PEzBhEjaMmUFOo2dQ86krqTrKfEywUXNWFRGXOUFpDMREk06HEICpy4n+C0THRvcRjgciFBB1PUWWTFhQ0faRUQpCGJSKsOQQCOhaY6RCeds2A1eFPSG4hIMKI+yJCXWK6jQcX0Ami7FBJSCdotyR0bCQPZxp1cDswGwx5uVwj09AwwsK4aUmLcYNa1wils6QhF4SAtZktejkDJAFFeeEMKK9XyKwMA2mrg7aW1CovYxnVWzSnDLpcNrOaAbTHUOTndfC5mqvP/AyfSDUzx2c9PuOvs/KPoQJYhU7fFg9v3FHuGTX8mxqpXMo4PMzQBwZPuW60xh2Qg519p9WpAk7FEoVoDWZ/CyBmdCsYA3iN5qQyg5ONwdltUzSQgcw4yxN215Uy4IUKKdG7k7Mcx+gkOiihtVof9DG0HAClEUbK2MgIh6QEgmJwQqMya5S0WWPDw5BRK+GOv+ekbvoXI/00FXfD692pf7kvqaMrngJrT+tUPfUjrcq2Ak6Gpw49DBQ7/ZwOM9LJM3/4UfKdCkAmLgMS1q8GyZcvmW9EkwV74wz2ArHpG0yNxT1hBbWV2k3dTT1J9fbv9PQ7h2XPhywn9APx7WQ850ZPxGPX/Ur8/3d5XgQW8+hAFtnPTJIDe6K8ade8vp76yaVh2BPucuxxhFNZAtDRfzwA6v8uv6snXJpKMsnJ9ee8K9/pXT4oRc3bNbleQoVLw/gxusJnkv3VgPpiQXTR1P+mANgOqNJwD6KIN2+iQy+h0rKbGmo4ngMWUesFD4IPUb+j3qI+wpTYWaABblAIai6y49fvJFFsd91/Gaf/y+/5W/rHhUA+/7fX+3/5fKygrHJG1FLpPed24H8/JH9rwXMHSA3wTPSbawHqv7+ThAraTgn7XBJ8RAMgZ7/9teCj/cGLQyBdPHhGwEwRDvC/qNb3X5Q9D4YJ85q1Z7VML9uFR0mI7BheoFRHZJ0ZZSGzyZCxMmX2o/fTunXofYfDOdxxEnSfdLQ7HKhHVLB7H73f96qgWpdESUG1rhT4SQHHyZOkwieibh2b9r1M9lOcgtRoBJF/iXwPph0JXwJEXzEgvYSyEUZPLCrw7Ofz4gziNCYwoDTBBgkIvmFEcgrTZ8rguKqWtZX42LqmEt03qrVlU5NwAFctB/qnvDX1uY1f1dSnmp/svvttMKRqXLByTSs5rgUzWkc1bWohByZcOb9t6d6h5HhL6lj78kV7m9tXLLq18AX06dKCKqeic/yOMcceXH6sbX5l8y1L8XHo3qVzVrQ37120vL351kXE/uosBYkvcKOIucib0sbu4sPjZ4e9S6bkQ7+t1+aH+VOWjN51367R9NfXvxToe13QBIsFXro++d2tt353DlMkY3fkxlQ80LGhfKAiH1FEUk1jhQibqJhqScJkKlkLn041pZrY0353qtZR70jVuv0FQdhryjPB3mDBJDAJrv10MUIIpihfpQ4ltVqQ1FX6aCpcrwaUVHqWUteLUHn4/lLRj8k5q2icxQaE52BB+hzKxMlzsWTHF9OsYiD9gAHhgJ9SWHzxAQpOhGrBjWgBWsC+OyCSJ4YPo8FoMHsq6EG11lorqmVoyKaDnmCuDzyKf73muBn0+nLBo/6crl5Qvr/7gQceSG3LhFbeBeT7u5999tlUFeryV2tPqNUnIP4jZ221H/QEa7VPg+vwsVcu79XWBlH309paUaaCpBQL8XvLcLsHqQKqjuzWGj00QTYN0pjCi0KPFzM/lNgjOY/BFPBEYiU+T8xDeHWfJ0A8j+EcocPSPg9XigA429fZLQF79Adqlus+mIEO/zkF2KNXvTkTpi5ZeiYOwm++gv4IrG0TnkN96HPYMfaKZTUHl1xaPHJJsil1K/PAWvTHuZ0vpJ6sTaA3gfQvbwP+ig+v1LkWrYrcfei5oa3X/cXRsG7C4x1ZB1YNWzOq3Jb+hpn9TBcVoPLwmwwW/PxcsBrywu4T2VsgGw20L4YpVUP6xOIynnjsHLoPgSaiI2YfHnq4UQZKwo6hbWDdsp5r54eaR7U+fOeKqYefXQvljUPALWDnhuT+2y5/s/oqxdDixQrENM0DNej350vB0PV9Xy5dfFtOSXfZ8Bwdev6pzsnokeOL52S1DJIbNj9ycOPW/b/zhsElq0vrgbw1w2txGZz7EEFn7fdaIOzBmjP6ZyFCmYMBCEUJA+UDwhxSiMeVoD5DgGwpScG1r1177WupbTvm2O1zWuvc7j0txg5D1vLBc+i3H1u3/rHH1q97bBf64Qgapnx+86qnrf8AW4ZPVpkIxoDimSNAwbhJ/WvPPPf2DkmOe3dLa61b6pFWDqU/WvcYrv/oo+ufRT+i3294dM+lE8EDtxZBsPsZIEU/UOfxjlL8Pg1UaxoJgGyfUiI3KJgvx/FDx89thFVlGI9AJP2dOJq8fSCzvyy2CWEO31vSs3hxD9Je2lE62VpSULnSaolWdZgMHXSf+CUOGm6YMudmORi/69ixXTf+EX4s44dVo7+IH+in7a9u2zZj5jY6u2fxkuHti9GrB5aWFxkM+BqVKy0eFi4UP+ZNgyauvGZ237Gdu469cyN6DgRWgHdxOuqZsW3bq9u3EbTxs2MkX7FnKRXul/mYTx4moCbRXEAQvmIGymTHHDOtATTRbo0nQoBYGwHModE8aQEgoQMhnmglskTqxKlZLohTEnQgQRTX2Dim6k10owaiiXjsKziNzAvb8g7dUDO1yE0zz+kgJ/UNv0aSPKIs5vWDb5T+4xh339/KUqHC99AL/MeG9rCl2FdkKYK739UrTKqwv8rTpPD+E5St3f4+mrTb2zGoUqcDO91xpSIEFqHrTE66LGAvbfZP5JSwHG2ZOOT6uaOMRjDTVqnT11w2JvUZusnpoxmO3Q8WgXkPaE0m+tEadM0zSjDD7WCgwZRnjaOX0M5Am8/gNZnkenoIWPDClyPR1YYx42+e1KBSAdqu0VSJfaRWKvZ5sq/bcA4tgvfg1iJEJNefMtBw1JMxIM04EsHtR7qHmagwgBOTN0+evHkj/fN4aJGlKJkFsrSQhPTqru6e7j4KH7rU+k2THHPNd0yjqWl3mOc6Jm0C60ihyeAEmCnleWnKKkYphEn2JHG9mRSPmJ5L4tJ3Tl6/fjKatEm0q5WS6TZKVWA+vnUAr/a/PLCIs+xJe8Qy8xm7WXDu3dMpXNakTRd99KSIipckL3DqtPi4Mwa8N+MR0mBy0yTyErXk8WvF47mXOEHIrBPkVVCW0Exgo9gAfc8IUUwPZGEe5YTwfpSfDFRXBuuPbAIR92kJ8mb9R9GfMFEiFI/siaANRYDcb0W9Vr8coIgtyIMdnwjHl8gxSWDhk3zQ9hLYgY+fgB2dJUHdtqDV57MGt+mCOPeG/kOS5xGuEEQLhcOAucZI5VKNgi5MGjRJnOXTZtjxBE71DEjNElJ5nOoX9hP7SzOCez4wUDVttuv3aMvNOXYTm7V50d/u59W8o8v3JfrDTbuKfFbOtXoDML9jUVt9C8Lr0KMPv9Fjdme7Fc4tD+4D+bONvDP3zQvh55uy+KVeWa7BKbXPVti/CBu35aiiVp/Us1blA7pC89BhhVzA5c6RBhqrlNkTLhAGAdGXLf4mPKGGiV82juYwjx3CoQSf8DAUescCzIjN2+5Cx0ChBX0KzuAwyGfeST3tRlNd6CsXKISDXWCfC+hceOzp8O8aGcVcSqnxCks82ldSQ6hR1DRqOrUYc6TbqOuo26iDVC/1LvG2RXqplxiNkhkbR3EzkrblaIM54zwgRnYHvYXEtjdhJoo4sVCiBM/2tJkz+IT0KKbZz2W404o7OIJzZIDnDIJnJOIi2ZS4MCZGRLvwMkCTbLIG8kSMae6PYXrVxHPFQgzysXjaGF/AbxaoOpJACUIKWotJSJVcplargUpmAjkKpUqqlaqAXCGRqRUy2ZkvDAaohjodVI+z2aBUZjbLpMB2xGpVyKHRCOWKyWYzVKqMRpWyC8fVEpnBIJOowQb0kdEo57QQ80taTj6Z5xVSHMJxqWIaTjPwOKKSypTgypc1Gg1mCdRqjUEzXa3WmrRAqQRak+ZPar1NDyQSJZTLFFJODZlZB5b1/Vuld4zuegG4dLGyZQf2fwMVcrVanvrhG7mq5Bhs1kpZVqqVpJ4FnwM5p5BxKrAguU4mW5eUNb31ukz+2lsyPDI//+FLheLLH5Rs3/cq1fd9KvdnP2pl3I+fSWTIBBeizT9yCv2PYK1eMRzlfS9V8N+Dd3lFFpJ8azR+C07LVKqUDn6G4FdyjVrxFUAKtdqFDF8otFrFF+ALpVaLpP9U6fWqJcvgWloj41ipPnXjsrugXkVvMsu96FSv6QCVwSegBB/GdgGBlKKy/Ak81ZAd+ipg+t9jjABOLUZL4pAH74G9K46i21AXuu3oCrD3V+KHQQ+YdjQTP0pTY0bdJ+pj3Deq774BEZAzIMLk4FNSjOHTgP1cnrJRPmoyHjuX4rGzFc9Jv9yvM3M6D/GnLChbExEuEKRlZBNXwhnFPXMOCn77iD07INYhRrIHS2wOKmBEsL/Hr40PmLJQAyAx40mOmLzH8L+QgaNJ0RC5ioQN+siYLGGPOML9AMrJcJdjM1gpV6JXlGA6sTVLURB5ohXlN7i0aggkdUWX13xw/03jNSoLYOWMbPJotQyWJBr9FpVK4TYCs1IvI8bwygSyl4yODgUbNCr8OAJChRKs3boTmtiWqL3UBVdYLm0pUjPMZmGLLQPDHHY0oiucSlCmPK1nKGLQdpqCI2wurtiEmSsAgmGPpQKd5pSAkdvCs/NlGghHd1+xruOWSFhjLJRAmnWtGbQf2S2Xh8fRq3M6uQAdZhiA65pwe6Tmxu2YKG5YOGZRqcLiAIA6r5+J32jUb/s2vJEAGuPWj0XJ5joOC5B+tEQDfCUFxDUbAVYntJ0Ptzgdjf1qS89t2r8vydGQoQFLJ/ftb0Lvdk5nIWTw00vgdUuugyxgGAjZ6Z2/odno5PzUfPCJwaaVWmivDNnhzvnzUbPBZiTOdtksGfSkPpK5JUajzQCemP/Ldhj529qBmAL4CKgnkQZDN/CROC02hgDhRsQNhUCI8/SvNgLIB9Zhs1k5i1+agSxHz28BvsbeFxrQp82zGSWNexcjUcxrQR82Pvv8b2iGz+bNu53jpYyE4WTM7fPmAR2wzZ+/j+MZGl9HuQ+3ydfok4yOzMD3LxV0gX9rC2COUvTTjSkNguwIfDoycgnY4q+/cxYYPOnKlpyG4c01RR3ouomAXbGyxF1a7f5tL3i3xpzsGLHSzs9P/QlYgFLv6Rjv1lzsnXKoyG+ceXSeWMIMGFFdyvCrr8Ak+6hesvnR3tONadLf8NygF/X2kirJblKFIGdmnjWzL0OeN0E1CyjrMZ+Rjfmc6bPx19/BR8DFdUCwEBa0oON8LEqcJMI0GQ2TREmQ/OjK//XtkklEwW3zpdd/eL3UOD053OQ9Ivh6Y5ID/sCvvXEyiaeyd9CdduvIhQtHWu01oDWZtCGb4J+xX+d1wLcqo1oEbbbftE4YM14j+x03JOIE6FEbEpHVTFoBdSUUIV5BCwBJMQgpv945MZFDfEce2EQYg00HtOCQm9+wQRs3GFndjBk61qh/1m4YO1YfD0K+pISHvOG3zE4FUlPqBHElebewb3y3JjXYsg/s2WeU6HQx4xr0/BpjTKu50TCpbxIP/TFD2Y1lhphed5E+Hf2t4/TCvSE202oCGmY08utLoeCFGAlHegFpFrUM/QRkst+0jtHJTF2AjxC/fy95fyDvBHLZRb5/ghpG8JN+05tVE9tRQLTfiYWpYL7iMXG04LcIEFV3Yr6IqVxMKPBiWZIZ+vWP3yW1KaIKWvrEE1IaB2zSv6nxy6rVf7swHS1XaeBV0KSqSZ9/U4vgKwTxlb77Dl8hiK8E8nn8h45dmJ6S4CvS5NJyHOj7PQ5gnid0djd7HLcX0dDF5JEEio595JjrMdkI7ZQI9ntcx4OAqCQN3O5jj8+cWveHOwrbOxx1c2cs7RprB3bbuFWrh9+7fPsdbx969LlyztpQUad3l0ditX+8oxq+9LL5CvTt7bb8Il1sybUfAw5c8tZ7aDf66uWue78cAsKHe3841rtvPWCUoazZI8Z2Tp/w9F/SMn1OnNcklBxzU3rMmVoJNgAPdAE2EZKBQGbDGfNuOjaAaRSdIe1UjLAkIgv9VzgBPYoe//3v6SgOfYcebQVavHh9fTVoS93FvPl79DhQpe6io96+N415xr43vV46igM4ASxCl4DZH/k3bOh7H+w49NHlTzzxxKSPwGx0CfpqA4D+Q2AHuik39WG2OfWhSgW95mzozTZDL6bkPzRn8Frxi7Arcb8cK/ZJYdfO58mFgoSjH8CD6N3rcSYQmGeirZDBC3ex0fQuHpfRAfN5Ra9a0kVXfnE3o6HPDAaQve+LSyYq9y+b0joMhB47ACx3gtNv3LP2ytnaGmVDa6K1NZY3oq5u6IjFdavuvmfNtdMm1beUtDeX5Q6vqx/asahm9X2wr+CV1fs/BfJ/3nXJ0/FQ7tI7ym8+cjv64k6JBX29evt0w1B1XUM81pjT2NHRmHPtilXbpy6orY+WDRITtp1vfyBibxKrmgThP843GvBn4VeJmBMgESxJhCRaKgsfvSFOnxUXfMuyZjwBcyYDfO2Xqv+wF22+//mO+zqeP/PN8w7H852wHqwVE15Lu4qlZzzf2fm8Q0JdRFNY3Ukq4aqkwv1oc+o5IQEEPxYrS5+/X7ycsF+TJTnB/oWgQIBzCk56osxPEXyCrGqy5R+KmRi95MSV/0S9qAf1/vPK50H70Q/QB2m/trPQBx8cBe3Pw+TDJPPKf4Lah/8Eln7tPpmPev6xUXRju/EfoCv/pPtrtI3ohPN4Pvs3bsPpuKfH9YlIMR6FjKBMIhiwA2LmTjY1E8R8Iy5oAxGCkWQKAbVgFS8auxcwmOuJmopdUrM+rVvOS//6Eguk4dpSDzt0SGROa7VWG3Jo7Cq1PDs/R62aE2oz8CBkNNze4wnRjGm4wzE7r4Pn3V5DoWf8iMEmY+VQC5OVU5ytVqk5eTh/eHFjbpGDB/SH6JKzh9Ghz7fAXcfBajxCpNFZK/bsPDA4EtK6ddropiUzXE5rsccmkSzVNdnsRYuy3E8+XrDY6wkM1umWqoc4naW3HK7Ndxs8Om1s7Yq13bNHVul0KtrprY+0N8+as3EwSqEZ/7jxZ9Ah0j1CX1NiPjdMtVOTqAXUKupK6ibibyPoJ54T8H/M1HH4GNQmzBKOqF0TK0YuFk+E4glznOaIIZeEqO6YcRdMBENEa5t0S5KLjxF8AXwZPFGmi4XifkqLj6LuJa6QIFWEWqQrUAOMYRjROOY8NXh63tvotnnlzry6G9/X1aX+NtJkL5s2rczFd/hYafk8dNvbpXW692+sy1v9qVr9L3fD4bLOopKJJUWdZYcb3P9Sqz/11B+uGFeUtyCvaFzF4XqUU1dKigd9ZfNAF6OdVmY3jfT7OnhXmanMFyQ3Ka17B3QB1daT6EV0AL14cuvWk6ASdILKk49dZIDMqpe8ddBbHCm7J2+MEuoclSWeQ+DmQ57SUseM7oXoX96Db0nqgXJM3j1lETihPWdMTvvE1jsa9N/I5d/oG+5onSgkTWq5o1H/tVz+tb7xjhYYrIeKMTn3luaUeg6+lbofzTrkKal0zF7YPcNRWuoJenDGvTljFBDfGq+d5Mm2DnxauO9i2vnnZFkcpcVU3yBqLrWUaDcGDERKHI3Q6bMpEZP4Mmr3RoL+Tw4EXoSwHWQaFjiQUJyPCquGj9A3bEzEjY+YojEfSSNuBcj0GzX6cGVaEB6Jgpj4hS5QYdOk+dNm+ZtbW/3BA21lkcoxyyvygtmLw40tuSe62uzFxa2d8sDgKyG8kganXXial/lkc+lrmEo/oLWYe9O7S4O16NWiIcWRpmI4Y6BI7GR9TS3YOXpUZzRwmdO5ZExkjobWNcYsdGBWfoNPe6ShVs26LXlSzSXDLQ4ZmmpPgE0FZnMRWhmRrTJ2fAyXdRgs7sJlNIDHA/GKoAW+50/EA/5YfOQFGK8SqhHPQ0cEDGytsIe5gFpBvHr4vMS/Ak1WJBIgI0PwpC4gs7BGrccrqCbHCPMQS8vxzRHgI1r1oShRtQ8YBVSrmC4a8wqI/QSeH+dEjcRVmM6Q1vwW1z848q7bDu6uqKxYu3YFUPlztTvWhkP5g8eMGZyPdg5afUndEw01Q6Y8d01XxzTwxIcM8yEDJw2eXd0ZcUohZ5EYg12Sv0vu15SpR4+tSn3dVlbePryi3DRjzkx6YlXH9VvBm68p5bnZ6x8zS4Mhd7bZ6MofWYbetpbNb76rkskevdDBWO4dcfXhwr7n8sfDqZO9ngmpW8Y/8mIoXNk1rgJMYaDkuZa4L3vtcwy6YROjvnTs2PKKcdQv/FLLgI/GkwftA7roL+w9soG8+1aLIeeWlYCbCf9ynlK6AXyHu0LeRFCKeHSEvup837NlZynmFfyNnAJWkAgOxkEiASPbXkERuZGYpBALcQEbRoCdJNq7IrAQ2WQWwI+JYgUmQujmJcMro9Wxn/KB3cjiYaI2Bpsaw1WDtYt7wL/3ou9uq20wmlnWb4yWTX002dKSfPR5fCqRq4LZ8tpJe/+6/DagYgw9i30Nw9E2ZDF5oN2w7rvfPb6xsnOYL6d9cQEe2N/vVbMBfGdGla6OT1OXzDGEDWp+zfYVf907cS9eB/XpdZAgNacVZRMEWoRYbkvcRGudjGNgTFNVBIXSxxF4TbOI1pR2KSMo2uLeJjqWIfvpAlAMEVWIjRTTArXUpAI69eHLrj68ZUtxR2XE6zYoQUJPM61jQ36ZUWdUaAEmsiqGGkYmpJBha/8dWzqiViNV10qzH+jwNS4fVWdwKyoMjBzCopUqlpHqh2YDhqHN8D3eYyjXmqqVV4PcyvqEMV7e1jS9vZwd2aAuUQKWBUv+sCB3icaQZXRDwNw8yBAoyGEskql6E89CBoD8MK2xxQPhkBOaAISQVjxbTRuyGxgZiBcAPkN3VWM683kBJ9yDaeShAobsOaJ9oKgbXjwZ4CBD+oMwOENcwk9QRQiyHNFeMYugc1qBUjXBxkh2bn19bjZtjYbt+fn2cPSLYjEFHiwJkZRQCfrRHboXnbzT7PPYiqrtHbLUEPThC6D1pYdB2TG46MpliVd2NZICdwLHvbcDx/2MvCMSDYeiaIojL9/uyM8DX12YcB9zMzq1t62ZpuWMDq5/73Xgvhc47tz8aapm2Z/GPr4wsO1b4Pp227bvRPwSyVncNK60r2GBZw3QIkRSDPMMBDlLwHaQnPRIzlKsXa1TqFDFt3q3Ssab6a4zx9CyAA29kqQGrwg/WMKnKadWyh5Gx80M5zGASYyvb/od6uwwT/fKzuElnGV/wpxo1nl3BZm7pu8JeMDKwMD7pr5Bf9Y71TLehMIBmvZJkj70+genZ4F2egrynrv7X9Bho3D3F3+vzg4Z6F7jaTWb2/fSlXB939/Pm3dKhDmB0B/4y4m8bNSUVt0XNPrxVzVxmZlIgAsWPi57vkNb0XxfQq0+ik7uPYheW8gB6ZVyjZYb+u6KOc9eNWLEVc/OmXao6UrijhrV2oLhkGvjfMDfsBc4jqZOZ5T3TghKaLQDvUqwua7fLLdKr5JB+ZQ5uPrb+CqD669yhcJEl5B45t4wc9Hqo3tQvzZfV0Z/7Zz+ip3wFWqoFehwbQE4z5psI+oTKWuBBJ91P7j+AsEhS+HMgYXQk7+QDVbjez2P77UF05Np7TNhlsQzCBHTCWCERtpgdtFprm5giRBuN4I8DDJun/AIE7g3onVu5Iloz0PmIr4kVAAvXkK4rmRH3iP5eQ/nWWzevHKtBwBVIDUpqAIgoK2NhK2WwsMFufflmK3u7LjGQ7AvWalapqks8FssBYcLcu7NsVq9uaUaH65og89YcUWffkTUasWXzD2Ya7X68stxpldbWei3JDku2+p2MXK5cQXYapQzjNyItm03ySXA6bblcVyOxeVi5XLzyjI6ny6wR7whi0TOOIS8PJvLDiVy49Wo16igaYUR1F6NA+ZgOtMBWLn5qr4RK4xyDjpdtjwBY8hyNskg3MZ5afwIwfzknIK2rz9ElO9FO+F4NsG7QAFLhLFJaL91gdV/rc+2wOa7Ydq6+tpx41YtAhHwkdXPNgx11gKJVRE7k7T6/Vbm+TPV5Ay+VhaWr1q2/cDK5dkBv8BHkD5FDfA7QjSIG6jBmNoxemKBX2gKe2K80RcjZ/rCvAv3ynA54qYSdKEeKLjXSuO69fT1nDghoVJZJ84l0slzYVh74kRfD9khHQAiFwQ4Dqlksg//mPNyEDUwli4myrfTvumJNgXxHoLbkODs4Zkcr6MB0jmzcDqenVjMCbFxpnfLM8+gH5+BaM/EdTi4Zd1EMAcSuDcSRHsgBHMmQooUeWaL0nRoDMkac8ikFKvhkAUnnjdWA1SMovyiDWwcs0ymqLiVjJcaLuMcLyEYxP7ClI+lLhs3quobCL+pGjXussseXge/qR6JA+NGVn8D1z0MLhtIKqUeXle+UqvWrixf9zAuwmlXll328GVlK7XcuMvoEwPpJq6fd9Thb11NtVDjqBmYe6AoYdtX2OEVBBOJODATnD2NgIBwjpGLElz1iBvwwuZxWmvWhJfOgbG42HeF+TOUVl0RpOoitkuJCI1mgIMMRdYFB/LkBqtKkaP3bhhlpZ8q+L6R52vHE9xU9DcCyyrAqT5xey0f4xvPyJUq+QSZTG6Td8rfV1gUnXK5zC6bIMvSqwXgky71g3qHHv/fPYEUleNiNrmMvjlikOcdWGAtkrPhURu8CvBAwXeN+IK1tz9xbeYewEVwX8fX8nwjyEtXxFe2fyUcZULKM8K1e9K30usHZe6PnyiNS0DalqEM5MuDAEt74AVbQCBOzIB5czBkZgMJCZfgiVGwOcHynCmSCPEBOBW4gXshupX95R4Qs3DnrK9rLt/1VQx9jD6OfbVra/XXs3a6QNPVly77cdmlV4Mm+Pbbb6OHmeRFGNwzQ14/Q48/ARqUR1vW7tu3tuWoEj17Yjx95vXNYfTnQaHQIJATpgTfdWn/0BmbgqGC1xCyw3AH9Sh1hMwOGc/VaVfuF8TBr+QHMkpNvl8r+ev5nlgJywjADtUMXgFdjO6CIrp+x6FA9BIpuoo8F4S1F01OPe8IQhi0w7P/TS2QTCG0EW1MIV20fdtjQAWqgfLQtvao7lyZoB0l7cET/TrwA7yLoiUXS90RtG/YYA+m/osq4CqVfA4EM+UqXUnLsNbyQKC8dVhLCRp7rsQofEl84X65XxoXwSBo75SlccD65yWeIBoRQV8mQRAlRMygH9qN7Q/B3qAtaEN4Qj7FWeC/CLytGMUz+T0Wru84gToCWQTsNxNielM4PyUsFZCi55pTtbC3L4nSiwJeJCgzSKTdnZOjSOcKz+wkFKeBI9pCTAjgBSqorwFmQASRnHCW/E8ggBbt6rkTVRxGux4H89YW3tmzC1wXnNccQN2fgeuD85iK4Nwg6sZlCtcKRQ6Dl0iZ6wPN83Hdz8B1AUH2bz2rlPxT8NtnpMoFr0QDURAu4uvSxWLqJi7Ae8bNEResZvGo14vWdwlaEPun/SXwgvMFFzCn1wCjLhE30XPXP7oe/wc/ruscv379+M51H9cOP3PPyIrcCYMnRMc7RsNGu4Sx+bhFbI25MTg4OrSq+eVVZ0bNr182p20MA6QeDjBjh89ZVjd35JlV1pwQo6EnNzCfNkw2hnJox8gVK0aOWr58VPqMfoa3jB3aODE1xew1aXBN4JDQVtsEgppPSxRas9uyczb6+6HFvqzC6GLQBKAUoAeXRAqz/EsOAfvsnYESO5TT8Ikhs2YNSTVr7CWkzWbg9XBvWk5L8CRwzxLcien4BLHBNyaADng4In7l6eT10H399akzY0DTcUw0t6Gnjx9HSxYybagNPEp+KSmi7Wf+efw4c1+fArXh8+XAI/bh8WcBex+bwpxgLp612qiZZKaCpKkFIkrkggUAz5BEAxjBrjGI43gxIiIuQBbAUJAWwDnTfh4I5oXg5MZPvqCexVEWT9oSEW1VUKnBxWgWsMpQ/KxPxQCWqdwNKjTFVot9F128En2p8/FKVqrP8ameHZw3ymyly7h7owGb+r5CNavzFYHlr7dJHalOtqK8FF0utWeD1vKwjA7CW2inBr3cYAHmArXLBZovi8gcgaJdkuPr0fuqLKlsco7GqFTLmx9r4hUyefBkQhMaB73WSMvjjbDVqffKctGR+J8NaqMcGFuNEWOuDoTq7ZwJjphl0I2DY3z23EkauU+f+v2rIYO8RSOFmCApDIOZ99dLeJ35gzLBvl+U4yTPs32wUz5MtxKfNfjrCVSeToB5jJ33IzSssEno0Rm48yAdPDGGQkSSnxIsNVgKYaLp3A8TecGSoCR5mlKwr2Oarrv9VLK9G1Ck0llM3dGUUI/ql8cLv75aulcEEmZqz/R63EHmvTOCripTm8RVcygV92cBb8GN57YRmC5Lj1qjIeHFzEraiiZB9P0EIDAB/8rnJc6IBfIbry1COo8pGBxO44KdS2cM4R2XdVw2B7as37h+GK3fLW/74h9ftMl3U2cVyiv+tWf0/etnlEPdLvlmsBIkwcrN8l1IoXgMrUelaP1jCoVut/wZyEAbZJ6R71bdYMjKy8syrI3gv116lbx13LhWuUq/C2ilc6fnVVfn7dIr5Zt37NgsV+JEjezWfftulZGCT7/xxtOkINGCE+xmhH3MgVKpGmoYNZKaTs2n1uDBeYFPOOq/PBNsSBHVLhIfmDYQ6047QAd7II0LksOIXgR4XTgh8UQPGxi7aCI9rGVOC/6PMvUz5ngsPgpJr7eUnBYk5yw+xuaJtcl/8LpwQq8PjF00MZUE56T38KyY1SvAUov8BrpLSKOp0xQpJyFH4hHvLMV+JSG4eoOEfRDo8RGYPAIfIBhBkY3JCiC4vxEmEKJkIbp0MvhCmAmkhSZKZDTSMVH6ld40FnnzrEGWSUA2ZD1jtNAyn94vY4Obtsx+qHtWzKIANMMMv6mg/cPFV3d2ztDDkUCBjpuc9L/YfCcc411fNH8xvXrUStTosfHogMbmcRlLT3R/VBqA5tDcKbubaiQ0oCsem7/h044wBKBLmvpR7jGxv3MGbXz2fjKHh9JrrZzS4xk8TLgqM8V7IB3EzJ+Eg3Q8oef1JEUGtDTxbxMUdQ/04LCHB/IuVL/+a6XeQO8vbhn+SJg59vHnINeHqrIRxcyZ2YDet45geC2YbfSxS+kuG6ZdZ4HDoETrQ7e88jyIA8cHJ9FBcC06kuLRYngTHUr1onFoLSyCCpAP7FqrzYBmi7IRmWg3oqEslAPzOIIfeuCLEwliwszSuG9yTEBwC8RHAR3lfaxgKULAHYyiijBnipqIure4iR93gwBm4ehoImoyRy/sxdyTV6lLaEZJK09vLFfUou8hSADNHTrb8iFbHwJs4MCcA3DPoPY1ewHYURSsDI1pMpmbF228FV5TnFdc0BTXgN5knenHB33vspqbky0lPwvdSYqP0BvYLpNnyRMrQSiuGj4RNY9vWuFEEG5IrYMbtfblk2cNMfuNriyP4jovWDljXqPVazR5gFV6Szx1qMvUTD9/RrgYK/RNS3/bEGuJXCpK1WJOfxyeCWZTi6nV1F7qKeoV6hPqFFAAK27TStAMxoE14GqyC51xzoGZwyDUJyRQb47rYcikh5ywpx4T9tVANOYzRo0VMEZ8SxujMXM0QRtzQawCGKOhSDQRLykE3lwciUX9Jf1CfX/E7GPEuRjH4umQ1+wNeYOCNAVPs8WRmKDaWmw2moycg/iL90kCUSLJ8nKi92N81ZJoxAmEk9EcJVBMGTa7BuA7B0mGOSHu/Ar76JgdJc8fFzZ5ibdkH74MeQXiSDtj5UXyQuRO0XN3wVcxpTNDokmRcN0Lb3pehXRmJo/z+siWD9kNMAibkwnCGCeIcDUYIu0U/AW+z5TkTbOfvWLEiCuOzLkpuWnylDvXTZywfv2EiZM2Tpm8KXnTnCMk79nZN8GZnI6jnQwrkbC0hGGlkKYJKIrwBwEe7GdMJl5vMul5cFcl2wS2mjB9w+tPm/1ms38r0Zkk5cieO2CgUAmC00dcTmuWRu22aFwuj8vpcR1wOnU24mjEoXm0UG22mg1Kk8fmKlRZ3FaDyupxejZKVSq+qMjlcBQaZzqDIZfHpNYbvdxM/yaz0uVyyqUymT7kcfJqvU5vNut5rdrg8Bx1uTR2ZyjkdKi3mJVOJykmXe90akpDIYdT3UY0hiGhSCFDM5DEhCckTz174ABi7h+Nm2o2aZbR80EVqBw5HR1D706fDvJA/pr56AX0wjxSYs5sXKLvOE3rDCqVQaNSoTJIy1lAWkHF5gUtVj1vGZvlFgNWv5WcnIARngKK7UOUbskz4IcYjfMsFoN26zC/fxj5NTZoDeHqsMHilUBGrlFY1BaDhwR1arPOorZypip7dra9KrI97M4K8SaNR5kVwvVbfIyDwRW1FhWwBC1Ki/bqzKVWZ7Kvblw92JBdmW2gyRcjLQKFpyB/5JtDQZcaMJ8MnApE//TCXCDHswHZ+aunxlLTqHl4JriMuoq6WfBySBBhBYffBiHAEkN4XcY5PJuWIcfPDSnBUaiIcS2MKkGWnFb0iWUGBdCpgRKynnP0QkDwRM8nyOhL/0BU0Lwit4v9QmoIyvzOSp2uyuGXfF3LG2pOjZwxfMqU5vxKV10dqM1OOI12o9PizS7Lq/QXBKS8w1RkzskbHK0FpkB2cU1NQW4wHG6ePas5h/mpbh96Ed2LDAhJPLZg3wPzds2btwvA6wZ3jh+8/e2nVixduuIpsLV9bkt16dQ6GfC0Jn6WJlpbE9zPiVb4U9Rje9/uVpXMXNI8CT0WjI4Hrf8K5xnkerXWaM8LJMK+bK1KojQZ7Hnh2qrs1kBdpKgh2GqYuWNm6kmoCY/bseGaoiB8kdx0nhSMOXEC3Scr7SxtLkOPXaNtKyxBj22B/jPK0ra2UuZ7fCTkuL7/20FMkasxH+rA9HgQc6PDqQnUUepveAZngQz4QQ2YRlF8NAQSZDLG81rAHDOXkOk3EhBPQDyx0RBx6s75QkZfyMf5eLzKRc0JYFAz3iCeEEMcJvTNCVzN6NNFjeLF+o24dHhhNAtzPSb7ExGyF+OC8UyizmcMkf/CVEjWXiHG9fO4Qgb+eYz4c5MfJ9gg4bq4p5GFQsRJT5CHNkg4F3BiDp90DfIoEUFEJ6SVxAtoIdFMdoUGPCZBcBM7MEG8KxBRpY1Ehm+Ku0DCKMnkSQR5RDrPBWhdpjm8sRKc6g2qGQH7IiG0TmzF+HxY19x05/btoGr6s+FRI7OBJ6djRC76jBzB6+Pz+kz1k8smb7ZutTZd2nXJvNGtcI9C57CELNmyde0jz1KAae94ayH64PjxPTfeyL4r9q1F1oT1PX6xATrlcmA212aPlllLrX/3PnHIeth8alD4oKU4dU1u7sume9vEbrgy6nokYUYvukvfMTd+Fo+gO8HYRMkxY4X7QamUgboy9z2VqXyLyaqvs3gH1d1cVI4+txptujqAmVazvqn2pmLMl/z1r7tvvBF9WQ9/mrVunddbHPGWhDeu8PuKi31fWWovu8xjDeQGrLHwhuX+8uE3Tly92Xa5ddiGLTVcjsat1EnsfufEqQunL6HHLEhdPnx4cSLedsnxSs+gsLMKfOusDC4oRN+8i/8qK4EGnQXgqadS7xpcBhUHwYTOTqAZP76vFGjKcL3UO58khg9PwANVVQUFhYXTgXqMWakEsKqqvByszsN/Jvw3dWpe3mNgKymZ6jSl/8rL0eUVFeNVs6Yz0rEWyxlzWCbzOuP5HuN0oHGBeyw47nHFZD6NSc5NAxrgTF2K71qK7wrvRd8ATerSMeVWrZwL+kM5ZVatDEgC6pm+cqtKCVhFwEUSDYwE1qNvX3+9snLLVRV4dpXrnHww/Cf8NakjR8j4VPSPTwXmunx4XI6kLqG2UPuoB6nD1B/S3qjS+0S4S/s4whEQxIeB6QLoCEdLCOYI0WcTpGQsHxeSB1hv4zMuQQnFNSAkQJWQ3msWMxLgN1/JINbgYyVCeU6AO0kQ03DxAU0XzsPw02jA6YsEHAFah5lVHVToTTYLmBL1O/0k9fQ9rdU9PKwDUkmLAeqBUq810WOmgVg2SVHT9sYhMweVOyr1jGoQD56Xsq0Kbl4eqxvGSkP5oEOFo9RZsK61ep9BuEiHkvnlRWyDyEXwekAu8oGqWSEUrefhqaFsDp5JoIIP+7kl59HVywPFWY5A1LMyxwXmKxjjvf6IEN9eEePRHImcv0Qqp+HUvwFWIveEFwytaLIYlDItMMpl8r27tDIWLtnMdEtVctBdmq6iuvSXVYCW0YKDQK1AXZCV8YD3mfDtzOCj85ZissfSvxZrqAg1BK/EE6gF1KXU1dQt4jqMF1RC/bK+uLAKC+tuetnl0ojchJYNCstuIg4SvpiGjqbNKEWFLlZYgPHkq4sSXEleWMEFK9dQGk0ycY6BFzIk6foC+RsMRX+BySmpMvIes97pKANPXCKJRE99Ud/ozwqW1+sbOloLiuoaQu4iZ4dbP6RrRFEUM1tdG/QFuuq84NCswixlDrhSo8oqlMs37bKVagt37YKX5IcH18akm3f5s0ZGq1BeQX1BQT39cFFkcteimsS8mRXassG5BjP7MzyfS1o1KOCTnXCNmfZpRZ1VZVLbPN1ZwVBTeZ1Fbda6rfrF2YFs4Fu01bhEOvt/RvldiuVc5CXr1XSWqxRlg4gbPQT+8uHqspLSwtQa625FaR14kdy5EH2+uKZ285JkZSI8283zhWr4yHkfjqbUmCf+VkIJ45wgK+nNpIHIfnCIjZQIY5msMsBEYEoIGluc+KeqZogbiczmE168zETVXmKq+rKlBNXuencnAJRWWzE6azYTlQL5zw/L7dJROPA0H+kYVxX67DlpaXupdO1zMXAHzoEH0d5XS1rm7do576Gs0RVa7dDZklq5XXbqPimUd+ECt2d5cybecN+3V+8BrIM3EP16A6/fMAnMxwVEe7Zz72HCdEQb2RXqf/ioDKRdM2pB/9slPEE6oSfWA7/6Yoz4KkNTP9ELcx7fMummziKmN/OiO+EPB6oWVYGGUb/6og+nXw58Dn8et6xm2oIoSqJa8cU3PAO0U9Fe5p6u3/ri/RjHbLJfzpUgmkPEd6EwhepEtKdfiwMPHhoeCcdm/AqKA8SX8QGREPdx3MTTC6QuDtM0MJw6AXqLODl6Qc7Ri/TqLtGBgyBwBLEmTVsY1IbbNE0gptb3QEGUkxKq/ocw/e9lMghlO3G4r2XkqmUj6aeE29wdKCkJ3K0fgGWcJ2g6El0EAjlEiZAutJNQTt6qgdpSGbEa/E86KMy6oUtXlb2JvgTa170jZ3eUapdrNw255pEntzdeI5OskMj7fk1HBRxdGGnLxePmrdeBVmbPHpK/UKttyi1+csful4pymjiZjM79NS2WgXJ4NfHTKrwD2cQQbPNZomziFWe29NavXkCbrBE9cZpNeMUkZXGn1lIer/CuZI4kcBoCnuIAuHKKfmP+5JrV06rmT+3qGQ1LmtdcM0zCc1MKHWzJvsm3P7L5b1vGXhGECiBjl7NSFq5krVmO8nH1RWg/ej+jCX/yEYVNmi0FUD7rzBbBj5/gnw+MA/fAUwtWVy04MLV79ZZXdIsOTotCEPNE6sf97sFbgfyWwbV8qUSpYBWpmy2WkA3IQlXL2zD1PzHTRNfJoKJYqVTJRnaSS4JS4Di6Go3r19sS9vV8ZE+PMmmJTZBBA4g8n3gEYUM88YqZFtoTfxcyEAJGSeORyR/Pkcv/KLfJ56buCsReP0vVJgNwwlwxbc5Hk/pegrW9qV4JdQT9NOmjOTjxj3KhbLIWUK/HhLJC2pyPJ5+uFcr2pvXIkCCHzE776OCozF47iAuOG0yUj2jaEl3kRDUjGd40Nx8d2jJ11brHJ8J1FX1Ph7aOBAz64S9rnltazjWWVmuy1da65llzJNSkpppxqavXTDi8PjkKNsTP/NiywDT4T+j7SXe8sZyNhLyB+kkVfs158tD8fjQ9AaE6ImBoihCXMCrEIOk2gpfKNGCxC/JGooMpYsdyAoTXxSOEkyGaZp7+fyJHI2pTMbFzMsVfRjjqFJXfHnS4cn2WsMnk9LcX5Lf7XUZzyOLLdTmC7Z1ipleI5KfL5Be0+50mU5iU+WUVIRfX6W6vJX4RxH+17d1nqCGlsWG8w+vgg53wP0aSRKjjsFvsJrWWt9ocTquV16pNOMEhpAohUNsr5jpsYu4FBW1Wu6m3vRv0otrMr5vWto4cFnPmWbLc5cEbW/5jRBzzgryKJXS4x0i8QGC2Hf+k1M8Ung4AdSoJemEtDp5OMlRfEuK+l+rt943SK6yDWrwSUpj8F7w+4VktynuIHxD8/Rk9TUHvPPTJre+I8807z9DsygX7U9Q7eN6Bl6c+XLAyMwulqFvRJ/PgHTSFJ7jzns2deTayZJCRRoZbSBhhxPiOLBnC83JUSLsqtRUPlE9RVy8cSgLg7VVanRE8ptaL73ACtRp1QqlMIbFMSJ/2t8RRzFiqi1CSBLeYEXWGJSHiXbkftES0EcFrFxTVoYkTFBFtWyKAuhIpp88FzVwwJBCSrFIud5X4A2DQsZ0Vc9taImWuYkVWxbiVHV0PzvrTrY+MKLWP0jjBJnT2hh+uGHv9K3PHXjd7bHlFTrmt68oRS4M1HWPHNZcq6IcWtY0uAkqTi9lgc5ibi5voWonPmW1XySd8s+P3gfiU9vXDL3eMmDsuvOjRrp6vptTE9nj9YM9tAOyY+9ruicHqaTMuX7oj/urU9pzKLLc5v2Juk1Z3yX6GNuco7Pns9GIjMNaftxaMFWT2RPcwVJLZvvKZMCkdEvFIDALyLV74TILiKkvayGwU5/5EP3SxMMy56EVw6vd85vOHZQws9sd1wMBPCsk9g6Lta6F26gxnOGIHIyumNpnLQoOGJ0fOfGIezUx6cOHTkwyKypwl45fu2T+n+9ICqc+U7U+UtuTM3zPnPD8GJx+ol6sCDqhSQH+hRuMfHJc7DUvbOW3XOKdU48i2seVN1xXunLViSHH3UzPAgicWX2K3LGwf8uCyuffMX2GcUj6hrDFkvxp+cr7BA52W8YoYolHqfM+7frKx7yEqTJwHR/VaPIMRQwct7iUePA0yybQeq3iiBa1YtG7F1VevABvnPHvVO2RtS1GZVY4mIWg5VyFz6kTfozfQ950jrgJ3X0AfDLAnpAS0fMoCxLvD9NMApl+tH7O+/feZ1X9v5tHz7ghQ+tIZGuL68x5GmP+Jigc+EetLC6aOCCoX/s41RBQi0ZKxEYpiMh94OJOZEUD5ie2B4A+O0A1ZeArJIj7KEiGyjJJ+g1MIzya4p43i0R9KhwjgWjQCT6OXwz7Lkbohm48c2bz04Tuf1peBxSALZU2fa2TZI5srqx7UyE0ao0//4KQjQAoq0Sm0HZ0a3lSH9uk9L5n77jmMTgHu8JKZVwqqlSAJHhv9oagY6TEAxYSZh0GyKeuM+wj6+cj1X42uuREkN8/e+SKQHrGgPnOJWuEEzJSNm48A4br4SlMfqJmGcm373wccWAK4xJPBkmCSiOYdqDtvoF01J/ScXIKnR10gT+YzYFS0RJAFw/P8CPsuxLfSlRDJJzEXM/MZ+TAjym6dQ1ifuW+e2ccOYYMuJugK/tNhSCUNDocBJg3gICmcovAhaZ0tewTYwRhgf0Q21wwUA+S/UAmSZqfTjJKuggJ4SdjhCDtSE1J3JWPDhsWS4hFO6F4EXm5bXlm5vA2VzxLWhStw3/sZrwsFBFuAEoe88O0wDy3iWEU9BAVKMCPwiIIsj4khkjFAGANRiRL3gZA4f1QAgeD0E+ggPJewT0b8qXp/JOKHz/mB1NyXQ8L0NePQew88go49ZKb/TBL6Lh0HQg9s/vbBOWBpxL9Jt+l99NbdP6L5058luZtxHBTf8wPYOf2IPwL/3hSNNkXHjBkV8fkj197zEHr3kUx49kPfgM2+yOjRd6O3PtgE5McjfiEGij/YhH48HiF2FYqzFPND+tvacf9fJmCK02Z9DPOGgq10AX41gqVkJhB7ElpwTk2EV2RdkdBpkVZcV0KsUfziRoWLSUQE+CQRkhyPEyNODoYkvrTrNUzkmdILj7Bdcc5QWFQV503malYQG9JESRyKaP6QPrxk2V3BMnSNiw54lTk+9OY+XZamctWwIt4wfPZmr9qcpQqW1TsN0dusFadu/fste/B3KkV/WBpQKnMbx47rcGo5i1bDOBqrsmrHB2jmSpnUA0fEO+71lEhbS5XOh5y58SWjJztWVzmz7+xo2/S8BEoKshuqhwcGd+yrGh5UT76vb8+i7p3vMZejp4zghYbSvu52aY4Vchy9ZRoaL2fBlPd9fT/4D1xjU1vastqn1cbRrdk11++/714Ac4ta9MUxBevyljh4hoE873fYTJaCKwa5l7qUSig/Cjl1bOjeEV5PrXKOTun9cHxi5lpbs6t6tQYcnds+M/WMTqJdf8n1M4dMG7oANWmqJ0+q3YX6nrskpwyozvn7I+ufjYoLOPEUiA5czHzp1Y8sdIH/mBMPkM0nGAp6sgiIvPAFiX8PE+PJImDv1YDHNCyte0t978Y7Dj99zY33qF5nq6JlNXJbPDQF/vmo+p5M+htMdYSkx0LFCbDQnS/ROOCY1K2pa0ezVp0k3+XKl+jNkjywFfBw2ljWomMLXL0/U1B72+P/evX5zx/sqW1ataxoSIP/6gsTWp5469UqqVIPa2oYjUpa+co7b79SJVWrWU9WHaNWyypfpl8/TaatzLrCduF2cVIVosZjGiA9OMCjozDSBY/DapBZ7DOeHeOZCH1C8C3Z042+FgKYYX9768ktILnl5FZUROI4EWi7e4QAfR3SCmW+7u45kyQhFrPlW07+H+a+O7CJI/t/Z4tWvRdblmXJsiRXuciSbINl2ZhibMCYZrrppptOgIDoJEBCT4BAuBBSCCnkm94wuUtCChzJQQ4Skji5NO6SXL65Sw5safjNzEq2bLjcfe/7/eMH1u7s7OzszOzMmzdv3vs8UBPZgZ7SKhlhEU8daWFaBBsQNsEGJEg0eKie2rGcgFXNxwGrUVz81q2unL5ORR1yL4a33oOjSUtJeifJYkGHFGis9G2stliq1/kqDQHEuk9Ishj8RkvSJMTdBwz0oCofvOarQsG0ilO+qtVbmjrON23Z0sQWNG2hn1uIc8EH2O6rLC6u9LUbjV/juK87z0sP+CorfXC6wfBMdiV9qOvpLYm+C2k0NWMtQnsKsOP/kpvsCnfDj3ZfBQH4ChwAXwEBsIaedXxpJLT0+PGlTOvS4+B12h25B3H/FCijH+qKP467g6kTj3EQNYKaRDVTc6mF1HK0CtxA3UHtpPZR91FHqAeph6nj1JPUC9TL1GvUaepdAeuYIRahTGwX1C7CP4GuMcTglhHQD3TFOEoXo212P/4JsBM6gqOLjqhiDoDuABJrCmjcIh7YTU6UJ4YB5R0BBphAQGcHfs6LVjgmI2MPABXw+nijRo8fMmkCGhPIB7wm4BY5HZzJIKGdbg3He4FJl0+jXsO43BLax+gcOsBXAOKOTgZMfjFl1p9lkvWnGXtSshq2aIo1cIHGbEpnT+uTmXP65BT9WyD9fTbdZNaCbWq/GtylxXd/b7LxL+uSI26wER6/Gx4HzdrsyFhAn1e98rJCTT8CV71GZ8Nv1bn0k4ANaazGCLxUAZapK+EIMEgcaeHASLiVRaNkVwi+e+j00UdYIH7Muh9kffYZe/aUiFmmju6+CP+IvmdmdOUW8HX2COD8YQMDjOJLnBjWAn+k9Sj6x5YXrMv8Pc08tnYQR681pLHwPolEj05PisWmdK1er7cnieVgCJuml0jAVC5Nj9KARsCCDBWYLRUn2Q3onz1JJIcHgN2oUMJX2LTIWTAZHlYzFlYi5eC9orfA2NfFNGg9c0bdMVzEVQ+ZCaTwbAjusAA/fJRVodQnRRxYXgUqH/rk1ZNixgdooFacBAoZfPsQKPvuUzG8NvBtWt72eQ58A54GXtV2+OUnuWBLB40awoDaCywHLCyEL4JfPoNfR+6AX4GUP/2pH5gpZdFnzoze18AI8hKC/48x7yjS/TsHA/rWCYp4z6+nvwZNz6+P/H398+z5p0IeaPGEKvOYxvWnwPT2qg2vvbYh4xnwKMYwh3pPH4HerEfj7XZKSjx7Y3kMSzGYcUF8C4fYXnSBFplASzmFCw4jxfmpgIg3Mg/B38L0ZfqzoOl8A5g6vj9cGX1j/vhgC+2HRxfRGjAlUwmvwNCyGczvTz+x+eBcMPA9Q30lN+s2mApPjx51Hkw6e2flmAXR03DlgDFgHV3W0RtMpfVLx81YDoPwY6W+qHK46SyonXfvhidjtEFMsf8gur+YkusELz9khyQH6PyIzfZ7bVi5k4nHM3ihixgZwTkdT7xDmfwmftrB9avPnP5iz54vTp8Jr+IOtgH66oEDVwEN/3vtuUOrHnujbd++tjceWzXztqfGvHPixE+BP+y599Onjixc9f6S94+deIdd3iEuHbtnz9hS9tqaWbM6HiqtZKKDt28fHGFych1z5qQzW9l7DlZFhnmLps/mBD76GJqbx3baW4z7n8uhb7ruAlhNQFohtMUKuCtW/Xi9lRzgl1b9NBxGB3jl1mFuy3cPdWQ89N3qmdLfLJg+OA9kv7o3slu5+cQx+hOD1WqIOnBCWoeP0e/xETyOj3AYCc8i4X3o+NBD33330OI3itLdC37T5/k/747srSqxf0xhbUnqRlAk2M4IftoMxFObnfhqy6MKKR9VSpVTlVRfqgbR5aGIMo+mxiPqPIOaTc2nFlHLqJWIQm9EFHo7otF7qf3UMeoiGhFY9OMkR5/dgK3XTD1/AROf+MMuiRJ/AOOC/coP3/caAv/krgnrsxj4W/yccQ6LgN9Yab+gjubo1KcDIpeA9W80eQMeERZei6jItaiYu6/9DL2XPtp+Zqgz/q9CNVOVhn5Wcm5WDZmpmrkc/W6LnSOVC4F+ETAsAvqF5C8W7njBueiBnvE/Dl7UmbEzumXtCy+sXff88/Cyu3d1b3fLJDOT1mdiaqDEEagfEsjKNKTXqBA3niGxKs1GeWrAZxdR7TvgE6ChkjkcmQw/4jLffht+uGjRnoS/u9Pz7cp0Tzr+Keye9HSPPX+CJ92Df+Pz0z3s+xk9/sETQxZ1j1k0JKNbnujP8fw6obTg9owsCQd0hkJvRbbUmJvmyeeBTG9IEhlNZUDFyBgRLTXlxf0LLELjbzvBe8jusYa9lXFezI0sZjQm3t92+HAbAw+33X9/G2iryLt2Ka+iIg88mRuifwrlgifzKsAWfO8wTtiy4DBb0v5KbkVFLleNj7/5DTrG+NBMRL8uo/MYRL24ONwR37U/TwTNGB+QFbxEUIkQSTHNAZ+wyRFXZRce8HP7ALvng48OjziwYmHzjIXL7x124Lfn7596aQRns4iVht7T4M9rNn6+GaScW37x8M6Nm46Nmb5x7UTrDI0+TfPH+8tmlxeJVYbkXk9NOAXZUubF997Ydej9wLjlGzYuHxd4fv+hl2rL2VSdQZnka5yz+MNNZ4F61NaHH9k6auW0iWGnVa8drL//vDPXaVDpUvrUdLzmTFXFeFnsfxzbEuRgjCiiwkB8UqYCoirWCxCQEYxFEseyZ2NnHfGwQPwKoI8QJ3VBEGDiMhUri5fhLHbQi6UWxIcvCUS/FnTIBVXyd23JHd8Bnkti7sVJIpTZZXTSJ98TxCbqZJWM5QF70uxiuueCA9FExXKmFVJJTmYFn5okVRdgjD6z0lvNMgEUVGjTjE7e1YVrj+st6OMPE3qbKq5GTzygmYCxKAD+0zpzlKtYtxu9djdiMnWAwtDYVHT3f1xr3S7gxDfgx7t0KOcblA7nl/4/r7vgR0Pg37E3TjmxNUO3dBLG7tbZJbTdaWcIQ+8UtsyJZw+MU2AvukDPhxfAVTA+2u+O92A7bGOiKObVyOv08ffgD/R8MAa2wXYwGoSVtDoS0pZpIyE1rQRhrZ0N2xkqOoPeH4kwLPG3EfmG3k8CIDwdUtp8TYTS61lKk6+lKWzHiSrJf4/mohrqHsTpUxwWy/NuAkX964eAYBz7Tw/OxEQaBm+ba7zYzagBQ4BiHw2Mpiv1v3olMDg5H55meB36yOExw4Zp/dphw1D4nx5wol+7P6w9LyFV6AON3noyLGwDhU9a9ZoPdIk5/errQAhgEx+I+ouQoe6f/X7l7m34bkODTtcQAk5QZi6XloEcbBwOL5ZJy83wTfixFt1s+NVMWLMAuRkff1zcl0sfailF2XWoJXUqAGJOINNjviAFN44S7IAaa2cxKCAWDKM7xx4TcHsxoRWILcZnMRYRkBZAgFyNvJe3MnSoqQk3RLgJUDQtHdlvEm/hJ/UbKSV6ujL0x8g5hUyjNSkyPDqpQiaXKaQ6T4bCpNXIFJyckZFU4IFdt0X23bZLkuoZ6hvzoZF+/QNN3wxbrnVO7znWXFtGX80Hr/MpHzZUjM5Wg9ZwCJtIhcJ0EUuLdTStE9OsVsLwPGsXm8V6Xs6yyY705OR0RzLLynk9irSzPM9IIkdvu/PO28oX3jFvkvlKKCTXZ5aUZgd3ZDuDQWf2jmB2aUnm0CGf29ccuTu2bxBFtKwOcawt2NpFSWOrChfZKCEiUEeC4NvVaRNupU12L5aEBsheO+7uMaEEYtVNeK8JcbABu+AinYjbM4H/Jhm7OCqvXvfyjN98r5YPGdK/aZ4z5QbVt1MMXleXtPJZYg4WHrxpSm4qTS0a8anVxbGupKhd32+hLmUavvlfi9bvuPudaxcWPWWCbzr0Ws3u/NwNr7zChYH4le4yd/D3Gae21PGyL4/Mf6v/7Pov16W445LxlLx5iNSlFKUaw3lWk9Uyc6EOvdbsOlGRYr4c7dg5P82WhlZ0WPD+Sk9xe8y/ERfm2hCPOwTPhHa9krZ5aAFPw4iVbJQsb2WxJ3QCrEFMGBlBVpWocdKplxebMbjwyrM/w/afz66sWry8vzmX5dLMZU2lmSrAFExed+rCqXWTCxigyixtKjOncWyuuf/yxVUw7DKHBBMn1Hq1PhD21TYRX1cV08rT0sqnVRQO8TvkKCuUoTQlyaRmZWkOq15vzUiTs8okU4oU5YTykzv8Q5ghEDsUCwv7Efjnq60Fjwg+sehOnzUpRHvKjiH8BAxLtx19/xQg+HLRmYwAzXkYmFbEy9CMgHcT7DGMSNoUZLAAnQKFnJRloju1xdroDk4NFhgdXL/XROlGQ7poV4mWds+Ad88XO3R5srW/Ezly07nFcPQM2BZcO78+I6N+/tpgG6QpkYRho49otfQYWptiAMnRaXqzWQ++anGAEzsPfqLR01wWbKCf0JtTDLDg4M4r13JqQhkZoZqca5iHo29QbJiLENsaCugpXuON9+pOQV0n3q7GA2jifZbVZuC9IvRjw/Dy5bYu0BghuO9va+WyrZ9vPA6yn4hQQo/Dez9M6yfwRdSXEpIK6kSs+gmgPbjp610q3S74Z62wm4OfStwHxbaA3X1CEm/IdLqHJhvAJhADwvEKZaPUXKvZRV4A160ee/Diny8eHItOS969D6yGHURYOSNeNHidQ18bCmpLIrj2vneXCKnxQ6vBapJNe7irLp26KCymzeWC7ZzWgJrQ8CtN6HNRROMMURyssmPFVINQEl4ULzQT3HkSNaqA2EBeKwQvw8sndx6rEOk0fQ3i3NbvWnPFqeUanagi+mBXJdjfDYB/eRi38oaER0lwQxLo/8nDwDCg6aQ6RT9r3bpZ+hT1yY4rCVUi/YHMNVXUQLznHFN4j1cDg8f9i/rhLuKnMBFw4vEdrxRLYZv5+NdYfav67f3bOrka2N5deqWRurFJqY1uTvg2qLOgr0O6zKYbh9++dQVRJ9IcfA/kmJRV/aBW2dGU+LXoTtvS2Rg95D+pG/52ATffCTls6CZtj08GgU6kYr+teyNw/7oR0EdeXTxNYpYWSIFk5nxyBxEhG765cdbI2I0xpYfBrsP/YSvhbvD2Yd98KRDnilOki1o2kT4fL9fsCbEbU0pWr76pFbHsh8b6TlyUKqGCVC3VQHZmjLToVqTD/k+ICO4haNY0UmiSdIvUTBFhSFxk4gUaLJPTgCIURvMnYUtEUL72b/sSKAakepAbDXCfPffYY+fOAndkN2JdWhfNOHBgxiIys9LX71i27A469CKuxYvkBvPXg/CHJ9TdSNHNBOkcyNMZFi0y6OAfou+sB3PWr4d74C+lx75oe7hUaHLEkLOqIUNUMAJitKH04bYvjpVivg3cEPG4v/Wj6qkJ1Jxb9TnEPosoXpTh9jABYep0duphdu+cptiAAsWEUTEFgVNvNKFWowJ4twvRRQobFpJObAWibj2trsKYBn96/gN4tM+S87vrxZI7v9i89OPRpP8kpuuV/twuEgkp9oGP0F8k/OkxBijf9X2yGTUk04oaEEXAn1AE25TY1yb+EH4ORsypH50SzTj66bLNf96rEsZgKDHVwImSRSgOHtG7ktsfJodHIqZU6wegwrl8F7we4REXhGIsaR/A0ygGtaEotq8xELXhOKr5V9oQ9Zl/izARdyNCU5K+R1i9gEuNe19nn1OjLhfu0YQ2+I9nP3tpydabxuzB67ebkoHipbaXdj3xdmxUUmEMFYCqs2TagQPTlrzIlAqdj1x2H6eo7Z6BkZT0VYNVNw9WzYsg/YGXgSo1fdUkMhq/iXVDMB93v9KHQevDpZHOrgdDD5d20x3qRZDjE+dMvlNZku8+ewa6NCb/6Tx6cZtE4kFEaNvg7vPp4BNC/ImLvz6vfrhNakYJJduHdJ9fB58Q4k9c/CfzLH2DJfNsKfHnaKQMepol27paf8DX9ZF5AdRJqEa8nl3dgo7Vhw5fBu4n4IfHN36+VYYpC9n8PDJOKMQ7aC34jlCfccKN6121YVYlwRc/eRj+eZdOtevrTQeB9gm18NmOjROeeVune1vIaNwxcqMj3H0eQis6PsyujteFoKALpU4glyIK6/EJ3JbR5PXFN0HtcTCq+Lfh5+p08CNJiiRPKn0RfhSj8f+kjMD1olSahxJ3hLqqRM9FFYYfCTdeFKggmoeeANmd7SNEvii8JfL9TfMq+TZYPiTwkJ1AcBReESCWt5MNwOwiKQl6QezjR/mE1iUMYvQ+ohM+Fc9UsV4SfbfHOxGBDbMYTxiDt8eYUaqzO2MtLwpe7+QjN3f1VnQCCXMm7euKRycqAYctI8HXpsYbwIqv3gBRJcQAw15NJ3DcY46iIge87S3rV/nVKyoWbTl65kzUjuO4cJGj/bijiB727Z6SEvB7yZFdj30bfRzdGOkoomLv4jB9q8M7YXhdwBqJ09F0l1spwsZR6KXaQJfYXdAFZ4nQlACbC7u2HqZ2w4k3ZxwG6uOuhqUnZlRvSpVmyKzG7CKnUqLKGcPbmuvLqxvHhAITKgpTFB8/dQb+PTk12WqkVd4hOUbmsTmn7mou3giPNL1wfO2gUIl7d86UnIaaIk56KG3cV2CMtbJ52K6hwar2YMWwopHNS2bmP34aRt/KbSjIkVjGMKqG2XPjcukVqO02ofVEECOWUAIyCdE9J+vsgOCOzEi0EQGpEMEZQhFMIs4tHzBq4zBkGO9OR5SPmPfMj3K0Rj0vv3Tj5B11AwDTP8kiSuJ1KrG4qC+XXl0yUS5Vtay5+sjUqY9chei0fMhPhxFZB6Z3li9/B17d/9vjcOKWOcvfoYsaJZzUnuP2BfN2tcweJR7bx8goDPotvKFGyotrQr4CHg6JZYJOa949dnVQMzcdZwLPwavvLJ+wCex9+g/7Uc7Er0sMf0zAC9IRGbEbtQJasQTsPrsG/TpNlRLC2k6cEeKPhvywii+FfyJbSX1JSX17UsKF8HfvdQrrUuNfmIDS3EtusLZ4iBZSRm1YYEhTXcfOfUeCxZ2DLWcom0uNcQVBjIVN0CKJzwP2uPYIcXBuiAuTOG98BYM1UrFnvInw9c8xOj8dAk0KnU4Bj+gUrQodPIIvQBO5iNrqigFVPQOLhniDzd9vWrlOP+Sep+8ZotdtGPFZcR0djgH8w/tvflrIN9paXPdD0Z23+aYtmTqxT6amHP3TNNUVx3Wi+X+Q+nmpkQn1wz1RBQS0DAEL0FdcQYYYRlslMh18xP2V4wncQmJFjTZSTcJ8ddXz2esSyRaJQim5fl2iVKAgDvSIiRqedTqHGUzdKnwADDyg11lSLWZnZ32jn/3zTLpinnX6fc5hTFflV6zQiFI8dr8zQS9WS5kJ/SKskqCnH/uE8a5n79SkAZQIze83qHZEyIkQiA7tu7Bv3wVu5Of3R0PoEiOhhQAm8wTrDBrx3X2h+z9H4XAXli6mY0ai5cJ4DXbebrBLcF93231ehqi86NCs1toKfwiAGjgNHkT/p4GaAPyhtRVQoA9YAfpAas4lEQVDreHWSCuDT6A1iqqFpqs4fRHeg+caB6bPLKbPiKchn6pCcKud4dXGPYuierOY7+945qJabexoM6rVF5/pQHzZj8SJE8oZ0fqXN0bC617g3lFlZqre4V5Yx4Q3vtzeSnw2gfMY3qkTby7h3TmCNOLW76cT3k/9y7J8IfgsDUXbGCi4NA1hK5RbFktw9Qpex8WKJl7E92ruRHRoKfG/YiGYNxoyQcTceWMhidEkobEPebcTz9USIETSI5KTNGqYb0jV65RWcIMJ0cbon9nZlgIT7E8nR2/kwhWgWuNQyulUlh3bMTvZIb4qzTexi/UW1Q2KmRE5DKR0v44vktMUV5ivmMip/vQKWmURwZ/obvjqqp746nZNT0z1dqoHkjp7XNB+S6KG3xCJH+RuxOyU86lqahAVASKgBSnAieh8b9AfDAUTwBywDPwXeB1cBlcBpBXo82GkNBfBSTNyWNqNPTG7XaIACROnZiIhDVZA8BuBN52Pgeu4Y9uZxS601OKDtBUAI+KdjUKOrJM4qcbY7XhxETsWC+tbky827eG9UMTG4akuCLD4x10c8MSeQ6s+vZUxYYAlF08wljysOwPDLAW8QQZbd5kEkSrgDVgLFZUYJ/IGgZXcISioDj3vF95pwKh9qIAmP9DjI64ZXiQJXhTRMindbTQVodpzRNHCRXxnmVDDFGK7MvxEAHEePpGJtJMVy24DLirmP8FXzLh4n8goxLs49HP7RA7BjYlTRDxco/QiHhWANfkzUHMUB0E5MJA3E8xAt1LsELmVDEbDcQsxeMFvZPwYWdClBCbh8xCFXfwUYhKMBG7KgcpkYomLehF5xmEocuJq8X6fAJeHfUCirDi/gA2rF4oJPhW7tMk+GjSkoEoV8y5dsg/Qw1KMxlLFqPS8gZsLMvPbFypGCkEP/TbIcqSk+13FFq5lSH1LS9uUv61KmX/70qH0T2IdD8aG/QWNxujQ6O9MowpHvgxoTicWJStTeInMkmpVmCwOs1Yv432NMolENZhOd1k4hUfJ0NIsqUplqgbBBRabQaweaCpjGJrluZTCgqLMFfnl03feoc8utgfl9DDgm9x7RAbgeJamAVNmqtGiicMyv3f/JKVGli0BrDpXwVlc6fQQpUQsb/RJeaDXmh0Wk9JuTpFJxRaFCf4sabCyKRa9bbAjWdHHquCYEq9qoFWZLTMY1dbrr1kbJHadJSUztVqR7HCqvAFW8pKyly4jz2NOZi6LNQyj0GTmgiTY9u1DD337kH/mLMBLU9emSVgO/iRmWPoCzYpEsvRN8F51VqlKyzBSru/rjHMDMD10AhgO2hlAa6pU5hJvGsfyUlok4eVitVjHzipl5Va1RcT8VxLtz8+VizWSslQwlNFUu7Nua+Qc6/zekQoT+9s3Jh+bJDLRaRJ5rlQHaEY3gtbT0+ATdfVicWXo/HkA2CNsklIHGJUqWylJo9Xy9/7rTbqJa1ye7eqrYaQjvf51W9VOXpKsM1ZxrNeQEG5MqZQoHHbPXI4bkZ4QZqtU4rwUR1GOSTdw5sw9Mz+am9end40oc277FVmaSVOyoB9N52cnJ2cV0MzBYUZtmkwqMaamSqRKvTJVLLegT6aqoaV9fa6coF3jlCZrOS3DAg7IRJmMiKXtaRktJat9alMqMKuTlIyS9lhYrafMV6MQqxRiJbMa/mP4nVIdo0xSKZWWJE3x6tIWh81OS+ksTo7y4RiUY5LYpbFVZGb5+knowiQV6kQWucSi1iokUovVIGaeTE22TXWuTNWxS7M3lilsSmVomlolBYtWMdWbCqfaklO1rC515dY0ZdnGbJFKPbVSU7lqPovacvRsxu3artPyYv363jS9/tjiJceOLVkMXagjpixFg0rGDOjzEtvYiJpdP7yBU9Fnei1LFou06j2p9DqTYvubgcLX9ysMNINBfGgejMlGQ1KsKOTEIg67tgQSvUYnY2igKa2QiD0KRWoGapboBqW6/1KZ3Dfb76un6d5XKkoWlBdvmcRKgIjW6kwyhWxYn/SzBsPuQoeRYQyW3mGQ769y2cGgOtR/kvRalmPFr03otc0/2yeXLeunVhai4tcLPEMfCeBeJZx5L+LPu5uWArCidvUWWVlMkvgg50GnDA/P/b1py6RJW6KLJm1patoSHVM6e/Mdvz0L3KD00tY/3DMpj8nuP2fVoBenpU4c39TPJR9yAJ58BF658uq6RdXV9vwc/NAk8ugkrrD36FpvpknJSU22/JIBQ6fNqTw0xrt44vSh9b29aWqGVluLvQN7DQ8MjescxPxypRFU0FpqBvbmQnX3VIQRHbvBNOuKECuC+HY0z3tZsljkO8UJWPmGtrHauM2zziDo3Akg14jrj1+5bKKeWIhsFnwCvvfZhg2fgWLQAIpxKDr3ZqTnhWq1Ta0GK2fVOlLJEj/VMVSwbI6bSn9Aote/tJ6cz8Er55gmlzkSjgOqc60bPoPv9Xjb726BCx0drIb4XW3qUK3PUaZZiOUFCzVlDh9T28MwG/4giNPGr18/XgjtOncuchdNUBEJVG/cnkwi4MqbCF+H12JejaNHU/gIN2XoqVpVZOTD18M8WotpldeIEjxPbORrfROqrrVWTZhQxYeqJvhqWQrzstFWEBYE+hHB9v0IDPtqj+BkDEl8pJbqUaaUzjLF5BE9imBIBjcVFbH1HEUT+6DEUvQoIioORbfW+noUIdrUvYzA9n9RHgYtbf9/Kg+NONL/s/LQneUxoVFL/U9KIv71UjD/1vuxLIljVyK6YSEonui1urirTOLRxBXz/G5i5xL3H+veluqTTiYXyE7BMzqzXJ6ZKZenaMH3VncmzEDRteg2+B26x2myubZsDacT8KsZLOvDPgpsBoxYpdHb0dHmFtkdPq/Np0FHTTEJm/zoDhOCreEwCIVC8MeWFvhjKARC4TBsRWd1SwtQh7hwG2wKR9vawrt2hdtoWxgcIUGhOeN2DXFvDzkE9aIXkZxiXBiiiKTBIxWdfXbOQJww+zQ+h8GJCkI0WlEpiT/dmME6PhP7dYMYjVgY7qAgdm4b5iiAsXqxSEWEfh3CGaLYCErFhLFz1CjqwTdQeuwLWHiKpUDcP247FvqjCMHnAw5FKdKDwjTuRfgBSsDOQRVDdUrrlBF5Yz4sRnWvVfe6aRzxGoLEWjoMXqe9q6rYe7Ad/VA/89ljWaHVuE9C6oprgv6EojNYdIQ1nlCto1S4HUVy6NeBbqAqCH4pcET8EYbAGAvP4h9NzjDmHJgcO2JNQZPtj6hwB7Vb7J24AbrWghqM7wk0iaMDXYhMdtxreZaKNGEAFC6UWUJcFIPbVUUvFDUAG2wSYksyI00lAxpQpIpKtLUREb/KFHYCVwH8zrj0A9Nu7HCxm6XQ8mup8hfl8Cdg60AduwScybS8YGnKjFDxVwPqmhSlOMSgDgFsmSXMEXSvCSXKjBUC91NRAn5WEvqmldRwaiqxuuwEJPR3ho1eI0ecpaAxacDgEDanD+N9F5P1InZ35SKWzAFiuuYTPG5jP6Qa+82mTvz9aSaxdP9+qdiksJoY+datjAyYOmZ+Wddnzm2+LVnZYAD9zpRpc1asmDNtSkGzxbLm+cm5uZOfXzONqRlZVRpqqELsJCwFfxk4sTtEUXGxk6O30dyTReksWAvYNlAM3yur6dWiUgNgX1DMiye/NFnMe1vkKpoWZdY3LW6qzxSxd/n7coy4jydQxaB1dw3j74Y/xHW2E8Y6MFMeKoh7gBLxHxmokho/RTyfeYjrThsLiCE3sVDVsqi2Qfomq6kxszZsoCdvmDULjD0Ef7p/2eVD4w+hbxwEStoy/4W/rYd/eApefvIJkP0EyFv78wvzQWNiLYGbfjbr1T+/iv6yogOzwPvwdfgTyuHysvuB8tAhWLf154eaHoAfvvQY/Pj4tEe/Y0TdcbCYbrwa4i25HrT9Jvxog6PLgM1IcPu6sKnCOkVHK5ZusiGFLjyhqoOQehZNB2jsxO8dORKPbMLJYtHsoK7EE0DwyJH4nXAsLubvVYxpN9Zl9VHl1AhqLpbFYCkdxpHXdMp/O6W+aPndeUFQxuNJ2LhMS9hxIYqF/iKTleV6RohaEe2krmMKSoFnlRWZNOHC2snsRjdlViihgcxgfzFMXjXZAP5Ctg8rqvLzq/LZHePv2r1h913j+y2c2sxq67Rs89SF/TqoW8WyIex9IRpiwijL9p+74Ik4GXopCZX2719KAup8nH1kUs3iKru9anGNbNv7z73E2+38S8+9v012y9hEGWceNQj1WjXNG7VxlYcuF1xqbcBFaxI28cltEGR8djSmTVZscadkDHbUtd0elIQLXzxy5KLQJqTITZ3XnGBPeeegHYv6Rah+i3YM0plMOnzFxq+4MOyA82fNgvNhRwI6Ewd2ohGxE3AJKE29U9c8/dOGDT89vSaVt2fa+e6XibLVPDIf/c9qmAPsepMdm07Tbgeq37+sVluEkjA/SKoXbKv7um7bgup/vyZVwfL2Puv+enJNWtqak39d110ujMve6z8rO4N6uwONg3+n6COYkSNK/c/P+HrG8/5/v+Tnnn46otz+dnb229u796f+/7v+JOLtrv+sM90xm35l9h3/u47k3bnTK3ShhO+gokqxRzuuB0kJBMUBj9htV4p5q9ik63GXa+sq+WTGnF5WWF88OjcnJ3d0cX1hWbqZYSO3ip3c9VRIqwzjMzqEAs0jG0O1eZVWi8VamVcbahzZHLhVHNaViT+UoDtBoVl8FvouZLc35lJd4xYCqNQmvE9EaDwqekDnjwGOCWndiUF3EfEFTg7oQQLSJUwKQKh2kUkw42PQ4sgWKvBUkYMD+N0uM42WxdI5UhJb5FZK0KmyiBMPryyt6tWcnmKbuk0xV9RSHw0PnwPfq9s+RcaJtk4s9gxgw7W+8PiCPlUeOMx6Ap/b8h3wkrsCL3uTszLAMxlZv+Bo2+2ZlWK6yhNe4R3EgXBRur+Qv3vqL95SWJeUX9+yZDjIrJneNmU7mLjO0Ldrr6cJfeNCCgNy4WZxCLYgySAOwghIy6Bm8cWsRBwJ58628OMlEs8ILJkbxKz+hG0KP3N4XJWtalzVAVfIV4tVcUP0U+l+vo6rEuJtz2xZlKYzTdk+8x5xnfL2odH63nMzYNi7b9bgou1TTLo0LlzlibbQamweGv3xBnXWW+vLSYeUNzcd7LWlgJ+I3eiP8QT0Ds/r/cvZ7VM0om0zoTIzB84Z0hzIp6nqkbP2pYOnp2xny+P7QIIOsAvNogOpydiPMYfXV4KYJWAXVMY7kaa5mLoSz4kYzH8K8EV4Q4Z0GZ4jGL04KggYZxyWmjPEMEcCeHuSIXcFG38+5ruiHHiJaSQW9HBs4cmjFaZgDQc7Zu3bN2tB7sCx+2Z58ujFaADvmzMSPj7u7oNHrRlVHrMeNBRWgBAOwU8t2hy1uqJIrwVN1oxvo0uTjL7aPCetjJIVKW266pk/p6EGDMvxoyXoe1sycLuXFvf1uOA74e2FPs66pK9Luu/CPo1lff2sfZq/7psVndq41TDCRL/Vf6AyYPdUSQ9I64tuUCiwQSE2G5zGopDkiDLAaK6Ja32ZVcozodpZtbPerMiZHqF0I2R9c+n7fLWr7YXwkifYz3P+fL9c8VBf9gDN9s6+R9aDGQQfD/Uk0OnErxw4O5kV/BHcQhhoilHXIiwXxpjF4IYGeww1yWsnWhWxVQEe1rjfmjiMzhwTfq0pn5dB917aUg/D9S3wi+in9S2PLgMPZkcbpu4WV7bUi1rHR3/rDkUqzS5GrZF605hQpBWFxQPy6PDYzBIuJC1Kg32rJqCxXKhWgPKkVKxUbnaJqJLCyN/uPwMPYY8vJ+9uqbctezS8ecqQGbb6luutYMqhNYyi2GW2OTz6NJfNZc5V5paVZKpUranOCVU2s4s/rPCkvEEEWAImHubtiqjFmGahNT8aTeTgj0G8pYAY1Bs2qGVikCTYZMnBYO10BxMHk9IlBFGD6XAzFQWAYKjHc75iMwjEwU4Yhw4HwbuDbtNNU3CL+VXjNetHDVunHzZDv27Y6I3Kccv5ldKAsSC9MHnmvtIiyFWPKHSVSx5cs1NS7ioIMRvMUyRBV34Vs5hnxVPFxXb6uex00FFSW4yG6tnQAIYN5bvLJYvN+5iKG9TEWrCz1JtnBJ+kWMdvkY6YM2MofBCcGDpj0SjpneOTHJDic9RWmWzPzGCLC27wisIFruhIeoyroCpfpYh+Au51eqs8SjlMtyy2wvm2LDNYmdOnuMb89Z9YIAeZCq0sv7rABa10i1JZUB3b88Xt6iUIJFMIEtetKV254GTc4UuYB4QAGrVuQu68ZCbomghuJny6mJcp/MOyu3uyqooHCsTvnNHBjUsqqS8RpoiBfjRZDPTP3muUThmYX7ygf0rqhHWWcermqmiRQAj3zuzfa9+fbcCG/zg0H0AKht/x1xUTIphiAC1Nky9n9S7JLMfzQGhMYEitr4kuCwwJH5x9he5rGMVvnnB58Vy4IzRUIIMz73HQjln72mN2aMIvYV/cSbzYTqLWEU8riVX0aZiYClMaELBWMaCvkU8XqQgUIelEpnQlE0OwFKYO1K9QFzRg/fhAfPoQuiaItThDUF/cwrraTxZWKoCXXpy4d/520wjD1sbo1Fn7/qrZN6t+vUWDCFWKoe+Sl+wB5cD+gaJ6RJuqnjQ6DWaxYoO0yoOij0hCHZXia9NzKt5EVKk2dEZZlemrZS25fWUjdNs1A7J9Q8W5/c6f9/QLeuClQvvqWh9zm6ni6MkJ4+DjI+fsQ7wSvTjPM2vf2IG5CzAhhh1cTSDDevRgRSFo0Js9VZvV6hytBX6KwxlW0KTVF1WAGcak6NK+8z1XaROmu9EwrXTm1bb/xZ8DhtU0jIXvuDx9i0vxrJexBb7nq+3EgeGfYSkqmfCPhltr7hQZOZ2RJ7FuGcb1Skcn4tjH7fLpBOBQHdmU1gmYMKN1CvihVrFZoYN/VOi0SiZZoWOVg4BEqtgk1wLPq2LDcr3klTyglW9WSCWD0fkuveSKVMoo2E8k+u0KLdO2RKGNXCAP52oVS5RanTRSoZBJNXK6Do7S6cBj0aflGqlUyZyWa3TRa0kpvENCi3WauA6DsKaWUNlUmWCH4BbcOPhNsbq4mS5vswIgmTAPGukemyRUwgYJ3jBhtb3tw1c+UDWg+KxYItbdqxe/flCrFPSgXeHgiMkjakR58AL88Y0lS94AapAL1CT00S12IZjKRrsWfjPwMtyqUao1YC58AOeDYXCS0u6bPm53hpTxL3kD/tgjP1jbIyMUSqx3HqI1xNMVKAr487GRH5qguE54ozTsiqkCcYceVuAV7P9espuapsf+ET1+f7NSlifSqmUsq9KnWJ26uslNA5191WqZSi32KVSMOtfXkLfnd68zcpRUmifW/Iuku9943X1zY0YfvHnzCOQ3a7UNCpZWMKxcpZTzUwfVTbEolTJAywfrdaw6LVl/eseuUziVkvlXqdjCWzQ7MNziG+JxFLrRxrdyNqJTQklYE++WgICEcQdMEsCj/3QbJnTRJvrII00DoA20nYaf0UfoI9EmdA3aoO00sDfBMN2GhZz4BkmGo9Nwolgy/NjnTSBMdZMb4Xe6EelEbzLxEmAKuCVcwB2QADffs+vSZ4EKXm1sbYJXgSlz1BpYxuSCN2EZ/G9gQrHABK9mjmLqblHJ57AxSuMplAQ/GEaPVIE30aP/jbI7hbJDDzaC67folFhWfUVCcRmonDrKEvOy2ZcahnpouLtXgPiuKhdTL/MTJ8LEVwpJhal+RizkFdTslYAAsAEMzVVkpQ3FQTquyauzK4l6OpYGYo0OtDQn8MW0j6jN2Inzc3prwOUOBNyuALsuMDgQGBxxLziyAP2xaxfUD1m44Eik79FFi48++PVRdt3RxYuOoovIZ/C/T91+YdWqC7efYh6D8AN4Gi65sH/sqL3n6KHwJ7gOu1QAq1mwJjcomXcAXju48dv6/AbZCFv91Y0H4bUD8yTBXDB3L7jvizZwJ50ivD5A47f7J+B3LlgASBlayYuPAvT7+ijMBKuBatXF9ourWNn8eWMPXFiy6P17J0R5HI0+A3oty3rXeO956T54bX/LlJKVxtucUxbsB+L7XroHxU9d0IL6zPQbFHuA0EUd1hcmYI3oYNB3KecAK8AeyXlTTPkdrT5jyuUBrHXkYQQ9JCuLaClWLLICpjfcAn8BUrAcSOG+F9avf2E9yFWwisw896IzNUBmtcrTRqb1OQN/ThuJgmlANuDdhe68TJREmlEQsnP6qgEtpWMfcrrsoYIMegmQvvwKyumXV14GB9ePH7d+/bjx0YdT8jKy7Mk1hgEkF4XVWn0G/t2KAiNxfoaaZHtWRl6K3qrUmlmlw2z0JiebtUprAn4YT/mpINFWje/ae4CIV9LprnwSwppHJqwkhJ1koRkVXaKjvzifxvwvrXbZRGqj7Sbx8f3jN4wfvwF4pRm90qSuVeuWpqSk9cqQGjP7DLvbe1eh0SgxlhtPLRyEjhKj8VTx9uF9Mvu/Bv/+2mtATq9IhDplIM5pfPQXfRKXLE7KzNBqk7kkfV6vXJ+y+K6CWAaL6oQsXytW+nJ7AS2Qv4ZzA992xzcVZBAvoHprBb91eJFDNKEROYg5L+/kyCWgkxUVpVaO2fIVPP3kU/D011vGhejT+Q6wx9m3EK39X4WvOjyFfTPAXjsXHlsZvf4UbP168+avQegpmg+N67hkxwCLhX3t8B3gt/ct9KbDVfaYjvq9iAbMwH2OA9jUxuVzURgQutjlsxuUtMlImbCSOo16m48zCApcRL3OX+wrQqsOFMUzRq0JeGiUAH8miuc+hJeT4c+VwNcAj400jF2cC+j+7qHFajO4PS/tI6Puw1TXURr07mOwz7HNq0iqnghCF3frggvtFxVf8eBFZf9eZvAeAFuD0Z/sM+jnC6M3NgIATjP6d4oWjeRc4iLaUuboFdkxtRwczHaDL3196SKQT3s8/f5a/eHeQCHNZ4gAKKSDRbCfPQo1zHVXoRIgqpLLbu8I1SbgaUupJGoh4mp3JVA8vPJUsjwIsk49j7GxUfujWpJVQRrZbsWAOAQyG9Ersl5SYT4/gDEJ0UU+8eyHOd18si4QEfdtVuwPGA3lCjQwBQ2PxBm722zAPGtPNrvSi1C2EyRLNm2dyMCj/PIN2ybQdzYzlmRW0WvgJ+vViCEQAfWAgW89DpJ0CjRI6AWH0/pKZVy1ci5tT2EVyXr9oLYNKlqB0qn6V7z3pFsuc87fn1YilbGlyhFrPoSX4Evw0odr1nwIMkE/kPnhZ7eYYOj1Zhcujn0Y3Vc8d9W6saLoK/y8levH9n77OK1VKaTpLYdsfVCW1aqZtNPKKlIzmdrPN6gYOX7tgD7nHgdGtVykk8tbDlhROq5KMbdEogjVfrpOTuMqKAZ8Q16+JrFA9Np/xkeB2NxqpNIxOg7AO2tOF/pc/gwJMLIBxoVmErXTqKURxXACP+3OwPgkiLAwt//4h2+XR81H4N+98LswmIcWjUMHAOOBry/Ah94S/a6MmXru7q/h38HeRtk0WNJ+8mT7SRFFr9j0g1vy8C7wyP2PwznRmXfvSYXl9utgzRUgC+yDp+An0WEblfT89aBiqegkfgiPKxr3L+5tsptgo9wuGi2smSAWUQTwGGKIzifNm9wiKzYEwlgbShbNg24rwGZBHhwwobKzlM5IKwHLbIZfwb5zyrT97p0hky1UZH+/2L+eT671jhCrZMmcaUyJaqvW4K3P8k6ocZaXStDyyZhl7v3o7QNPHtk7OyVH3Cdv1NQU1c47ACIpLD3igUvw6g0K5F1bD4aDviBnPPxGyWiGLqTzft9bjBg/wA118KYC6at9cgaVpPASr5tmyzJoXqsQMxOHyspz0mqm+8a++4TLNaz/cTBm/iA4G76x5gZ15cSUuCwnhuMfEPw1skTFFWt/ovkpQAw/XJjgYdjZXui7AT1NoBT8Wl8x7Sa+FbXcxWOvH4TfTa8dzbKja6cD/cHXj90Gzz6aqnwS/u7LTbhvPMc8AgrBgwe2NC+9Y+mBt948sGzzstmb7+Es83atGd++PXt7+/g1u+bNWQ7Ee34A1Sefwz0JLItca4WPra4YXgImf/knMLl0WOXt8ERsfaJG3+1HKofyURVUP+Lvxi6sWhHbgkuNCol1LQJap4jRUmh1goHMMAyOkSEkG383QGR+WMEV2MmiFhHFjrUf75nyeBF4uOQreO6Rlx/98qHv8zTj3gL6F/5WAV4EyVYVdePpUPOIgtpp/WYNn7Prtnf7eq+/OWnkontWPO+ZDK7Rl7hLd+/4Iz2qpGDXG+OH3//3jcMWA37Rkd6PguZfhsDv0YQzESwxByZXLT7+HHhq2OR++Y/O39yxauT4YQM+3XSWHnjXa6/F5WxhXvAzgnEBbrmrabhpv9CXuDFN6RTXyY6lSNjNjNoA2YiIkI0I0BS14Q1LUahqArAxJGEE72cyZyOC/kt8vyEc03kRymVE8+KfUblMeOdY58V7aYISNPofe3tWp7mjn+GwTp+bbLUJrq/RqHK67nyjX0mGR8kkaXUs7bWWToQ/FlRXs9+CYnQqePqCGubQ+uxBgZV1tuzydIdBqtWP6J03qNTr0IAL1Vw4NKJk6cbZhyaO1kl+GPtYc3UBl4QfbP+2oPoDMGVa3sB+hXJzVUr1a0ePnhnsygop5DJTfqFt6pPC+lZ5g+JuI/KSftRj1BtoVuUFiBBBFRorkGMl7phZFFnE4SBaIRj5m61XAjHTFZOR0xOI4nSSic9B8jF5NTGLK0GVHUWmgTjsseCLSRNDbxMu0RoSt1bsM+qx5VsMJwaXgTHqO4uKUxPNdjIQUY0W7Dpw9Ni9e+YvCGbL2WIvB7SWoumTwxt23L0xPEkkVckNGdBQVWGwaFRSSbCKk6rUtFZcVaW2ahUivrJSa00Bb3nyhtZ/+NOH9Q05KiApLpI6ewNmysw9u8+/v6vMb1Gp0WrPJWveMaB/8+z+oXkbmp7eVLN921tntvmSaLHUbjSkGTTMXKs1chFkrvLMXXHbh/VD8zxpEpnMrJDws6aF92xcm6JFpE+x7tEH771DJloQDIUqWlp2zRhpEYstgBnTd9X0yf6SkgAqMcvonHQDKbG0vIpT0yolL62sUqdquapKjTVl4NJ5M4fWjxtX39Bs51M0asuUajCM3tI049yu3efVsiKvmGFEd8+Y1q9//YBGOKVPzaanJr65fds2Xzotk0jFnElFP6IyzYOp2cN1nnH1Q2e2gPNivVph5sdmlxRK85MVarY0VIb7TOoNSvS5CGOPBanFWMLm9Bv1aDpwpHuwW2DilNnEOv1OjDaDODTU2RG3r6QdSiabFgBu/EaM2ZeGGRIsLVAyZJueCwhfHg0UJzFAtDIGoCfGCP5yoGREKpVRpQiu3f/Z0mU/PHNsarqYFUkVXOscsBEceA3cK9Po070arcSQr+EMdnOuLgeIlGIJJ8L6v6JZRZ5VcEOK06VU/ClzsE4nU7qWbdmxvjlY0nj78m1Tigzpo0SG3sW9tfCj3DGrT06f+sCkyuRoU7+qmuFWZa/muZW9RaJUnTowtE9hcOyS8VkSlYQD7JLCp0ZmfqCeXTgsSynV5e038hLsQlRwFkvT6gIRLwePplUVZctkbc5Ber3M2GtUpqhg2N1jh28bX5NlkdBrKm0+2uhsCKT0XjqnobCoZvyQ9Ojhkfm5xuTJeSUP0Pr8iZ02P2EyR3mJhtbsBJvQOKpyl21uZ8gZw7T0xTAuuR7Xgn7pr1irx4y1iIvumGNwRAgxsRRONxIdMCWE2XB7mKESkAwSghxVV9ylv9KEyW9T7CjYogsahQnhdi02PaRDPXMiwW7toyJeDrxEg82QOCcUGbG+3b/GAv0XDYraikWTQlRQG0FFiKBVIZZEd1WdSfRPFb5lq6EYcCSeRhv9gKVab6qzEB5864aq694n3IhzIX3C2QVx5iJUudP3UMwO3WTU/5+1wyhsZf7KK4KN+auvClbn8etXXpFEbP9Z09xz6+w6r2Hb/6699GgdlUmVYKxYiQCaFGulmLX+/1UDcSZISc1S2CYU/QoQ6tLR9J81C90bUhIJsAkNgnIj2UbL/oPGAJ08b2qMjgAyNcdPCdIJ0Gp20UkaU/zoMl8nevIiymWObASPK11mKJw6hHh0FOSKrLD2IV5HqKJAp5w8Dn7gJDslnSIjP3htQ5CYfQ4Hj4BceAE2wgs0hSuz65zWon0UtKqiC/Ar6LvZQuE2yAWP1KF753bhZMseFWSaTvSdPyZzlJPo4BARVJe4petjYaSmeLG6qGkMuAGzkQbR+1LpdktmO7E3pUOCVSqVaYm8AgQTVYbgpLW3Zlq2k5Q0alv2j+irb7dgQEiCBOYyhywdV4iOv5lpFQDCUHKcprVVkLeLKa6D6BXjsUwJe8k8ELmdXFxj2h9A3Bfn9HMaTuNE/wE6819ajNpoOCkpem/0XqlSp0GXNLqkm+lmW0cSHepoom1sW7SN+1lvbw/rbfwNSib75RdOprdz+BKQS8WBDunX7C+KDull9pf2KPvL5Q5pomxYg0rli883eKOWtCQqj/0WMfGNcDyscLFpSqHTQBsvRifQxrPfdrvseEQsoimtTiEWQXQSIWa9PaQXo86jQ3O7XgxwoGcMc4OS6toRk84AFOAQzx7fr7HxeJhj+xoThRVie3hUiZ8F7U63iBMRU8xAkA9gJQms6kkLjlPAu91PP8Kpf5837XHYXpwu1zNsEudU2lVmpYrb9fCP4D7wLbiPrk2A9RT+gAc+CC8/pn28RMoApUxl5OxKp7mgoI97TPTuJ4D7scc67XkTyu0hiK49bIPiZ7x3gsZLGsZzQ/w45ssz/GoX0HdWCMupfS6/C7uU4ALEJxV2CmMFt6zZVdgMD71/97pRKUmee1fmlPYtfw9Mef99MBRXuF/tm7C9sJJTJbEMB6S0nOYLDFlJVtmhZ7tEHfSzN9c7vPW7O1reHVjUNHZoxRyXSLz1O6D9Dm59AjWG+Mk+SjGiM6yaVSG2UOwzlXgGZI4Gon3rvj8xbdqJ78l3lLAU9w/UA0WUlFJgKq1BfyAZkDM244XoP01+aMCNBu7oSXiJWRY9CTLZwzhMD4GXcSyRGzbcaBU9zoWIHboIUI50xsXQ2HtrMGb1qhXWNwE/itRyRtHjUvga/K+v7pqc2zhghHbuoKRHPPeNmLjYlGsMVHpnTBMrVpSGloNhHUz7d3ASHAr4I6AKiOomG+7JvFMsWbsVfj7y+m9+M2KrGdwhE3euY0UCLoOUIGvbAaOzow4sotoptvyTT6KbPvkElKOJgQLH6GUgC/4xegc8H+/X8We1VCU1IvY8TzC3A+6AGzva5tBKN4DVlGOgINgGC62hDHYfWnVijR1vwJGOOeniIA18REnPp7GjlVwsHS4Hs01em5Y8e3ZyWq18os/mg/tsyeAJR9WAwo0bmur0UkUNaN0r4mgATrm+EbEsI0+hl/p5jobfm4aZ5Mp+uPhsq33YwuTS0uSFw+xNTUdt+YZArVO56PYBYTFcp5QDvnGkEgCWlXJgfVgkYupTUlJlkd+OREshRi6ixdOMvB7epZTQkpFC3acSGoT3e4ZiL6NYz5BsyNhimzAxiHanLghMHFEtwWPF52RYouAA8OxC5hngRwuM9NjCFK0Z9YLnQVccBlrPU72K5BfhDlgPd16UeYOLh43o/RHIWswkKcEC7YCcYGPjqlHw6WaQ+3HZiGGL2x8YtaqxMVjeyCD2XmqVZR05ciRLZpXKZDn3TGiccI9x1ajG8mAj/XTZxGRP0UF4bf9+ID6Yn588qaxhScW9UlqiUDNDnXkol1HBgTBTck/5EvgNeUkjbJJZZVJpdmZmtlQqTZPlFEkkRdfwy0atIn267w1a9DJqlwIsfQgyeBsKazrYrQzq1BqRHLF+GJQooAS83e9h89EKqi9Qj9j5GgB7vgHz5jd3HAQzH/nDH9+uGQe/hw9sf/VnmvnyDwW91fRKsS04pKHaaNx8/c0D9Ferv3l378g/vPnyjVfmH22wmft44ebAQNpfA5p+9xMYPrn3+gmDVg8qMasA4IasuyfeX4luvYBGn0JRqKfFWArcIbERSSez5JVQE6quIQYHG3FgMxUR4lH+geJsdFMUq5iDdGLCgrigtraqCZ26nS8Tu5UCPL87yZYc7giCP8kA8SYlCKwReUQsHmVPcDhqYhzYPqXICpRAVPDRwJ+37762Y8TOt+atv1r3x3nw/nd+Az+6sHr1BeD6zUWwAIboZxfDWvjDc3EJ73OABcduv9/dtMWWJ5fm/TJ/+Z07ru2a99bOEbfNuf3R1tUX4EeIeqAsPqT7wSNR+FEXrYQ/X4WLjwBiToLayYbq0RbD043hEQTswK0BaYjO0XbA7Y4eGMeMan/2BfZ+/e7od2AclEceBVOZXmDdPZFPFzNjoslNEyMPgSH0msindK9424S5H8l+7u2ooxCP5J0uazrDHLZEIZos6IyuEb8aP/s6z0Ha6NV0+iQ2CHA56Jgq7D4gYmko8lvpbs+gs0EjnOmwukmN/mgqfo6GW460RHF054+T82pgs+fabXkuwyC1pjev7peirdFlFgE1L+cS09LqNnXXX1QNQlhhDbbSP6rVLXQLOpCfiMcGv5tVDpPNZnKoNFKVSv2BSqGSbwSA4UUtsYTRHS1qwccj6auzBAQsgd0qB3ajycoRvj4O8iasJFE/47CXLjtR+BG8PGGpVSAo6gVixAfPwI50JYvVobH7RzEqH2wViyW8OvKQ06PWpJnSbJomxKkTnh+ipWSTrSzXY3FrdSZLbl4SvNd4ZyNW2mm809iclJdrMem0bosnt8w22zA5iCsdnGyYrbGhfDRqj5MdY1PTH4td4laOlWrDZbOdGUFbhropnrlW2aRP8bvq3Fm+0pr04XP2Xdg3Z3h6Takvy13n8qfoS/ujr9K/VJ1hC2Y4Z5eFtXpZd90AHo1iO+FJiPILpcY2QF4S6qHUsnpISTRaAujn18Mhz0TX0Ztvpa0SbBkMFPAfgH0hEgYKMPMWmyeYhlxG38WNuOEyahA1ifggdovi+E14H0uQVRtNmNy7hS1+ogHX5b1D8BFnBSbBDTx+TO12ERFVhrozCoudCBcgmlvt49V8VpJcnmaRmlZ8sHLTF/459cbckKl2Jv4crHHI/P1v39Xx50d/PLM3CIK//QsYa1q8v32SKStJZ5Zr+/fXyosrtJMAtcmUZdKZFdo5c7QKszmoBc/1mmjIy0+yMNJSa/8BK99fses2y2BTKNdYu/fC3vmD7zrz10f3f2l84Uv422+SX77tyR12habC3AzoZnMwQ2G+qxomvZWu0AbND77+2wfMFRqtPAXxFBk3KO4K2Yefh9hIMuvhsSrgMmIPCBw2f8GCNKyemwaIT1LWjbfffXERG8H4cmQDD0ssvohXUytjsoq5K6ufWbPmmdVXFx2077o694WVk/0OucSSN2xWQ26K2GSZ485ctE+b558wvsaiWnzXjKyssZveWrH8zNoxLmuOP1dDi3Tm4gyPRa9qdDqrp2RLXdWrR9XdPr6mIF0npRWj16wZPWbNmlOqJ5cODA3O7jNyeINXqcuv9GY48nu5len5KVYaTG8w5+W6ivLSFXxgzMI7JgzesX5SaXHDrJleT01OqlSqdflH+dU6AIKDnUkuf0Gv1ORSfyjQz1/jTbTDE+zXb9o9cPa4TnTETbdqlTfI2hOgI+h+Fe7pc7uJJiO5SygUC4MbPTxrd/Fygr5NgFjTd1rDU7YgUHvQAh6ojc5EX2mJsobYNZ3f3aYdHAaZ7bt2tcNL6Ah+wGVo7SoUOXA9C97xxK72zqcGdyt6Qrgb/4o9Gt7Ukt1cmIcScwCtv9ZWN7UP06N9/mnrBBJtNf9Va8zrqs//oAl66kc5qXLEa+iIQTOBVweIgSda3IISfOe5yIiFQ4InPNJGQthvM4o+cTA6Xer1cKpOxzhE40deHz6SyUgGFFk04QOVnAHXu4pdiEajI4bjCsO3LEaDwWgBpUz/yHWGT7Inety0/+YGJfiewBQqHr7/889jdnb4ZCAIRb2oGmxnB/AUlQPiOr8xDxho7nIzHsQRETVrZ7zkRmGUdIZ1fqzjwojwpOcHjIPobcY+A9GfxXqxOXGUf0yfSSlVi8QDPB2UZ4B4kQpfg2lmB22js4rx0ZkMjmDHF8UuEI6dm2y0ozgL3XOYuWRnx+rxG6bpto15WNBXf3jMNt20DeNlffMexrBfKCKvL4NbMDrL07u3h96HgpE2OssMjpgdbJYZNiWnh1AYwyw0kebpChtQOIt14IssOp39CE4FLzbOx7fnN8L+4L7cEhwuQf3fjvrlZ2QNNgR7yHIwePPLztiLTEYiXGKINijqFY7OEO4lRI6UECJg20ae8XaGcA7MZyEYYmAJnwRfDIGASiplSzgzfHEon9SmlkqYwRCFPleR0Nv4hFKC/iEcJilB/6F8cpsqljIWwvlIsDjqBgWutSXdoORKZVsSfAFNb2pQEj+jQ1sSEO6BATgOnomf5XJh/TkbzTN7Y/aaGmJxb+I1Jp6RMBoG6woCNP6JtSUapARlk6nZs3fvnvXgPDwHimDBjfEgBFvHUzfo34fmHz/9y+nj80PxAPjTnr3Mtr17IpPAeVCE/p+PHqJujIen4Cn0AGhBY/Wtt1cVFq56G5Si8VoqhIWxmXmDYi51lotyBtyagFuHJQVYcRKd6OGPo382MDX6FfzjHLAYbpsDsuiUBSdOgHknTkT/G94X/ZJ+C16aA5aAJXPgJfqt6JeCXU1M1wvLY7KoQorqlBx1SpBEBM1Ph6VfRH6IpV+YOLOxOxxV11xX1xytIye27nMBqW+toqNNZ0M9UMHayDnaFLvzHk5Xx5DkdTAtDuzXqte2o05u1ms5dHo5Fk3kRsyN/qIo9yKRkqhRSVOxPxjs9kWXBUAhJk/+IoDdPkhAIQ6bmpmkyH1aJT8NnKP3wOeiP74Ji94UF3EF03ilNnIfk0QuxUwwIqGXKnIMoDgiEY2N3kdPNUU3wvcMOYroncw/0JUpQd7Whr4E3nUpxH5RfQ5AbMjdGPCKIExyerxcF9Qn0wXlScHtB54s0Fx65AjTt3nr5utNoPHanrUwk2AbhKeMhtEXVpwr09Xpys6teAFGR0/5ERwCX4NDP9KtbdEL4zJoMLG2qX4SALe3tb58bPqaQ5/ObASgceanh9ZMP/by+8JkEMduiMtPhHWWjspE/IBg821w+HTEE5m960dE/cDNE+OT2BSHVmYc+uuht0czkUiE+Qk+BkZgtdxoE+OWi21w0wcfwE02sVwuZi+J0ZLtRTiL3voJOnwxMtiRGRw5MsheCo6kF4TD1I01ayBGP6CEcORB/MQN6rHH0JgUd2SiPNgJ+/bt03c9NrKbzkoanpVAbMNelAawto7JyuJ9UxxDAw9Hu21yFI/B2QzAAZS0h2bCzSVbz6dnjJa63cFpjb5cCZtbv3jR7tr9ABT5LIPegw11C4b1KvPUutEwOg18V+9ssHJKhQL0aYbfGLc2n9j7En3+dw3vLNZpMtXWtJxpGyYM14iH33l83RJblYhJzzCUoZG/uve6Q/deeRMUbRnQcvKRr47/adnw4Sb4Ikilk5S0bSSVoNuWT3awiId5ygN41uZ0KclespJG9JUoICAKGvBidXJvUSCIoe9pN+bxYyOS7bEW6YlS1HOtwk2V55lhB/wWdpjz5Cnm1+fSKWaLRGpMlihz1WK/JlvjF6tzlZJko1RiMafQc183w+eJgJPeOv9V9OQXsOPV+fNfBRywAu5VWAvPwC/PrVhxDlhACbCQ0JlbrX9GFKeIgkFRSnGeyCM//Ono/obkAimbpd+6fPlWfRYrLUg29B/96WG5R3SUiFMX9HgTDs1ZcQ5+2eOFsOBWamio11cj+v1yrI0HoBgjMYchqx8dgbV3xzQ4UXuibi/yAKKTixEa0eTmpAWlaT0oCpBVBbYtxHqCRm5VNZfLlmeJmNxSxnF3YM8dY8/u3DT9juUPAvHeZ+2NZZztr+ZqK/g2Q67JOQsWZe1pbt4zM/LRrDFbd726p2PX4q29z9K/9MuPXs4uAUyfXPC4eMGaS/fdMW3TznPj7lyYAnJH/cbKVTWmXjTxWviVIb9P0bd68Ggzzqb9tfKti3e173llz9bGuTvPUj19/A4mvuB6+PjFKAG8khY2u0l0kPl/1X0JfBvF2ffO7KX7Wmll3bJOy4dkS7Lk24rtOIkdJ45zx4nj3PcJOUmIIeTghgRSIORqgHC2JdBwFRqgJZQWSLkbWpoE3raUEiiUtpBo883Myo7thNK+7/f+ft+XWDs7s7Ozs7PPzDzPzPM8/zTxa0Fgi4g2S1RWMoFeHTS4obcOIpazP39LL89BFO/HbnnctUUhr8tKYhZHwuMqL56YrAi7EkqDWrFYxfDrP7zq/TPSuU8fmjv3oU8BQ0Jw62CmuL23RBM4HW+vcltMZqee7OM1+asDfoPWFvAUVjvM9Rqug7erjj4GGlFx/YuVnhjESqP2CJ+n2clEPqxDXEs3tpHtXc1BLRDCCGGob3mAQBNdDSINO7B2D+lzSEikBdknpwfw2AejBxMEJo+wH/vyIYB6WKUDiFg1qBz8oGfq1J5OcENNo066ldcxNK9eDw402vTaeLnLRsMX2fF+RmUy87zgMaqZ6JvWKa1ecD/PI2ZKWlLUmZcX4NQxf10B9rW2gd7poZVqM7dS+iWtoGk184vOIZnOzsyQrD/uF63giIaHtEJ7vbRXSh8ttHN2m7bGYYSTwf57PsgLCFoAaY05Tw8RP7rRV5D9B6uhgfa+FScr0tO8zQ5R4xUMSjBdeqRMwUJWHVE9DD4GDIRKBfF9RlMfKinGiUZaNeKey6hWag61Cc9wNJvM2QABoQ9dgsgEIRluDXMA/eYIRHM078fuM5NROpxw055/I8UK/vkQoCfN6U4lOxdnXwCC7j2dIP0urTJJX1kELSxWmsBInZmuPntM+kJnNuuA5mVwB9A7a4sSoUq7AQCgs1eEiiJ1LiN8CqXXXUi39aYfyeWvGJgOoBso75u4UNqwEryS1eDS68boA0b4lc78knTlb1Ef+pvOLM1WBxbNWFNUsmZBp8OhcHVO3VwdWztvst3+H6bL+59sD/sF1UJNRRLK1WhawMD32Kk+AWcMozYJJdPYkQdZgSRo6TBn3c4TZ3lYJLRe0FSrAwIas+SlHUTvVhERqujDheAFIJJi4OUEJFKGckloluDhAjviLBRKpT5g7crzaTkVqwDBIFCwKk7ry+uyBvRKpQLAgH2i14zkisrR9S4PR5eFQmUVjvrLaTrjs5m9E/fZQ0IwiDH/WlstT6ZMgrB8OY7t2nUQR6bMmDEFR5dcfvmSO9Vda5VMiUOhU6tZi+BieqQeDAnJqtU6haOEUa7tUou1GoXJGBufbtTwi05IX5xYtD7cGQDApNDU0odC5UIQvSmGLGx9q1X4McaZWwlqVuKEXdKkXS/jhM4/A+rPnThpCZryfiX9ifhJN+ODoqeP1/UQbKM0VY/mYoynNR3Nx8uoNYjyt1E3U9+j9hE7e7KjEsiFMBcOTv/WfIN2NL8t/l3ht90PoOy5+PskkP/g9/unZb9/cY6vvcTLMlxOAmn5JWKsHGQHxC6ZMxcD3V0XngDlQOq6OG1A5Jxuv3w3/gO3XBw5Kwf0gNilMsp/Od9l3DmO6vMIP5IaRy2grqBuQKxArtVSvUiZgAe9FlTybElb+oylUgRWDXc9Yu1D1huJvNfb9kE5TVbqkJcjvWJQxp3DDKqMvyayYq/uZi5Blvv/QI5jATUFSayPkAj82JoM+D2ukP7UPiyJL9xlTQR8BeGAjJmA8vRiN2RJftCMgs3Tpl6DgtdA4DVwHeHnhHy+6W6LwmBMWp4AQaXFptYUG6a+KvIGQ9LyyX1k0eEueemh5Dw1BVDb5AhVPWtSLOIP1TVE9p3CqzILK2eMLw1HkzPSMooKrlMOGOJ9cgvR9sAuHnG447XXbsasnSgcXoUehCpw7RbC6p3ZjaLo6Tk9XPo8pcjm9CzmUT8i/HzO8p1wuynsgStGrGty4Ip49tER028+LqvE4haNE818NN+FwkEZ6g+jZAopUzrlxqZJfCqHiYfOiMf8IHHMR3OyNjteBU/3fityLvOIdUTNIO3ru5DIJVdD0criQZlRVNuMdcHy1oDKVxqsM9rgZb1n1bkr0gTjSH/d8EQpraWn7ikwOgImi8UUcBgL9kzlDE7pg890+gLjfrVO/PVtxtvXeUbHeU9z7IpbCuoZtrRgXGu0/LJ5ATv9aF8Ou7/EZZPzMIpAun8u0794FNA5gR8/C2ZguS/cWl6Rr/CEfeVX50JIUoHJbzd6jGD22ECrUak0tgbGzobQsZb3g4y1TLsGqHcD42wbXzfKXj10nBE9G9VTVaKKm1o3SEdxDumr3dJns0W/R84BSgN9OQLfUvYAm2OBakI87FS83020o0LePj0pxJwSbAoSIz0RiXa5fsoR9X+5n9J4jwRx8b12dxhvhw6Fsaob48UdRPrtayi45slr5uMuhAmeQJsEwgW+QMK6ayGm4X2n9CGXxx9IWjt3Z184nX1W49Pcr9FwGXT42D6s4arO17U+eDRH7jty5A8+xHA8OCL3k/SMZDRcOn5G5UKyrLkv0lAX8kdik2ZVo96TvQEXi0r1aTgOHbUf24bduaDzdU3/NXwzNYmgLmEID9nPeG6PB2/x51iBMGblMUsAie2+rIXu9+G9DjQ/4h6Vc3pSR3yJ5xTGExd7R6NnahFDiLhCs7oq2dzktDqN4A+jtBZt5zZIl32RV9x1e8uBnTbAiLrWkkKLyy3yeUM9/krbvIkdOyZbOIGl1auXlI4GNKt8coBxXtbRGH85rqYBnJWZ9HBIly9V6q5gFW1QPD3kY85460+m79jLQd/Y5MxYXsxrQ52TF11NHb5JixfuaBcnixquxgSUUD/QTA9xqUHEQ51gz1M2xKdSxF8dkmggBmZBDYStdEwy+mpYvkJakfb7+mDSzZiAcNPQaURshbJKF0HRTHiNZsjLroHcAP6J0bqs4fCCxcbA0Bjj1JhV0JAxCPALvYIT2zOeQ0/qOZVLYe3afLh7277wxFToHpAfjXrzvSXt5UUiy6tUKvDhN0OveHZpMgVWj2TpOQcniB5hPfN6nsujt1ZJ/7i2eOyoGACsRtUGyts6s4d4LaANymkKIXC9p/PRO7oObS/vWdDoBNZwfHgov6B+2uruQiWkwVenF59+4UZBKd0xU/p+gK6s0/I/RTQE0Py3iT1L1VIdiI+hMGoqXkbAUjAqOUdI2GcL9lBSAuSxDbBBcoId51jjsoEcFpB4awzSvYrsHuyvjhOBgBeB9QBbv5NxmqflLUUlkEMhFxdQC+JMqTQisnqv44Pash0F6uFczJv9q7RfGa5MhQAjZSKVENaEwdPZf0TiHFcZVIFT0oFQKcel/JwOHP0NYIBVb37ar7M5LE+fYANnAA3y1F5Pi+MmyAGvib5Xz+hLNemFMLKjPPOBrzAR/MSm8+W35QGV9I3FEvS3mv+6XW/xBUcZn5+jcOcBDayIhCvo6abbCiofjNZIs7xFTIW3oiCYYr01kXASZNhMxF9S06WqDwZKYHcQRLUbrWPyQ69sDMIQ4AALPKNsVrVzJ2BhyWJwSPr7iJb3q52putiDtYW3WYOgIn8M4rq90n5wzN8umPJ80lQwxj/KKNhD0oyf6Vmz4WSkBlTKY6Cbp9iZ6GtNQ/IAYmGCMngBokcOTYV4rZXYmqSwNodIRgQiiyLxHRKX/3YgR8No1sMQXX5snUsTwALBHLQGBBgkbhooTPFibiRFny0YtoLRkJlwb6WFYVS8jjPBJ4FmqfFyjUm1YepsoAKv7zSbO89/DyWpBdWGjNTEV0Xof55RaqsraakiXJQHNqh11zILTxb7oJf/EZ0sA8ZHH5c+bhzeJS11miesdxY4D19pBh1K/nFY+aOp7rDSbDBrRIWVPrvyJa2gyhj+S5A+/ZNnpOem32de0pqVKGENneTzrKyUkobTSObl6RHOgqJsI6Mq5n4O9pSX08Ua6SnV3M5lwAQsyzMPTF34LKwucK6fYHY6zVceNjJ8rx7Z9xiJXYC4/BjBy8WjqaxeSgYBK8fn1HG9WHJKpcWwGeqBL4xhnsJi2BIKu5FghRfdsFqRPPzigVQGVmLsO2/7w5927Nz+xc7uCV6+oe3Qh6dAx0lvQ2XkV/v26Vz5YzcNL9HT6fSILZOWZMe2nRguwMIXF/l99uiy6i5HS553BfjBu/sOHNj37s5/7PDUZZx/v//BTz99cHKbNjCz9aj02mzAem+8/40fdg717f8+fOd09Xnpqda1m4JC1622VHVwnL3YbRhfteC2JbVti3r9Y5G5w05FqCiaT8cRDx5EfYzLuQvAOBkEJdmbogngFS9Wg5QRzxZhkU0SjQMCcojfOMeKDZosGLszUize9afdd19WXsJYa4bc9frrIPn6YajyxCdWWiyq90NMe9VUcFUiMnZoe17LFhdzY1OyKjHKYgQj+k8O4LNRQ23KeGbVwYOrLntAKCq2/EZ65a23QTYvVr/21stmiPT1wHD5kvYnwndH5g6fYBWGDikIGmcPSa4JJVvKCz+/aE7off/RRG8u2duHoMyhY/vF3LSIbX2tskoPJ2N54fUg7JkKEoUfHBDdKjJ1DtzuPS82znXx4Vg4aNYUqBkFawxsHX9spJFlVJoClcWPrvCZreK1UKE3aBI6f6Z4WKRoeFHGr0toDToFvBaAwath14isflJG4PSixiUKNgOcLoz2j5p4r3+0MB3q88wWl0bUc8J1LlaMimyhoHT73ehPYS5gRXB28DoYoPSoHVagdsCtkJJxwGRFJoI7SBxwWXPwYFDWa8rZM8nNJDebTOpYgwLKjiYS8Rx4Od2+9e1Kh1KnMzWYXKn61npNcPNoZ9L5Pq8wW83jxKDNW5eqm5JKTq5N1XnswbyxRptZwb+PsozaEtDUj6xPuvQNZpNO6ci8x/aA66+oWhe7hXcEnN5iIezUOzu252vUnKs5X10R1LKsP1LgcBRE/CyrD1ap85tdnFrjvW4Myhg2F3kcQTt/U+n6qmvXD6KB6f9XaWCwBwOWkukgiuhAXaAhdLBlwkttJk6FF9fMiPEgdHAdVOgM2oTWP0SmgyF+bVKr1yvAdYAa0BkQEegmDcFa0zkiqE+OCiAiCLWFRkKDTSYCtQ4TQQwTgUomAqVQRIu0elBfALJOIuKr8ainY2X2B71ggOX4OlAP8OISS+QnmgsTC2AuCmMgWZ5En9lEoddnrek6BjHVSmro8oZyUaRVCau+eUi7IjZfekj6/dQ3Y6MM+mFPjt0y8mnEcyvVHPeC3ttzeodEbe/Y2l6oAdx1Hx8FS37BCpXlzRVJ3VwYSgybkWzYsKaBo6JTm0cUxjjTp1FXfaiY87yse7j8SoOb5x2t3qDWE6I5US0dcvF5kyFwRn1GAACXBktBDVDqfSUjoo8ybd1X3DKkY01Lfj8/WM2IZ+6iZhPdNjMfRuN7v58vnOZD/X94VR+N7f1+aHjk0+KAXxIG/ET4wOQQMAlEL9soq2eTA1vI/uWkEH7n0eL6PfNqR4/WhUaGdKNaGubtqS47/E5YOPkpy545hTNEa/fOaxyBBvdwSM6xtzb66NtBC8rh3iN9uXfNe3umTt3z3pq9QLtnRHZZdhm8Ff4sW5OtYX+WJfgFsKfEoxs1ogndGDv8blD86HOOO3NaKHj3cNGQvfOHDh+tK/T5C3WjRzTO34dzoIf/heM+PSUUvHM4Vrtvft3oUTpPdD/Q75m258TaNSewx2Y9dGehdBXYBCWw6etfgrvpNNgtzTn3C7rzXI+UAUfpHnC0T8+S2BJFqBTGN+Nz+jBIoOh1phxMAB3HY7NX1MdAQgDG/FQa612G024AxsLH7Nl5S/esm2ZtLbnh2DH69/+Q3FZ/unzk2MV1ByvNZunDj56hJ5z7r6AC3jer3TZnIxsavnfpuez02wV2+Ms30PQNL5/45ova8ctGjinLhy/a706Wp5Lwd9knwBdnH0ibGN34G1yNvseoXl/vOV0+M5VPlVCVaDRcSq2lbqH+eMHaAIlJoZz3QTTTXToy8BxwOTfZaTRUmHq9yllTva5GTWEskHFYCEvLHtXQ0EEUSsjdOSW+3itkREb9kcX29Yy+F/WK2LGHiURCRqx0KExGXzKS0WQSxxIdlLl1ItThgZ24pORFOYEe56vw+SqujtQURFzuyMMFNZGI2xX5QQSFNb0B0IyT3vvhFW/f0mGZf/Vad22F25tGv6Ved4WzTLv86puGG93TU6fdYw/vWDZLKzVnZmbqZ9fDVa3fm9l2S7q0c2755IAxUc60jgfWxpoq6UwnU12UKyCNfrGKKYtXT0slVwz1hie3Hi3NM5UMWdxQLQpWaKZV9jzDxK+3+x3VE8dWshotIpeQYU+BzV+SnsL8qSoWq4p9M26lu6jIvdJdXOz+l2fwlf3H5j10cu2kCT989/vSW3Mq4+Sfx9YFhMdaOeHLCas33bbrd82l8HB89Oh4YvRo6WT3fYubq/ctmb9Q4CqSdnPTiyuXSZ80ZPbYwcqijHx/Y2lTOxA83Xz06MqK+ZXX3n3luKTLRps5fTRkXnYNk6lkedaoFwCXp0Hz8+fusvb+MryNChItgWQ435LoU6C15jgwRGXBRLm/3G/xWxKWxIA9t9s5addvNBvbZ91ww6xpNfMX377/5Mn99/4STF6yZCn6B0yDWAi4Jt9zzcjJN790c/Wc2Vi/4o01S0nG1YO5Azw3BHPjZZig1GFqRYMcb/QbozkngRjBRl4xI5sLiEw5quwH94yQPhx/z2v760f2HOkZWf/cnbNm6V5Mtk1SX2e2hxjq3FOlumR1qfQDdpJteVNnT09n03JbU7EeRkwQ+8rE4/QYgtPBot44gZpK3UZRpngKdQ42yoZlkLh6EIWoPnrgN8axzwCy+Y0xybBdNZmKQ9aE0Y/d0qFM2KQBTWYpNwajJlwNQ8qTJ2t54USHPVghLgD0Td5YdRl1G3Rwc9ix+h3tXm+7l1OqKu1xf1TcOPZseyWoelSsCo5UT23Yu5v1ahw6iwJELls+Kla5zNhSbvZCVX5Rk4e/pnvanoZ5hyZX/trpKNpa/LwNya6GdrNrkToJKFIsUITs0ijH0ub86enCjQ0111yxrFQ6Jd1FFLPu1TW4qgtrMoFVszo6Zh3yZ8pS/oQDsd6z7CHQk8lkOG2LL1OYtN7QxXQPPdz0mloNYMPe7EmApDu1Qvrtspi5opKLm9JWVWFmdB6kHh/Z+GX+uPwEjJ+w0gmPMCkvcL2+oQUVhbXR7aEhY1WljZrySsanDjfFgD1kh/vtIV2TM2l1qisqNMaAvdwzxBAaoHMRJFzEBQYojcRSrGcLragBRRCQtROwiZaO9uFtrHCIk5UYWDcTr6N5qqvhm0xDl1pRZ2luXn/vUnZ6aXtVe3wqt/Te9c3NljqFOvsrwHeoaUVIYVf/cTnbVYaul3WxT+9R21Eare4AvKo9PqqtpW1MaQe98lyUQLK8oVfyaWNV+bR17czw/GDQ18y2r5tWXmVM88rs/T+tVdjUSVToA2NofDV/OL31clRWUm1T1P5UUeMrEcWYt36g3mMZ1Y4lcJDzAaOji7Cil+zJIocI6KarIVaBD6RTomBELxuM4lxkqx21wcUIBBj+vA7fhFsMolZ5IaygVUWHrmTChaNbggAEW0YVh9i1h8KoskGFQ931FttWPDQPgLyhxW0sgGm1/aUpQzuld+j2wmac3FzYTr/7i6pyHY+NBImbD9zAkSvAS1wg2taKy2xtiwaKTp+eFIHLEuiNfVfNoL3euNUaz/cw065yk7ZhlCMOMnUen89Tx7xUrKCzIXr/2IqWP8AGt9/vboD37SuLa/hzGPqVfuQcsaal91eEVoIZrNtflpdX5ncHHj7SgcmFUlOW8xT7ST/7DjvlpnxUCMmicWo1IiNrDFUrzAIrHQZBGoUxNKdy2EYbsHQQpHkrSU6HeaKHkdbDMI9NWWNYo53l/KHycIgO1QPsZFc+poNxKytaBGLobbFiHxtpbMuKXW1gQRbdDFpe8b0HTMCklt6SznxY+hViImt10n5w43Q4D0Jm1Hg+Ww+oJuljZq7+DzB7CqwSpMn0XebT8BYO8gC6HzMLwxTMn3l+Js9I7zNQ8RGThnxtFxgOFV1bYDdUgkdZGtRyZm71lSy7juXG0exrHPsVA/Vm5qcceOcvb0uJE1+9C7a+DYb9Knv6HdD0snSw/bPRQK+kk80c3Psy+PUjZx/78z2fwxUvgKcOnnvm45sWTGfYNVM/6Pkov2wVSz/DsmMPsPSfIQRfMMDIM8EJHJjOsyWzFeANFb0N3MmwUhlP146H3BUtDFOxlKOvpOltDLdyG83CO9n+PJwLjfzjyaop7dcxWPDzyauhiGzpC8yKJeciYSDm1gXnCQPOmEfVntL2BJd2J2LRWMKd5hLtpR71uFqYqR33yJ3v3In+4AaTrrur4WyGIGYcbegiJhvdfUdQWDl7zrASJt+Qp1LlGfKZkmFzZleOmDED7l58xx2LF91xhzT6qM50Et/OEtiNk0TTuyd3zO0nkHdUUkXUZGoBsZ/LaYGgEYvpfR3ER1UDNxuvYy7xLn3OIS56c8tFGG2M/Gql0RExr4Ef3ZA92jBa6SwZU87ycUuJKxKKuEoscfiYoO0mAMq544BW0ArnKUF7lqCKMKg30xvQqy5Cryw96asdMXlkpHHevMbSzoVtScajtirRP6vaAxjU7Qkys3zs3yq4MJa49sFWw0IOJ0ch8yT5aIwbQo2hVmF74SjsowBI3gj2gmXn4FJ6/XEbvyMuTw/lstMAYurWe9Zv5YgyRdoruVJHcWFhYbGjlKtsj5haUpBKjd3yky1bfsL4+qvSW/TZl/UWix5W6C0DVOzRbCLt7++AQyLQLBx6dzC+Z9GsSsapNyuVZr2TqZy1qGc8rMeFb5H+0OeAApgqcMn4ANQXUkfhb9KfJuXv0yVjA17cfluIJIGJBFvikakuHXcD2LufPMgfR/l3xIUBtHUJFwuXwLVhqJaURKVaLm7Y6/+DJkUU9HVGxvru758BQ36jmeXrTF/zvt0z/qIWBs+Q5s129zXkmb7W/aov7VycIQSJqbx/EwvMqxd6QtfAuRhraBSgeYM4IfXJILmER5adSlKGXs+MvSqOX4qb1zQ+9dpTjWs2iwtBC7gStFyb0zaGp276THr8iSMDFAZ/vvtVQ8vYsS2GV3fv+uEP4WEZDfwUSEm3ST/+6yDFwgv1MlABqpjYaogmi/mCmiV2DJlzHmgxW00J0ZuOh3KVha/IJd2IFSR3SI9/hspkltx+Qa3x9obPN4PFmz9/IFdhjsK6lEd+jCp8801/Ba3k9rPDXv3mblnXUvro7m9eBcN6eg7kaj0Qj8UjW9uAAUNeuleBwWKmSK1SBgFvBcAw5w1zZBeReTQ2flpD8cs3nnvwxpeLG6aNj40ec92zx5+9bgySOGRd7KJJG/fsvFW6+tadezZOgp/rSmdueXPzXe+/f9fmN7fMLNVt3Dkf5UY3zd8JhdzLfHPq5rmfATO/aRMv/eWzuTf3+ZtmZX8LNsqP9Xr79SYxPqArYYPaSwA79aFpDugKYyra32uvGBPZvu25bdueAwfOodGVlrmkc4TWMJkfxfSNSHpCz4QJPYtnV7a2Vs4GTxFSPruf7f4GIzmxr36T6R1WcyMChnnvHQuwbkkRVU21Up3UHDyekn1IJL7L29W4ut82nA6OB/vGS/mNLhpe+1Dj8wfv3fbofU2xzJOZWJNPX18MHiyu7yGqMMxy1MVJ/4PoXaWeXuNIQJyu5EykZJOpvlwYTNOk+xrF+YEdvyk1bWI8k4lPnJZKt7WBg0TXRjp5Yezs8+fS79AvESwl7de/q/+rduwj2RwhwG8bU4OD4uwgDdiLx9hLExJux/piaUJxfb/W/O+3Y8/XiOS4o4OHzybUdum+lgTvkFbMXnCM9MUlGvFC2rnDTPdZTJYDh0xIfJSdRv3ITtAZDdDvg0aDCTs5ZIiSM1mZAgkRb4AjQYaTvdli546ySSReOUogXuaPb5z64PjxD1oqRV+qfEQkml+24KFrDjU2gq2rkLgy4sapw9ZMbcifsXiX9OHvtm37ALhuX/fJsTsnHLguNq2qtgF+isSjSukl6UXpZ9IvjEU1zUUuw4zOxXNul7Y42pd2Dgm1dKQdl/8CRB54EBS9cvnwG579+trnpJ8vah7R2jsezFFS7G7KiySGO6mfEhtPojaFXkcgyxC5RXoD0fkP9lm+ku9nvqAm0etVD3VCsqSGsvRXiLCQzX9ZexJvlhLEGKIzQZbY8MIHYzW7WaJZEiJxwBou6A+kU0aCL4TtTWVnmEiC+bnXAjT1s069HV4eErz1M8vWXBGfAG06s5Kt97vOHrOH/C6m0h56t9E2OWxQ84ZQFKUYaX2RtYFWaatElqG9oVR5qNAVNwBg4hxr7igb1lxmczmESLwmUhN2GhQcrVBpjCqrs0DlaBheC9+8TqgaNc5rcFeNVj4RSVYtgKJaUCu8QvOVM7s1cI4ln9ZvBE6wHYwHxsQCh+Con9tx7Bvpj2+Mn0TbDTZxgyscsqMfHLF1VmiMWaXhlIXx8dGRqUJWE9OK9pH6Kr3NYqsEDANL3cG6aLQuOLOuyMyykDaoi55fn163ZPGaZHmk1KDUmF1CItGSKcX+pCyi2mm1jTM3j9y/TTrzX972abUeg37YWPUfQMnm44vWLKEtGqvRrBTyH9gsffRwYf/1hjwy6wupEI+EOBG7qbKKPKgEfBx7gLnICPvencqw99x+l8WQ9zsILGpeLc1AFLL4ZAYuvoQ9wn/BHxeHNNJjaqeNHwoadQpWJV37kTj/3gDcfSmDAq7Pt5OW7CQnCA4qldP9S6WNCaMbWDGKomw4SEjMm0qbiZ/wNDGEtBhFIWd5g38QjyzNVT1VzT1NNei0pukZoHqmR1by6yHnPUfJP2z8XjPbQl9/bpVldk3b1hKawklZqmRr25ZnntnylPQ14J86shkew7Fs5WZwnWxcQwxs/p+oO7w++/9t3cH10v9K3csTlv/1ul9//X+n5v3rriTzslz7vrqjueQ/rzf6+3dqPXrFitH/cY0NfRhMeKUJe6tvpkZRE6guai61lFpNXUltpW6idlF7ZY8XoNdXYBSkZWy5fGPOkUpKtGLsTJhzSc3k7IBSvfHeMCmnBAanD87/Lff33scNCtk7VarsTSq7qkOlKh4uVLTMXbjrPIUZ6YXPDet6raMYXcqXFXWnkEBW5M3el1PelTWCqQGJ/TNKJ/pHchlkC+Qp/Y4sj56D6oGqYVcVd/5p1rBdC88iRh1z9R0tYdeQYpVKOkTum3LRMUmK6PmWqycuSgldlIItW/t89QWpEoKYOpRqozYieftG6nZqD3Uv9Qj1Y+pZ7MEX73j1sXzEUL0vhv6oQdreoVwoDoqHLsFdVoMcHp5IlhXRBMQhuulPNnGR+pZyvq38wem9ca5Hdo5YPyRLDakXtNhxM8yYnCaTs4Mco+S4o9+5fGQ6ZG4dSSa7Fi4eGZ0fEdXqQrVaeokEYkDpDCbKWzG+47mei+5+41+myE8DR48+sOoF/ITVorjUaLUan171wFHwA3zNFO13NF2Uku0TD2DPwl2jBJ13YOWil8f9GHPJBI5edG/Hv0yR/wjPiHUdKVZC42yGGk6tkHW8eCTOElbOC8wYNgGrvuL/2H15APFxhGPDPCRe5kfcH1YXTQXSKSTP95lVmGVfedhbHsCKxYS7JPZgeEspSvZfU25AnzZ68kTpnJjnMYKj0C397T0FRl1gIFDse/6I9PKPN5w+MB2An+3jIU0DBQR6xW2n1yn41T8F9M33gNj7m7OnNz+9efPT4OCiaQrE21h5VVXDqpdWbDmqVTUOUfF5LDQopi+C9DUfXH3LP28FkyYse3fmlCkz31068X5AfS5tmEBrlKUmr15JjwHxJx8HJfer+MWP/HHjk9Lro2mlJU8Z0yg1TNXvQdmhmwH7/HqlasVx6f0gfubm89T6t4dxClWyQKVK7ehY9vQMjf5nW6beX6NSRZJKBddyYuPm09dy/Na/5nyTy3bFApoPCJr7IJRlNEycRd9D3o2Q5WYMP9zdX14BcjkA2y1Sg+U3fsC9J8lyZm5hiO7z70BTGjTWUxFgjEA0esvrsjkUrgvV6asTTWURoWAoeCTv0+gB2f29CwGwW3ZyDlGm8+gKpHC6vJ6I0/EKQVefX3b87tjXLhVMp5IxQA4hnx6EQ2QvEjspzKGgWJHEP3il7ns7VKqPP1apdqBhFYV21aA4vKz/q7/7bdlycUbo36Z0v/rJ6z7/tl/cQbX8GD/ngQfk56BQNSh+TnvxJwYPXDpvX1x6laG6B8qsvWM8oSUMhH0RWx8F8exK6TW2+xI8PJgLk9lfgeOX4td5UjYkuh/YF2uUaqR+Rr2FrUx06LXrAMvJpnHYTs7a10Ryw4R7r4nmIOnmBLUH9XghRbYA+TrgAakw3vDEciDe6UQX0TiC9czSobCPaFdhWRNbn3DoAr6OcS7RcINRcvBGdjoK+TomIRI9GVG+zorWUFjHoAEmZSI6ptiifTD2CKvWF2jUuqRBmqKw8goFb1Xwe/0avzak0cjBOpzEK0QDuN63MxWKMi1tmRAUeYHT0SzNv0hbvT6uYNJQoVCjgQEO0HRRBadaOK5msdPNBxKekgk6Z41BGw8LUa1Wqyop00LIg6DbJvrn+PKnHDEAlV5vKSqMDBeg0mu0VuR5LFqdgi9YyAKnVsu4RY+gh0o/FG2Fgk4rlLz0hGfCakds0fz68N/Rh3wMfbHHyBdrQ1+s7XMmYDQWmIxs4C2FQiHiVxI7/FptSOvT+jWasMa/GqcrFAZxSqYo5GybOcHsDkALZ1FZ9KI5TzKZXTqzaljaoFUDUFJijqhUeR3xcVtUfKIsMbslpWcyFYtXWtRCnh2AuBPd5GJo5/Try3WiYUks6ntimEGtMdmqRKNQ64acErB6lgd8JFg+t3Te5a5CjuPjkfrqxgZ3yp7nToWKvWrbYaDsTm6qmDZ+LA3BukvaoIO+dViMEGgUiX15PUjQgp9oJOYWoeoYlMZBjFTjz2fL8XcXTGHsl6qczY+nMWHg/HjdD3LzHg0Gm0qM+fN0/DyXviY1UfrHxClgjr+sNhYvNE2bzCXYHZ+UFGdvkLZvaiwDCloNY02bwFr43PWfcAaGneb1TGjO/tapZ0dkVwCWpmHJ8Juk56TnNzXFgSL71qhWRm0L1xW+F5Q6alkOaObatKVpuBns+LI2qs2bq3E0ZadN3bBulTG3H0J0XIxUMVWKeO6xuZU7JA/oGL8x7qadgMURSBSua2jMYpPEhNEP0I8Phf1IhBMSAou6C+vz+4qAMZ4QU+EQWy7bc5SjDOlL2qvcBQBkFTqlEknvENQAwKgVSpahGY7lFCwNzn6wfj04vHCf06zZu6hkZBF4gKUNJq8lYrQomE5z4IEKGoBaRu9zRT2rlvLuWNz7eP8tOfjhEUZUGHgFDcqhgjaw4qx1wKrQc0rVbqji1RwGGODUrO4MeE8qAO/97rYRKKiQXgb1ukarwWbQsDRKSOyu27fF5fXrfXdJBe5ALW0atNfBUqXnoaKV/Sea0SyUHbXizSgxFCZey0QKDysxrNMv4NEE4M0FoqrJ19HY8wUfwkpfEI1psF5GIuDR+4exgTUePNBN2NKN58Kc30vRvpCfwzAEojVKx0AU5YPWHGOEh7IAg7giZg3HaqNXLlrlMe5tAB3StPttXpoZF2TXF/mK3ez+DW9KH+zbKf1toVtfc9/3tkUK8guUDH3lLw+ub2b0Fb4rvn781mBQ9NsZXflxKbvtSOS67RvD4ZvXvnimRWdv/v3rpb7hnYEgRstpAYikjf4gGjyiwxbFXTRkKwsayhI+hVB/MAPVYyPbnOV6n3cv8IPKXb89/XNAK9yzlzw0kfa9Lb0Dq50jn0iVd9w0BJZmxkVFae8BEHhr44LuqrmJIRaOoYErGFSpLQ1tNYEVX1ZxkYYmW55BKdhm5M0ImpnuA9OGqDXW0CywASi3tR2XPrksX21X0WAK0IL4xgWddrumOXTtzZsLC6FFb89zODQqT43Ce/uNrxy8bJbTp2+pCY26TGpG3y94XsO9x/6NsqJekKEmEo9TqVA4B42GFT74FNBBJoC5zDo6zdmBBiBWkzdDM/EhRDZkABsFxegCB60hWMcQfHk6RYWxXyU3o6PRB2drXcMmVG2bY9Lo/VZPlSNQXxTMM2vVKrAi+fxfpC+kbz5/fB4L9KoQk5j/BRgHusGUy83wyzHbf3L8J9vHyAFYPuSP0qfSL6X3JelIu7uMHXnTs6c++/vp11rzq2o00rv/VEBo3/jG9m6Ldfatp7YvfubATPh58UOVYZfZYVWxNKNXaYPBgkB+nhZkf7np6Rl5ic1HgfWeyMTIWu1xaask3aU5cI9Dy0DP8efwJtBzcsDtPD5LMebRv0v3HDsASv72xvfmRKzj77ksfpN01d/ApCYWlTz1tmd//fpPdkyG7tk7Xpf1ScgYQ/YB8RpKPdHpXkZtQn1kH/VDihIsfh/2UIl4R+y5MvE/jQ/mhdBYVkR+5dgFaCJe/j+MH11uKDWgv+XfETI/qig4dxT7TKUzBRWIMfruW0gIqB6DweBFv3/3bP83GfwYFj/srAKnoCuff0co6xDG0Pw2Bn2bWzCvKdvhxrA0FQrTQaMVa9+EYoDYndTia8TFipGldViE7lX1I/gpVrYEsMTqoDfFg43NRKvAGmWAlRx0sQtbp7mx2ZkR+z4W9UC27tUD8jg0zQS1IIgtfzn3oaetWq0ubn06rY0P086V/nrcAPPyI4bloWRouSGSnwcNx6W/ztUOi2vTT1vjOq3W+vQhl11Z6AIpAgz5CqN0+Bi7AxdkT4q5coD+EuUA/aByHHbG51Ay0isE0zLlKlTawcH8RdqEFVVq4f5QQhUExXdLx86YCj2CwtTzDtYFfKfHpBA8haYzoPJu6a2gKhHavxCVZk1oF+Vz0Vg+V7dnTx0IFBeyuKSoTicXJL11N6i8dEHSsbtB8cCC2MLiAMAFcfmxaK/NjMyHm7BEBTCTiycVDs8qAZMSiCY0hzA8C0JYRkbjVoB9nm/fcXzV5e/fu4BHZ79etRuYHwbDpINr16nUR6S3jpyzgU5yDkqOHIJ3wemrf3NgDs+Puvn1VeRMuZ06z9RK96ySXrnvCenlY7ZrQOflIH3fk6DimE2cJK8/5vD/dKheIqpZivigUwO/EE5becS8lAArHw6iH/NdcH2PH0z88KGyx0ZZPrdIQ0Hp1dJxcOLzeZ+BTT/teA7W4glNekH64M0NG94EPkRtvjf/cil545z0BOiSvg9W55fNjcMFqJSr18z7bO6UMc+N6SJ3behfElxzCa4QyaznAT+FPU9NomZSi6k11FXUQ9QT1AvUq9R71EfUGfSO2AanDoRlSGEaW+KgeRqLGLTs7wqbPXNEhCBSglWUVyVSZDHCGifzPZ51UowoL1/UASDqADkRqdy6Bda3E0mXxAqMIroljLPk1juiMJXG3Y7glaYQk4HYYpArTb6BlEdgjXCyXAzoe57YP3NYzoFS2RQTS5bQ7MgWVjevxE0zkKd5lsc+0NUKtZpzBxzAoLRo1Cl3ZKHVEA8WiWOa3RETfwvLeXQODs4EXKLZzIxt58wWFwM38Zp4mbGpNX5uCGfQ62w0bXDCiRreF9Go0SFrCdSjSdxkQkeWETQVQ0Iah3PINUPLF09ZYr5qb60GzPvbsDg9dk1hqC7AlC9s8m7d9+iw4dvXTYpxyWaL9+xKndIslGnJ8WHG5HMytGAwOpl7GYtZ8CksZnN+drFB73TUGgz6VB38hjHo9bgaqDI/0StFMeVWFZeDaJ4Z5NljTz0angOBEUJAA5qhoZZVsRwNWIMV6HkkYzm0pmih88YNt4Chsxloz9eCVQq1jteHTF+qQ0FrSHH/PqULhAzS187y2XlKLe253y0/zM5JJ4yRPIURH+hUSiOYMnaHxiRkgbMxpKloMAsamFkhfT2ynm7vYtNKMKxk/ohO3YqbD1TVbF85Vjn+ykpr2sIPmb5thKGjex5cbi7TobcmR1RBl0IwotdmhHPVZh/DWAp8LGOlFzrq0Ws7nHU+Q3ac3sbQRp3ejupzWkwZ9KrilFf1fwBUC+G2AAAAeJxjYGRgYGBhPD3hfEVkPL/NVwZudgYQuGJ81ghG////n4GTkQ3E5WBgYgDqAABkIwvXAHicY2BkYGBj+M/AwMDJ8B8IOBkZgCLIgGkrAHsKBc4AeJyNVktrFEEQrnn0PIybLIYVNQRWSUyULIqo6EXmsB69iB4MiCLiRSKCJ3Nq/Bn+D8Gjv0q8rVUzVT3ftJOsSz6qu7q63tWTzNNn4l/6kij5RVTSf+F1wbTwPU/WAid7PzxjfHWePplMYXcYruNdK3TPd++ZzBjkXt7pbkQu031r2/d61YcLzvwEmRzsr41VfcmppxhvOeSdOvQdzouUEvblO+P4rNhG0KieB4Ky50+cD7k7xdxYDhRTF9VC5Y5beIijy2UjMlWUb8sD2KfMQx76moS4kZqvrj8/4py8CTmyWHp7EneKPp8JTzON20W1nyr9wvxEZfK4lxhbA7897ZSWd0WtOnOtZeqpSTVvxsOeUt2H2Eecr8TyhT1TQvxQuwZzEs58Vx+NK/jIuhaMCdfgmYB9WzDC3mzkXY0xVsv1sKejfoHZtLNG52/C+4XeTdnH1HKi9K3kifGO7zsByyeF+sLyE5tPXmdM98bqrXm5aLNvvMQP8v3Q+Gw3E6ybL6jd/ewb04xyp3EzfQQ9dkPA/BaFwUOvE+1ID0Y9vBHHoXaX7Qzxn0DzafNscuEu+3KkNLxDpfK0DvPSr1b4prLsbGRWwqyKTAX+W71l9utO/gTf6TBX1L8P5W+6Fc+T+mlvcxtXjXd6Oq16/tzqUa+pWYQD81n9nzO2wcZS/XnM60sghz4/4fMrI+9CjKuM93z+Sv2+rXpqpge1+h6D5TYF+F1AvVVELb9Qh3bNPm7gu4x1wDuDtdZX99sF6NQeT62v4L1NZUZZvtCzlNftXNhsQJ2DriryIe6J6g+9qHU/lifrbYy7gPOSzu8NzCfmsvwxOAv9yPY+tHd/9vpD/MOaXGa5Taa7Y32h7/h+Nc5/Hvn3FGzNzReIbW8sLtV9nfcfWe+h8rNyqFvWS51/6cfMZlz1B3m3ov1Cv0cO7Xnawh6xb5We79dDW7Oov/7pDeDv2t18BPC/RRLPRUAKve7pruRcfbwTZDzdFHre7y/1CnzxeJyllntUz2ccx9/P404uuYYQGmnNQpFkihBiIeMQi7kzs2mbTYaJZYwk17k0l61NyD3kHic0cg+5h5BpriHsZf/4f+uc9/n+vs/zubzf78/zfU7Sv38e/wExkqkIFkg2AmRIhYJBnlQ4VCrqCq5IxUdKJcYC9kuyXsoNnJIcoqTSA6UyCVJZ3svx7khZx8VSeXIq0KNCplRxIiiQKtGvspdUpZzkRJ5TulR1tFQtCMRJ1ennzHoN8moWB3CqRS+XGQBOteOlOp5SXRfJlRhXuNULlOpnS270bAA3d/LcU5BHD49H0nv0b+gPeL4fDtjzRLPnSqkRPRvDqQk9veDlxbs3tb3h650sNeV30zBATjM4NkOnjwOgjs8mqTleNefpOxTkSi32SH7oaQk+8APwasVeK3r7k+9PnQD4B1C7dS+QL7Whdxu4B1IrkPi27LXjvT1x7bOkIOp2QH9HH6lTohRMTGdyuqC/Czy74PuHSVIInELg1xUdXfGpGzy7MYPuxHVnvqHs96BmT3zsRd3e+NQHX/pQOwyuYXDpS1w//O5Hj4+pEY6OAeQPwMeBhQFcBoUAzsHgVGkINYfQcxjch6F9OLMYQd8RcBoJt0+pP4r8z9gfzdn4HM+/oPcYzlIE84kg90tyxlEnknMTiT/jWR9P3HfR0gTmMZG1SU4AnpPxMIrZRVF/CrlT4DkVjT/QJxru0+AwnfwZadJPxM9kbxY5Mcwxhr3ZnI9Y+MWyFgufWNZiOZdz6D+HnDg0xlErDo/mwn8e53E+81/ArBY6S4vguoj5/EyvxfizhHpL2VuKd8uYWTz7v+DPcjQvR8MKZrYCniuZ1yrqJHDWVuN7IrUS8XIN72typLX0WofGdcwxCW5JnOv1eLSe72MD3DfwHWyA30Z6bWQWm5jLZvzaTN0t1NqCH1s5h1vhnUzeNuK3wWl7+lvsgEcKmneibxc6d1NvDzPchx/78Go//FLplYrfB/DwADoP4n8aZyYNPofodYg6h6lzBL5HWEuHy5/EHKXnUXQcg38GtY6j/zjzO4HWEzxP0uMk6yfRfApPTrN/Gr/O4PsZ8s4yp0x0Z6LhHGvn4HUeb8/D4QK+XKBHFryz4HyR2IvovISWy+xd5pu4AuerrF/Dl+touM65yIbjDeJvMuNbxN2idw7rt/kW74C7IBff7nGW/+JM3mfvAb48RNMjch/zHT3BhyfwfEp+Pt7nU+sZZ+I5vV7Qs4BvpQCOL9H3Et4v4f8Kza9Ye11cRhVlimySKfpIpli+TPEMmRIDZUqWAwtkSjnJOBQGK2VKe8iU4SouGy3jyG/HeJny6TIVfEA213SMTCU3QGzlXqBApsoeGacomapjZapFylQPlXE+JVPDH/CsSU4t6tdiz4W82sTXIbYu3OqOlHFlz5Ue9YfKuOXIuAfLeFCjIc9GEQDeja/INPEESTJeCTLerDclppmrDHehaR4k44se3zyZFvTzg49fpkwrOPo7ywTQs3WaTBsQuFimLfHtQPvRMkE8O8CnowtAYyc4B6O7M750QUMI4C4z3eDQPVAmlLgecPsoHBDbkx69vAAxvdHSG+/64G8f4sPQ3Bce/dgLj5PpT6/+KTID4PkJeQMTZQahZTDah2TJDGVOw8JkhsNnFBpG03sMdb5C29dwH4u2b6j/7QyZceRE8hyPPu4qM4G8CcxzAjOeiK+TqPs98ZPhNpn9KPKn4N9UfkezN43cH5nr9DeA30w0zcTbWfgaQ7/ZnJs55MfxnIuuucx6HrXnE7sQXYuot5i4JcxxCRqXsrYMz5Yxw/hUmeXMZQW9V6JlFX1/nSjzGz0S4MsdZBJy3+J3vPiDc7Uab1dzFhLxZQ1c1vK+Fr3r6L+O9yT8SOJ9Cx5uRWMy3nDPmO3sb8ffHZyHHehLgVMKfXfSb9cbsLabWnvwfy8c98JvPzn7mXcqeg6g+SD9D8IlDd6HwGH6HGEvHc1H4XyM+hn0PM5sTzCrkyGAvdPM6Qy9znKWzuJRJuf1PPwvUDMLXKQWd4W5RL3LcLmKD9fIy4bHDfZu+sncgtct9OXAP4czdZs+d+h5h9934ZiLj7nJgNr3qHUffffRlIeGPPz6G20P4POQvIf4/5i6T/h+n3Dun8LtKT7lw+8Za895f4FnBcQUoIV7w7zkLLyix5v74nWGrPGStc6yhTxkCw+VLXJKtliIbAnWS/Lb4Yps6TzZsk6y5VhzzJat4CdbkfhKgP+vbBVPWScf2aqustWiZavzu8Ym2ZqhIF3WJVK2NrXrJMq6Bsu+Q3y9INn6xLo9km0wQ9adNfcs2XfjZD14NqRWw1xZT9AoSraxPyiQbZIs6xUh681+U3Kbu8j6ku8L1xYOgJp+biBTtiXcWhHvv0A2AB1t4mUDqdGO96BwQH4H+AWn/B/8A2W9n3QAAHicY2BkYGA6zCTJoM4AAkxAzAiEDAwOYD4DAB0oAU0AeJyVk99qE0EUxr/dpE1rpGDRUryQQUTBi920lBaCN9s/6U1oYgilV+o2O0mWJrthdpKQa19A8AXEKx9AvBe89FUEH8FvJ2MTsUJNSOY3Z+b8+c7ZBbDtPIWD+cfHG8sOyvhk2UUJ3ywXcA8/LRdRdh5aXsGmU7e8SvvUcgkv3WeW13DXfW95HXfcL5bLeOD+sLyBR4WAWZziOnevTMacHWzhnWWXtz5bLuAxvlsuYstxLa/gCXXNeZX215ZL+Oi8tbyGbXdmeR333Q+Wy3jufrW8gReFAo6QYoQZFGL00IeGwDFCTCBJp6QEEc8FdlHBDvbhkQMM+BVLXpnZSa6Sa+4d8SaO0tFMxb2+FsfhRIrTMIlmYreys++JYDAQ5igTSmZSTWREhxrrSRgvwNRESzHkilqa6GAqs3TITYuWHsasIGQutGRvPAhV7tvAGdqo0/sQVe7atJ3gAk1yizvUGmftenBYbbRrJxfNRqt9u4znRlVGtfldgT1qO+CvstQXnEuVxWki9rwDr2JE3i54k0IkpWSm5XkTuyadoF9q/vvm5KZR5T4d0u/CulzVkk/X5s8tijkiWoembVe0hbRqE++S7VxESbjmu46pmVNpDmSYSc6pK5XQqdB9KRajzWRH58K7qTInXaoTWoWRHIbqSoRaq/hybK4kqY47MrODVqayv3qjtLhuzk3PIhbPEkwfNPtS5SvuX+sN/4jpGWXoaz2q+n5eXjiP78Xp/0TwOal5VxLTef8fMf0BRSaZ9PELz4vYEXicfVcFdOPIsnVVmWInGVimt8yU2JacLE9gmZm9st22NZYtjSAwy8zMzMyPmfYxv33MzLCPmaqk9kzm/HN+TtIk3b7dfW9XKSlM/b8/+BoXkMIUpW5KXZ+6LnVj6pbUrakbUrelbgYEgjRkIAs5yMMQFKAIwzACo7AMlsMKWAkbwcawCWwKm8HmsAVsCVvB1rANvAm2he1ge9gBdoSdYGfYBXaF3WB32AP2hL1gb9gH9oUxGIcSlKECBphQhQmYhP1gfzgADoSD4GA4BFbBFEzDDMzCoXAYHA5HwJFwFBwNx8CxcBwcDyfAiXASnAynwKlwGpwOZ8CZcBacDefAuVCD88CCemo09UZqBBrQBAUtaEMHbFgNXXCgB31wwYM14EMAIUQwB/OwAIuwFs6HC+BCuAguhkvgUrgMLocr4Eq4Cq6Ga+BauA6uhxvgRrgJboZb4Fa4DW6HO+BOuAvuhnvgXrgP7ocH4EF4CB6GR+BReAwehyfgSXgKnoZn4Fl4Dp6HF+BFeAlehlfgVXgzvAXeCm+Dt8M74J3wLng3vAfeC++D98MH4IPwIfgwvAYfgY/Cx+Dj8An4JHwKPg2fgc/C5+Dz8AX4IrwOX4Ivw1fgq/A1+Dp8A74J34Jvw3fgu/A9+D78AH4IP4Ifw0/gp/Az+Dn8An4Jv4Jfw2/gt/AG/A5+D3+AP8Kf4M/wF/gr/A3+Dv+Af8K/4N/wH/gvphAQkTCNGcxiDvOpHXAIC1jEYRzBUVyGy3EFrsSNcGPcBDfFzXBz3AK3xK1wa9wG34Tb4na4Pe6AO+JOuDPugrvibrg77oF74l64N+6D++IYjmMJy1hBA02s4gRO4n64Px6AB+JBeDAegqtwCqdxBmfxUDwMD8cj8Eg8Co/GY/BYPA6PxxPwRDwp9TqejKfgqXgano5n4Jl4Fp6N5+C5WMPz0MI6NrCJClvYxg7auBq76GAP++iih2vQxwBDjHAO53EBF3Etno8X4IV4EV6Ml+CleBlejlfglXgVXo3X4LV4HV6PN+CNeBPejLfgrXgb3o534J14F96N9+C9eB/ejw/gg/gQPoyP4KP4GD6OT+CT+BQ+jc/gs/gcPo8v4Iv4Er6Mr+Cr+GZ8C74V34Zvx3fgO/Fd+G58D74X34fvxw/gB/FD+GF8DT+CH8WP4cfxE/hJ/BR+Gj+Dn8XP4efxC/hFfB2/hF/Gr+BX8Wv4dfwGfhO/hd/G7+B38Xv4ffwB/hB/hD/Gn+BP8Wf4c/wF/hJ/hb/G3+Bv8Q38Hf4e/4B/xD/hn/Ev+Ff8G/4d/4H/xH/hv/E/+F9KERASUZoylKUc5WmIClSkYRqhUVpGy2kFraSNaGPahDalzWhz2oK2pK1oa9qG3kTb0na0Pe1AO9JOtDPtQrvSbrQ77UF70l60N+1D+9IYjVOJylQhg0yq0gRN0n60Px1AB9JBdDAdQqtoiqZphmbpUDqMDqcj6Eg6io6mY+hYOo6OpxPoRDqJTqZT6FQ6jU6nM+hMOovOpnPoXKrReWRRnRrUJEUtalOHbFpNXXKoR31yyaM15FNAIUU0R/O0QIu0ls6nC+hCuogupkvoUrqMLqcr6Eq6iq6ma+hauo6upxvoRrqJbqZb6Fa6jW6nO+hOuovupnvoXrqP7qcH6EF6iB6mR+hReowepyfoSXqKnqZn6Fl6jp6nF+hFeoleplfo1dQdmbZjBUGmFwV2Ixsoy2908qo/pxzXU5kO98N0EFp+QYqa6nnhYjoKlJ9u2U4vH3ZqjuW3FYadnLTtIES3m/VVz51TubWu26vZ/Xxcu1FIbquVDex233Ko4bYzoW8FnXTH7ak8z6ZqlhOmQ7un0r5rNYeb7nzf4YYM5wedbORJlbH7dXeh6DnWYq1h+w1HMaenrDDnq5avgk5elhJP6LiNbrrlWO0Cb6bpddy+CgpzrhP1VI3XU9RNIRjS7cjLrvEbblPl6lZcU2i10/wXpOuu281L0bP8bsbz7X6YbVg95VvpltsP+bnTzNqh5diNYqgWwlpH2e1OWIjb83Yz7BT4Wbtfc1QrHE6aDdUPlV9MOr68PpK0V0dBaLcW07KXot1v8nsJTrfjd0dbVkPJqdXm7KZyc57dCCNfZT3Vb9hOoWd5NVmr8rNWUybkE+Z1qqYdZoKO5atMo6P4hESwkSBUXq1uNbrzlt8caVl8hINeftBIy6FnPItNwMZwvVzL9WV8OH590Iln0p2MWq0a4TDzzPlusvORQSfewpDnREFNjFHo2X3dLCYmits5txvXI2sixUfCOOkN2f2Wm8CChq9UP+i44YiGJa4YYmDSKtSt/qBp+b47H6+jmDTjVeSTduTp57Ej4iMSH/FyAnutqrUixxnW7aBnOc5ytdBwrJ61blnptt1i2ymrxXfEV3m1yEZjNYak0XDcQA3zqfTtfjt+PcPn2Vf5huWoftPys77Vb7q9XMPt9VjjbM9q91VYGJxX5K07R1kf2z2cVyoc4a17nkzZ4As73GIXKj8hK+qOLGGZXvic8kObGVfofsf17bVsX8sZYsfXGh2ZJJy3Q/ZlcvBiMrF93BtOHF9jct+lrlpM820O8nrJwUjYiXr1gNcqB7dM92S50h+KA0nHclrFOLokMSUn83KIGHHsfpfNmRxlzouCDm9rhG+P8jls1ORxHELsfpbJvc5isW0zQz3xQRIdhCbjsA/4cOW+F2OLJ0Sjg8ubdAvxCwmZ3nB+sNdsMnM26ksMKbLF+NLIATfJDwLqNPlSsBv48PrpunKcYkOOtcUHG6pCh2XU7o6b4rZc3Iq8ZEQOZEXiyNp6R67cYCSeYNkGQ5G3IUim4Rju1lV23uc738mEVtANshxReTNDdd9WrYYVqII4N7knmbbvRl5azjLDHoma2bqyOEJQIwpZSo9PxfJi/9heOrDmVEHOp1Zno3bZca7PfsLIQdfhiOHbXRV2eMJ2ZyjiuOTztIrXUHdUhs1rNzjMR43uEMvI6+HrO7quFR/78rbrtnk362JAcclAhjVUiwU+cxXGO80nTb6kSSO+xEkzPiu+NxzC+0E6cH22GhfJPYlbfHkGmS1OKgOvpXndLhumzf5vckqqu6xxUdtZ3hweWDvOKBzjQ/ZrqDi25tnbPmtvcUTkmFdwZBE1tkU9z3GBdW6r0fiIa4MMNpx0E6fmJJXWes0iY8OOG/Dhq3wQ2aEolhdTCWO2wYlKKc4wLkdlyZRxOpEt1CPb4R208wz2JO8MWT1mt/oNle2pZtcOiy1ZErOsVrx0xXmgk4Sp1lhLrWi6UV2s1JcTj/23wUjivw2G2H8b9GVfhfX44hJgfoAorH8111RBl9NG1rE8qWKjhMM9ty77im/jsPZ37LfCmsgN9dRJM9GZd9vv82aSdzOc/Z3Fgg4FfDDLl4bAOAwtCYPSL6gFT25hoi4L6CXvZYIeLyTT4qvVp57q5Noc6zyrmecwF/siL98S8uZo3IhDC7u5mecz5uxlOWn5YhiKF8SvOcvWxTsdgDiYJMkivr/pBkexIYFIuuxKsGFXpmul6mRxSWYpBhHfSL6+tse2jupJi1+bKA970dq1cna2aihOoDKhHOPo+mYt/vDq2Mppjg4STbKaFZKiauwm9lBkBx0+UZ+DnZLEs9BocoDS2SYYfLSs3GBEB6ilQxKglvbjANUJe46RbgRBOcve5JBZSKKqNjFHJs6OG7HfbS+wgyUJacW6sUHSStfKY+Wh+NNP5s/yIK93dP2XQ5yuk5AfD+YdxZdebJg0Yscmz+PPiDisx1eiVh4vFZKUH2cEvvZ8rSWzJQZZ7xS2rrxdJRX51K57FAVNsvs+rfYWyY/q1PXnqR425DNZDa27s8vjOFQXY3gdq843slYuTa5cNxpyOK1HoQo2/b9Dsq2RwXAcg1ds0ItjU61crkhhDC9yNo3qeiO6k15gmYcWBp8e696Rw8w12Sz8Uc0hnb/0BsGLv7G43/atXrbF37Rdn6wmh47x6vho3Q7rkRy9loEjoeMXkyoeWua4TLQ+S40s6Ufe0qfiq+VL+skVn+fPXHc+yPE19V27meGLES3wMu265Jagu+hxUnMjP1gTsWL8OcBWcbMtDsuOSkshCTy0PQoikdY0c/LPjT2nqB61ca6bmVd23eV/HPr8yy9US6Px3muDzctYZZNkSYOc6yQ5Rx6Zo003XPJAxiaG5/hTnL9K4zXxyMTYSJLZ4oGaK0MlKcpSiFYThhSmFFUpJqSYzEV9+9DxVWN81tY4j0wKaLIsXQFNCmhSQJMCmhTQ5GS6VhmLEXVplaQoS1FJZpsal44pRVWKCSkEND4mhTwdF9C4gMYrUhhSCGJcEOOCGNdrmx7TteBKgisJriS4kuBKgisJriS4kjCVhaksiLIgyoIo6+XN6AlnxnUdvyHQsqacMXRt6lomr8gcFWGtCGtFWCvxA4FWNHRWiA0hNmRaQ0CGgAwBGQIyBGQIyJClmoIwBWEKwhSEqZd6aPxMQGaVz7sVPxNQVR5UBVQVUFUeVIWmKjRVU15uSEtoqoKYEMSEIMQXFfFFRXxREV9UxBcV8UVFfFGZEMSkICYFIaaoTApispJulWIZ2RTcih8IQkxhsCm4GJeiJEVZiooUhhSmFFUpJqSYzMwpDpvcFEsYMpchljDEEoZYwhBLGGIJQyxhjAtJSUhKghAzGGIGQ8xgiBkMMYMhZjDEDIaYwRAzGGIGQ8xgiBkMCV9GWRBlQZQFIR4wyoKoCKIiiIogRHpDpDdEekOkN0R6Q6Q3KoIwBCG6G6K7IboborshuhuiuyG6G6K7IboborshuhuiuyG6G6YgTEGI6IYpCFMQLHqrxAguBMGic0sQIrohohtVQVQFIaIbIrohohsiuiGiGyK6IaIbIrohohsiuiGiGyK6IaIbIrohohsiujEpCIkEhkQCQyKBwaK3SlUV27Q0MaZrxpkivSnSmzoelCYMXZsyWJViQgrmM8VLpuhviv6m6G+K/qbob4r+puhviv6m6G+K/qbob4r+puhviv6m6G+K/qbob4r+Zim5lqVVeoWrxnVd0nVZ13qpq/RSV5m6rup6QteD+VbpekrX07qe0fVsUk9p3inNO6V5pzTvlOad0rxTmndK805p3inNO6V5pzTvlOad0rxTmlcHzdK05p3WvNOad1rzTmveac07rXmnNe+05p3WvNOad1rzTmveac2rY2tJx9bSjOad0bwzmldH2JKOsKUZzTujeWc074zmndG8M5p3RvPOaN5ZzTureWc176zmndW8s5p3VvPOilMmNemsJp3VpLOadFaTzmrS2dn/AboJB4wAAAA=) format('woff')}.fa{display:inline-block;font:normal normal normal 14px/1 FontAwesome;font-size:inherit;text-rendering:auto;-webkit-font-smoothing:antialiased;-moz-osx-font-smoothing:grayscale}.fa-lg{font-size:1.33333333em;line-height:.75em;vertical-align:-15%}.fa-2x{font-size:2em}.fa-3x{font-size:3em}.fa-4x{font-size:4em}.fa-5x{font-size:5em}.fa-fw{width:1.28571429em;text-align:center}.fa-ul{padding-left:0;margin-left:2.14285714em;list-style-type:none}.fa-ul>li{position:relative}.fa-li{position:absolute;left:-2.14285714em;width:2.14285714em;top:.14285714em;text-align:center}.fa-li.fa-lg{left:-1.85714286em}.fa-border{padding:.2em .25em .15em;border:solid .08em #eee;border-radius:.1em}.fa-pull-left{float:left}.fa-pull-right{float:right}.fa.fa-pull-left{margin-right:.3em}.fa.fa-pull-right{margin-left:.3em}.pull-right{float:right}.pull-left{float:left}.fa.pull-left{margin-right:.3em}.fa.pull-right{margin-left:.3em}.fa-spin{-webkit-animation:fa-spin 2s infinite linear;animation:fa-spin 2s infinite linear}.fa-pulse{-webkit-animation:fa-spin 1s infinite steps(8);animation:fa-spin 1s infinite steps(8)}@-webkit-keyframes fa-spin{0%{-webkit-transform:rotate(0);transform:rotate(0)}100%{-webkit-transform:rotate(359deg);transform:rotate(359deg)}}@keyframes fa-spin{0%{-webkit-transform:rotate(0);transform:rotate(0)}100%{-webkit-transform:rotate(359deg);transform:rotate(359deg)}}.fa-rotate-90{-ms-filter:"progid:DXImageTransform.Microsoft.BasicImage(rotation=1)";-webkit-transform:rotate(90deg);-ms-transform:rotate(90deg);transform:rotate(90deg)}.fa-rotate-180{-ms-filter:"progid:DXImageTransform.Microsoft.BasicImage(rotation=2)";-webkit-transform:rotate(180deg);-ms-transform:rotate(180deg);transform:rotate(180deg)}.fa-rotate-270{-ms-filter:"progid:DXImageTransform.Microsoft.BasicImage(rotation=3)";-webkit-transform:rotate(270deg);-ms-transform:rotate(270deg);transform:rotate(270deg)}.fa-flip-horizontal{-ms-filter:"progid:DXImageTransform.Microsoft.BasicImage(rotation=0, mirror=1)";-webkit-transform:scale(-1,1);-ms-transform:scale(-1,1);transform:scale(-1,1)}.fa-flip-vertical{-ms-filter:"progid:DXImageTransform.Microsoft.BasicImage(rotation=2, mirror=1)";-webkit-transform:scale(1,-1);-ms-transform:scale(1,-1);transform:scale(1,-1)}:root .fa-flip-horizontal,:root .fa-flip-vertical,:root .fa-rotate-180,:root .fa-rotate-270,:root .fa-rotate-90{filter:none}.fa-stack{position:relative;display:inline-block;width:2em;height:2em;line-height:2em;vertical-align:middle}.fa-stack-1x,.fa-stack-2x{position:absolute;left:0;width:100%;text-align:center}.fa-stack-1x{line-height:inherit}.fa-stack-2x{font-size:2em}.fa-inverse{color:#fff}.fa-glass:before{content:"\f000"}.fa-music:before{content:"\f001"}.fa-search:before{content:"\f002"}.fa-envelope-o:before{content:"\f003"}.fa-heart:before{content:"\f004"}.fa-star:before{content:"\f005"}.fa-star-o:before{content:"\f006"}.fa-user:before{content:"\f007"}.fa-film:before{content:"\f008"}.fa-th-large:before{content:"\f009"}.fa-th:before{content:"\f00a"}.fa-th-list:before{content:"\f00b"}.fa-check:before{content:"\f00c"}.fa-close:before,.fa-remove:before,.fa-times:before{content:"\f00d"}.fa-search-plus:before{content:"\f00e"}.fa-search-minus:before{content:"\f010"}.fa-power-off:before{content:"\f011"}.fa-signal:before{content:"\f012"}.fa-cog:before,.fa-gear:before{content:"\f013"}.fa-trash-o:before{content:"\f014"}.fa-home:before{content:"\f015"}.fa-file-o:before{content:"\f016"}.fa-clock-o:before{content:"\f017"}.fa-road:before{content:"\f018"}.fa-download:before{content:"\f019"}.fa-arrow-circle-o-down:before{content:"\f01a"}.fa-arrow-circle-o-up:before{content:"\f01b"}.fa-inbox:before{content:"\f01c"}.fa-play-circle-o:before{content:"\f01d"}.fa-repeat:before,.fa-rotate-right:before{content:"\f01e"}.fa-refresh:before{content:"\f021"}.fa-list-alt:before{content:"\f022"}.fa-lock:before{content:"\f023"}.fa-flag:before{content:"\f024"}.fa-headphones:before{content:"\f025"}.fa-volume-off:before{content:"\f026"}.fa-volume-down:before{content:"\f027"}.fa-volume-up:before{content:"\f028"}.fa-qrcode:before{content:"\f029"}.fa-barcode:before{content:"\f02a"}.fa-tag:before{content:"\f02b"}.fa-tags:before{content:"\f02c"}.fa-book:before{content:"\f02d"}.fa-bookmark:before{content:"\f02e"}.fa-print:before{content:"\f02f"}.fa-camera:before{content:"\f030"}.fa-font:before{content:"\f031"}.fa-bold:before{content:"\f032"}.fa-italic:before{content:"\f033"}.fa-text-height:before{content:"\f034"}.fa-text-width:before{content:"\f035"}.fa-align-left:before{content:"\f036"}.fa-align-center:before{content:"\f037"}.fa-align-right:before{content:"\f038"}.fa-align-justify:before{content:"\f039"}.fa-list:before{content:"\f03a"}.fa-dedent:before,.fa-outdent:before{content:"\f03b"}.fa-indent:before{content:"\f03c"}.fa-video-camera:before{content:"\f03d"}.fa-image:before,.fa-photo:before,.fa-picture-o:before{content:"\f03e"}.fa-pencil:before{content:"\f040"}.fa-map-marker:before{content:"\f041"}.fa-adjust:before{content:"\f042"}.fa-tint:before{content:"\f043"}.fa-edit:before,.fa-pencil-square-o:before{content:"\f044"}.fa-share-square-o:before{content:"\f045"}.fa-check-square-o:before{content:"\f046"}.fa-arrows:before{content:"\f047"}.fa-step-backward:before{content:"\f048"}.fa-fast-backward:before{content:"\f049"}.fa-backward:before{content:"\f04a"}.fa-play:before{content:"\f04b"}.fa-pause:before{content:"\f04c"}.fa-stop:before{content:"\f04d"}.fa-forward:before{content:"\f04e"}.fa-fast-forward:before{content:"\f050"}.fa-step-forward:before{content:"\f051"}.fa-eject:before{content:"\f052"}.fa-chevron-left:before{content:"\f053"}.fa-chevron-right:before{content:"\f054"}.fa-plus-circle:before{content:"\f055"}.fa-minus-circle:before{content:"\f056"}.fa-times-circle:before{content:"\f057"}.fa-check-circle:before{content:"\f058"}.fa-question-circle:before{content:"\f059"}.fa-info-circle:before{content:"\f05a"}.fa-crosshairs:before{content:"\f05b"}.fa-times-circle-o:before{content:"\f05c"}.fa-check-circle-o:before{content:"\f05d"}.fa-ban:before{content:"\f05e"}.fa-arrow-left:before{content:"\f060"}.fa-arrow-right:before{content:"\f061"}.fa-arrow-up:before{content:"\f062"}.fa-arrow-down:before{content:"\f063"}.fa-mail-forward:before,.fa-share:before{content:"\f064"}.fa-expand:before{content:"\f065"}.fa-compress:before{content:"\f066"}.fa-plus:before{content:"\f067"}.fa-minus:before{content:"\f068"}.fa-asterisk:before{content:"\f069"}.fa-exclamation-circle:before{content:"\f06a"}.fa-gift:before{content:"\f06b"}.fa-leaf:before{content:"\f06c"}.fa-fire:before{content:"\f06d"}.fa-eye:before{content:"\f06e"}.fa-eye-slash:before{content:"\f070"}.fa-exclamation-triangle:before,.fa-warning:before{content:"\f071"}.fa-plane:before{content:"\f072"}.fa-calendar:before{content:"\f073"}.fa-random:before{content:"\f074"}.fa-comment:before{content:"\f075"}.fa-magnet:before{content:"\f076"}.fa-chevron-up:before{content:"\f077"}.fa-chevron-down:before{content:"\f078"}.fa-retweet:before{content:"\f079"}.fa-shopping-cart:before{content:"\f07a"}.fa-folder:before{content:"\f07b"}.fa-folder-open:before{content:"\f07c"}.fa-arrows-v:before{content:"\f07d"}.fa-arrows-h:before{content:"\f07e"}.fa-bar-chart-o:before,.fa-bar-chart:before{content:"\f080"}.fa-twitter-square:before{content:"\f081"}.fa-facebook-square:before{content:"\f082"}.fa-camera-retro:before{content:"\f083"}.fa-key:before{content:"\f084"}.fa-cogs:before,.fa-gears:before{content:"\f085"}.fa-comments:before{content:"\f086"}.fa-thumbs-o-up:before{content:"\f087"}.fa-thumbs-o-down:before{content:"\f088"}.fa-star-half:before{content:"\f089"}.fa-heart-o:before{content:"\f08a"}.fa-sign-out:before{content:"\f08b"}.fa-linkedin-square:before{content:"\f08c"}.fa-thumb-tack:before{content:"\f08d"}.fa-external-link:before{content:"\f08e"}.fa-sign-in:before{content:"\f090"}.fa-trophy:before{content:"\f091"}.fa-github-square:before{content:"\f092"}.fa-upload:before{content:"\f093"}.fa-lemon-o:before{content:"\f094"}.fa-phone:before{content:"\f095"}.fa-square-o:before{content:"\f096"}.fa-bookmark-o:before{content:"\f097"}.fa-phone-square:before{content:"\f098"}.fa-twitter:before{content:"\f099"}.fa-facebook-f:before,.fa-facebook:before{content:"\f09a"}.fa-github:before{content:"\f09b"}.fa-unlock:before{content:"\f09c"}.fa-credit-card:before{content:"\f09d"}.fa-feed:before,.fa-rss:before{content:"\f09e"}.fa-hdd-o:before{content:"\f0a0"}.fa-bullhorn:before{content:"\f0a1"}.fa-bell:before{content:"\f0f3"}.fa-certificate:before{content:"\f0a3"}.fa-hand-o-right:before{content:"\f0a4"}.fa-hand-o-left:before{content:"\f0a5"}.fa-hand-o-up:before{content:"\f0a6"}.fa-hand-o-down:before{content:"\f0a7"}.fa-arrow-circle-left:before{content:"\f0a8"}.fa-arrow-circle-right:before{content:"\f0a9"}.fa-arrow-circle-up:before{content:"\f0aa"}.fa-arrow-circle-down:before{content:"\f0ab"}.fa-globe:before{content:"\f0ac"}.fa-wrench:before{content:"\f0ad"}.fa-tasks:before{content:"\f0ae"}.fa-filter:before{content:"\f0b0"}.fa-briefcase:before{content:"\f0b1"}.fa-arrows-alt:before{content:"\f0b2"}.fa-group:before,.fa-users:before{content:"\f0c0"}.fa-chain:before,.fa-link:before{content:"\f0c1"}.fa-cloud:before{content:"\f0c2"}.fa-flask:before{content:"\f0c3"}.fa-cut:before,.fa-scissors:before{content:"\f0c4"}.fa-copy:before,.fa-files-o:before{content:"\f0c5"}.fa-paperclip:before{content:"\f0c6"}.fa-floppy-o:before,.fa-save:before{content:"\f0c7"}.fa-square:before{content:"\f0c8"}.fa-bars:before,.fa-navicon:before,.fa-reorder:before{content:"\f0c9"}.fa-list-ul:before{content:"\f0ca"}.fa-list-ol:before{content:"\f0cb"}.fa-strikethrough:before{content:"\f0cc"}.fa-underline:before{content:"\f0cd"}.fa-table:before{content:"\f0ce"}.fa-magic:before{content:"\f0d0"}.fa-truck:before{content:"\f0d1"}.fa-pinterest:before{content:"\f0d2"}.fa-pinterest-square:before{content:"\f0d3"}.fa-google-plus-square:before{content:"\f0d4"}.fa-google-plus:before{content:"\f0d5"}.fa-money:before{content:"\f0d6"}.fa-caret-down:before{content:"\f0d7"}.fa-caret-up:before{content:"\f0d8"}.fa-caret-left:before{content:"\f0d9"}.fa-caret-right:before{content:"\f0da"}.fa-columns:before{content:"\f0db"}.fa-sort:before,.fa-unsorted:before{content:"\f0dc"}.fa-sort-desc:before,.fa-sort-down:before{content:"\f0dd"}.fa-sort-asc:before,.fa-sort-up:before{content:"\f0de"}.fa-envelope:before{content:"\f0e0"}.fa-linkedin:before{content:"\f0e1"}.fa-rotate-left:before,.fa-undo:before{content:"\f0e2"}.fa-gavel:before,.fa-legal:before{content:"\f0e3"}.fa-dashboard:before,.fa-tachometer:before{content:"\f0e4"}.fa-comment-o:before{content:"\f0e5"}.fa-comments-o:before{content:"\f0e6"}.fa-bolt:before,.fa-flash:before{content:"\f0e7"}.fa-sitemap:before{content:"\f0e8"}.fa-umbrella:before{content:"\f0e9"}.fa-clipboard:before,.fa-paste:before{content:"\f0ea"}.fa-lightbulb-o:before{content:"\f0eb"}.fa-exchange:before{content:"\f0ec"}.fa-cloud-download:before{content:"\f0ed"}.fa-cloud-upload:before{content:"\f0ee"}.fa-user-md:before{content:"\f0f0"}.fa-stethoscope:before{content:"\f0f1"}.fa-suitcase:before{content:"\f0f2"}.fa-bell-o:before{content:"\f0a2"}.fa-coffee:before{content:"\f0f4"}.fa-cutlery:before{content:"\f0f5"}.fa-file-text-o:before{content:"\f0f6"}.fa-building-o:before{content:"\f0f7"}.fa-hospital-o:before{content:"\f0f8"}.fa-ambulance:before{content:"\f0f9"}.fa-medkit:before{content:"\f0fa"}.fa-fighter-jet:before{content:"\f0fb"}.fa-beer:before{content:"\f0fc"}.fa-h-square:before{content:"\f0fd"}.fa-plus-square:before{content:"\f0fe"}.fa-angle-double-left:before{content:"\f100"}.fa-angle-double-right:before{content:"\f101"}.fa-angle-double-up:before{content:"\f102"}.fa-angle-double-down:before{content:"\f103"}.fa-angle-left:before{content:"\f104"}.fa-angle-right:before{content:"\f105"}.fa-angle-up:before{content:"\f106"}.fa-angle-down:before{content:"\f107"}.fa-desktop:before{content:"\f108"}.fa-laptop:before{content:"\f109"}.fa-tablet:before{content:"\f10a"}.fa-mobile-phone:before,.fa-mobile:before{content:"\f10b"}.fa-circle-o:before{content:"\f10c"}.fa-quote-left:before{content:"\f10d"}.fa-quote-right:before{content:"\f10e"}.fa-spinner:before{content:"\f110"}.fa-circle:before{content:"\f111"}.fa-mail-reply:before,.fa-reply:before{content:"\f112"}.fa-github-alt:before{content:"\f113"}.fa-folder-o:before{content:"\f114"}.fa-folder-open-o:before{content:"\f115"}.fa-smile-o:before{content:"\f118"}.fa-frown-o:before{content:"\f119"}.fa-meh-o:before{content:"\f11a"}.fa-gamepad:before{content:"\f11b"}.fa-keyboard-o:before{content:"\f11c"}.fa-flag-o:before{content:"\f11d"}.fa-flag-checkered:before{content:"\f11e"}.fa-terminal:before{content:"\f120"}.fa-code:before{content:"\f121"}.fa-mail-reply-all:before,.fa-reply-all:before{content:"\f122"}.fa-star-half-empty:before,.fa-star-half-full:before,.fa-star-half-o:before{content:"\f123"}.fa-location-arrow:before{content:"\f124"}.fa-crop:before{content:"\f125"}.fa-code-fork:before{content:"\f126"}.fa-chain-broken:before,.fa-unlink:before{content:"\f127"}.fa-question:before{content:"\f128"}.fa-info:before{content:"\f129"}.fa-exclamation:before{content:"\f12a"}.fa-superscript:before{content:"\f12b"}.fa-subscript:before{content:"\f12c"}.fa-eraser:before{content:"\f12d"}.fa-puzzle-piece:before{content:"\f12e"}.fa-microphone:before{content:"\f130"}.fa-microphone-slash:before{content:"\f131"}.fa-shield:before{content:"\f132"}.fa-calendar-o:before{content:"\f133"}.fa-fire-extinguisher:before{content:"\f134"}.fa-rocket:before{content:"\f135"}.fa-maxcdn:before{content:"\f136"}.fa-chevron-circle-left:before{content:"\f137"}.fa-chevron-circle-right:before{content:"\f138"}.fa-chevron-circle-up:before{content:"\f139"}.fa-chevron-circle-down:before{content:"\f13a"}.fa-html5:before{content:"\f13b"}.fa-css3:before{content:"\f13c"}.fa-anchor:before{content:"\f13d"}.fa-unlock-alt:before{content:"\f13e"}.fa-bullseye:before{content:"\f140"}.fa-ellipsis-h:before{content:"\f141"}.fa-ellipsis-v:before{content:"\f142"}.fa-rss-square:before{content:"\f143"}.fa-play-circle:before{content:"\f144"}.fa-ticket:before{content:"\f145"}.fa-minus-square:before{content:"\f146"}.fa-minus-square-o:before{content:"\f147"}.fa-level-up:before{content:"\f148"}.fa-level-down:before{content:"\f149"}.fa-check-square:before{content:"\f14a"}.fa-pencil-square:before{content:"\f14b"}.fa-external-link-square:before{content:"\f14c"}.fa-share-square:before{content:"\f14d"}.fa-compass:before{content:"\f14e"}.fa-caret-square-o-down:before,.fa-toggle-down:before{content:"\f150"}.fa-caret-square-o-up:before,.fa-toggle-up:before{content:"\f151"}.fa-caret-square-o-right:before,.fa-toggle-right:before{content:"\f152"}.fa-eur:before,.fa-euro:before{content:"\f153"}.fa-gbp:before{content:"\f154"}.fa-dollar:before,.fa-usd:before{content:"\f155"}.fa-inr:before,.fa-rupee:before{content:"\f156"}.fa-cny:before,.fa-jpy:before,.fa-rmb:before,.fa-yen:before{content:"\f157"}.fa-rouble:before,.fa-rub:before,.fa-ruble:before{content:"\f158"}.fa-krw:before,.fa-won:before{content:"\f159"}.fa-bitcoin:before,.fa-btc:before{content:"\f15a"}.fa-file:before{content:"\f15b"}.fa-file-text:before{content:"\f15c"}.fa-sort-alpha-asc:before{content:"\f15d"}.fa-sort-alpha-desc:before{content:"\f15e"}.fa-sort-amount-asc:before{content:"\f160"}.fa-sort-amount-desc:before{content:"\f161"}.fa-sort-numeric-asc:before{content:"\f162"}.fa-sort-numeric-desc:before{content:"\f163"}.fa-thumbs-up:before{content:"\f164"}.fa-thumbs-down:before{content:"\f165"}.fa-youtube-square:before{content:"\f166"}.fa-youtube:before{content:"\f167"}.fa-xing:before{content:"\f168"}.fa-xing-square:before{content:"\f169"}.fa-youtube-play:before{content:"\f16a"}.fa-dropbox:before{content:"\f16b"}.fa-stack-overflow:before{content:"\f16c"}.fa-instagram:before{content:"\f16d"}.fa-flickr:before{content:"\f16e"}.fa-adn:before{content:"\f170"}.fa-bitbucket:before{content:"\f171"}.fa-bitbucket-square:before{content:"\f172"}.fa-tumblr:before{content:"\f173"}.fa-tumblr-square:before{content:"\f174"}.fa-long-arrow-down:before{content:"\f175"}.fa-long-arrow-up:before{content:"\f176"}.fa-long-arrow-left:before{content:"\f177"}.fa-long-arrow-right:before{content:"\f178"}.fa-apple:before{content:"\f179"}.fa-windows:before{content:"\f17a"}.fa-android:before{content:"\f17b"}.fa-linux:before{content:"\f17c"}.fa-dribbble:before{content:"\f17d"}.fa-skype:before{content:"\f17e"}.fa-foursquare:before{content:"\f180"}.fa-trello:before{content:"\f181"}.fa-female:before{content:"\f182"}.fa-male:before{content:"\f183"}.fa-gittip:before,.fa-gratipay:before{content:"\f184"}.fa-sun-o:before{content:"\f185"}.fa-moon-o:before{content:"\f186"}.fa-archive:before{content:"\f187"}.fa-bug:before{content:"\f188"}.fa-vk:before{content:"\f189"}.fa-weibo:before{content:"\f18a"}.fa-renren:before{content:"\f18b"}.fa-pagelines:before{content:"\f18c"}.fa-stack-exchange:before{content:"\f18d"}.fa-arrow-circle-o-right:before{content:"\f18e"}.fa-arrow-circle-o-left:before{content:"\f190"}.fa-caret-square-o-left:before,.fa-toggle-left:before{content:"\f191"}.fa-dot-circle-o:before{content:"\f192"}.fa-wheelchair:before{content:"\f193"}.fa-vimeo-square:before{content:"\f194"}.fa-try:before,.fa-turkish-lira:before{content:"\f195"}.fa-plus-square-o:before{content:"\f196"}.fa-space-shuttle:before{content:"\f197"}.fa-slack:before{content:"\f198"}.fa-envelope-square:before{content:"\f199"}.fa-wordpress:before{content:"\f19a"}.fa-openid:before{content:"\f19b"}.fa-bank:before,.fa-institution:before,.fa-university:before{content:"\f19c"}.fa-graduation-cap:before,.fa-mortar-board:before{content:"\f19d"}.fa-yahoo:before{content:"\f19e"}.fa-google:before{content:"\f1a0"}.fa-reddit:before{content:"\f1a1"}.fa-reddit-square:before{content:"\f1a2"}.fa-stumbleupon-circle:before{content:"\f1a3"}.fa-stumbleupon:before{content:"\f1a4"}.fa-delicious:before{content:"\f1a5"}.fa-digg:before{content:"\f1a6"}.fa-pied-piper-pp:before{content:"\f1a7"}.fa-pied-piper-alt:before{content:"\f1a8"}.fa-drupal:before{content:"\f1a9"}.fa-joomla:before{content:"\f1aa"}.fa-language:before{content:"\f1ab"}.fa-fax:before{content:"\f1ac"}.fa-building:before{content:"\f1ad"}.fa-child:before{content:"\f1ae"}.fa-paw:before{content:"\f1b0"}.fa-spoon:before{content:"\f1b1"}.fa-cube:before{content:"\f1b2"}.fa-cubes:before{content:"\f1b3"}.fa-behance:before{content:"\f1b4"}.fa-behance-square:before{content:"\f1b5"}.fa-steam:before{content:"\f1b6"}.fa-steam-square:before{content:"\f1b7"}.fa-recycle:before{content:"\f1b8"}.fa-automobile:before,.fa-car:before{content:"\f1b9"}.fa-cab:before,.fa-taxi:before{content:"\f1ba"}.fa-tree:before{content:"\f1bb"}.fa-spotify:before{content:"\f1bc"}.fa-deviantart:before{content:"\f1bd"}.fa-soundcloud:before{content:"\f1be"}.fa-database:before{content:"\f1c0"}.fa-file-pdf-o:before{content:"\f1c1"}.fa-file-word-o:before{content:"\f1c2"}.fa-file-excel-o:before{content:"\f1c3"}.fa-file-powerpoint-o:before{content:"\f1c4"}.fa-file-image-o:before,.fa-file-photo-o:before,.fa-file-picture-o:before{content:"\f1c5"}.fa-file-archive-o:before,.fa-file-zip-o:before{content:"\f1c6"}.fa-file-audio-o:before,.fa-file-sound-o:before{content:"\f1c7"}.fa-file-movie-o:before,.fa-file-video-o:before{content:"\f1c8"}.fa-file-code-o:before{content:"\f1c9"}.fa-vine:before{content:"\f1ca"}.fa-codepen:before{content:"\f1cb"}.fa-jsfiddle:before{content:"\f1cc"}.fa-life-bouy:before,.fa-life-buoy:before,.fa-life-ring:before,.fa-life-saver:before,.fa-support:before{content:"\f1cd"}.fa-circle-o-notch:before{content:"\f1ce"}.fa-ra:before,.fa-rebel:before,.fa-resistance:before{content:"\f1d0"}.fa-empire:before,.fa-ge:before{content:"\f1d1"}.fa-git-square:before{content:"\f1d2"}.fa-git:before{content:"\f1d3"}.fa-hacker-news:before,.fa-y-combinator-square:before,.fa-yc-square:before{content:"\f1d4"}.fa-tencent-weibo:before{content:"\f1d5"}.fa-qq:before{content:"\f1d6"}.fa-wechat:before,.fa-weixin:before{content:"\f1d7"}.fa-paper-plane:before,.fa-send:before{content:"\f1d8"}.fa-paper-plane-o:before,.fa-send-o:before{content:"\f1d9"}.fa-history:before{content:"\f1da"}.fa-circle-thin:before{content:"\f1db"}.fa-header:before{content:"\f1dc"}.fa-paragraph:before{content:"\f1dd"}.fa-sliders:before{content:"\f1de"}.fa-share-alt:before{content:"\f1e0"}.fa-share-alt-square:before{content:"\f1e1"}.fa-bomb:before{content:"\f1e2"}.fa-futbol-o:before,.fa-soccer-ball-o:before{content:"\f1e3"}.fa-tty:before{content:"\f1e4"}.fa-binoculars:before{content:"\f1e5"}.fa-plug:before{content:"\f1e6"}.fa-slideshare:before{content:"\f1e7"}.fa-twitch:before{content:"\f1e8"}.fa-yelp:before{content:"\f1e9"}.fa-newspaper-o:before{content:"\f1ea"}.fa-wifi:before{content:"\f1eb"}.fa-calculator:before{content:"\f1ec"}.fa-paypal:before{content:"\f1ed"}.fa-google-wallet:before{content:"\f1ee"}.fa-cc-visa:before{content:"\f1f0"}.fa-cc-mastercard:before{content:"\f1f1"}.fa-cc-discover:before{content:"\f1f2"}.fa-cc-amex:before{content:"\f1f3"}.fa-cc-paypal:before{content:"\f1f4"}.fa-cc-stripe:before{content:"\f1f5"}.fa-bell-slash:before{content:"\f1f6"}.fa-bell-slash-o:before{content:"\f1f7"}.fa-trash:before{content:"\f1f8"}.fa-copyright:before{content:"\f1f9"}.fa-at:before{content:"\f1fa"}.fa-eyedropper:before{content:"\f1fb"}.fa-paint-brush:before{content:"\f1fc"}.fa-birthday-cake:before{content:"\f1fd"}.fa-area-chart:before{content:"\f1fe"}.fa-pie-chart:before{content:"\f200"}.fa-line-chart:before{content:"\f201"}.fa-lastfm:before{content:"\f202"}.fa-lastfm-square:before{content:"\f203"}.fa-toggle-off:before{content:"\f204"}.fa-toggle-on:before{content:"\f205"}.fa-bicycle:before{content:"\f206"}.fa-bus:before{content:"\f207"}.fa-ioxhost:before{content:"\f208"}.fa-angellist:before{content:"\f209"}.fa-cc:before{content:"\f20a"}.fa-ils:before,.fa-shekel:before,.fa-sheqel:before{content:"\f20b"}.fa-meanpath:before{content:"\f20c"}.fa-buysellads:before{content:"\f20d"}.fa-connectdevelop:before{content:"\f20e"}.fa-dashcube:before{content:"\f210"}.fa-forumbee:before{content:"\f211"}.fa-leanpub:before{content:"\f212"}.fa-sellsy:before{content:"\f213"}.fa-shirtsinbulk:before{content:"\f214"}.fa-simplybuilt:before{content:"\f215"}.fa-skyatlas:before{content:"\f216"}.fa-cart-plus:before{content:"\f217"}.fa-cart-arrow-down:before{content:"\f218"}.fa-diamond:before{content:"\f219"}.fa-ship:before{content:"\f21a"}.fa-user-secret:before{content:"\f21b"}.fa-motorcycle:before{content:"\f21c"}.fa-street-view:before{content:"\f21d"}.fa-heartbeat:before{content:"\f21e"}.fa-venus:before{content:"\f221"}.fa-mars:before{content:"\f222"}.fa-mercury:before{content:"\f223"}.fa-intersex:before,.fa-transgender:before{content:"\f224"}.fa-transgender-alt:before{content:"\f225"}.fa-venus-double:before{content:"\f226"}.fa-mars-double:before{content:"\f227"}.fa-venus-mars:before{content:"\f228"}.fa-mars-stroke:before{content:"\f229"}.fa-mars-stroke-v:before{content:"\f22a"}.fa-mars-stroke-h:before{content:"\f22b"}.fa-neuter:before{content:"\f22c"}.fa-genderless:before{content:"\f22d"}.fa-facebook-official:before{content:"\f230"}.fa-pinterest-p:before{content:"\f231"}.fa-whatsapp:before{content:"\f232"}.fa-server:before{content:"\f233"}.fa-user-plus:before{content:"\f234"}.fa-user-times:before{content:"\f235"}.fa-bed:before,.fa-hotel:before{content:"\f236"}.fa-viacoin:before{content:"\f237"}.fa-train:before{content:"\f238"}.fa-subway:before{content:"\f239"}.fa-medium:before{content:"\f23a"}.fa-y-combinator:before,.fa-yc:before{content:"\f23b"}.fa-optin-monster:before{content:"\f23c"}.fa-opencart:before{content:"\f23d"}.fa-expeditedssl:before{content:"\f23e"}.fa-battery-4:before,.fa-battery-full:before,.fa-battery:before{content:"\f240"}.fa-battery-3:before,.fa-battery-three-quarters:before{content:"\f241"}.fa-battery-2:before,.fa-battery-half:before{content:"\f242"}.fa-battery-1:before,.fa-battery-quarter:before{content:"\f243"}.fa-battery-0:before,.fa-battery-empty:before{content:"\f244"}.fa-mouse-pointer:before{content:"\f245"}.fa-i-cursor:before{content:"\f246"}.fa-object-group:before{content:"\f247"}.fa-object-ungroup:before{content:"\f248"}.fa-sticky-note:before{content:"\f249"}.fa-sticky-note-o:before{content:"\f24a"}.fa-cc-jcb:before{content:"\f24b"}.fa-cc-diners-club:before{content:"\f24c"}.fa-clone:before{content:"\f24d"}.fa-balance-scale:before{content:"\f24e"}.fa-hourglass-o:before{content:"\f250"}.fa-hourglass-1:before,.fa-hourglass-start:before{content:"\f251"}.fa-hourglass-2:before,.fa-hourglass-half:before{content:"\f252"}.fa-hourglass-3:before,.fa-hourglass-end:before{content:"\f253"}.fa-hourglass:before{content:"\f254"}.fa-hand-grab-o:before,.fa-hand-rock-o:before{content:"\f255"}.fa-hand-paper-o:before,.fa-hand-stop-o:before{content:"\f256"}.fa-hand-scissors-o:before{content:"\f257"}.fa-hand-lizard-o:before{content:"\f258"}.fa-hand-spock-o:before{content:"\f259"}.fa-hand-pointer-o:before{content:"\f25a"}.fa-hand-peace-o:before{content:"\f25b"}.fa-trademark:before{content:"\f25c"}.fa-registered:before{content:"\f25d"}.fa-creative-commons:before{content:"\f25e"}.fa-gg:before{content:"\f260"}.fa-gg-circle:before{content:"\f261"}.fa-tripadvisor:before{content:"\f262"}.fa-odnoklassniki:before{content:"\f263"}.fa-odnoklassniki-square:before{content:"\f264"}.fa-get-pocket:before{content:"\f265"}.fa-wikipedia-w:before{content:"\f266"}.fa-safari:before{content:"\f267"}.fa-chrome:before{content:"\f268"}.fa-firefox:before{content:"\f269"}.fa-opera:before{content:"\f26a"}.fa-internet-explorer:before{content:"\f26b"}.fa-television:before,.fa-tv:before{content:"\f26c"}.fa-contao:before{content:"\f26d"}.fa-500px:before{content:"\f26e"}.fa-amazon:before{content:"\f270"}.fa-calendar-plus-o:before{content:"\f271"}.fa-calendar-minus-o:before{content:"\f272"}.fa-calendar-times-o:before{content:"\f273"}.fa-calendar-check-o:before{content:"\f274"}.fa-industry:before{content:"\f275"}.fa-map-pin:before{content:"\f276"}.fa-map-signs:before{content:"\f277"}.fa-map-o:before{content:"\f278"}.fa-map:before{content:"\f279"}.fa-commenting:before{content:"\f27a"}.fa-commenting-o:before{content:"\f27b"}.fa-houzz:before{content:"\f27c"}.fa-vimeo:before{content:"\f27d"}.fa-black-tie:before{content:"\f27e"}.fa-fonticons:before{content:"\f280"}.fa-reddit-alien:before{content:"\f281"}.fa-edge:before{content:"\f282"}.fa-credit-card-alt:before{content:"\f283"}.fa-codiepie:before{content:"\f284"}.fa-modx:before{content:"\f285"}.fa-fort-awesome:before{content:"\f286"}.fa-usb:before{content:"\f287"}.fa-product-hunt:before{content:"\f288"}.fa-mixcloud:before{content:"\f289"}.fa-scribd:before{content:"\f28a"}.fa-pause-circle:before{content:"\f28b"}.fa-pause-circle-o:before{content:"\f28c"}.fa-stop-circle:before{content:"\f28d"}.fa-stop-circle-o:before{content:"\f28e"}.fa-shopping-bag:before{content:"\f290"}.fa-shopping-basket:before{content:"\f291"}.fa-hashtag:before{content:"\f292"}.fa-bluetooth:before{content:"\f293"}.fa-bluetooth-b:before{content:"\f294"}.fa-percent:before{content:"\f295"}.fa-gitlab:before{content:"\f296"}.fa-wpbeginner:before{content:"\f297"}.fa-wpforms:before{content:"\f298"}.fa-envira:before{content:"\f299"}.fa-universal-access:before{content:"\f29a"}.fa-wheelchair-alt:before{content:"\f29b"}.fa-question-circle-o:before{content:"\f29c"}.fa-blind:before{content:"\f29d"}.fa-audio-description:before{content:"\f29e"}.fa-volume-control-phone:before{content:"\f2a0"}.fa-braille:before{content:"\f2a1"}.fa-assistive-listening-systems:before{content:"\f2a2"}.fa-american-sign-language-interpreting:before,.fa-asl-interpreting:before{content:"\f2a3"}.fa-deaf:before,.fa-deafness:before,.fa-hard-of-hearing:before{content:"\f2a4"}.fa-glide:before{content:"\f2a5"}.fa-glide-g:before{content:"\f2a6"}.fa-sign-language:before,.fa-signing:before{content:"\f2a7"}.fa-low-vision:before{content:"\f2a8"}.fa-viadeo:before{content:"\f2a9"}.fa-viadeo-square:before{content:"\f2aa"}.fa-snapchat:before{content:"\f2ab"}.fa-snapchat-ghost:before{content:"\f2ac"}.fa-snapchat-square:before{content:"\f2ad"}.fa-pied-piper:before{content:"\f2ae"}.fa-first-order:before{content:"\f2b0"}.fa-yoast:before{content:"\f2b1"}.fa-themeisle:before{content:"\f2b2"}.fa-google-plus-circle:before,.fa-google-plus-official:before{content:"\f2b3"}.fa-fa:before,.fa-font-awesome:before{content:"\f2b4"}.fa-handshake-o:before{content:"\f2b5"}.fa-envelope-open:before{content:"\f2b6"}.fa-envelope-open-o:before{content:"\f2b7"}.fa-linode:before{content:"\f2b8"}.fa-address-book:before{content:"\f2b9"}.fa-address-book-o:before{content:"\f2ba"}.fa-address-card:before,.fa-vcard:before{content:"\f2bb"}.fa-address-card-o:before,.fa-vcard-o:before{content:"\f2bc"}.fa-user-circle:before{content:"\f2bd"}.fa-user-circle-o:before{content:"\f2be"}.fa-user-o:before{content:"\f2c0"}.fa-id-badge:before{content:"\f2c1"}.fa-drivers-license:before,.fa-id-card:before{content:"\f2c2"}.fa-drivers-license-o:before,.fa-id-card-o:before{content:"\f2c3"}.fa-quora:before{content:"\f2c4"}.fa-free-code-camp:before{content:"\f2c5"}.fa-telegram:before{content:"\f2c6"}.fa-thermometer-4:before,.fa-thermometer-full:before,.fa-thermometer:before{content:"\f2c7"}.fa-thermometer-3:before,.fa-thermometer-three-quarters:before{content:"\f2c8"}.fa-thermometer-2:before,.fa-thermometer-half:before{content:"\f2c9"}.fa-thermometer-1:before,.fa-thermometer-quarter:before{content:"\f2ca"}.fa-thermometer-0:before,.fa-thermometer-empty:before{content:"\f2cb"}.fa-shower:before{content:"\f2cc"}.fa-bath:before,.fa-bathtub:before,.fa-s15:before{content:"\f2cd"}.fa-podcast:before{content:"\f2ce"}.fa-window-maximize:before{content:"\f2d0"}.fa-window-minimize:before{content:"\f2d1"}.fa-window-restore:before{content:"\f2d2"}.fa-times-rectangle:before,.fa-window-close:before{content:"\f2d3"}.fa-times-rectangle-o:before,.fa-window-close-o:before{content:"\f2d4"}.fa-bandcamp:before{content:"\f2d5"}.fa-grav:before{content:"\f2d6"}.fa-etsy:before{content:"\f2d7"}.fa-imdb:before{content:"\f2d8"}.fa-ravelry:before{content:"\f2d9"}.fa-eercast:before{content:"\f2da"}.fa-microchip:before{content:"\f2db"}.fa-snowflake-o:before{content:"\f2dc"}.fa-superpowers:before{content:"\f2dd"}.fa-wpexplorer:before{content:"\f2de"}.fa-meetup:before{content:"\f2e0"}.sr-only{position:absolute;width:1px;height:1px;padding:0;margin:-1px;overflow:hidden;clip:rect(0,0,0,0);border:0}.sr-only-focusable:active,.sr-only-focusable:focus{position:static;width:auto;height:auto;margin:0;overflow:visible;clip:auto}
 </style><style>
-body,html{height:100%;overflow:hidden}ul{list-style:none}.main-container{display:flex;flex-direction:row;height:100%}.sidebar{flex:0 0 150px;overflow:auto}.sidebar ul{margin:0;padding:0}.logo-block{height:200px;border-bottom-width:3px;border-bottom-style:solid}.logo-block img{display:block;margin:30px auto 0 auto}.logo-block p{text-align:center;font-size:20px;margin-top:10px}.nav-item{height:64px;font-size:16px;position:relative}.nav-item i{font-size:20px;position:absolute;top:22px;left:20px}.nav-item p{padding-left:50px;text-decoration:none;display:inline-block;width:100%;height:64px;line-height:64px}.nav-item:hover{cursor:pointer}.navbar{margin-bottom:2px;border-bottom-width:2px;border-bottom-style:solid}.navbar img{height:50px;width:50px}.main{flex:1 1 0;display:flex;flex-direction:column}.content-container{flex:1 0 0;display:flex;flex-direction:column;height:100%}.summary-list{padding:20px;margin:0;border-bottom-width:2px;border-bottom-style:solid}.summary-list li{float:left;height:120px;width:280px;margin:10px;margin-right:12px;font-weight:700;display:flex;align-items:center}.summary-list li i{width:80px;font-size:60px;height:80px;line-height:80px;text-align:center;margin-left:12px}.summary-list li>div:first-child{height:42px;border-radius:2px;padding:10px;font-size:16px}.summary-list li>div:first-child i{margin-top:5px}.summary-list li .summary-content{text-align:left;padding-left:12px;flex:1 1 0;margin-top:20px;margin-bottom:20px}.summary-list li .summary-content h4{font-size:16px}.summary-list li .emphasize-num{font-size:20px;font-weight:lighter}.summary-list li .emphasize-big{font-size:42px;font-weight:lighter}.summary-list li .descp{font-size:12px}.row{margin:0}.chart-container{padding:10px}.chart-title{padding:5px 20px;border-bottom:1px solid #e5e7ea;font-size:20px}.pie-chart{display:flex}.pie-chart>div{flex:1 1 0;margin-right:10px}.divider{height:20px;display:flex;align-items:center;padding:0 10px}.divider .line{flex:1 1 0;display:inline-block;width:40%;height:2px;border-bottom-width:3px;border-bottom-style:solid}.divider .icon{display:inline-block;width:40px;height:40px;border-width:3px;border-style:solid;border-radius:50%;margin:0 5px;position:relative}.divider .icon:before{content:"";display:inline-block;width:10px;height:10px;border-right:3px solid #9ba3af;border-top:3px solid #9ba3af;transform:rotate(315deg);margin-left:12px;margin-top:15px}.list{padding:0 20px}.list li{height:48px;line-height:48px;font-size:16px;border-bottom-width:2px;border-bottom-style:solid;display:flex;font-size:14px}.list li span{display:inline-block;text-align:center;overflow:hidden;text-overflow:ellipsis;white-space:nowrap;font-size:14px}.list li span:first-child{width:50px}.list li span:nth-child(2){flex:1 0 0}.list li span:last-child{width:120px;float:right}.right-content{flex:1 1 0;width:100%;overflow:auto}#codeStyle .right-content{position:relative;overflow:hidden}#codeOpt .right-content{position:relative;overflow:hidden}.sub-nav{width:230px;position:absolute;top:10px;bottom:0;left:10px;right:10px}.sub-nav ul{position:absolute;left:10px;right:10px;font-size:16px;padding-top:1em;padding-left:1em;padding-right:1em}.sub-nav li{height:40px;line-height:40px;cursor:pointer;padding-left:1em;margin-bottom:2px}.sub-content{margin-left:250px;margin-right:10px;margin-top:10px;padding-top:1em;padding-left:1em;height:100%;overflow:auto}.sub-content h5{margin-left:24px}.sub-content a,.sub-content p{display:block;margin-left:48px;margin-top:24px}.sub-content h4{padding:10px;margin-top:0;position:relative}.sub-content .emp-num{margin-left:24px}.sub-content section>div{margin-top:24px;margin-bottom:24px}.description{position:absolute;right:10px;top:16px;font-size:12px;font-style:italic}.none{display:none!important}.gotestSummary{padding-left:0}.gotestSummary li{height:70px;line-height:40px;background-color:#fff;margin-bottom:20px}.gotestSummary li>span:first-child{width:36px;height:30px;line-height:30px;border-radius:2px;display:inline-block;text-align:center;background:#333;color:#fff;vertical-align:middle}.gotestSummary li>span:last-child{margin-left:2em}.col-sm-9{padding-left:0;padding-right:0}.col-sm-3{padding-right:0;padding-left:10px}.col-sm-6{padding-left:0}.col-sm-4{padding-left:0;padding-right:0}.col-sm-8{padding-right:0;padding-left:10px}#changeLang{font-weight:700;width:90px;text-align:center}@media screen and (max-width:1280px){.sidebar{flex:0 0 150px}.sidebar .nav-item{padding-left:30px}.main>ul li{width:220px;margin-right:12px}}
+body,html{height:100%;overflow:hidden}ul{list-style:none}.main-container{display:flex;flex-direction:row;height:100%}.sidebar{flex:0 0 150px;overflow:auto}.sidebar ul{margin:0;padding:0}.logo-block{height:200px;border-bottom-width:3px;border-bottom-style:solid}.logo-block img{display:block;margin:30px auto 0 auto}.logo-block p{text-align:center;font-size:20px;margin-top:10px}.nav-item{height:64px;font-size:16px;position:relative}.nav-item i{font-size:20px;position:absolute;top:22px;left:20px}.nav-item p{padding-left:50px;text-decoration:none;display:inline-block;width:100%;height:64px;line-height:64px}.nav-item:hover{cursor:pointer}.navbar{margin-bottom:2px;border-bottom-width:2px;border-bottom-style:solid}.navbar img{height:50px;width:50px}.main{flex:1 1 0;display:flex;flex-direction:column}.content-container{flex:1 0 0;display:flex;flex-direction:column;height:100%}.summary-list{padding:20px;margin:0;border-bottom-width:2px;border-bottom-style:solid}.summary-list li{float:left;height:120px;width:280px;margin:10px;margin-right:12px;font-weight:700;display:flex;align-items:center}.summary-list li i{width:80px;font-size:60px;height:80px;line-height:80px;text-align:center;margin-left:12px}.summary-list li>div:first-child{height:42px;border-radius:2px;padding:10px;font-size:16px}.summary-list li>div:first-child i{margin-top:5px}.summary-list li .summary-content{text-align:left;padding-left:12px;flex:1 1 0;margin-top:20px;margin-bottom:20px}.summary-list li .summary-content h4{font-size:16px}.summary-list li .emphasize-num{font-size:20px;font-weight:lighter}.summary-list li .emphasize-big{font-size:42px;font-weight:lighter}.summary-list li .descp{font-size:12px}.row{margin:0}.chart-container{padding:10px}.chart-title{padding:5px 20px;border-bottom:1px solid #e5e7ea;font-size:20px}.pie-chart{display:flex}.pie-chart>div{flex:1 1 0;margin-right:10px}.divider{height:20px;display:flex;align-items:center;padding:0 10px}.divider .line{flex:1 1 0;display:inline-block;width:40%;height:2px;border-bottom-width:3px;border-bottom-style:solid}.divider .icon{display:inline-block;width:40px;height:40px;border-width:3px;border-style:solid;border-radius:50%;margin:0 5px;position:relative}.divider .icon:before{content:"";display:inline-block;width:10px;height:10px;border-right:3px solid #9ba3af;border-top:3px solid #9ba3af;transform:rotate(315deg);margin-left:12px;margin-top:15px}.list{padding:0 20px}.list li{height:48px;line-height:48px;font-size:16px;border-bottom-width:2px;border-bottom-style:solid;display:flex;font-size:14px}.list li span{display:inline-block;text-align:center;overflow:hidden;text-overflow:ellipsis;white-space:nowrap;font-size:14px}.list li span:first-child{width:50px}.list li span:nth-child(2){flex:1 0 0}.list li span:last-child{width:120px;float:right}.list li span.metric{width:120px}.mi-row{display:flex;align-items:center;margin:4px 0}.mi-pkg{width:320px;overflow:hidden;text-overflow:ellipsis;white-space:nowrap}.mi-cells{flex:1 1 0;display:flex;flex-wrap:wrap}.mi-cell{width:18px;height:18px;margin:1px;display:inline-block}.copy-meta{margin-left:12px;font-size:12px;font-style:italic}.fmt-diff{margin:12px 24px 24px 48px;font-size:12px}.fmt-diff .fmt-file{font-weight:bold}.fmt-diff .fmt-hunk{color:#999}.fmt-diff .fmt-del{background-color:#f2dede}.fmt-diff .fmt-add{background-color:#dff0d8}.copy-diff{margin:12px 24px 24px 48px;font-family:monospace;font-size:12px}.copy-diff th{padding:4px 6px;background-color:#ecf2f6}.copy-diff td{padding:0 6px;white-space:pre;vertical-align:top}.copy-diff .diff-num{color:#999;text-align:right}.copy-diff tr.diff-changed td{background-color:#fcf8e3}.copy-diff tr.diff-deleted td,.copy-diff tr.diff-added td{background-color:#f2dede}.copy-dup{margin:12px 24px 24px 48px;font-size:12px}.copy-dup th{padding:4px 6px;background-color:#ecf2f6}.copy-dup td{padding:0 6px}.copy-dup .dup-num{text-align:right}#dependGraph{overflow:auto;max-height:800px}.depend-toolbar{margin-bottom:10px}.depend-toolbar>*{display:inline-block;vertical-align:middle;margin-right:10px}.depend-toolbar input{width:240px}#dependGraph .node,#dependGraph .link{transition:opacity .2s}.right-content{flex:1 1 0;width:100%;overflow:auto}#codeStyle .right-content{position:relative;overflow:hidden}#codeOpt .right-content{position:relative;overflow:hidden}.sub-nav{width:230px;position:absolute;top:10px;bottom:0;left:10px;right:10px}.sub-nav ul{position:absolute;left:10px;right:10px;font-size:16px;padding-top:1em;padding-left:1em;padding-right:1em}.sub-nav li{height:40px;line-height:40px;cursor:pointer;padding-left:1em;margin-bottom:2px}.sub-content{margin-left:250px;margin-right:10px;margin-top:10px;padding-top:1em;padding-left:1em;height:100%;overflow:auto}.sub-content h5{margin-left:24px}.sub-content a,.sub-content p{display:block;margin-left:48px;margin-top:24px}.sub-content h4{padding:10px;margin-top:0;position:relative}.sub-content .emp-num{margin-left:24px}.sub-content section>div{margin-top:24px;margin-bottom:24px}.description{position:absolute;right:10px;top:16px;font-size:12px;font-style:italic}.none{display:none!important}.gotestSummary{padding-left:0}.gotestSummary li{height:70px;line-height:40px;background-color:#fff;margin-bottom:20px}.gotestSummary li>span:first-child{width:36px;height:30px;line-height:30px;border-radius:2px;display:inline-block;text-align:center;background:#333;color:#fff;vertical-align:middle}.gotestSummary li>span:last-child{margin-left:2em}.col-sm-9{padding-left:0;padding-right:0}.col-sm-3{padding-right:0;padding-left:10px}.col-sm-6{padding-left:0}.col-sm-4{padding-left:0;padding-right:0}.col-sm-8{padding-right:0;padding-left:10px}#changeLang{font-weight:700;width:90px;text-align:center}@media screen and (max-width:1280px){.sidebar{flex:0 0 150px}.sidebar .nav-item{padding-left:30px}.main>ul li{width:220px;margin-right:12px}}
 </style><style>
 .sidebar{background-color:#354052;color:#a0acbf}.sidebar a{color:#a0acbf}.logo-block{border-bottom-color:#303a4a;color:#c9d0dd}.nav-item.active,.nav-item:hover{background-color:#2f3949}.nav-item.active i,.nav-item.active p,.nav-item:hover i,.nav-item:hover p{color:#fff}.navbar{border-bottom-color:#e5e7ea}.navbar a,.navbar i{color:#596679}.navbar li a:hover{background-color:#15a4fa;color:#fff}.navbar-nav li a{cursor:pointer}.navbar-nav li a:hover i{color:#fff}.sub-nav{background-color:#fff}.sub-nav li{background:#47bac1;color:#fff}.sub-nav li a{color:#fff}.sub-nav li:first-child{background-color:#37a8af}.sub-content{background-color:#fff}.sub-content h4{background-color:#d9e4eb}.sub-content h4 .emp-num{color:#bb8fce;font-size:24px;font-weight:700}.summary-list{border-bottom-color:#e5e7ea;background-color:#ecf2f6}.summary-list li{background-color:#fff;-webkit-box-shadow:4px 7px 10px -2px rgba(102,102,102,.66);-moz-box-shadow:4px 7px 10px -2px rgba(102,102,102,.66);box-shadow:4px 7px 10px -2px rgba(102,102,102,.66)}.summary-list li>div:first-child{background-color:#2aafff;color:#fff}.summary-list li .summary-content{color:#8a95a5}.summary-list li i{color:#fff}.summary-list li .fa-star-o{background-color:rgba(187,143,206,.8)}.summary-list li .fa-check{background-color:rgba(42,175,255,.8)}.summary-list li .fa-info{background-color:rgba(255,213,7,.8)}.summary-list li .fa-circle-o,.summary-list li .fa-font,.summary-list li .fa-question{background-color:rgba(233,26,97,.8)}.summary-list li .fa-clock-o,.summary-list li .fa-code,.summary-list li .fa-file-o{background-color:rgba(71,188,194,.8)}.chart-title{color:#596679;background:#fff}.divider{background-color:#ecf2f6}.divider .line{border-bottom-color:#d2dae2}.divider .icon{border-color:#d2dae2;background-color:#fff}.emphasize-big,.emphasize-num{color:#888}.right-content{background-color:#ecf2f6}.list-title{background:#6f7d95;color:#fff}.list{background-color:#fff;height:400px;overflow:auto}.list li{color:#596679;border-bottom-color:#d9e4eb}.fa.fa-circle-o.avg{background-color:rgba(71,188,194,.8)}.fa.fa-circle-o.high{background-color:rgba(187,143,206,.8)}.fa-github{font-size:24px}
-</style></head><body><div class="main-container"><div class="sidebar"><ul><li class="logo-block"><img src="data:image/gif;base64,iVBORw0KGgoAAAANSUhEUgAAAHgAAAB4CAYAAAA5ZDbSAAAACXBIWXMAAAsTAAALEwEAmpwYAAA59GlUWHRYTUw6Y29tLmFkb2JlLnhtcAAAAAAAPD94cGFja2V0IGJlZ2luPSLvu78iIGlkPSJXNU0wTXBDZWhpSHpyZVN6TlRjemtjOWQiPz4KPHg6eG1wbWV0YSB4bWxuczp4PSJhZG9iZTpuczptZXRhLyIgeDp4bXB0az0iQWRvYmUgWE1QIENvcmUgNS42LWMxMzggNzkuMTU5ODI0LCAyMDE2LzA5LzE0LTAxOjA5OjAxICAgICAgICAiPgogICA8cmRmOlJERiB4bWxuczpyZGY9Imh0dHA6Ly93d3cudzMub3JnLzE5OTkvMDIvMjItcmRmLXN5bnRheC1ucyMiPgogICAgICA8cmRmOkRlc2NyaXB0aW9uIHJkZjphYm91dD0iIgogICAgICAgICAgICB4bWxuczp4bXA9Imh0dHA6Ly9ucy5hZG9iZS5jb20veGFwLzEuMC8iCiAgICAgICAgICAgIHhtbG5zOnhtcE1NPSJodHRwOi8vbnMuYWRvYmUuY29tL3hhcC8xLjAvbW0vIgogICAgICAgICAgICB4bWxuczpzdEV2dD0iaHR0cDovL25zLmFkb2JlLmNvbS94YXAvMS4wL3NUeXBlL1Jlc291cmNlRXZlbnQjIgogICAgICAgICAgICB4bWxuczpkYz0iaHR0cDovL3B1cmwub3JnL2RjL2VsZW1lbnRzLzEuMS8iCiAgICAgICAgICAgIHhtbG5zOnBob3Rvc2hvcD0iaHR0cDovL25zLmFkb2JlLmNvbS9waG90b3Nob3AvMS4wLyIKICAgICAgICAgICAgeG1sbnM6dGlmZj0iaHR0cDovL25zLmFkb2JlLmNvbS90aWZmLzEuMC8iCiAgICAgICAgICAgIHhtbG5zOmV4aWY9Imh0dHA6Ly9ucy5hZG9iZS5jb20vZXhpZi8xLjAvIj4KICAgICAgICAgPHhtcDpDcmVhdG9yVG9vbD5BZG9iZSBQaG90b3Nob3AgQ0MgMjAxNyAoTWFjaW50b3NoKTwveG1wOkNyZWF0b3JUb29sPgogICAgICAgICA8eG1wOkNyZWF0ZURhdGU+MjAxNy0xMC0xMVQxOToyNjozOCswODowMDwveG1wOkNyZWF0ZURhdGU+CiAgICAgICAgIDx4bXA6TWV0YWRhdGFEYXRlPjIwMTctMTAtMTFUMTk6MjY6MzgrMDg6MDA8L3htcDpNZXRhZGF0YURhdGU+CiAgICAgICAgIDx4bXA6TW9kaWZ5RGF0ZT4yMDE3LTEwLTExVDE5OjI2OjM4KzA4OjAwPC94bXA6TW9kaWZ5RGF0ZT4KICAgICAgICAgPHhtcE1NOkluc3RhbmNlSUQ+eG1wLmlpZDowNjRiYjM5Yy0yNTI5LTQwYzEtYTU3OC0zMWQ1NDg3N2EzOWE8L3htcE1NOkluc3RhbmNlSUQ+CiAgICAgICAgIDx4bXBNTTpEb2N1bWVudElEPmFkb2JlOmRvY2lkOnBob3Rvc2hvcDo3MjkwNTk4My1lZjA1LTExN2EtOGRhOS1iOWJjMTVkZWZlMzc8L3htcE1NOkRvY3VtZW50SUQ+CiAgICAgICAgIDx4bXBNTTpPcmlnaW5hbERvY3VtZW50SUQ+eG1wLmRpZDoyMjUwZjc1My1jZmVhLTRjMDItOTc2Yi01NjIyZTcwNjY0OTU8L3htcE1NOk9yaWdpbmFsRG9jdW1lbnRJRD4KICAgICAgICAgPHhtcE1NOkhpc3Rvcnk+CiAgICAgICAgICAgIDxyZGY6U2VxPgogICAgICAgICAgICAgICA8cmRmOmxpIHJkZjpwYXJzZVR5cGU9IlJlc291cmNlIj4KICAgICAgICAgICAgICAgICAgPHN0RXZ0OmFjdGlvbj5jcmVhdGVkPC9zdEV2dDphY3Rpb24+CiAgICAgICAgICAgICAgICAgIDxzdEV2dDppbnN0YW5jZUlEPnhtcC5paWQ6MjI1MGY3NTMtY2ZlYS00YzAyLTk3NmItNTYyMmU3MDY2NDk1PC9zdEV2dDppbnN0YW5jZUlEPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6d2hlbj4yMDE3LTEwLTExVDE5OjI2OjM4KzA4OjAwPC9zdEV2dDp3aGVuPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6c29mdHdhcmVBZ2VudD5BZG9iZSBQaG90b3Nob3AgQ0MgMjAxNyAoTWFjaW50b3NoKTwvc3RFdnQ6c29mdHdhcmVBZ2VudD4KICAgICAgICAgICAgICAgPC9yZGY6bGk+CiAgICAgICAgICAgICAgIDxyZGY6bGkgcmRmOnBhcnNlVHlwZT0iUmVzb3VyY2UiPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6YWN0aW9uPnNhdmVkPC9zdEV2dDphY3Rpb24+CiAgICAgICAgICAgICAgICAgIDxzdEV2dDppbnN0YW5jZUlEPnhtcC5paWQ6MDY0YmIzOWMtMjUyOS00MGMxLWE1NzgtMzFkNTQ4NzdhMzlhPC9zdEV2dDppbnN0YW5jZUlEPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6d2hlbj4yMDE3LTEwLTExVDE5OjI2OjM4KzA4OjAwPC9zdEV2dDp3aGVuPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6c29mdHdhcmVBZ2VudD5BZG9iZSBQaG90b3Nob3AgQ0MgMjAxNyAoTWFjaW50b3NoKTwvc3RFdnQ6c29mdHdhcmVBZ2VudD4KICAgICAgICAgICAgICAgICAgPHN0RXZ0OmNoYW5nZWQ+Lzwvc3RFdnQ6Y2hhbmdlZD4KICAgICAgICAgICAgICAgPC9yZGY6bGk+CiAgICAgICAgICAgIDwvcmRmOlNlcT4KICAgICAgICAgPC94bXBNTTpIaXN0b3J5PgogICAgICAgICA8ZGM6Zm9ybWF0PmltYWdlL3BuZzwvZGM6Zm9ybWF0PgogICAgICAgICA8cGhvdG9zaG9wOkNvbG9yTW9kZT4zPC9waG90b3Nob3A6Q29sb3JNb2RlPgogICAgICAgICA8dGlmZjpPcmllbnRhdGlvbj4xPC90aWZmOk9yaWVudGF0aW9uPgogICAgICAgICA8dGlmZjpYUmVzb2x1dGlvbj43MjAwMDAvMTAwMDA8L3RpZmY6WFJlc29sdXRpb24+CiAgICAgICAgIDx0aWZmOllSZXNvbHV0aW9uPjcyMDAwMC8xMDAwMDwvdGlmZjpZUmVzb2x1dGlvbj4KICAgICAgICAgPHRpZmY6UmVzb2x1dGlvblVuaXQ+MjwvdGlmZjpSZXNvbHV0aW9uVW5pdD4KICAgICAgICAgPGV4aWY6Q29sb3JTcGFjZT42NTUzNTwvZXhpZjpDb2xvclNwYWNlPgogICAgICAgICA8ZXhpZjpQaXhlbFhEaW1lbnNpb24+MTIwPC9leGlmOlBpeGVsWERpbWVuc2lvbj4KICAgICAgICAgPGV4aWY6UGl4ZWxZRGltZW5zaW9uPjEyMDwvZXhpZjpQaXhlbFlEaW1lbnNpb24+CiAgICAgIDwvcmRmOkRlc2NyaXB0aW9uPgogICA8L3JkZjpSREY+CjwveDp4bXBtZXRhPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgIAo8P3hwYWNrZXQgZW5kPSJ3Ij8+dnlyywAAACBjSFJNAAB6JQAAgIMAAPn/AACA6QAAdTAAAOpgAAA6mAAAF2+SX8VGAABQ1UlEQVR42uy9d5wl13Xf+T33VtWrlzqnyTliZoBBxgAkGECIQRIzl6S4ZrBEivaKlte7lmX7Y0uyVitLpk1bFqW1LIkSJZGiRIlZJAGCIIicw2By7p7O+b1+oaruvftHVff0zPQAPZwBqM9HLnzeZzDT/VKde9Lv/M458sjBAZZ7aa0ZHh/n5MAZ6nNV8uUyxWKBo8dPsX7tBtqLBaYmxujsbKWvbw2r+vowxrzka1oUXTJCbuYESgV4vkCQB8AvgwiXd1mBegPXGCEePoS34S5cbQBd7gKv5dLPEyAXQq2OaUz2DB7+9seCsFjtXXvT/+dsEvMqXOIJD5/t5pFDI6zu7iRfCJmdrXH8+BnyxQJtrSXCfJ6T/YPcuHM7B48fo62zg458wI4tG9ixquei1/T4X9eiE+yTmMqasz/8T98unfnOzlqug0r77wyVV+75Mqb5yr63A0KNDBtw7qq97D88AYvK7uYF6qsVSW22p37wy5/v9Zs7/fbtMHUGVxna5vwbwSWv3EcC8BWHTtcZmbFoJf9LwD+SkjiHS2ogCoUADgeICFG92TV93698rTS5/xa9ehes3Um+YwNeXD/iDn0HnHnFNFeUY84V+Obzvaxd1XX5bul/CTjV3CRq0Bh8BFvooESAJI6G5/CjqKWy/2++FAw/covyAub695MLS9gV142rzbc+gfbA2VfkYymBhoF7n2nSjBtXVXv/YZpoZzMTbRFAdBDMjDz6x8HQD1/vmxqJ1YShwVZOEbesO+FXJgfMK+h/PQ+emt7A2apFq8bVf/1/eJHUvIYoUF6+dvqhP2ptHnuX2/Ba6sW1mNowcvobKKtQHbuP5fp2GaLqK/M5xHBixHB2RpPTlsorcYD+QUbLIogOg4n93/0T/9ifvTcu5Ghs/zgtd/waUTJF9DdjxGefoVgsHmByPyTRK2CbDRN2Pc+PtiNSe+UsxD9E4Trl50aOfuPzhaN//t6SHzLWTCiu2YcAXnWGoLuXWuF1Tb3y5h+QbwObXGXNtcRRzKHBPBpLIvz9E7AAvueRC3zla5XL+X6UC3wjIn+PhasQLyza4Rf/MBx97L26UGauNo2380MU196FdQmTT/wafv9DFFe88bTye54yiYALrqJ4HRKW+cN7TtDSHtPZFtCM/p4J2PM0SsmW/UeOf3Km0bxlttroPjFSnVzRUnhky+qePwh8/8DfO+EqD2dV1/Sx+/+0uzLwFrV6B1Lbwuzwaco7/w+a4qH670cd+w5hPEizWZlpKXlNoto5t31VBGw4eFYYmqxRbk24qi9+NQScC3zODo1+9ImDpz493pR2NHhK0T89y8FT47e8eOzkR+68Yfu/u2vVut/5+6LNSvmY5tz6yYc+9+f5M9/cZ1RAw8wQhgVyez+OXnktnqky/fifUIwm0VLEhiuOEMfWJeYqCRbE0zxyWtM/GxB4af79Sl/e5byJpzWjYxNvu/fJw3+UhO0UcxpNhMXhBR7kcozZqO2LDzz739as3jB5+00df+6cQ+Ri7Oh8jM69csL1c8SVeNfYY//5q+Xj391YKHjUckJx6gST4UpaV78eIyATxwm9YUzHSqozNQqtvYfc+HGcuUr2UyxNWjh0poVye+FVO9zekeGZZf+y7+m2r93/2GesXySvHcrVMUpj8RBxiIvwtAflLv74y9/8vamp8WO33HTDY7kgwFq7hGgFD0M+rGBQL3EALuPSAQQKSULEy1EZOf4+d/pbv9mTa26wq6+hPn0G3YTExvgb34Dq2IS1VeYe/TRlFaNX3QyFUafX3/SYWrENroaARXDOMjwRIvokr6Zd8wpuennC1YqTZyduPTE5uzkXlnDOEGkPhyKwBuccRkFgYzxRVJxXvvexZ/7iuj3XvDafy501SwDoKdxgkUsJ0SlQaplCFtAelTPPfrD+wj0fa2krnoxmhjui/s++s5ibE9N7DUHvLnTbFpLxgyRj09TPPoM38hDu7MPYM19hzmtBl8fwu3dPi9OHotHTVwX4V2KZiNqYaJbQyvFqXp7XXJ4GB56mWZ3eZdCIszgEZTWaBHBYNNo60p8YvLBI/8zcxj/5y6/+j/f89JvfsaKrPbYX3CyLoqzqaDFclIg4h419tFdY3k0WwTpPzTz6hX/fNfDVrabQimhNi44wSUC9/zni/DC57h5YfSNy7ccoqzwT3/0V2qeeIB964OrYqeNU9crZzkLLFPbKq4SCwzhhrFlCyasrXABvtlJbtoDn6vVA0kAfJwnKCTiFVRZxmR6KwyIYIF8o88yJ0beueuaFX/+Zn7zrl+pRhDV2IXB0ODRmaQ0WwUYxys0iLCPaFFDiuWDlrvtr4z/cGtoE38+REAAJeW2wtSHsqePMrDS0vu53CAjp80KaDz+PaRhCI8QYVKk0ptrWVHHJlWmwpL737IijZhQ5/8cg4Ov37lnWL4a+jy2dPvaVZ4cQAXEGJ5p5jQa7yLOC5ywgBK0dfPuR5/5ld0v4zPW79nyxpbWNeU1WDvK2nj1jCQvtLHHT4svcMoUsrmfvuz8R9W29b+bQNz6lBh/YV0xmkaCEUR4uSMBqwqHDxPu/QHPlLpjcT2n1G2nGEyRDp6nPVfHCljMMP49LmlcUAIo46hSYaGxFqVdfuADeC4Nzy/QjQlMKj3fkmK0415InQDmHEQNOspufChWnAYsjAeWR5Mp86Z6Hf29Vz6rjq1avfaIZJwiCMYagFr10tiaamBK+1JYnZBMRdG34y87bP/XX1ZG7Pzh16Ov/Mhx+fFfJNEjCAEOZQMU0f/iL4FpwUiNq3YTftQ5/423EE92Y8rYTzimcU1doni2TzVYSq9FifjwC9i4DhussBKfedMO23/jigy/8Zq7QiXYRSgygcKjM48zbJgUSo21EQXlUaWv782//8C+bUf1NDne8mVh6W/KsXsPLCM0BQuKKeMvUZJdEOOdMoWPd5wu3/uxfz47e9pHxo/f+s+Lok9tCXcWoVnIuj5IY4xlM5Szx3DhxuZdCuRPTvaYmbV3IjxpBewqckNSFJC69YqXGZX2UvdfuvIxoUNh9zbb/NDw+/ZqHX+x/m9/Shu8UyiUkKg2vBJOV0sGKQpwBp9C5kFNDgxueef7pN3a0th4v+MImncdjFY7cy763Q4hdYfmaDGkOa5v1UteG3yuUP/6l5uSb/+n4gS9/olR9fmWgIfK6CaxgJSJIDGriADOTnYS7cv25fBuXTdMRAMWpoWnOVNq4rpygMPw4L09rfTnpHCDmbXfu+8jQ4F99/1StuksHZQSVMh5EA4JyNvXITuEkII4b+GZq9n1vee0v3rKl549X52us7ixRn64QG4e/rHd3gCJ2xeX75PloPWmiVDgRrtr7a0mc/M85+/pPVM98/5+Wpvd3Ch4SBCQatAZXr2Cjig8C5jKLDOKoJ+38ybefY8Va4cbdLfBjFrCKm02W+4gaTaJGg/XrV49/8sPv/0cdnpmNbEysPbQDhcWgMeIheCjxqDfqdBe8w+99/U13v+OG7j/e2eUK3UU85xyxcVl1Zbmpf+oAYlfA4V1eAOQcLmmAaQx2bLj13/e+/tduTnb+wn+fClfMNRsV/KSJsWWKKqZ++Js/H89OrCVRELvlPyLHXMNHa/D03xOY1jnH5T60VmzYsP6Zt79+3ydMrYJxFqsc4hS+M4CliaJRr7C2zFfedsf22z/65l2PrS6a1wczh3+g5oa+3Zid/jkwtzgb32LjaKdzqJQQtzxbGLsiDv0jaYhNGijtnWjZuO8Xym/+9A3R3l/886rqa6pmBZM35I98d8/sfZ/9E1OPxVRrLOfhqlUaNUdsPRSOvy+Xl2rR5cKqDhs3ue3m6784U6vf+OX7Hv8XptyBzlCbKLZoM+fedsuO37p568pffu2uNqfnBj7cmBz9r3nrWjEREg2/0RcLkcNWvQainzFB+X9Q7vhcCl67l3V3NduGJaasZhdM+PI12mKjGrny6sO9+/73D9W33f2fZx7/g3+jhu9/Z9kMScM2S4gDZV/WbYk4GpHPYLOTXF69IoLSSuF7GiWsFmjRSgZ8T8++XC3Bsz/KYcueUygU+InX3vKvTpw4uev5gcpPuFIHUVSjy6tP3bZ728+/93U3fml9vqoZG/g31Kd/NVQxKA/EQ2MRI4APYkNc7bYoqt4Y18X5/oo/WQ5ClOAxmKygKFU69AQlVYEF6NMtmGacRaxFHIhJEJeGg6mcE4hqhOX2p936N75btv3Um8Ze+Nuf7Nn37i/pjhaHy79EQOVIjGa6mmOmmcMoLg27/si5tOBpxVy9/q6nDh37pxO1aHczTsrPHDo12FbQD++7ee9/9HS4/9ICvgK2QhRZoiRJbti17cP90088cGZ2dGufx3P/21ve8LE7t7U93atHCzI18d9zUeWjaI3DglI4LM45RClAI1ggh4/1XW30v9Rt8JgqtB/SKndJP6twVFwRhaXmitSSIgWZo0NmyRGjJUK5BKs9nC6SeG0kxhL3bCQKSvjWEIjKeNKCMwnONAnLa+/xSivu8XKtaV5/oSWRNJiKE8V0tcBMLYdJUurr1fa6ge+jpL728Mn+/3hicu79dTy05yMqoFazG09PVzee/dYP3vae19/4oV3bN39raahyYuzKoDjn2LNj80hrufRzTz77wkc3r+r9v9+8tTS+olS/NR4f+E+ea9xuPT8FOF2A2MU66EAM4ubxrxw512g3zaE/inKF92tdOJPykZe6dTbjRabBHUDNFak3C/jSQ87VyRemUW0ap/PM1mYYmj2D37MW1VDMTJ0hcDVWF4r05QMC5aOcSzXaJrgLedDzgo0103Mh07UciVEo5VDKXnWtLYQ5Dh499pH7nzz8H4ZmktV+rkhJTOpa8EA5vEKZmUbc/vXvP/aFnTu3XL+xu+34RQK+olps9lRrLd2d7Q+8+67XPLAxH9FB5U1m7MznPet6RQeIlTRYdo55CafAiAMBiwZJEJeiWgVqt7nK0KdtOXxfLtRO2+gCIafmOXL+eSZxXtAOqNoyLhdQ8CoIgnMG6xzOJIjzmYzmmLNVzgwfZKvbTlH5tPt5ejL/fLHGaqaqITOLBHu1K0NCSqhwzl7zze8//BvPnxj86TjXQlAI0dZgESw6g5ESFA4XBgxUmy1fufexX7xj55ZfuDo+eIkP1ogtq1qEQl7RaFQ3lSXutl4Zh0bbNGd14rI/yfygwznJUkiFiKOZxLhYyAdT76zWw19UuVX/RV1Ua3IIakGDlzbhNgVZFvnjxb+tReGLwpMUijg8OUxvex+FcivWD9Gk/OnYCJPVkNlaHmMU8goINoUUPZRWbU889+Iv/WD/8U/N1P1CvtBBngRshBEP50AkwaFJxIOsBh8EOfoHRl6/5OuWPXs1bAoSQNk3BBJhw9YvJFHlV3xsrxEF4nCLor35qtm811LOpl5VLArLyOAg3Wt6dT4e+e14Ro+49pV/IUot+EMRx1ySwzlBrkIJTgBPFMo5ZvJl6rvfxbjK4WYKzNY0kXFo5BUpGPi+h+90MDwy8qFHnjv5y2cn65t1IUch72dlWC8NHF16twTBSor5i1EoZdAKJmqNlUtXk3r0Vfqo83BjHouajeuzD7ho6r1KVNYB9BKWPotILeDlcnT2djPeP8DKDRu1ac7+uyjpfVgF/qmFbgQcFVfIUOrLLb5rPBWg8FBoFBpP+Qv8KOUsLigxg4+tBjhxaJVwNUu5IoJSisD3OHt26B3ffuLpXz4yOHmzUkXyxSJKIhISQC8OdhZ7RbRL45AYTeQsHS0tI0sKuBm7q+dA3IKKOhO0fN1F4+8WUFZpXv7wO5QD6yBfKlGslxkbHKBzxfot9UZllbPJKZUFVpOUqIl3WQV0JQqtfKqNmZur1ZM/PeX61yfSLIjzzcB08LyJ81/yVHBYKQ0uSV9bOay7MsaYOw/mTXustRIVR9Fb//reB//ZwOjMXQ3xyIVllHPgDMlCPr/UERaU0zgMVic4CbG1Cruu23jfkgI2jemrC40pRWLBGvuEc+JUVmW6FOIk7pwGixMkcRgttPWuZPz0SSYnK99o72t7VICG0QzbMk0U3jL0VyQ1aR4ezaix5ejwM/9hJD75zqZMBaJicB4IjNYPvicXd33KjG/+drl4x7/SunT2aqWzfpYSNgzkfcfo2MSHHr7vyf9jaHLuljmEMNdCwZFG7uKwqKwSt7hP2F1wZOI0ubQ54sjRm6dy656t/31JAR+L266acB2KXDLNhnaNyxWno0Y4lrdRnzj98iU+mX8FSbGJBNp7+qiF3T9QYWgqtYThuBWrJCtRvrwZbNQdNldncOzY1ucH7/+7SX1yI6rImuBaVhe34Htt1JpTjFQPMKJPdR2NHv/Q1JGZrbetf+c78rnC0JVGJwahnRoFNcexiqVRWo2VYP2h/rFbSh0rKbk6kGBF4SQVrKT4MfYiG7CoyiyCI6DZaFKiWfvZ97/5H1+zfcPBpfNguXoCDoKAv3pwP6/dtZbX7N40nHPyHjdx/MvKxb1O/Je18W6RcJxziJfHJ3p/Mj35hw3VOaO1QquUBKBSYHRpWE8cTjRONPV6o+WZwfv/ZEof39hqerlz0wfY0bEXoQQLaVWVF4ee4b6Bv2Dce+7mJ88kn7tt4/t+2te5pnExDo04fckjKu7cT/y0KWBRti60qIituUlmci103HnTbz1x4ODbD47N3lgI8ggG5eyCC7OQImJOlqymIQqXQK0xwY7VHU/cuvu6T+3aseXRKIovVZq+ekm6rxzWmtbjQxNdLd1rgsmz5uTe1uKRFbrWi3EL6dFibbbKZX4l01wM1hiU1hg8gmT2JjM78FsVnfvNwciIH2gFiSjnpeQuWcrfOovVoJrubPO5j86owVtztPHWrZ+gZDZy+EwFJ1U0gkNhnGFl6w28eWPAN49/llF99O5jg4d+oUv3fsk4EziJBaesmuejLYo5xLFQAxfnqOYCao3Ien5wFpEIUVg0xlDw6uO97Z47+ZOvu/VfHf/L795jXSiezAOrKfAjmas6F9S4hcQPNEkzIu81mvtu2PqZPVvX/4qxqtFoRuQL4SXqwdZdJd8rNKpzbSdPD/7wvicPbP/i955SlYayO7sa+j+//xZ69AzGJSgJUNZhlaSYh0ubsZxYnHiIeEycPU2hpZ18SxfWajypfdxMHP3Yb/7F80y4guRVgufSqrMVWTj9TlIj7yTBGUU+iLnrA6c9FWhu7Xo9SW0dH/zNT1NpCkqDZ32cxNSdYU2hwO/9259jb8cbeKJ6Dy+MPPbb3/jb/b9B0q3waugUbVtAsZXLxGIFK2l0HqOpWUt9dpz31Kv/U627+Z/bZuWORrPynnwyd0s+qbSqltx7fuqW67735P6TX7j/+RMf1KUc2hkS8ZCM36Zd+r2MWMRpnPOwiUEns6wtB/dfs7HvFzfs3vlcVJ3l5ZpTlOPq/IcI1VptRf/k3C7jt3qx9ZXWgffkIPKrX32Rqt+GxmGcjxOFcuBEFtKTxMVYwDpFW3c3U6OD2GYVUR7G+mzr1d4/f8tGL27M6ikT6imDN2W1N2PEmzHizVq8GeO8aeu8SqK9qYbned2Jl2vTFIxme+9t/NnXvsfx8Zim5KlZnznrU7U+DV3khcEKX/zuU2xdfSu5RCh1WnSL+FNxU88lTldjX88m2qsYvGrsvGok3rT1vXF8bzLxvLGG8+YadW9dwXr/+OZ13js2eh/xJ158Ol87cU/oZj/h++q6wIs22MmBXy/r4eDNt+z+151hMBsbB06jxGFlnp2aMlORgMQpokaVFa3u7J3Xr/35a3euv9s599ylTPJFGuyuWoAFIsp5nkQRLtDAiqLCtbZxeLjC3zw5zIf2tqLiOkb7eJaMtAdKaaLZJpXKGN1r1qO9Vjq6uxgbOEXXmo1o7eNqFd60s53TM1v4ytMjaK+IQlCY1JCJRUjSz2EDklCxomsajCPvlfFsK+PTw2zqaafkaZwoxPkgikiESHdydvAsil0Euojxqmzs6aJYD/F8DaLR2uEytqgTwMUUTMTKkmVzbwt71hTZs7JEMVSQuNAYdkCISIwYQ+x5GFt/S3PkzHuu3b73L97w2r2f/ovvPPCrQbEXMU20kDJVRZNYTTRXoz1nop2bO3//xmu3/3YzigcGB0YuK2/ztFyd1kgtGi2+1cbhxGKd5f/6yLvYvrqLhhWIZolnj6Cjk4CQiIeyNqPeaAotXVQrk8yOn6G1ex1huZ2m5In9NiSporXG1qt89IZ23vm6G2m2bEQ5h7YWK6SmmjRYcU6hlOLkzEM8MPI/ifwQ4xL+9Sc+jBPwncsyag8wJFis8wi1xZkpEhsQ1Wb5hfe8lVWlPTSkgT81hK5VkCRGXAwuQlyToqqT06QtM8RYFxEbQWwWmEmCSAzKR9kQTzUx40Mfb/c6vn3LlhW/9egTpQ8OV+a2Bbl0MEyMYOp1cip2ezf2fPmufTf8xvj01DOJccTJ5Vf+PHuVGH/iwDorThw4h3IJrTlob8lnAUMB297C3ClHvnoKtBArzczZftpXrsQGAT0r1zNy/EWqKsTvWEl5602otrXUTz1OOHsK/Dw0Zym7I3T2rgC/8yUAAYhZix6x1M0kc8k4q1r7smKHWsr+AHBy4giNuELo51nT3UJnEOKalrmZw5TMdBZVuaytJszSISBqpNwAUShJ2wNQUfqnVSQavCRBOcvkmUN3qvGBT1+z/c0ffdvrXvfLf/i33/6bONeKbTTxpM72Fa33XH/Nxt/s7eq+r1wuMzQ2xuVw5y7A5N0inNNd4YMMXkvbWJxNQ3uTCh/xQwqrdmJ0K2ITtKfJF0uMD5zAt3VAUV6xmbh1LblNr0W1b8LoPOHKa0hUKyqOUZ6PNBrMnXwGF1dZoNy7+aFIJiv1OVrzK2nRa2iaOvuHH8U5gzOWxBkSDCYz72CwJsIQsX/sYayfkvG/f/ivOTjxEImylDrWQdgGhW4irwfjF3EKLD5iVFqx0pJSl7LiCc7HOR+clwZlnqURxcSmTm1y4ENeZfymn75j19/efM3ab+vGhLt2bfs33rFv95tv3bPj7kKxeF8zil92UuDLarCfU2As1l7ZC6nstDjmI2KzUGAQlxUbnEEK3ai1t9I8/TD5uE65s4fIxIwNjNC+Yh30bqJj3XU4KeOsTbsm8l2odTfTOPUggST4Hb3YyhhR/9P46/eRQqHzCYwFNM5CISizqeNWRsdOc3Dmh6yd2MmO7n0oA0gCKgYXYI1GeR77h37IiZnH0AUwRjhtnuXEiQNsLd6MzChOnniebRtu4LU730Dz0JPEI0dpWbMCHZZw1gMrGb7t0u+f+guM8vFdiHUR1eEBCrbOjJeLJZefa9MN9m5e84ktfe1runpWPjQ9PUMUJ6hAnYOir0TAXeu6OXS6n7L2CUyW4/0okZfoDBucR2HUgikUEcSZNMEQB2292PpWosFnkKRGsWMNUeta6FpNPt+GJY/KtFCyPNFr6yVesY3G+HGKSuG1rcBMjhLPnsVrWweSZEzLFJ5EWXCaG1bexZnJ5xi0R7n31BeYa06xu+dWcroNm4EXtWSc588+yOPD38d5jtZ4BQ3TIJI8uZLlhdH7ObZ/AgKfp54b5NGjj3OtH7LLyyOjHm29Ocj5aVZg046rDJpLCQHENOrTTI6OInMT1J1PuPmOX25f3XsgshHOcSYMwzNRnGDs1SUPeABVG6PEMVatsaLcsahsfnnFhkQQi0Kch3PeApbqJEaJR2Qd07NTtBRbCfq2YHwPU6ugy520tK/HolA2LTigTNpzvMBR1BS6tzFda1BvjJEvt2G0gWg2pQ7IPNK0QLvHASW/g7u3fphv7f8cw/6L3DPyOV6YeIiV+S3k/BLNaIqR6iHG7BmaGtaynTdv/zlqZpanBr/F2ZnDTJ6t0eK3kTcxzSDirO1nbFbxnPK5trmCa5sxKzv7CAtlrCc4lVrFJDbY2hyV+gi16iyBsVgTYdt3fq5rw+7/qpRFZ9/O2leGiemRIaBKhLHqDFFkWNfRib3crjon4Kxo59AWEJtxrSBywqOHj/H48DRlHfORfbcBAaprexbHOuIs5UHNF/M1OitUyALqFVJevZ366Sr10ROIbkWV15zjU877YZE0FhDBOugtbeUdu36e+099leP1ZxmOj3A2fh6THkcCkyekh62te3jNujfT5q8DYPW2Hezvf4SDT/46Kh/REI963ECLIvRLnNWGs83T7B8eYtdsH7tbemnLmgASDLGLswbyPDl8cE3qLeseXHPjG/6vIJeDxOJ5hryOiV6hebYeF7AchmZmQMPGllTIbpmarJxDO2dTX5jgJEZ7iulGg0//zVc4migKPX28Y8tq8lqBNTgh89oK31lEZaFaFvS5BSjSIdiU5uO3Ulh7A0l1HX6+myTfimDSn0n6edV8b5RLw3tnHe2ltbxj1z9hYPoIpyf2M9kcICJGS57ucBUb23exsnUToLE001cwOfaseQ3Xrn0tDx/5Jiqv2NV3MzO1cYZnz2Bx5PwcZ3MRw9UT9EeT/GTXJkpNh/UcvnNEXp4g0WDrVMPOF1Zde/f7g0JpgizmEV7ZMSzexeC04mx1FueEDW1dOOuW2V+vcGgxIlhxaNFMJY4//cpXePDUALtuuI0WYq7vTFMbp3RKOybJ8FzFi4Oj5MOQjR0tqQURm72uIAgaSAAbtCEdbSlBAIM4lcKFThDRqWuQc1C1U9nQUTRr2nawpm3HuWhbVPbKAiYNEEWlt8VIjMLyj970Kdav2kFUPc1bN97BeLPKXz7/XTo72jh46iBj0yeJCpoTZpaZqEGnLtHMasmhMTjToJJve75j+7735wvFsykN89XpfPCWrkAoBudmQAnrW7txyzHXSmOVWsCYdVDgy489xVND/bT39REqYXVYoK9cXqjipF9SpYUGJfzg5CmiJOGfvOa2tDFFFlGQM5Ke5+yi6DzNadPyWQJOp7wvSQ8MWRIlC+FoGtVmqFuaysA5K6VUFolLFj4IzlpyXpm797wDN/h97PALdOXyfPLmN+HZHAfat3HfyYepqDlOHzsAbakNCKxNMWbTpNGy/oG+bTd/RPzcSWsStP/qzZ+75Dt5SjFYmUIENizDXM/XZQSLcpbYKg4MjaACn46WFqxN2Njbi6dTblXWyIbFocUxWa8zGDtOD43w1LHj3LZ1S5o7z4dN2aSeFESYL+XoBY40Tmd5qaSBi3Oca4WZBzcyiBGbIllKkAUfbxeOkiMF+lOLoHAqIZkbIx4bIdAKcQ41NkRiEjaVetm29x3MuSYP+k/SWQiZGTuDUgmNyNWltPUznTtv/39zOV1pNOaWHbEa667KXPCXPEqe0gxWZlDWsqm1/SWFrJ1GO2OxCuMUubKHF2ocPsVSOwVl2dHTlVFSz/lHcanvPDo+TTUyzMxM88jRQ9y2dQtqUa+SnBduXciRlAVzrBf9/VyGvvg309+SS+Hp8yQ8UmsgGbNYcp2oVTdidURcGYeJAVyoCKNRmB6jnMvxlh3X43zFmPUYHx4eLa3d+CG/6e7xxIE1i8CgRe+oNWgfJwajA5zycEmTrlKeKEkPWzMxKGOxzmGsyypZV0HA85o8MFfBCmxs7UiF7JY+dRZR81qlQ43BUAqL5MIcYmvkfH+hiLrAEXQGI4qDo+PYBOLIcLh/gHt/8AAqSQOxlC6akQAy87z4/y/8+4/6s5RSrqg1mpRaW3jt7bfhrMVhsdrH792afurSBHF+FblQ0xg9gDI1tHb4lVNMNNv41XuHiXXxzC+//q33JJVxQmUIXZ0ETewkJcw5ED9HNDmJHTxKrmUV7aMv0lWdQCZPs33t2+ldt5GGEfLNLpTvc2RknHIYUKs3aCYWZSwqiy8u1VexLGfgKcVgdRZQrG9tz17QXUSRMSKCk3QKjwanhOZck7m5KlHR42vPPM9H9t1MKGCcxUNAaSajBqdmZ6k3mjSaDWw+z65duyno1L8upbOvxGUBTwmHj53kO9/7HnfeflsWJwiBSyN1i6DDdvy+Thzg59rB1ZFancHhE/z2373A1wcc+7a2K6NCovJqRpxBbILLkTYB1FLhJhMTjH7tz+kaH0QVygQmnRddTxw2atKS8wmjJnduXoF1cF1fKwoYmp2j5jR1U+TMRJUcluASKweW7e09pRmamwEs61o7U/aFW3RuUjqGSObLrFVop4mJGD5xnPXbruH5Ro3vvHiQt+++Bk+E2Bgma3UeOzvEnDHMzU2SEJEP2+nubM9AgFe/z7a1rZ0wzJ/jfGeBHM6mdCBS5omg8PJtJLTjFeCHz/bzncOz9BaLlKKm9dA4a1KETbxzHt4PMZUJRr/2+zQnBtFBDpIIo/20sTKLso1LI/FmNk4x9FLruLErFbQBrusuAA2IGkD3jy7g+Tx5sJrO1Vrf2oVdRPcREURwImmYY5qOfEkRa0Uc1Rk6cZK+Hdt5cGAQCUNUbDk1PsVgo06sPER5zFVmEWtZ1d6a+lJnFwKpV+NyWUJmnVlk8tw83TybZACLSdIOi3YCorC6QOAHKNsk0gUVYYjPY5M6xM9hKiNMfu2zNMf70bn8RW7jkhYm8412UcuvvAzmeNnxuqcUQ3PTgLChpSsLvCCzyThnEfFJKjVc2YOcAt9npjaN9J9m3dpV3Ht6AOsEZxU1k2Cq0zSmpkmaCSGam7ds4RzH8sdwuaXYyIt4WEhGNUoBnkTSpCy0hpzUSaRAnLWWzB8GRzo3M5mdYPKrn8VMDKKC8KoQLbiaAp4v7g9Wp1EirC+nQp7HjlK+mODjWNFa5GRlFs/Lob2EybEhyoGg/YCJmWmi6hzNqJllPBrrhFJi2djRBRbsQkElJU8bAe3s+UzyrDNRcFnUqxYpWQp4WHTaHiOS9UbYLJqW82rB57qGL8aXMkJvxhxRCymeQqOsA5U20UU6wLMhoREbuCTL1TOfOzPK1Nd+n3h8EJ3Lv3KbXC6o8v1o+ZVSDM5Nc2p2LMOBsxEPkoIEKrL8zG03sbWtnaiRQn++0vSfHeTUyZNUp2YxcUKg8nhegZpL6Mpp+pIEU2+ASpOZWhQzXqthrcW5BOsgchbjhLlGMzNdCYmDBIe1BuOidJamc1hnwVqMi0lcCluqeQFbi3UJ1sUk1mST3d1FOTELbW5yDvWSjMWcgTXzcYgjQXQFq0QS7ZMoDxMWac7NMvy1/49o7OxV0dxXXMDzgdfZuWlOVMZTLrAS8YwQaYiThNX5Mnt2bScXqrSyQtqT4ymNFgVaURXLdG2WdZ7jl37qLWwst2KiNJp8/NBJPvulr/Ot7z9CpdnAEw+lHIHy+NJ37+d/fPnv+Py3vw+i8ZTgo1BK8CRAlCZQCiU+SgxaaTxxaCUMTcxwemQMUQolHlo8fKVRGUTp3LkcYTkuwmVmWJxLWR0u6+ZwqVk2s5OM/+1/Jx4/i7yKwj3PRMuVCLk6haeFdgmb8xml8jzOVOsMNSO2XrOT6dFRJiermEadxKWIkovq9Bbz3H7dLt5/+610tbTw1aiK+MJUPeab9z/Cz733zbTki+RzHl/7wRP0jwxy977bOTUyzfXXX8vDjzzB8dNDvHDiBG2lEp09Pezff5SpZsJ73nADxwZGeeKFI+y7bg9iZzk0OEX/0DSVmSl+7t0/wZMHT9DdWqCvr5cHHn6KN7/mZnSQw4p6STrQxeBJBmertOgvToH2iacnmPhKJlw/96qHE55IWioECs65ddY537pzzuniWd6L/uqcc86JQmSwOpVUbX6dVZ7KWUugPA5WalQaoHWOvr61dHYZonqNamKx9Rrv2rmJ12zdTEehcC6OVYoAj8lqg1K5xOrODv7LX/4dGzpLDFca3LHvdr7/2HO0lzv5/nd/wOadm3nu0DGGmgnHTx+hODpLl+exc20Xf/K1vwPVxgfedgdf+vp9RKLYsW0TO8tFoqiPx599geFEM9A/QHfFEAFr+ro4eeo02rklGFuXLIUvaLJvY7RSWJdzJRUw8d0/ojl+Fp0rZIxM5ie3cN4Iqfl/k5TXdbWKEZ61lmqzcfejA4O/GUV2q1Y5bRK3OPpwi1OhxYUHWUChEU8l1s1OuZryPFxMXQynZ6bxwpDYGWJjUUqRL5XRounpauOua3ZSzID3+RRFW0ViLGs6SjQbNR578TDdXa1MzVRpOmFmfAQ/VExNz/Kut9/F/Y89B8UALzG8+3W3cGBklpHhEbxpRVdrC1MVx9nhUXw/NdebejqZGB3mxMw0bbkQXZ/hHa+/lef6Ryh3lfGUZLnrYv20Lylit1D1UojLEwUBjfpw1+lvfPaT0dCJAGuNbc7hXBp6i4gTAeusOofQ4RDlRMBEibJJ8h2Bw1cqZu/o6f5tj5/s/8LK9raOuzdtpOjn0kmyblGu4M7lCecNUnDnBK8UnBye5MnvHaYuBtURYnIeiUrnSiiVEu9wgmk2Wb+ii4Kv01Mti9OhdM1MoIWPvvut3Pv4s3jW46ffcAsHzwxx6PQZfvp1+zh55iwbezsJr91Oe28njzx/mNG5lCx3cniCjrZW/vef/AmOnBnmgWde5Kfe+Brqs1O0lUPWdG9ltPIst9+4lwefepahyRl2bloPcTMrZfqL5PnyuK9ysjA62AoURFMYGVh9+uuPf7ZFN0i0d7FJl/kbKOfjgs5SbcTM7Z34RbHJYZIr27rmDczMfrDoFTr+8d4b6CrM+50fDVxwtRjlYmJrWLuyj5zvYxKLZ+abwSBWoJ3hmr4uBJfVcRdikrQAkdFXVrW18OG7X5vdZsMdbdu5Y892wNFzzTbA0HXNZhyO973hVgCeOnyat995CzfvWA8Ydm9cze6Nq1NB9XYspFTvv+sOwPHeN95+sQkWzpU0L5gNslTEstDOYsGoiIbR2LXbDu+4+db3RceeyZkg78jADLlwJMG5NxeXwoPSnhjV0dt70sTx8kq1LyXg2Xp9S7k9oCuviWyCcgoti1hZsgQ159wMhoWTqBRENqbmgzE+nX29JNZlp1uy9sgYazTdYcjGzjbmq7bzryuSAib2PNMxjySpJbyeWnR70890w7Z1i56rL9DAxXnuIifjzvnQFMRYYnxSNrMDpxYdgBTZss6iRaGtxrOCih0u1I38vrceSJIEd/YITql5Ab80auFA5rsTnbvilQLeinLLfQ8MnP3AC5NT7O7sXDJ4WBrSueg+ESoPlyhaWwuUOgvUsAQkOEmnrqdT3BtsWtFFOciBSys1InqRR79QZ+QCnyCL4g95iU8rl/h3x7lW1SwflvOBDnXeyXYLWp/OEZFFRyV1L/NCiL35DgsfYqUSXSLcsoucGU4FfMFnsVl/o2QwpAO0SjH6QEFUmblilqXXXi59uay9D37uiYOvv3X1SgpFjRG7gI+6C7fezLP65wvt4hALvgqYGpskUXX61mxE6xxhs4HRNh1RagWDxndNdvZ1ZZSY+VkT5wvgHItfljCdV3rJBSyRi0EBlS0bWThiGdbMoqPB4v5dpbLvk2JbCsEzJsoPH0GdeQQKeZaaw2kyDrUAJhOwpwQxFq10uqnmijU4mpm6uzN499FK89/+8PjznwqseIksFGHPu6uyYEbmwTqDEQfOR2tLXBeU18ravi6M1DE6wUhAziRYEWwidOZyrO/oAGvT5bkXvEetXmVoeIRCPsRYe7EHnO+dlUvvW3rp8eJy7nBmEOR8Xy7WgefRPzJEPQNbknnM+VwpAlnQO0E5g7ICOv1Xzwom9KhNnl1z5q9//78WmWsxopb8qGnz6fxsg0x1xLlmHHvde1s+397afm+SXBnd0tseTdLW/8L1+oGv3No2elbloiKJHy9xo+S8OrB26XYVqxQOTWhrnMlv4diKu9E9RWJjyJk8TaUwKk5vTJKwo6+b1iAAm+lI5tPmc8TXv/5OhoeHmZqaTl3QRfVgeVmo3b2sDtusaqRBC0pSnNokCVYHuMYsb7hhOy6qYf0CkKCaVfALOBUgLskw7pT77ZRDowhMWl1Szkei6srBU9//VDFqYggu8akWOaBsGpE4w2x9Dn/Xrv5N3bvupXGFJnq2Wrvh8b/6/NfD9lz+5g/8ArnW3nOBnZPzwh2yjNCRmVZZRJzzhI6RKs8PNPDEx0YxkYpRokF8YnFoSdje15OaJHEpmOB0lu8L1jre+Lo7X12oJ5kgmuhHCPC7N4DK48YOwNQp5o4+QH7jjdhGhfrAC9C+mnzneupnD6ND8Ht3g1dcmN5nBZqepcVEVLoKVN76RqZlNBusspR41SKTrxasftJIUGs3Dlc6i8TJ8utBhSXz4Gcf/rhyzfzt/+ZztK7a8aPdpHgGZs/QzhOcKXg8mETk8TBi0S4iRlEjx8YQ1rekAZfNfNXirEFlviwtOS6FIcll6upLhasCSZX60Yfw5kYQPBrNKv7KLTSGj1M0owT4NCZPoCzkGiM0puaIczmkNoRMjWF1Gb1i1yJ/HePZAEOTYq71+M273/QpxRzWLd2GrebnuuEEUTZNtLRRKJdz5Wen5y5zZ8QSSKhX7T+6acW6DbSs2kHdRng2QSkv1Txk0c1modSW1mMUWhzJyAniY98l0ONUx2Mm/b0kSjBEKOfjWcFqRS5uclNhBH34FI0V15Dr3Z1VARfTWueBE3VZAdOPJFwEkzgkruEHYXrgpk+TdHaiwhKmNotvY+LZYVTfRmzYQWhqREkdr6Udb3yGeOosqnsDThfTmZsqRllF7EHoWqba3K5v5YpnEWXOD8rOF3Da3akVgYSAh8VSjwxz9QR1hYGlKq/bfOLsqSPMnDlATgX4XgGtAkQ0Igql0rkZIt58kzeeaLQIGoWpHiKnJyGGw2EH/bqLfKJxeFgxNLSQENDh5tgRTpGvnsBWBjJNlYVZlT+Oqr72Q2zYltJzNWib4CbHoCWPMYIoQUcRRAZVaEM5hT9+Am9uAsnlkeYUpjK0IDtl8ziJUBi0Uao5VyKe68AzHsr4Fz3IHuJymIbw+PFnefTEE1SrFWYma/gSolyw7MeSPnjrzXf/7qkffvdDj//6J/Pb3/Y+XEcXXpJxiJ1LTaicAxysS7lFvmliRVMsVcjrHBL5jJuQCV8RSkApttR8Q6I1No5YU6jRoxsgAUr5mf955ffnvqQm6xyqbTVJbYrAOpQyqMkzGN/DF4UVD8/FmNFT4FlEW7Rr4qyfLtZ0MdHUCEHbehCPhJBEpfM2tBKbyAzFXERetbJUb5md77cQRRzVODnSj1EJOzo2U68llAoB5gpJAV7Y3vncDR/61NuO3fOlX3nuT3/3NUZpSUcdqUUuMGVUODEkSlLv6WrMEvCmD72LwvouROW4IR8wODPKAZvQ1EU866GNw7iI3V4V3yQY0YiXdR6Jwr3KAnYL2XXa5uq3riIeOQVuFqcE38UEcZLyk0WhMHjJHNZKOsxcvJSsj0NphaoO4+oTUOhFSPCNwZMCc67a/uLYd944FpVWhn6xy+KW5oA5QQmYOJmwnh52Cn108mSuhVVPoOSKJ+55D7ocAx17nhp6x5rHZkdHbtcNp8VrwMIIwkXUmIx7JNbS0EJXPIvp8/DiJrGNWKkrfLA15L76JA/VoJIroV1MT9Jgm47ShZM4sDrNJUV+TLzJzH87hwpKSEs38eQEnoQYBC2WRM3PWs7acRbGLVpSETusCvDiKtHUaXShF6fS0DHWRabswKZG/al7j85VSNzSkbCVNN10WZokOgdiOdz/Q25b88Ffai20/1Z8pcWGCGk7Ojn5V5FO7rr2mr0UVUAs8QWg4TkxaOfQTmgon1XNE3TE+3FJA4XGJZoy/by1XKYnSPjOlOGkbuf2XEy7niNF3Sxa+wuVE7WoBeVVEusiWDKdzaXbV5BMHcFzpOOMst0L4tJDmELeNisHkh3ODNnSGmaGYFWULuUUH6ViVs/WufHQixSSUSy5lwBl0hYbZV228kBIao6g5TW9pcZKbNxY/pdbwg17E7XGByvEd/38jTews6Nj+S9mmyQHHkaaNYyfR9m01y9WOfyowW3+GVZ21XhwapSbQwPGYJSPUwabcYRlKTjyR4iHzxUl1CWrPgsVXZeNj1Vph0aCoItdSNhK0pzDy8adcd6oRLOoeHE++uWUh9eswtwQonTaLWmb2HLf2TU9e74UzB1viXXLourU+V/ASjq6MGccShKaSmNaPVTY9l2Mn83SvhKgY6a5b1VbJzvbOyC2GJ2BcHLB/pCsxdOSYJUHY8dhdgDtB7hsf/f8iCKnNM4Ia9Q0/1unBqNwDjQm5RxrP71VcmUA8/kYl7xk+qTO5WAZOGNxSqOJsbqA37UV2/8k1hPEeogYnGTjFzPhysLEgvkiWorXK2tgagDlPBCLjhTN4srxwb3v/D9Xvfj/oJvjiA4X4M3F2iySskeVcSgxKRaQBOjATzFud2XWzcvnglPHZyYZjur05XLZGdUX36b5ecd4KCJqEy+SVyZNc7Jpsgutl/Mze4xFVIJyHiJ+2r7hYHquSW18Jts/7C65SEouAvTcORh6kR+TxRh1lq07JPuZW3RLBSEi0hovMuTtLG1qgqorM50UWePnUbZOonyUNWn3oXMXkcvPjUDOPpUH1KaQqiWWAHSNwEzYOFzPxOZP0Vd/nurISTzqKFPHEqQzS0Qt8KISnRX+hWyjciu4EHcZAl5KVby1HR2fPzgw/JG/euLFVXdu20yrgMkycLfALZ5vFLPEoikkY/TUJ7KWDoXKIHNZZHQdGpG0Qy8toWUpQbmDz9/zEF998fsUc0XALm2+Lvkt3IIKzY9rEJdF+U4taOfCHlQnnDfJFoiJKYvit96/lbbuHoLGKX7/b5/lrj1reNPmEN2IUPiYDISw883kbikQzSGSjmewtXGCWKG9kFhakmJzmh4vQffeTDKVIMxAMo2hiHIxIt5CGdQqvXBAHRanCuD8JQGSy9Lggh8c3tnX9ZFD1Znf/OyTT+4OnJN5wpk7z0OmQUliUDv90fijvV4IuXQtTjafCvyFSPPc4ql5oWT5nBKmG4aRWpNCfHE1aSko0p3Xw5thuJL6SoekN37xNpf5pR9OnSuPZAKy4pM0I1p621izogtT3EmxfIY7dwzw1acGeN32a/HdIFaVsy0xZCMi9BJOQRZqTFiFn/OJaWLF0pgbKPknvnF3FEihGYTKRJVEu5mSSqbzjihyLlbZNJOMUJ9ONhEcNrGKpPk4zhzCJVcmYGMtudC/d09L92vcXH3bXFTzdfpm84drodnWIlJJlLqpbDYF8fE/Q5yIFazyFraosFAhOrfpxGX+Ox1WHtPX2sLudW0UfbW4u2PJCNPhsrmSLAx1sZkQsYYkMrjM1KmFyNctmHWrXBojiMb3QlAJNu6gLFXGJ2bozY1jmlXu3NLBQ4ernBypsrUlwDmX+taFFnG5hEERDDEeAcb5bFzfQ6sfU3KNHd965Lnv4BJiJXjE7ModZas6jE0ciZwbHieLyPOCYy4yeB2rfruju/VfXjEni4xNIE7qvlLP+qLQSi1mxi741aaBm1oTrimio+mGeE6jrYdVdqGReyGulXOBmcsIaViLWEM+COhuLVBQNuvcv9R2s9THzuesOrPOVsBYoeDBzg2r8RY2qM1rtDuX6opBC0xXGxw+M5INTSsSNZs8c6rKW4svEtdrFIMib9/TRjI3gWvvQbI6rFPpKMJLt3mlSwOcJLhYWJMvk88ZErOaF1mBUxGJhMRRRN/6W//5zXtLh63KK4tOFsZ+SxbNZXeubBNPguCIie3C7K9lC/Ol/s1x/gjqxa4mso697T63dAREkcTGKcSlw8cccUpJcedGJpzTZlCotMA/34hlEiSOcYFCjE3nSl0KypPsxjoyeCEFXGyzyerVvdy5Zycqqqc+mGyUoDu3eEuweEqoxMLQwDAzsUVUHbyA5/tnuXNzQNHWME3HzesKGMlj4jgjAqpslrUsPrsL+Vlq+tMN6EYcE7UEI4pIIlBkgIgicAmRiah62w7OFbu/09qWyyIztSiUXnTHncHWBtMc+Ar50cs6Hk3juKazwC2ri4BCotwpN5YbULa+2s57ksUVoMV0eckmu0paJYrEZ7rewDihZkN8G2Ost+Bpz2MRCouCtvPXn1itODI6xjcffZqir0lMOmFOLdRmsyk+zoAWxitVRhsxiI82MUZy9NdmeH4g4ra1rUjcTKtLpNGtzO8nXrBCqZIpl241Tw9amjYq8TGJx2RljsTmILY4FyMunT8iicFX8WRHe+nosRHFdfkIrcxLCthdpSGxLytg46AjB7tb68SNZgqaC2M2Vzzjqs3V4mX8aatYtJjwAitmM9OQ0Iy9A0Gu9S9XdUhRpCiexOaCSNE5N2+5zgn8vP/PgjXrrJsYG03GrPGc6LS4PE/zUeIytDsdx6WUWtHV4okB39nEiK+ixJOJWlQ3lP65stIqGYXIpmtKUSZDskgHdafDYCzWy8ZYZEPflEAt8b7VXggfiv1COfQEZY1NRJSTWHt48bW7b/x6e0fHiXpsmG0a2gvwaqwZ9paDFL1hUwdtRQ9nMgFqD1tb9QdJZfQW36Cd1gjmkoFIGiQZYmsorlzzO9fr0u8/fugMnlfAU/F5X/TC7omXrHWKwlMQJUnKzJS0Y3D+AMw3k6q0M52GSRdh+s5ixCcxjmuvXY1xo2slmvtYGsPq84hu81mZsjbFrhZG/riFdlKjFWGh/ZGf2rf2N544NUX/RJVQWZwoEokIPY+e7i6a8au/7v0lK+vGQqsvtIghacSYKEkfjSYSdv1ZI2y/30mS1nUXVrFezOVVFjARTb/n29K+5vO71rfh6bRFxVp33iMx6dgCa7noZxc+jLUYa7HZny/3WPzcKDKs7iqxuqcdV+j+TOJUgovTfl6nLro1Rme7GaygE52GR75Gl4vEzTnOPPHAu+unD/3MT+1dzx2buxFJp+MsfE7z6gv3JTU4cSlH94ZuHz9pEl9gfbX2k7i85gvx5PQbPIwgKusNdhkLcbGFbtIUPRl0b/u3Qm6uGFhWtBU5OW4JMqDGZvFUKedRnxpHwkKKXS8gZFehVLjo/FkH21eUkLiB9nIvxLn2X1bNyf/oKVFO0uEUNkOylE1pek6lzXFSCNDOUh0d4+QzzzBw4AVGDh++rnPPvk+3tHV8Y6PYGdoNz81oJpqGwNP8uC5vKbMcI/T4cF2LT4fymKm5JUpdQj7X9rQXlGtKu2KKsaVFhUUwWBr9WoNp2/KZQmvPUyQO5Ws2dLdzfGw8FaxzFAOP9tCnlPM4NlIn7wlWJ0QqIBF9RYK21hFbh9ZZf0QCUWLPIVHO4cKO/9SwOvHimf/XpxmKqAXaEgjKU5AXolqD8QNHOP7M8wwdPs7c+BhhSyvGb2Hu9KHe2fGht6zZueeLuztiNieORwdnOT5WSQebLWTyPyYBp2mGx+12lm1xA28ynfRy8bN8hgfP/sT3fvi1P91ckHx+RTvtba0UOtoJ21rxwxxkE+2II5p+x0O5js2fMXE6FBwLazpaKQZT5D2hI/QpBGpBhA4w1qKJCU2MEU1TBenqGbX86pN1jjgxlPM+127qZMfKPOKmqURFHtk/fUEjncUvd3xG1ZIHIxOsA/VhbaPbdSB5LTpfnxzh+ENPc/rAUQaPnKIc5KnMVWnr7qRemaWzr4dwZoyh5x76J1vuuOuvMSbxcdzdt4KTQ1M0Q59abNMmdJ0RssyrIuB5oDFdr7r25LMUq8OcEn1JfVHaY3Z8+A1Tzz/ZM5ArMf6C0BX4aF/IdXRQbCnR0tNJoauV1tYy/po9xxNpqYwNjJ8XCb9uXRv9M9U0CLfZOC8Rnc/n7ygWi08ppaoL1kAgEc1UDMbYl0wPrXPEsaWYD9ixtZM9mzspt4RQm4MYWtqKvOeOABOnxLvzAzeeNH7rk0F7z5eb08Mrxk4d/t3Jxx9855HnD6CaTRrVKh2tbdSjBh3tZQKBto5WxEvIFUOmjjz7msEXn7uu3NbxpDUpzNirNclMdUFZ3BlH1NNK0Nryagg4NX4rVcL2MCK3shtDDy+1i8ULQoa+d3pLHFuabQWac3XqSrBRhJsdoRxMMfTCUXL5AOX5+MWH3mXXP96x8yfe9zOeMDsv5I6ch169imRRf49Syiilpmq1mih1fqATChgTMRE7RCn0BVK21hFHhlLB59qNHezZ0km5JZ3LTGzAOowFlaSRtvYdVsW4SF8EsSglNJr+0FMPPhvPfu/vUJ19iKfJlfLkQ00xCMmHPi7zzzp2xJ4inKty+tEffmL3T7z9SRunAm7GyUX0idrZKVwCuc7WNM+yr4zx9nqP30+nNZQlpWdH2nvZmlRsKURTwzvWd7RhPUNX2SduNPDCItOVOZSChrXkGgl+LiA5dbxU9kt7W/NB05uH/7NK1bS1GMt5/rVarYZhGC6po10tPmWnGJxtkJhzqVkzNpTyAXu3dLJnSyelllyaBkRmUR1YZaOC5Vxvs7bgXwhTwexElTBXYtetr/vCt+//zvs2lEJi06C9rYyYdLqOsWkOZSWdHxlrn5wPM4effk/tNa//Fa3U2ZdK+WYHhlCjY7RsWI0f5l8RIXubtm5gfj7dsi7tMTc1ubYxNbilNZ8j8qCkfIyvMU4TtpWJ4iatpSIzsxVMochkUqdnZe9Tp46+0DTzFBRrUWGR8jX7yHv+ggZrranX67W+vr5cW1tb2oC1RN1zZRSx/9Qg1XqTxFhu3NHLtdu6KJfDVLDJBeOWlMaaqLtRndibb2u/X5QXLVSJA/BbcriawSqfuckK9cYsShzFlvK9m6+99mRp7NQGHRYxcZzytGR++EqKp1vlUNYjDgQzcaqt/8D+d6/fc+N/M83GS2qLqSdMHT1LeWU3YXt5AZm9agIeOLj/8lgUWjM3Pbk7SSIdK53CalbwBERZtFLkfR+FptzeShPwSkV6+lYf2bx9O3GjttiZ88MX91NpxAsEb6014+PjSUtLi07Pgb0EyCH0tRSo+rB3fRsbVpVwSR0zPrfE/VGIzqnxQ5//I3vm6z/Z2PrPPuoVuz+Xjk2a99uWfLgGJ3lKLT7l1nnaab46fe11Xx/8zvFPhdmuRMlcygKZIDNInjNEypH3HKOHnv7Za97wlt9XItHLdQg65yB2mGqM1xKmAZgsZp9cgYDb1m69rCf4uZDJ+//uOtVo4PLldAyuU/hOpV/cGERMyhj0ICTCtrYyMzVz7NCD9+Dmb6q1SKmd4spryRXPmUYRoVgseoVCwfN9/yVRrTYdcMPuHjq7PFw2z1EtheWYiLlTj7wvGPrmTyqvidfWMRUUuziv1ipgY8XQwXGcjRdMttIKv2vL/3Bh68eNmQtR56pVzqlzfcbOI9YGz1r8XEj19Au7R44fvKtnzbpvufjlWlBStU3q0Bi3BK0hXs5CcuWT4b3K6MjlPSHMMzdy9poAk/U8eiiJ0y5DEbRTCz42nTDnsOLRu+WaI/liYQHuVOKYI2AsOT9XEBHiONa1Wq2UDj9zl8htoVjQtLbl0+jbu8SNEA/rZlZW+7/8H8rxODNrP/KHKzfc9C2sn7ER5l2Poj4wgS9NVE6dBwwUuntfnFi96cm5k0/coXNFDBaxKQxqVLa5PBO1cimzMmcTTt//rV/su+vu75hoeRjlPIHQjnsknr7s3mDdtxTp7lT/ZdhnAdHe3Nmz23yts5xVo2jg8M6dbHQ6mskprDXoYvvsqp03HAlLhXNAiECzGXNk/xkacXLePOcgCGYmJyel2WxecuRBHFvWri3j+Ytec6lbpnPMHLn/X+TGn9tcb7m+0r5h329RPRkvFKwX/apv8nSvLl/EQJBcyMp9d/7F/qOP3NEaxAtzKNNNoWk51IlF27Rwb5zD83NUTrx413jbP9peXrHmRRcvv883uaBydgWsWbxVW3ctX75KETWba49/b3ad5/kpd0hAOb3ANLRybgZnSvGJkVL5+OETx0fFxOdxmRoqxC+0k+P8DsN6vW4nJiZ8Y8wlBRxFhltv7AXrZwX5pRy1R3Pm7K3uxJ9/Mu8sZuP7fj3fd90RXJ2lVqfPjc4Q1evnZmQsvFlMrnfVl6Vj1X9Q1ZFOrQOMpLVfbecb6OQ8RdBiUJUxGX7+iQ/19Pb+sm3WfiwMf+/E/icuS8CNubk9KprLp3CM5cJh+y4jKNhsZ0JsFKUVG050bdsRJ/XaonvgaMaCHwfnuRmlFFNTU7n29vbWMAwvaaKTxNJVaIdZgeQSAvZy1I4d+LDMnczXuu8+2LH2tt+lUV86TBWHSizaXNwJKMZQLpVHe/e85qsT9335Y4Vi+vxEhMA6kvm6dQZ222x/YxAWGH34+//IXrvvP/q5YNpZ9+oLeO2ea5f/y7mQM08/ds10Yw5VyC9QbWShWHhu9JBVaZOpUx5+ozFUe/w+7GIzZRLcym2U1l/L4mhWKUUcx9VVq1YV1q9f/xJVGMec05ycVJfMK7Sfo39sbSmf/9iLW697689LsTSHiS9Ve8RJZWGFz4X4vE0MHRu2/dlIkP9YIkm63U0ssVIpSSBj9hmVLiVxCBLkcUP9K88cPfreNbuu/YMkbr6iwiwtJbO5Zn35Guws48MnbxJlMSqt8Sp3rolqfs/RPI1Ri8FqYcWqFQc7g3SO47nYVjOe1Dly8sS5EcFZmjQ2NtZsNBr1FStWEF/CdylRFPNtC9Sci4SrNOMTY1tfHLUtb7rzk/sK7WYWkqULpKKwtQb106ewyaXdQks+91DL2g0HGqcP7AyCAM9F6SAVd25Kj0OjnMnyWUfOsxx/9J6fbenp+CMbR+ZVF/DqjduXHWA5pYMDs5M7PKUxZDNU5rd4O7WwoCodyeBwVoh9n46b9u0vr1wNiweKaMXc2UmGnj6N76kL80JXKpUKo6OjS+bBzjnyhTxhmFs0Le58nVPi6xOHD/7KjvXdfxZ44ezkZBXPc5eOxap1Su2dCxNzlrQIuVzUtevGL5499uKvSZBkU3JSzrTJ0Cxt073kiTh84+NykAy8eLNtJnd0rd7wA5vEr66JHvjW3yyzmApotcVMjK/1PQ8v4yQ7mZ88M9/yo7DKkK54bZAPuyZHR6vHpmZOnAfFicBAQ5HP59EX3FNjjCRJ0mqMEbeEE7bW4nk+sTNLHgDP8zgzcOqnmqj6ytUb/qrRaFKrBZcMTJ2Dbu1T7mx/aaK51qzee8sXz9z31V9yUaMo3jxNVyNuflWdy0h6kiFcmqA+xZkXnvzZnu3X/ID6qxtseXrbdctTYN+n1n9yjavMhNZXC/Od5kcqgWWBgEcK2xnbpK2r90RP7/ph16xxPnHc0Qg98t35czTXc1dzYmKi7vv+/O6J87RXBErFMr7yF2Y1zx9CUUIcxd6Zk/0f2r17169qT7AmuOgQnU8p0jRnBnFzoy/b6ahzuaOlzdc8MPfcY2/JeQrmuxMW04XnHYekK3WDXJGR5x995/ie6zfmC4UTV7qr+ZLAz4a9SwAdOliegL0cs/X6dj+OIPCxCz1FWdtKyq3GZgV05RxN67CF/EBUGbMual5kFsebATN29qLJmM45d+LEiXypVLpIwMYY2ts76OtbtaSp9TyPQ4cOfaq1tfWhjo7OF5Jl7b13hOVWCgXv5VhMSBCw/rY3/cHT+594iz+/6f2lGJDOYXUOPT1UHB84+eGtr33Tv7eLsolXXIM3rl2xvN/MhTz+g6/fJiQ4F6ZmOQuwLAol6ZJIJyysj3NOCNraD1SHTmGS8+E65RxJaS3iFZcKkoxzLkqSRC4GOGLCMERrzYXCU0oxOTm5bmJi4q7rr7/+g/X6cgJIQWxMY3qAaFk7GgUvX/52rnf1SRk9scEG3sscHYPFp6gdA8898Z5VN772/1Gio6uyt+5CES0l4OnDh5b1ZOUHhfrgmT0u0NkCDrVQSZmPICVrkLYiOJWmCit27H1h1c69XKjBIgI1TSVxF+mMiMSVSiVpNBrqwppwLpeju7uHer1+nv8VEbTWHD58+BNr1qz+vXK5PL087RXEgLJJFiO8jIM0kAvDevf26/9mrP/Iv/B9sIvWWF+ceWRJl+cTTw1vFJesDPPFU86+OuQd7+SzTy8T5PA6zcxEn1Iqm9fhsjnP7vxZHtmXciSosGQHz5w6PT46cn6t0zlUkKd83RtoKecvwlxFxBaLxRKg1RJRbRiG5ILgvCXWnudx6tSpa51zydq1a78RLxMadDiUKMK27qVigaUPu9as2HPb5wcfvuef+a7mIZd2czqdBkaiNM4lUp8ZLxHXLlklu+oaXK9Ns8xvlRPbzCsUotJuBeXSnI80WUi7GCSd15jYGN3SNr1i084zS43ndaSr70R754P+mTY2Gg27efNmr1AonIdmOefQ2j9vCmsGoeb6+/t/ZuPGTZ9xDrdcmqpDCFxEmC+x3PBWROhe0/5cy8adD9UP/uBOL+9fEjt2mHSKHQ4Jwrh15drxXKGIs68OjdZbvfeG5cnXC8YPjJ8ZDUZm1zhxiMpgOdGca/DMuvFEp4NZ2nvOtHZ3D2GSiwRsRfHIwYNMVRvpxpYLfOnBgwdnW1paJEmShdPunKNUbkV7sqC9IoI1hmPHjr27vb39bE9P12AULR8xcqIwzSrTjWncMpfQOMALcnTsuvVPTx14+M6yMTSVn3YiiVuY/aVJOwvFgqnXKGy78WlrGatNz/BK+OBSzxICLqxev7xSVBBOB6s33xcNHPmwH/gIGs9CoiyJSgeziFPgfISYmvHoXLv7m/XpKZs0m+cphwBzRhGLR7lcWlJDdu/e7RljdKVSOU+D48QuGjecIl/9/f3vn5yc/PhNN934k3EcnWe6X+4y1tBRLNHdXeZysGJRinLXa/+q/9F7/vVc/zOb/HwZ5+azi3Q1XiQ+iZdQrjWZkgI33HrXpwvlNpPi4a9SFN0YGVr2F9q8edvvHHj+8Q8E0UyQ5EISrQmsIchWozvnk7cJDVthdsWm8R3bdv1BjMNdsPFaZb1WJjFL1rOzilKH53nTIjLhFjWkV6rjmUan09NNkrQ++dRTn+3s7HxqcnK6drnjd2PjCPta6W1pS6k+y9d9wkJYueanPvDJx/9w6G/bGzPFgm9paI9YIDAO3wjW1JhqwKqf+Jnf61y3+WszEyOviPYCtC1poltLy/5CqqPtqfE3vPWX+v/ui/+lrVHBC3LEyk/LZlg81yBu1pkI2pMb3/Gxn1+xacvpuNm8yLMpJbi5BvWh/vmVPhdpcLPZ/EFra2uslEqbsZVCKUXg++cFVkePHvuosza57bbbPtnV1WUvt0Uk7RIVbGIvfy5kEtPe1XPP7g984u3Pffdv/1th6PDOYhKjjcFoTcNBPeyorXrzm//rxlte+++sTbDGvLpIVnAZ9WCAm7ft+kxH94qZw9/663+nx06uT6ihxMePHPWwRGPVxoPXveUD/+faTdu/HSdJNkFWLhKwyKV3GGT/3lis0bOzs0xNTaHOb05fc/z40Z+74YbrP97Z1XlMAK3V5QsY+FGZbkkc0dbd/b21N96+T8/ueWfl5OG7W8rl1mp12rR3dh5s61z5Zys2b3/BWbPsLaNXVcCXbS6ShL4NW//Yf9c//srYqYPviudmX2OMLZEw2trWe49uCe9t7+6uJHEECz05V2aSlFJMT09z8uRJPO+cuY+iKPD94HdvvPGGrxiTBmM/yu270ntukgSbxDNdvSs+F81Of+6GD32SA/d/E9WoUPc8TBS9Ymb55SuAP6Y3/l/Xq3P9/wMA9B77MB/waGYAAAAASUVORK5CYII="><p>GoReporter</p></li><li class="nav-item active" id="navSummary"><i class="fa fa-home" aria-hidden="true"></i><p id="homepage" data-i18n="homepage"></p></li><li class="nav-item" id="navUnitTest"><i class="fa fa-umbrella" aria-hidden="true"></i><p id="unit_test" data-i18n="unit_test"></p></li><li class="nav-item" id="navCodeStyle"><i class="fa fa-glass" aria-hidden="true"></i><p id="code_style" data-i18n="code_style"></p></li><li class="nav-item" id="navCodeOpt"><i class="fa fa-envira" aria-hidden="true"></i><p id="code_opt" data-i18n="code_opt"></p></li><li class="nav-item" id="navCodeCount"><i class="fa fa-code" aria-hidden="true"></i><p id="code_count" data-i18n="code_count"></p></li><li class="nav-item" id="navCodeSmell"><i class="fa fa-spinner" aria-hidden="true"></i><p id="code_main" data-i18n="code_main"></p></li></ul></div><div class="main"><nav class="navbar"><div class="container-fluid"><div class="navbar-collapse collapse" id="navbar"><ul class="nav navbar-nav navbar-right"><li><a href="https://github.com/360EntSecGroup-Skylar/goreporter" target="_blank"><i class="fa fa-github fa-lg" aria-hidden="true"></i></a></li><li><a id="changeLang">中文</a></li></ul></div></div></nav><div class="content-container" id="summary"><ul class="summary-list"><li><i class="fa fa-star-o" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_score" data-i18n="hp_score"></h4><span><span class="emphasize-big" id="score"> </span><span>/100</span></span></div></li><li><i class="fa fa-check" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_cover_pct" data-i18n="hp_cover_pct"></h4><div><span class="descp" id="hp_code_cover_pct" data-i18n="hp_code_cover_pct"></span><span class="emphasize-num" id="testCover"></span><span>%</span></div><div><span class="descp" id="hp_pkg_cover_pct" data-i18n="hp_pkg_cover_pct"></span><span class="emphasize-num" id="testPkgCover"></span><span>%</span></div></div></li><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_issues">Issues</h4><div><span class="descp" id="hp_issues_subtitle" data-i18n="hp_issues_subtitle"></span><span class="emphasize-big" id="goIssueNum"></span></div></div></li><li><i class="fa fa-circle-o" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_circle_complexity" data-i18n="hp_circle_complexity"></h4><div><div><span class="descp" id="hp_circle_comp_middle" data-i18n="hp_circle_comp_middle"></span><span class="emphasize-num" id="mediumCycleNum"></span></div><div><span class="descp" id="hp_circle_comp_high" data-i18n="hp_circle_comp_high"></span><span class="emphasize-num" id="highCycleNum"></span></div></div></div></li><li><i class="fa fa-code" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_code_amount" data-i18n="hp_code_amount"></h4><div></div><span class="emphasize-big" id="codeLineNum"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="hp_unit_test_title" data-i18n="hp_unit_test_title"></div><div class="row"><div class="col-sm-9 col-md-9" id="gotestChart"></div><div class="col-sm-3 col-md-3"><ul class="gotestSummary"><li id="hp_unit_test_result_summary" data-i18n="hp_unit_test_result_summary"></li><li><span id="noTestCount"></span><span id="hp_files_no_test" data-i18n="hp_files_no_test"></span></li><li><span id="coverLessCount"></span><span id="hp_files_less_coverage" data-i18n="hp_files_less_coverage"></span></li><li><span id="timeGreaterCount"></span><span id="hp_files_more_time" data-i18n="hp_files_more_time"></span></li></ul></div></div></div><div class="divider"><div class="line"></div><div class="icon"></div><div class="line"></div></div><div class="row chart-container"><div class="pie-chart"><div><div class="chart-title" id="hp_pkg_circle_comp" data-i18n="hp_pkg_circle_comp">包圈复杂度 </div><div id="gocycleChart"></div></div><div><div class="chart-title" id="hp_pkg_issues_count" data-i18n="hp_pkg_issues_count">包Issue</div><div id="goIssue"></div></div><div><div class="chart-title" id="hp_pkg_code_amount" data-i18n="hp_pkg_code_amount">包代码量占比</div><div id="goPercentage"></div></div></div></div><div class="divider"><div class="line"></div><div class="icon"></div><div class="line"></div></div><div class="chart-container">DependGraph</div></div></div><div class="none content-container" id="unitTest"><ul class="summary-list"><li><i class="fa fa-star-o" aria-hidden="true"></i><div class="summary-content"><h4 id="ut_avg_cover_pct" data-i18n="ut_avg_cover_pct"></h4><span class="emphasize-big" id="coverPct"></span><span>%</span></div></li><li><i class="fa fa-check" aria-hidden="true"></i><div class="summary-content"><h4 id="ut_pkg_cover_pct" data-i18n="ut_pkg_cover_pct"></h4><span class="emphasize-big" id="pkgPct"></span><span>%</span></div></li><li><i class="fa fa-clock-o" aria-hidden="true"></i><div class="summary-content"><h4 id="ut_unit_test_time" data-i18n="ut_unit_test_time"></h4><span class="emphasize-big" id="unitTestTime"></span><span>s</span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="ut_cover_title" data-i18n="ut_cover_title"></div><div id="unitCover"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container row"><div class="col-sm-6 col-md-6"><div class="chart-title" id="ut_time_pct_title" data-i18n="ut_time_pct_title"></div><div id="pie-chart"></div></div><div class="col-sm-6 col-md-6"><div class="list-title chart-title" id="ut_lack_test_list" data-i18n="ut_lack_test_list"></div><ul class="list" id="unitAbsentFiles"></ul></div></div></div></div><div class="content-container none" id="codeStyle"><ul class="summary-list"><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4>ISSUES</h4><span class="emphasize-big" id="styleIssueNum"></span></div></li><li><i class="fa fa-file-o" aria-hidden="true"></i><div class="summary-content"><h4 id="cs_file_nums" data-i18n="cs_file_nums"></h4><span class="emphasize-big" id="styleFileNum"></span></div></li><li><i class="fa fa-font" aria-hidden="true"></i><div class="summary-content"><h4 id="cs_quality_class" data-i18n="cs_quality_class"></h4><span class="emphasize-big" id="styleQualityRank"></span></div></li></ul><div class="right-content"><div class="sub-nav"><ul id="styleSubNav"><li>Index</li></ul></div><div class="sub-content" id="styleContent"></div></div></div><div class="content-container none" id="codeOpt"><ul class="summary-list"><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4>ISSUES</h4><span class="emphasize-big" id="optIssueNum"></span></div></li><li><i class="fa fa-file-o" aria-hidden="true"></i><div class="summary-content"><h4 id="co_file_nums" data-i18n="co_file_nums"></h4><span class="emphasize-big" id="optFileNum"></span></div></li><li><i class="fa fa-font" aria-hidden="true"></i><div class="summary-content"><h4 id="co_quality_class" data-i18n="co_quality_class"></h4><span class="emphasize-big" id="optQualityRank"></span></div></li></ul><div class="right-content"><div class="sub-nav"><ul id="optSubNav"><li>Index</li></ul></div><div class="sub-content" id="optContent"></div></div></div><div class="content-container none" id="codeCount"> <ul class="summary-list"><li><i class="fa fa-code" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_code_lines" data-i18n="cc_code_lines"></h4><span class="emphasize-big" id="line_count"></span></div><div class="progress-bar"></div></li><li><i class="fa fa-question" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_comment_lines" data-i18n="cc_comment_lines"></h4><span class="emphasize-big" id="comment_count"></span></div></li><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_function_lines" data-i18n="cc_function_lines"></h4><span class="emphasize-big" id="function_count"></span></div></li><li><i class="fa fa-file-o" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_file_lines" data-i18n="cc_file_lines"></h4><span class="emphasize-big" id="file_count"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="cc_pkg_title" data-i18n="cc_pkg_title"></div><div id="lineCountChart"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="cc_file_title" data-i18n="cc_file_title"></div><div id="fileCountChart"></div></div></div></div><div class="content-container none" id="codeSmell"><ul class="summary-list"><li><i class="fa fa-circle-o avg" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_avg_circle_comp" data-i18n="cm_avg_circle_comp"></h4><span class="emphasize-big" id="cyclo_avg"></span></div><div class="progress-bar"></div></li><li><i class="fa fa-circle-o high" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_circle_comp_high" data-i18n="cm_circle_comp_high"></h4><span class="emphasize-big" id="cyclo_high"></span></div></li><li><i class="fa fa-circle-o series" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_circle_comp_serious" data-i18n="cm_circle_comp_serious"></h4><span class="emphasize-big" id="cyclo_grave"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="col-sm-4 col-md-4"><div class="chart-title" id="cm_circle_comp_pct_title" data-i18n="cm_circle_comp_pct_title"></div><div id="cycloPertChart"></div></div><div class="col-sm-8 col-md-8"><div class="list-title chart-title" id="cm_circle_comp_list_title" data-i18n="cm_circle_comp_list_title"></div><ul class="list" id="cycloList"><li><span id="cm_circle_comp_list_col1" data-i18n="cm_circle_comp_list_col1"></span><span id="cm_circle_comp_list_col2" data-i18n="cm_circle_comp_list_col2"></span><span id="cm_circle_comp_list_col3" data-i18n="cm_circle_comp_list_col3"></span></li></ul></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="cm_circle_comp_rank_title" data-i18n="cm_circle_comp_rank_title"></div><div id="cycloRankChart"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div></div></div><script>
+</style></head><body><div class="main-container"><div class="sidebar"><ul><li class="logo-block"><img src="data:image/gif;base64,iVBORw0KGgoAAAANSUhEUgAAAHgAAAB4CAYAAAA5ZDbSAAAACXBIWXMAAAsTAAALEwEAmpwYAAA59GlUWHRYTUw6Y29tLmFkb2JlLnhtcAAAAAAAPD94cGFja2V0IGJlZ2luPSLvu78iIGlkPSJXNU0wTXBDZWhpSHpyZVN6TlRjemtjOWQiPz4KPHg6eG1wbWV0YSB4bWxuczp4PSJhZG9iZTpuczptZXRhLyIgeDp4bXB0az0iQWRvYmUgWE1QIENvcmUgNS42LWMxMzggNzkuMTU5ODI0LCAyMDE2LzA5LzE0LTAxOjA5OjAxICAgICAgICAiPgogICA8cmRmOlJERiB4bWxuczpyZGY9Imh0dHA6Ly93d3cudzMub3JnLzE5OTkvMDIvMjItcmRmLXN5bnRheC1ucyMiPgogICAgICA8cmRmOkRlc2NyaXB0aW9uIHJkZjphYm91dD0iIgogICAgICAgICAgICB4bWxuczp4bXA9Imh0dHA6Ly9ucy5hZG9iZS5jb20veGFwLzEuMC8iCiAgICAgICAgICAgIHhtbG5zOnhtcE1NPSJodHRwOi8vbnMuYWRvYmUuY29tL3hhcC8xLjAvbW0vIgogICAgICAgICAgICB4bWxuczpzdEV2dD0iaHR0cDovL25zLmFkb2JlLmNvbS94YXAvMS4wL3NUeXBlL1Jlc291cmNlRXZlbnQjIgogICAgICAgICAgICB4bWxuczpkYz0iaHR0cDovL3B1cmwub3JnL2RjL2VsZW1lbnRzLzEuMS8iCiAgICAgICAgICAgIHhtbG5zOnBob3Rvc2hvcD0iaHR0cDovL25zLmFkb2JlLmNvbS9waG90b3Nob3AvMS4wLyIKICAgICAgICAgICAgeG1sbnM6dGlmZj0iaHR0cDovL25zLmFkb2JlLmNvbS90aWZmLzEuMC8iCiAgICAgICAgICAgIHhtbG5zOmV4aWY9Imh0dHA6Ly9ucy5hZG9iZS5jb20vZXhpZi8xLjAvIj4KICAgICAgICAgPHhtcDpDcmVhdG9yVG9vbD5BZG9iZSBQaG90b3Nob3AgQ0MgMjAxNyAoTWFjaW50b3NoKTwveG1wOkNyZWF0b3JUb29sPgogICAgICAgICA8eG1wOkNyZWF0ZURhdGU+MjAxNy0xMC0xMVQxOToyNjozOCswODowMDwveG1wOkNyZWF0ZURhdGU+CiAgICAgICAgIDx4bXA6TWV0YWRhdGFEYXRlPjIwMTctMTAtMTFUMTk6MjY6MzgrMDg6MDA8L3htcDpNZXRhZGF0YURhdGU+CiAgICAgICAgIDx4bXA6TW9kaWZ5RGF0ZT4yMDE3LTEwLTExVDE5OjI2OjM4KzA4OjAwPC94bXA6TW9kaWZ5RGF0ZT4KICAgICAgICAgPHhtcE1NOkluc3RhbmNlSUQ+eG1wLmlpZDowNjRiYjM5Yy0yNTI5LTQwYzEtYTU3OC0zMWQ1NDg3N2EzOWE8L3htcE1NOkluc3RhbmNlSUQ+CiAgICAgICAgIDx4bXBNTTpEb2N1bWVudElEPmFkb2JlOmRvY2lkOnBob3Rvc2hvcDo3MjkwNTk4My1lZjA1LTExN2EtOGRhOS1iOWJjMTVkZWZlMzc8L3htcE1NOkRvY3VtZW50SUQ+CiAgICAgICAgIDx4bXBNTTpPcmlnaW5hbERvY3VtZW50SUQ+eG1wLmRpZDoyMjUwZjc1My1jZmVhLTRjMDItOTc2Yi01NjIyZTcwNjY0OTU8L3htcE1NOk9yaWdpbmFsRG9jdW1lbnRJRD4KICAgICAgICAgPHhtcE1NOkhpc3Rvcnk+CiAgICAgICAgICAgIDxyZGY6U2VxPgogICAgICAgICAgICAgICA8cmRmOmxpIHJkZjpwYXJzZVR5cGU9IlJlc291cmNlIj4KICAgICAgICAgICAgICAgICAgPHN0RXZ0OmFjdGlvbj5jcmVhdGVkPC9zdEV2dDphY3Rpb24+CiAgICAgICAgICAgICAgICAgIDxzdEV2dDppbnN0YW5jZUlEPnhtcC5paWQ6MjI1MGY3NTMtY2ZlYS00YzAyLTk3NmItNTYyMmU3MDY2NDk1PC9zdEV2dDppbnN0YW5jZUlEPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6d2hlbj4yMDE3LTEwLTExVDE5OjI2OjM4KzA4OjAwPC9zdEV2dDp3aGVuPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6c29mdHdhcmVBZ2VudD5BZG9iZSBQaG90b3Nob3AgQ0MgMjAxNyAoTWFjaW50b3NoKTwvc3RFdnQ6c29mdHdhcmVBZ2VudD4KICAgICAgICAgICAgICAgPC9yZGY6bGk+CiAgICAgICAgICAgICAgIDxyZGY6bGkgcmRmOnBhcnNlVHlwZT0iUmVzb3VyY2UiPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6YWN0aW9uPnNhdmVkPC9zdEV2dDphY3Rpb24+CiAgICAgICAgICAgICAgICAgIDxzdEV2dDppbnN0YW5jZUlEPnhtcC5paWQ6MDY0YmIzOWMtMjUyOS00MGMxLWE1NzgtMzFkNTQ4NzdhMzlhPC9zdEV2dDppbnN0YW5jZUlEPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6d2hlbj4yMDE3LTEwLTExVDE5OjI2OjM4KzA4OjAwPC9zdEV2dDp3aGVuPgogICAgICAgICAgICAgICAgICA8c3RFdnQ6c29mdHdhcmVBZ2VudD5BZG9iZSBQaG90b3Nob3AgQ0MgMjAxNyAoTWFjaW50b3NoKTwvc3RFdnQ6c29mdHdhcmVBZ2VudD4KICAgICAgICAgICAgICAgICAgPHN0RXZ0OmNoYW5nZWQ+Lzwvc3RFdnQ6Y2hhbmdlZD4KICAgICAgICAgICAgICAgPC9yZGY6bGk+CiAgICAgICAgICAgIDwvcmRmOlNlcT4KICAgICAgICAgPC94bXBNTTpIaXN0b3J5PgogICAgICAgICA8ZGM6Zm9ybWF0PmltYWdlL3BuZzwvZGM6Zm9ybWF0PgogICAgICAgICA8cGhvdG9zaG9wOkNvbG9yTW9kZT4zPC9waG90b3Nob3A6Q29sb3JNb2RlPgogICAgICAgICA8dGlmZjpPcmllbnRhdGlvbj4xPC90aWZmOk9yaWVudGF0aW9uPgogICAgICAgICA8dGlmZjpYUmVzb2x1dGlvbj43MjAwMDAvMTAwMDA8L3RpZmY6WFJlc29sdXRpb24+CiAgICAgICAgIDx0aWZmOllSZXNvbHV0aW9uPjcyMDAwMC8xMDAwMDwvdGlmZjpZUmVzb2x1dGlvbj4KICAgICAgICAgPHRpZmY6UmVzb2x1dGlvblVuaXQ+MjwvdGlmZjpSZXNvbHV0aW9uVW5pdD4KICAgICAgICAgPGV4aWY6Q29sb3JTcGFjZT42NTUzNTwvZXhpZjpDb2xvclNwYWNlPgogICAgICAgICA8ZXhpZjpQaXhlbFhEaW1lbnNpb24+MTIwPC9leGlmOlBpeGVsWERpbWVuc2lvbj4KICAgICAgICAgPGV4aWY6UGl4ZWxZRGltZW5zaW9uPjEyMDwvZXhpZjpQaXhlbFlEaW1lbnNpb24+CiAgICAgIDwvcmRmOkRlc2NyaXB0aW9uPgogICA8L3JkZjpSREY+CjwveDp4bXBtZXRhPgogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgIAogICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgCiAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAKICAgICAgICAgICAgICAgICAgICAgICAgICAgIAo8P3hwYWNrZXQgZW5kPSJ3Ij8+dnlyywAAACBjSFJNAAB6JQAAgIMAAPn/AACA6QAAdTAAAOpgAAA6mAAAF2+SX8VGAABQ1UlEQVR42uy9d5wl13Xf+T33VtWrlzqnyTliZoBBxgAkGECIQRIzl6S4ZrBEivaKlte7lmX7Y0uyVitLpk1bFqW1LIkSJZGiRIlZJAGCIIicw2By7p7O+b1+oaruvftHVff0zPQAPZwBqM9HLnzeZzDT/VKde9Lv/M458sjBAZZ7aa0ZHh/n5MAZ6nNV8uUyxWKBo8dPsX7tBtqLBaYmxujsbKWvbw2r+vowxrzka1oUXTJCbuYESgV4vkCQB8AvgwiXd1mBegPXGCEePoS34S5cbQBd7gKv5dLPEyAXQq2OaUz2DB7+9seCsFjtXXvT/+dsEvMqXOIJD5/t5pFDI6zu7iRfCJmdrXH8+BnyxQJtrSXCfJ6T/YPcuHM7B48fo62zg458wI4tG9ixquei1/T4X9eiE+yTmMqasz/8T98unfnOzlqug0r77wyVV+75Mqb5yr63A0KNDBtw7qq97D88AYvK7uYF6qsVSW22p37wy5/v9Zs7/fbtMHUGVxna5vwbwSWv3EcC8BWHTtcZmbFoJf9LwD+SkjiHS2ogCoUADgeICFG92TV93698rTS5/xa9ehes3Um+YwNeXD/iDn0HnHnFNFeUY84V+Obzvaxd1XX5bul/CTjV3CRq0Bh8BFvooESAJI6G5/CjqKWy/2++FAw/covyAub695MLS9gV142rzbc+gfbA2VfkYymBhoF7n2nSjBtXVXv/YZpoZzMTbRFAdBDMjDz6x8HQD1/vmxqJ1YShwVZOEbesO+FXJgfMK+h/PQ+emt7A2apFq8bVf/1/eJHUvIYoUF6+dvqhP2ptHnuX2/Ba6sW1mNowcvobKKtQHbuP5fp2GaLqK/M5xHBixHB2RpPTlsorcYD+QUbLIogOg4n93/0T/9ifvTcu5Ghs/zgtd/waUTJF9DdjxGefoVgsHmByPyTRK2CbDRN2Pc+PtiNSe+UsxD9E4Trl50aOfuPzhaN//t6SHzLWTCiu2YcAXnWGoLuXWuF1Tb3y5h+QbwObXGXNtcRRzKHBPBpLIvz9E7AAvueRC3zla5XL+X6UC3wjIn+PhasQLyza4Rf/MBx97L26UGauNo2380MU196FdQmTT/wafv9DFFe88bTye54yiYALrqJ4HRKW+cN7TtDSHtPZFtCM/p4J2PM0SsmW/UeOf3Km0bxlttroPjFSnVzRUnhky+qePwh8/8DfO+EqD2dV1/Sx+/+0uzLwFrV6B1Lbwuzwaco7/w+a4qH670cd+w5hPEizWZlpKXlNoto5t31VBGw4eFYYmqxRbk24qi9+NQScC3zODo1+9ImDpz493pR2NHhK0T89y8FT47e8eOzkR+68Yfu/u2vVut/5+6LNSvmY5tz6yYc+9+f5M9/cZ1RAw8wQhgVyez+OXnktnqky/fifUIwm0VLEhiuOEMfWJeYqCRbE0zxyWtM/GxB4af79Sl/e5byJpzWjYxNvu/fJw3+UhO0UcxpNhMXhBR7kcozZqO2LDzz739as3jB5+00df+6cQ+Ri7Oh8jM69csL1c8SVeNfYY//5q+Xj391YKHjUckJx6gST4UpaV78eIyATxwm9YUzHSqozNQqtvYfc+HGcuUr2UyxNWjh0poVye+FVO9zekeGZZf+y7+m2r93/2GesXySvHcrVMUpj8RBxiIvwtAflLv74y9/8vamp8WO33HTDY7kgwFq7hGgFD0M+rGBQL3EALuPSAQQKSULEy1EZOf4+d/pbv9mTa26wq6+hPn0G3YTExvgb34Dq2IS1VeYe/TRlFaNX3QyFUafX3/SYWrENroaARXDOMjwRIvokr6Zd8wpuennC1YqTZyduPTE5uzkXlnDOEGkPhyKwBuccRkFgYzxRVJxXvvexZ/7iuj3XvDafy501SwDoKdxgkUsJ0SlQaplCFtAelTPPfrD+wj0fa2krnoxmhjui/s++s5ibE9N7DUHvLnTbFpLxgyRj09TPPoM38hDu7MPYM19hzmtBl8fwu3dPi9OHotHTVwX4V2KZiNqYaJbQyvFqXp7XXJ4GB56mWZ3eZdCIszgEZTWaBHBYNNo60p8YvLBI/8zcxj/5y6/+j/f89JvfsaKrPbYX3CyLoqzqaDFclIg4h419tFdY3k0WwTpPzTz6hX/fNfDVrabQimhNi44wSUC9/zni/DC57h5YfSNy7ccoqzwT3/0V2qeeIB964OrYqeNU9crZzkLLFPbKq4SCwzhhrFlCyasrXABvtlJbtoDn6vVA0kAfJwnKCTiFVRZxmR6KwyIYIF8o88yJ0beueuaFX/+Zn7zrl+pRhDV2IXB0ODRmaQ0WwUYxys0iLCPaFFDiuWDlrvtr4z/cGtoE38+REAAJeW2wtSHsqePMrDS0vu53CAjp80KaDz+PaRhCI8QYVKk0ptrWVHHJlWmwpL737IijZhQ5/8cg4Ov37lnWL4a+jy2dPvaVZ4cQAXEGJ5p5jQa7yLOC5ywgBK0dfPuR5/5ld0v4zPW79nyxpbWNeU1WDvK2nj1jCQvtLHHT4svcMoUsrmfvuz8R9W29b+bQNz6lBh/YV0xmkaCEUR4uSMBqwqHDxPu/QHPlLpjcT2n1G2nGEyRDp6nPVfHCljMMP49LmlcUAIo46hSYaGxFqVdfuADeC4Nzy/QjQlMKj3fkmK0415InQDmHEQNOspufChWnAYsjAeWR5Mp86Z6Hf29Vz6rjq1avfaIZJwiCMYagFr10tiaamBK+1JYnZBMRdG34y87bP/XX1ZG7Pzh16Ov/Mhx+fFfJNEjCAEOZQMU0f/iL4FpwUiNq3YTftQ5/423EE92Y8rYTzimcU1doni2TzVYSq9FifjwC9i4DhussBKfedMO23/jigy/8Zq7QiXYRSgygcKjM48zbJgUSo21EQXlUaWv782//8C+bUf1NDne8mVh6W/KsXsPLCM0BQuKKeMvUZJdEOOdMoWPd5wu3/uxfz47e9pHxo/f+s+Lok9tCXcWoVnIuj5IY4xlM5Szx3DhxuZdCuRPTvaYmbV3IjxpBewqckNSFJC69YqXGZX2UvdfuvIxoUNh9zbb/NDw+/ZqHX+x/m9/Shu8UyiUkKg2vBJOV0sGKQpwBp9C5kFNDgxueef7pN3a0th4v+MImncdjFY7cy763Q4hdYfmaDGkOa5v1UteG3yuUP/6l5uSb/+n4gS9/olR9fmWgIfK6CaxgJSJIDGriADOTnYS7cv25fBuXTdMRAMWpoWnOVNq4rpygMPw4L09rfTnpHCDmbXfu+8jQ4F99/1StuksHZQSVMh5EA4JyNvXITuEkII4b+GZq9n1vee0v3rKl549X52us7ixRn64QG4e/rHd3gCJ2xeX75PloPWmiVDgRrtr7a0mc/M85+/pPVM98/5+Wpvd3Ch4SBCQatAZXr2Cjig8C5jKLDOKoJ+38ybefY8Va4cbdLfBjFrCKm02W+4gaTaJGg/XrV49/8sPv/0cdnpmNbEysPbQDhcWgMeIheCjxqDfqdBe8w+99/U13v+OG7j/e2eUK3UU85xyxcVl1Zbmpf+oAYlfA4V1eAOQcLmmAaQx2bLj13/e+/tduTnb+wn+fClfMNRsV/KSJsWWKKqZ++Js/H89OrCVRELvlPyLHXMNHa/D03xOY1jnH5T60VmzYsP6Zt79+3ydMrYJxFqsc4hS+M4CliaJRr7C2zFfedsf22z/65l2PrS6a1wczh3+g5oa+3Zid/jkwtzgb32LjaKdzqJQQtzxbGLsiDv0jaYhNGijtnWjZuO8Xym/+9A3R3l/886rqa6pmBZM35I98d8/sfZ/9E1OPxVRrLOfhqlUaNUdsPRSOvy+Xl2rR5cKqDhs3ue3m6784U6vf+OX7Hv8XptyBzlCbKLZoM+fedsuO37p568pffu2uNqfnBj7cmBz9r3nrWjEREg2/0RcLkcNWvQainzFB+X9Q7vhcCl67l3V3NduGJaasZhdM+PI12mKjGrny6sO9+/73D9W33f2fZx7/g3+jhu9/Z9kMScM2S4gDZV/WbYk4GpHPYLOTXF69IoLSSuF7GiWsFmjRSgZ8T8++XC3Bsz/KYcueUygU+InX3vKvTpw4uev5gcpPuFIHUVSjy6tP3bZ728+/93U3fml9vqoZG/g31Kd/NVQxKA/EQ2MRI4APYkNc7bYoqt4Y18X5/oo/WQ5ClOAxmKygKFU69AQlVYEF6NMtmGacRaxFHIhJEJeGg6mcE4hqhOX2p936N75btv3Um8Ze+Nuf7Nn37i/pjhaHy79EQOVIjGa6mmOmmcMoLg27/si5tOBpxVy9/q6nDh37pxO1aHczTsrPHDo12FbQD++7ee9/9HS4/9ICvgK2QhRZoiRJbti17cP90088cGZ2dGufx3P/21ve8LE7t7U93atHCzI18d9zUeWjaI3DglI4LM45RClAI1ggh4/1XW30v9Rt8JgqtB/SKndJP6twVFwRhaXmitSSIgWZo0NmyRGjJUK5BKs9nC6SeG0kxhL3bCQKSvjWEIjKeNKCMwnONAnLa+/xSivu8XKtaV5/oSWRNJiKE8V0tcBMLYdJUurr1fa6ge+jpL728Mn+/3hicu79dTy05yMqoFazG09PVzee/dYP3vae19/4oV3bN39raahyYuzKoDjn2LNj80hrufRzTz77wkc3r+r9v9+8tTS+olS/NR4f+E+ea9xuPT8FOF2A2MU66EAM4ubxrxw512g3zaE/inKF92tdOJPykZe6dTbjRabBHUDNFak3C/jSQ87VyRemUW0ap/PM1mYYmj2D37MW1VDMTJ0hcDVWF4r05QMC5aOcSzXaJrgLedDzgo0103Mh07UciVEo5VDKXnWtLYQ5Dh499pH7nzz8H4ZmktV+rkhJTOpa8EA5vEKZmUbc/vXvP/aFnTu3XL+xu+34RQK+olps9lRrLd2d7Q+8+67XPLAxH9FB5U1m7MznPet6RQeIlTRYdo55CafAiAMBiwZJEJeiWgVqt7nK0KdtOXxfLtRO2+gCIafmOXL+eSZxXtAOqNoyLhdQ8CoIgnMG6xzOJIjzmYzmmLNVzgwfZKvbTlH5tPt5ejL/fLHGaqaqITOLBHu1K0NCSqhwzl7zze8//BvPnxj86TjXQlAI0dZgESw6g5ESFA4XBgxUmy1fufexX7xj55ZfuDo+eIkP1ogtq1qEQl7RaFQ3lSXutl4Zh0bbNGd14rI/yfygwznJUkiFiKOZxLhYyAdT76zWw19UuVX/RV1Ua3IIakGDlzbhNgVZFvnjxb+tReGLwpMUijg8OUxvex+FcivWD9Gk/OnYCJPVkNlaHmMU8goINoUUPZRWbU889+Iv/WD/8U/N1P1CvtBBngRshBEP50AkwaFJxIOsBh8EOfoHRl6/5OuWPXs1bAoSQNk3BBJhw9YvJFHlV3xsrxEF4nCLor35qtm811LOpl5VLArLyOAg3Wt6dT4e+e14Ro+49pV/IUot+EMRx1ySwzlBrkIJTgBPFMo5ZvJl6rvfxbjK4WYKzNY0kXFo5BUpGPi+h+90MDwy8qFHnjv5y2cn65t1IUch72dlWC8NHF16twTBSor5i1EoZdAKJmqNlUtXk3r0Vfqo83BjHouajeuzD7ho6r1KVNYB9BKWPotILeDlcnT2djPeP8DKDRu1ac7+uyjpfVgF/qmFbgQcFVfIUOrLLb5rPBWg8FBoFBpP+Qv8KOUsLigxg4+tBjhxaJVwNUu5IoJSisD3OHt26B3ffuLpXz4yOHmzUkXyxSJKIhISQC8OdhZ7RbRL45AYTeQsHS0tI0sKuBm7q+dA3IKKOhO0fN1F4+8WUFZpXv7wO5QD6yBfKlGslxkbHKBzxfot9UZllbPJKZUFVpOUqIl3WQV0JQqtfKqNmZur1ZM/PeX61yfSLIjzzcB08LyJ81/yVHBYKQ0uSV9bOay7MsaYOw/mTXustRIVR9Fb//reB//ZwOjMXQ3xyIVllHPgDMlCPr/UERaU0zgMVic4CbG1Cruu23jfkgI2jemrC40pRWLBGvuEc+JUVmW6FOIk7pwGixMkcRgttPWuZPz0SSYnK99o72t7VICG0QzbMk0U3jL0VyQ1aR4ezaix5ejwM/9hJD75zqZMBaJicB4IjNYPvicXd33KjG/+drl4x7/SunT2aqWzfpYSNgzkfcfo2MSHHr7vyf9jaHLuljmEMNdCwZFG7uKwqKwSt7hP2F1wZOI0ubQ54sjRm6dy656t/31JAR+L266acB2KXDLNhnaNyxWno0Y4lrdRnzj98iU+mX8FSbGJBNp7+qiF3T9QYWgqtYThuBWrJCtRvrwZbNQdNldncOzY1ucH7/+7SX1yI6rImuBaVhe34Htt1JpTjFQPMKJPdR2NHv/Q1JGZrbetf+c78rnC0JVGJwahnRoFNcexiqVRWo2VYP2h/rFbSh0rKbk6kGBF4SQVrKT4MfYiG7CoyiyCI6DZaFKiWfvZ97/5H1+zfcPBpfNguXoCDoKAv3pwP6/dtZbX7N40nHPyHjdx/MvKxb1O/Je18W6RcJxziJfHJ3p/Mj35hw3VOaO1QquUBKBSYHRpWE8cTjRONPV6o+WZwfv/ZEof39hqerlz0wfY0bEXoQQLaVWVF4ee4b6Bv2Dce+7mJ88kn7tt4/t+2te5pnExDo04fckjKu7cT/y0KWBRti60qIituUlmci103HnTbz1x4ODbD47N3lgI8ggG5eyCC7OQImJOlqymIQqXQK0xwY7VHU/cuvu6T+3aseXRKIovVZq+ekm6rxzWmtbjQxNdLd1rgsmz5uTe1uKRFbrWi3EL6dFibbbKZX4l01wM1hiU1hg8gmT2JjM78FsVnfvNwciIH2gFiSjnpeQuWcrfOovVoJrubPO5j86owVtztPHWrZ+gZDZy+EwFJ1U0gkNhnGFl6w28eWPAN49/llF99O5jg4d+oUv3fsk4EziJBaesmuejLYo5xLFQAxfnqOYCao3Ien5wFpEIUVg0xlDw6uO97Z47+ZOvu/VfHf/L795jXSiezAOrKfAjmas6F9S4hcQPNEkzIu81mvtu2PqZPVvX/4qxqtFoRuQL4SXqwdZdJd8rNKpzbSdPD/7wvicPbP/i955SlYayO7sa+j+//xZ69AzGJSgJUNZhlaSYh0ubsZxYnHiIeEycPU2hpZ18SxfWajypfdxMHP3Yb/7F80y4guRVgufSqrMVWTj9TlIj7yTBGUU+iLnrA6c9FWhu7Xo9SW0dH/zNT1NpCkqDZ32cxNSdYU2hwO/9259jb8cbeKJ6Dy+MPPbb3/jb/b9B0q3waugUbVtAsZXLxGIFK2l0HqOpWUt9dpz31Kv/U627+Z/bZuWORrPynnwyd0s+qbSqltx7fuqW67735P6TX7j/+RMf1KUc2hkS8ZCM36Zd+r2MWMRpnPOwiUEns6wtB/dfs7HvFzfs3vlcVJ3l5ZpTlOPq/IcI1VptRf/k3C7jt3qx9ZXWgffkIPKrX32Rqt+GxmGcjxOFcuBEFtKTxMVYwDpFW3c3U6OD2GYVUR7G+mzr1d4/f8tGL27M6ikT6imDN2W1N2PEmzHizVq8GeO8aeu8SqK9qYbned2Jl2vTFIxme+9t/NnXvsfx8Zim5KlZnznrU7U+DV3khcEKX/zuU2xdfSu5RCh1WnSL+FNxU88lTldjX88m2qsYvGrsvGok3rT1vXF8bzLxvLGG8+YadW9dwXr/+OZ13js2eh/xJ158Ol87cU/oZj/h++q6wIs22MmBXy/r4eDNt+z+151hMBsbB06jxGFlnp2aMlORgMQpokaVFa3u7J3Xr/35a3euv9s599ylTPJFGuyuWoAFIsp5nkQRLtDAiqLCtbZxeLjC3zw5zIf2tqLiOkb7eJaMtAdKaaLZJpXKGN1r1qO9Vjq6uxgbOEXXmo1o7eNqFd60s53TM1v4ytMjaK+IQlCY1JCJRUjSz2EDklCxomsajCPvlfFsK+PTw2zqaafkaZwoxPkgikiESHdydvAsil0Euojxqmzs6aJYD/F8DaLR2uEytqgTwMUUTMTKkmVzbwt71hTZs7JEMVSQuNAYdkCISIwYQ+x5GFt/S3PkzHuu3b73L97w2r2f/ovvPPCrQbEXMU20kDJVRZNYTTRXoz1nop2bO3//xmu3/3YzigcGB0YuK2/ztFyd1kgtGi2+1cbhxGKd5f/6yLvYvrqLhhWIZolnj6Cjk4CQiIeyNqPeaAotXVQrk8yOn6G1ex1huZ2m5In9NiSporXG1qt89IZ23vm6G2m2bEQ5h7YWK6SmmjRYcU6hlOLkzEM8MPI/ifwQ4xL+9Sc+jBPwncsyag8wJFis8wi1xZkpEhsQ1Wb5hfe8lVWlPTSkgT81hK5VkCRGXAwuQlyToqqT06QtM8RYFxEbQWwWmEmCSAzKR9kQTzUx40Mfb/c6vn3LlhW/9egTpQ8OV+a2Bbl0MEyMYOp1cip2ezf2fPmufTf8xvj01DOJccTJ5Vf+PHuVGH/iwDorThw4h3IJrTlob8lnAUMB297C3ClHvnoKtBArzczZftpXrsQGAT0r1zNy/EWqKsTvWEl5602otrXUTz1OOHsK/Dw0Zym7I3T2rgC/8yUAAYhZix6x1M0kc8k4q1r7smKHWsr+AHBy4giNuELo51nT3UJnEOKalrmZw5TMdBZVuaytJszSISBqpNwAUShJ2wNQUfqnVSQavCRBOcvkmUN3qvGBT1+z/c0ffdvrXvfLf/i33/6bONeKbTTxpM72Fa33XH/Nxt/s7eq+r1wuMzQ2xuVw5y7A5N0inNNd4YMMXkvbWJxNQ3uTCh/xQwqrdmJ0K2ITtKfJF0uMD5zAt3VAUV6xmbh1LblNr0W1b8LoPOHKa0hUKyqOUZ6PNBrMnXwGF1dZoNy7+aFIJiv1OVrzK2nRa2iaOvuHH8U5gzOWxBkSDCYz72CwJsIQsX/sYayfkvG/f/ivOTjxEImylDrWQdgGhW4irwfjF3EKLD5iVFqx0pJSl7LiCc7HOR+clwZlnqURxcSmTm1y4ENeZfymn75j19/efM3ab+vGhLt2bfs33rFv95tv3bPj7kKxeF8zil92UuDLarCfU2As1l7ZC6nstDjmI2KzUGAQlxUbnEEK3ai1t9I8/TD5uE65s4fIxIwNjNC+Yh30bqJj3XU4KeOsTbsm8l2odTfTOPUggST4Hb3YyhhR/9P46/eRQqHzCYwFNM5CISizqeNWRsdOc3Dmh6yd2MmO7n0oA0gCKgYXYI1GeR77h37IiZnH0AUwRjhtnuXEiQNsLd6MzChOnniebRtu4LU730Dz0JPEI0dpWbMCHZZw1gMrGb7t0u+f+guM8vFdiHUR1eEBCrbOjJeLJZefa9MN9m5e84ktfe1runpWPjQ9PUMUJ6hAnYOir0TAXeu6OXS6n7L2CUyW4/0okZfoDBucR2HUgikUEcSZNMEQB2292PpWosFnkKRGsWMNUeta6FpNPt+GJY/KtFCyPNFr6yVesY3G+HGKSuG1rcBMjhLPnsVrWweSZEzLFJ5EWXCaG1bexZnJ5xi0R7n31BeYa06xu+dWcroNm4EXtWSc588+yOPD38d5jtZ4BQ3TIJI8uZLlhdH7ObZ/AgKfp54b5NGjj3OtH7LLyyOjHm29Ocj5aVZg046rDJpLCQHENOrTTI6OInMT1J1PuPmOX25f3XsgshHOcSYMwzNRnGDs1SUPeABVG6PEMVatsaLcsahsfnnFhkQQi0Kch3PeApbqJEaJR2Qd07NTtBRbCfq2YHwPU6ugy520tK/HolA2LTigTNpzvMBR1BS6tzFda1BvjJEvt2G0gWg2pQ7IPNK0QLvHASW/g7u3fphv7f8cw/6L3DPyOV6YeIiV+S3k/BLNaIqR6iHG7BmaGtaynTdv/zlqZpanBr/F2ZnDTJ6t0eK3kTcxzSDirO1nbFbxnPK5trmCa5sxKzv7CAtlrCc4lVrFJDbY2hyV+gi16iyBsVgTYdt3fq5rw+7/qpRFZ9/O2leGiemRIaBKhLHqDFFkWNfRib3crjon4Kxo59AWEJtxrSBywqOHj/H48DRlHfORfbcBAaprexbHOuIs5UHNF/M1OitUyALqFVJevZ366Sr10ROIbkWV15zjU877YZE0FhDBOugtbeUdu36e+099leP1ZxmOj3A2fh6THkcCkyekh62te3jNujfT5q8DYPW2Hezvf4SDT/46Kh/REI963ECLIvRLnNWGs83T7B8eYtdsH7tbemnLmgASDLGLswbyPDl8cE3qLeseXHPjG/6vIJeDxOJ5hryOiV6hebYeF7AchmZmQMPGllTIbpmarJxDO2dTX5jgJEZ7iulGg0//zVc4migKPX28Y8tq8lqBNTgh89oK31lEZaFaFvS5BSjSIdiU5uO3Ulh7A0l1HX6+myTfimDSn0n6edV8b5RLw3tnHe2ltbxj1z9hYPoIpyf2M9kcICJGS57ucBUb23exsnUToLE001cwOfaseQ3Xrn0tDx/5Jiqv2NV3MzO1cYZnz2Bx5PwcZ3MRw9UT9EeT/GTXJkpNh/UcvnNEXp4g0WDrVMPOF1Zde/f7g0JpgizmEV7ZMSzexeC04mx1FueEDW1dOOuW2V+vcGgxIlhxaNFMJY4//cpXePDUALtuuI0WYq7vTFMbp3RKOybJ8FzFi4Oj5MOQjR0tqQURm72uIAgaSAAbtCEdbSlBAIM4lcKFThDRqWuQc1C1U9nQUTRr2nawpm3HuWhbVPbKAiYNEEWlt8VIjMLyj970Kdav2kFUPc1bN97BeLPKXz7/XTo72jh46iBj0yeJCpoTZpaZqEGnLtHMasmhMTjToJJve75j+7735wvFsykN89XpfPCWrkAoBudmQAnrW7txyzHXSmOVWsCYdVDgy489xVND/bT39REqYXVYoK9cXqjipF9SpYUGJfzg5CmiJOGfvOa2tDFFFlGQM5Ke5+yi6DzNadPyWQJOp7wvSQ8MWRIlC+FoGtVmqFuaysA5K6VUFolLFj4IzlpyXpm797wDN/h97PALdOXyfPLmN+HZHAfat3HfyYepqDlOHzsAbakNCKxNMWbTpNGy/oG+bTd/RPzcSWsStP/qzZ+75Dt5SjFYmUIENizDXM/XZQSLcpbYKg4MjaACn46WFqxN2Njbi6dTblXWyIbFocUxWa8zGDtOD43w1LHj3LZ1S5o7z4dN2aSeFESYL+XoBY40Tmd5qaSBi3Oca4WZBzcyiBGbIllKkAUfbxeOkiMF+lOLoHAqIZkbIx4bIdAKcQ41NkRiEjaVetm29x3MuSYP+k/SWQiZGTuDUgmNyNWltPUznTtv/39zOV1pNOaWHbEa667KXPCXPEqe0gxWZlDWsqm1/SWFrJ1GO2OxCuMUubKHF2ocPsVSOwVl2dHTlVFSz/lHcanvPDo+TTUyzMxM88jRQ9y2dQtqUa+SnBduXciRlAVzrBf9/VyGvvg309+SS+Hp8yQ8UmsgGbNYcp2oVTdidURcGYeJAVyoCKNRmB6jnMvxlh3X43zFmPUYHx4eLa3d+CG/6e7xxIE1i8CgRe+oNWgfJwajA5zycEmTrlKeKEkPWzMxKGOxzmGsyypZV0HA85o8MFfBCmxs7UiF7JY+dRZR81qlQ43BUAqL5MIcYmvkfH+hiLrAEXQGI4qDo+PYBOLIcLh/gHt/8AAqSQOxlC6akQAy87z4/y/8+4/6s5RSrqg1mpRaW3jt7bfhrMVhsdrH792afurSBHF+FblQ0xg9gDI1tHb4lVNMNNv41XuHiXXxzC+//q33JJVxQmUIXZ0ETewkJcw5ED9HNDmJHTxKrmUV7aMv0lWdQCZPs33t2+ldt5GGEfLNLpTvc2RknHIYUKs3aCYWZSwqiy8u1VexLGfgKcVgdRZQrG9tz17QXUSRMSKCk3QKjwanhOZck7m5KlHR42vPPM9H9t1MKGCcxUNAaSajBqdmZ6k3mjSaDWw+z65duyno1L8upbOvxGUBTwmHj53kO9/7HnfeflsWJwiBSyN1i6DDdvy+Thzg59rB1ZFancHhE/z2373A1wcc+7a2K6NCovJqRpxBbILLkTYB1FLhJhMTjH7tz+kaH0QVygQmnRddTxw2atKS8wmjJnduXoF1cF1fKwoYmp2j5jR1U+TMRJUcluASKweW7e09pRmamwEs61o7U/aFW3RuUjqGSObLrFVop4mJGD5xnPXbruH5Ro3vvHiQt+++Bk+E2Bgma3UeOzvEnDHMzU2SEJEP2+nubM9AgFe/z7a1rZ0wzJ/jfGeBHM6mdCBS5omg8PJtJLTjFeCHz/bzncOz9BaLlKKm9dA4a1KETbxzHt4PMZUJRr/2+zQnBtFBDpIIo/20sTKLso1LI/FmNk4x9FLruLErFbQBrusuAA2IGkD3jy7g+Tx5sJrO1Vrf2oVdRPcREURwImmYY5qOfEkRa0Uc1Rk6cZK+Hdt5cGAQCUNUbDk1PsVgo06sPER5zFVmEWtZ1d6a+lJnFwKpV+NyWUJmnVlk8tw83TybZACLSdIOi3YCorC6QOAHKNsk0gUVYYjPY5M6xM9hKiNMfu2zNMf70bn8RW7jkhYm8412UcuvvAzmeNnxuqcUQ3PTgLChpSsLvCCzyThnEfFJKjVc2YOcAt9npjaN9J9m3dpV3Ht6AOsEZxU1k2Cq0zSmpkmaCSGam7ds4RzH8sdwuaXYyIt4WEhGNUoBnkTSpCy0hpzUSaRAnLWWzB8GRzo3M5mdYPKrn8VMDKKC8KoQLbiaAp4v7g9Wp1EirC+nQp7HjlK+mODjWNFa5GRlFs/Lob2EybEhyoGg/YCJmWmi6hzNqJllPBrrhFJi2djRBRbsQkElJU8bAe3s+UzyrDNRcFnUqxYpWQp4WHTaHiOS9UbYLJqW82rB57qGL8aXMkJvxhxRCymeQqOsA5U20UU6wLMhoREbuCTL1TOfOzPK1Nd+n3h8EJ3Lv3KbXC6o8v1o+ZVSDM5Nc2p2LMOBsxEPkoIEKrL8zG03sbWtnaiRQn++0vSfHeTUyZNUp2YxcUKg8nhegZpL6Mpp+pIEU2+ASpOZWhQzXqthrcW5BOsgchbjhLlGMzNdCYmDBIe1BuOidJamc1hnwVqMi0lcCluqeQFbi3UJ1sUk1mST3d1FOTELbW5yDvWSjMWcgTXzcYgjQXQFq0QS7ZMoDxMWac7NMvy1/49o7OxV0dxXXMDzgdfZuWlOVMZTLrAS8YwQaYiThNX5Mnt2bScXqrSyQtqT4ymNFgVaURXLdG2WdZ7jl37qLWwst2KiNJp8/NBJPvulr/Ot7z9CpdnAEw+lHIHy+NJ37+d/fPnv+Py3vw+i8ZTgo1BK8CRAlCZQCiU+SgxaaTxxaCUMTcxwemQMUQolHlo8fKVRGUTp3LkcYTkuwmVmWJxLWR0u6+ZwqVk2s5OM/+1/Jx4/i7yKwj3PRMuVCLk6haeFdgmb8xml8jzOVOsMNSO2XrOT6dFRJiermEadxKWIkovq9Bbz3H7dLt5/+610tbTw1aiK+MJUPeab9z/Cz733zbTki+RzHl/7wRP0jwxy977bOTUyzfXXX8vDjzzB8dNDvHDiBG2lEp09Pezff5SpZsJ73nADxwZGeeKFI+y7bg9iZzk0OEX/0DSVmSl+7t0/wZMHT9DdWqCvr5cHHn6KN7/mZnSQw4p6STrQxeBJBmertOgvToH2iacnmPhKJlw/96qHE55IWioECs65ddY537pzzuniWd6L/uqcc86JQmSwOpVUbX6dVZ7KWUugPA5WalQaoHWOvr61dHYZonqNamKx9Rrv2rmJ12zdTEehcC6OVYoAj8lqg1K5xOrODv7LX/4dGzpLDFca3LHvdr7/2HO0lzv5/nd/wOadm3nu0DGGmgnHTx+hODpLl+exc20Xf/K1vwPVxgfedgdf+vp9RKLYsW0TO8tFoqiPx599geFEM9A/QHfFEAFr+ro4eeo02rklGFuXLIUvaLJvY7RSWJdzJRUw8d0/ojl+Fp0rZIxM5ie3cN4Iqfl/k5TXdbWKEZ61lmqzcfejA4O/GUV2q1Y5bRK3OPpwi1OhxYUHWUChEU8l1s1OuZryPFxMXQynZ6bxwpDYGWJjUUqRL5XRounpauOua3ZSzID3+RRFW0ViLGs6SjQbNR578TDdXa1MzVRpOmFmfAQ/VExNz/Kut9/F/Y89B8UALzG8+3W3cGBklpHhEbxpRVdrC1MVx9nhUXw/NdebejqZGB3mxMw0bbkQXZ/hHa+/lef6Ryh3lfGUZLnrYv20Lylit1D1UojLEwUBjfpw1+lvfPaT0dCJAGuNbc7hXBp6i4gTAeusOofQ4RDlRMBEibJJ8h2Bw1cqZu/o6f5tj5/s/8LK9raOuzdtpOjn0kmyblGu4M7lCecNUnDnBK8UnBye5MnvHaYuBtURYnIeiUrnSiiVEu9wgmk2Wb+ii4Kv01Mti9OhdM1MoIWPvvut3Pv4s3jW46ffcAsHzwxx6PQZfvp1+zh55iwbezsJr91Oe28njzx/mNG5lCx3cniCjrZW/vef/AmOnBnmgWde5Kfe+Brqs1O0lUPWdG9ltPIst9+4lwefepahyRl2bloPcTMrZfqL5PnyuK9ysjA62AoURFMYGVh9+uuPf7ZFN0i0d7FJl/kbKOfjgs5SbcTM7Z34RbHJYZIr27rmDczMfrDoFTr+8d4b6CrM+50fDVxwtRjlYmJrWLuyj5zvYxKLZ+abwSBWoJ3hmr4uBJfVcRdikrQAkdFXVrW18OG7X5vdZsMdbdu5Y892wNFzzTbA0HXNZhyO973hVgCeOnyat995CzfvWA8Ydm9cze6Nq1NB9XYspFTvv+sOwPHeN95+sQkWzpU0L5gNslTEstDOYsGoiIbR2LXbDu+4+db3RceeyZkg78jADLlwJMG5NxeXwoPSnhjV0dt70sTx8kq1LyXg2Xp9S7k9oCuviWyCcgoti1hZsgQ159wMhoWTqBRENqbmgzE+nX29JNZlp1uy9sgYazTdYcjGzjbmq7bzryuSAib2PNMxjySpJbyeWnR70890w7Z1i56rL9DAxXnuIifjzvnQFMRYYnxSNrMDpxYdgBTZss6iRaGtxrOCih0u1I38vrceSJIEd/YITql5Ab80auFA5rsTnbvilQLeinLLfQ8MnP3AC5NT7O7sXDJ4WBrSueg+ESoPlyhaWwuUOgvUsAQkOEmnrqdT3BtsWtFFOciBSys1InqRR79QZ+QCnyCL4g95iU8rl/h3x7lW1SwflvOBDnXeyXYLWp/OEZFFRyV1L/NCiL35DgsfYqUSXSLcsoucGU4FfMFnsVl/o2QwpAO0SjH6QEFUmblilqXXXi59uay9D37uiYOvv3X1SgpFjRG7gI+6C7fezLP65wvt4hALvgqYGpskUXX61mxE6xxhs4HRNh1RagWDxndNdvZ1ZZSY+VkT5wvgHItfljCdV3rJBSyRi0EBlS0bWThiGdbMoqPB4v5dpbLvk2JbCsEzJsoPH0GdeQQKeZaaw2kyDrUAJhOwpwQxFq10uqnmijU4mpm6uzN499FK89/+8PjznwqseIksFGHPu6uyYEbmwTqDEQfOR2tLXBeU18ravi6M1DE6wUhAziRYEWwidOZyrO/oAGvT5bkXvEetXmVoeIRCPsRYe7EHnO+dlUvvW3rp8eJy7nBmEOR8Xy7WgefRPzJEPQNbknnM+VwpAlnQO0E5g7ICOv1Xzwom9KhNnl1z5q9//78WmWsxopb8qGnz6fxsg0x1xLlmHHvde1s+397afm+SXBnd0tseTdLW/8L1+oGv3No2elbloiKJHy9xo+S8OrB26XYVqxQOTWhrnMlv4diKu9E9RWJjyJk8TaUwKk5vTJKwo6+b1iAAm+lI5tPmc8TXv/5OhoeHmZqaTl3QRfVgeVmo3b2sDtusaqRBC0pSnNokCVYHuMYsb7hhOy6qYf0CkKCaVfALOBUgLskw7pT77ZRDowhMWl1Szkei6srBU9//VDFqYggu8akWOaBsGpE4w2x9Dn/Xrv5N3bvupXGFJnq2Wrvh8b/6/NfD9lz+5g/8ArnW3nOBnZPzwh2yjNCRmVZZRJzzhI6RKs8PNPDEx0YxkYpRokF8YnFoSdje15OaJHEpmOB0lu8L1jre+Lo7X12oJ5kgmuhHCPC7N4DK48YOwNQp5o4+QH7jjdhGhfrAC9C+mnzneupnD6ND8Ht3g1dcmN5nBZqepcVEVLoKVN76RqZlNBusspR41SKTrxasftJIUGs3Dlc6i8TJ8utBhSXz4Gcf/rhyzfzt/+ZztK7a8aPdpHgGZs/QzhOcKXg8mETk8TBi0S4iRlEjx8YQ1rekAZfNfNXirEFlviwtOS6FIcll6upLhasCSZX60Yfw5kYQPBrNKv7KLTSGj1M0owT4NCZPoCzkGiM0puaIczmkNoRMjWF1Gb1i1yJ/HePZAEOTYq71+M273/QpxRzWLd2GrebnuuEEUTZNtLRRKJdz5Wen5y5zZ8QSSKhX7T+6acW6DbSs2kHdRng2QSkv1Txk0c1modSW1mMUWhzJyAniY98l0ONUx2Mm/b0kSjBEKOfjWcFqRS5uclNhBH34FI0V15Dr3Z1VARfTWueBE3VZAdOPJFwEkzgkruEHYXrgpk+TdHaiwhKmNotvY+LZYVTfRmzYQWhqREkdr6Udb3yGeOosqnsDThfTmZsqRllF7EHoWqba3K5v5YpnEWXOD8rOF3Da3akVgYSAh8VSjwxz9QR1hYGlKq/bfOLsqSPMnDlATgX4XgGtAkQ0Igql0rkZIt58kzeeaLQIGoWpHiKnJyGGw2EH/bqLfKJxeFgxNLSQENDh5tgRTpGvnsBWBjJNlYVZlT+Oqr72Q2zYltJzNWib4CbHoCWPMYIoQUcRRAZVaEM5hT9+Am9uAsnlkeYUpjK0IDtl8ziJUBi0Uao5VyKe68AzHsr4Fz3IHuJymIbw+PFnefTEE1SrFWYma/gSolyw7MeSPnjrzXf/7qkffvdDj//6J/Pb3/Y+XEcXXpJxiJ1LTaicAxysS7lFvmliRVMsVcjrHBL5jJuQCV8RSkApttR8Q6I1No5YU6jRoxsgAUr5mf955ffnvqQm6xyqbTVJbYrAOpQyqMkzGN/DF4UVD8/FmNFT4FlEW7Rr4qyfLtZ0MdHUCEHbehCPhJBEpfM2tBKbyAzFXERetbJUb5md77cQRRzVODnSj1EJOzo2U68llAoB5gpJAV7Y3vncDR/61NuO3fOlX3nuT3/3NUZpSUcdqUUuMGVUODEkSlLv6WrMEvCmD72LwvouROW4IR8wODPKAZvQ1EU866GNw7iI3V4V3yQY0YiXdR6Jwr3KAnYL2XXa5uq3riIeOQVuFqcE38UEcZLyk0WhMHjJHNZKOsxcvJSsj0NphaoO4+oTUOhFSPCNwZMCc67a/uLYd944FpVWhn6xy+KW5oA5QQmYOJmwnh52Cn108mSuhVVPoOSKJ+55D7ocAx17nhp6x5rHZkdHbtcNp8VrwMIIwkXUmIx7JNbS0EJXPIvp8/DiJrGNWKkrfLA15L76JA/VoJIroV1MT9Jgm47ShZM4sDrNJUV+TLzJzH87hwpKSEs38eQEnoQYBC2WRM3PWs7acRbGLVpSETusCvDiKtHUaXShF6fS0DHWRabswKZG/al7j85VSNzSkbCVNN10WZokOgdiOdz/Q25b88Ffai20/1Z8pcWGCGk7Ojn5V5FO7rr2mr0UVUAs8QWg4TkxaOfQTmgon1XNE3TE+3FJA4XGJZoy/by1XKYnSPjOlOGkbuf2XEy7niNF3Sxa+wuVE7WoBeVVEusiWDKdzaXbV5BMHcFzpOOMst0L4tJDmELeNisHkh3ODNnSGmaGYFWULuUUH6ViVs/WufHQixSSUSy5lwBl0hYbZV228kBIao6g5TW9pcZKbNxY/pdbwg17E7XGByvEd/38jTews6Nj+S9mmyQHHkaaNYyfR9m01y9WOfyowW3+GVZ21XhwapSbQwPGYJSPUwabcYRlKTjyR4iHzxUl1CWrPgsVXZeNj1Vph0aCoItdSNhK0pzDy8adcd6oRLOoeHE++uWUh9eswtwQonTaLWmb2HLf2TU9e74UzB1viXXLourU+V/ASjq6MGccShKaSmNaPVTY9l2Mn83SvhKgY6a5b1VbJzvbOyC2GJ2BcHLB/pCsxdOSYJUHY8dhdgDtB7hsf/f8iCKnNM4Ia9Q0/1unBqNwDjQm5RxrP71VcmUA8/kYl7xk+qTO5WAZOGNxSqOJsbqA37UV2/8k1hPEeogYnGTjFzPhysLEgvkiWorXK2tgagDlPBCLjhTN4srxwb3v/D9Xvfj/oJvjiA4X4M3F2iySskeVcSgxKRaQBOjATzFud2XWzcvnglPHZyYZjur05XLZGdUX36b5ecd4KCJqEy+SVyZNc7Jpsgutl/Mze4xFVIJyHiJ+2r7hYHquSW18Jts/7C65SEouAvTcORh6kR+TxRh1lq07JPuZW3RLBSEi0hovMuTtLG1qgqorM50UWePnUbZOonyUNWn3oXMXkcvPjUDOPpUH1KaQqiWWAHSNwEzYOFzPxOZP0Vd/nurISTzqKFPHEqQzS0Qt8KISnRX+hWyjciu4EHcZAl5KVby1HR2fPzgw/JG/euLFVXdu20yrgMkycLfALZ5vFLPEoikkY/TUJ7KWDoXKIHNZZHQdGpG0Qy8toWUpQbmDz9/zEF998fsUc0XALm2+Lvkt3IIKzY9rEJdF+U4taOfCHlQnnDfJFoiJKYvit96/lbbuHoLGKX7/b5/lrj1reNPmEN2IUPiYDISw883kbikQzSGSjmewtXGCWKG9kFhakmJzmh4vQffeTDKVIMxAMo2hiHIxIt5CGdQqvXBAHRanCuD8JQGSy9Lggh8c3tnX9ZFD1Znf/OyTT+4OnJN5wpk7z0OmQUliUDv90fijvV4IuXQtTjafCvyFSPPc4ql5oWT5nBKmG4aRWpNCfHE1aSko0p3Xw5thuJL6SoekN37xNpf5pR9OnSuPZAKy4pM0I1p621izogtT3EmxfIY7dwzw1acGeN32a/HdIFaVsy0xZCMi9BJOQRZqTFiFn/OJaWLF0pgbKPknvnF3FEihGYTKRJVEu5mSSqbzjihyLlbZNJOMUJ9ONhEcNrGKpPk4zhzCJVcmYGMtudC/d09L92vcXH3bXFTzdfpm84drodnWIlJJlLqpbDYF8fE/Q5yIFazyFraosFAhOrfpxGX+Ox1WHtPX2sLudW0UfbW4u2PJCNPhsrmSLAx1sZkQsYYkMrjM1KmFyNctmHWrXBojiMb3QlAJNu6gLFXGJ2bozY1jmlXu3NLBQ4ernBypsrUlwDmX+taFFnG5hEERDDEeAcb5bFzfQ6sfU3KNHd965Lnv4BJiJXjE7ModZas6jE0ciZwbHieLyPOCYy4yeB2rfruju/VfXjEni4xNIE7qvlLP+qLQSi1mxi741aaBm1oTrimio+mGeE6jrYdVdqGReyGulXOBmcsIaViLWEM+COhuLVBQNuvcv9R2s9THzuesOrPOVsBYoeDBzg2r8RY2qM1rtDuX6opBC0xXGxw+M5INTSsSNZs8c6rKW4svEtdrFIMib9/TRjI3gWvvQbI6rFPpKMJLt3mlSwOcJLhYWJMvk88ZErOaF1mBUxGJhMRRRN/6W//5zXtLh63KK4tOFsZ+SxbNZXeubBNPguCIie3C7K9lC/Ol/s1x/gjqxa4mso697T63dAREkcTGKcSlw8cccUpJcedGJpzTZlCotMA/34hlEiSOcYFCjE3nSl0KypPsxjoyeCEFXGyzyerVvdy5Zycqqqc+mGyUoDu3eEuweEqoxMLQwDAzsUVUHbyA5/tnuXNzQNHWME3HzesKGMlj4jgjAqpslrUsPrsL+Vlq+tMN6EYcE7UEI4pIIlBkgIgicAmRiah62w7OFbu/09qWyyIztSiUXnTHncHWBtMc+Ar50cs6Hk3juKazwC2ri4BCotwpN5YbULa+2s57ksUVoMV0eckmu0paJYrEZ7rewDihZkN8G2Ost+Bpz2MRCouCtvPXn1itODI6xjcffZqir0lMOmFOLdRmsyk+zoAWxitVRhsxiI82MUZy9NdmeH4g4ra1rUjcTKtLpNGtzO8nXrBCqZIpl241Tw9amjYq8TGJx2RljsTmILY4FyMunT8iicFX8WRHe+nosRHFdfkIrcxLCthdpSGxLytg46AjB7tb68SNZgqaC2M2Vzzjqs3V4mX8aatYtJjwAitmM9OQ0Iy9A0Gu9S9XdUhRpCiexOaCSNE5N2+5zgn8vP/PgjXrrJsYG03GrPGc6LS4PE/zUeIytDsdx6WUWtHV4okB39nEiK+ixJOJWlQ3lP65stIqGYXIpmtKUSZDskgHdafDYCzWy8ZYZEPflEAt8b7VXggfiv1COfQEZY1NRJSTWHt48bW7b/x6e0fHiXpsmG0a2gvwaqwZ9paDFL1hUwdtRQ9nMgFqD1tb9QdJZfQW36Cd1gjmkoFIGiQZYmsorlzzO9fr0u8/fugMnlfAU/F5X/TC7omXrHWKwlMQJUnKzJS0Y3D+AMw3k6q0M52GSRdh+s5ixCcxjmuvXY1xo2slmvtYGsPq84hu81mZsjbFrhZG/riFdlKjFWGh/ZGf2rf2N544NUX/RJVQWZwoEokIPY+e7i6a8au/7v0lK+vGQqsvtIghacSYKEkfjSYSdv1ZI2y/30mS1nUXVrFezOVVFjARTb/n29K+5vO71rfh6bRFxVp33iMx6dgCa7noZxc+jLUYa7HZny/3WPzcKDKs7iqxuqcdV+j+TOJUgovTfl6nLro1Rme7GaygE52GR75Gl4vEzTnOPPHAu+unD/3MT+1dzx2buxFJp+MsfE7z6gv3JTU4cSlH94ZuHz9pEl9gfbX2k7i85gvx5PQbPIwgKusNdhkLcbGFbtIUPRl0b/u3Qm6uGFhWtBU5OW4JMqDGZvFUKedRnxpHwkKKXS8gZFehVLjo/FkH21eUkLiB9nIvxLn2X1bNyf/oKVFO0uEUNkOylE1pek6lzXFSCNDOUh0d4+QzzzBw4AVGDh++rnPPvk+3tHV8Y6PYGdoNz81oJpqGwNP8uC5vKbMcI/T4cF2LT4fymKm5JUpdQj7X9rQXlGtKu2KKsaVFhUUwWBr9WoNp2/KZQmvPUyQO5Ws2dLdzfGw8FaxzFAOP9tCnlPM4NlIn7wlWJ0QqIBF9RYK21hFbh9ZZf0QCUWLPIVHO4cKO/9SwOvHimf/XpxmKqAXaEgjKU5AXolqD8QNHOP7M8wwdPs7c+BhhSyvGb2Hu9KHe2fGht6zZueeLuztiNieORwdnOT5WSQebLWTyPyYBp2mGx+12lm1xA28ynfRy8bN8hgfP/sT3fvi1P91ckHx+RTvtba0UOtoJ21rxwxxkE+2II5p+x0O5js2fMXE6FBwLazpaKQZT5D2hI/QpBGpBhA4w1qKJCU2MEU1TBenqGbX86pN1jjgxlPM+127qZMfKPOKmqURFHtk/fUEjncUvd3xG1ZIHIxOsA/VhbaPbdSB5LTpfnxzh+ENPc/rAUQaPnKIc5KnMVWnr7qRemaWzr4dwZoyh5x76J1vuuOuvMSbxcdzdt4KTQ1M0Q59abNMmdJ0RssyrIuB5oDFdr7r25LMUq8OcEn1JfVHaY3Z8+A1Tzz/ZM5ArMf6C0BX4aF/IdXRQbCnR0tNJoauV1tYy/po9xxNpqYwNjJ8XCb9uXRv9M9U0CLfZOC8Rnc/n7ygWi08ppaoL1kAgEc1UDMbYl0wPrXPEsaWYD9ixtZM9mzspt4RQm4MYWtqKvOeOABOnxLvzAzeeNH7rk0F7z5eb08Mrxk4d/t3Jxx9855HnD6CaTRrVKh2tbdSjBh3tZQKBto5WxEvIFUOmjjz7msEXn7uu3NbxpDUpzNirNclMdUFZ3BlH1NNK0Nryagg4NX4rVcL2MCK3shtDDy+1i8ULQoa+d3pLHFuabQWac3XqSrBRhJsdoRxMMfTCUXL5AOX5+MWH3mXXP96x8yfe9zOeMDsv5I6ch169imRRf49Syiilpmq1mih1fqATChgTMRE7RCn0BVK21hFHhlLB59qNHezZ0km5JZ3LTGzAOowFlaSRtvYdVsW4SF8EsSglNJr+0FMPPhvPfu/vUJ19iKfJlfLkQ00xCMmHPi7zzzp2xJ4inKty+tEffmL3T7z9SRunAm7GyUX0idrZKVwCuc7WNM+yr4zx9nqP30+nNZQlpWdH2nvZmlRsKURTwzvWd7RhPUNX2SduNPDCItOVOZSChrXkGgl+LiA5dbxU9kt7W/NB05uH/7NK1bS1GMt5/rVarYZhGC6po10tPmWnGJxtkJhzqVkzNpTyAXu3dLJnSyelllyaBkRmUR1YZaOC5Vxvs7bgXwhTwexElTBXYtetr/vCt+//zvs2lEJi06C9rYyYdLqOsWkOZSWdHxlrn5wPM4effk/tNa//Fa3U2ZdK+WYHhlCjY7RsWI0f5l8RIXubtm5gfj7dsi7tMTc1ubYxNbilNZ8j8qCkfIyvMU4TtpWJ4iatpSIzsxVMochkUqdnZe9Tp46+0DTzFBRrUWGR8jX7yHv+ggZrranX67W+vr5cW1tb2oC1RN1zZRSx/9Qg1XqTxFhu3NHLtdu6KJfDVLDJBeOWlMaaqLtRndibb2u/X5QXLVSJA/BbcriawSqfuckK9cYsShzFlvK9m6+99mRp7NQGHRYxcZzytGR++EqKp1vlUNYjDgQzcaqt/8D+d6/fc+N/M83GS2qLqSdMHT1LeWU3YXt5AZm9agIeOLj/8lgUWjM3Pbk7SSIdK53CalbwBERZtFLkfR+FptzeShPwSkV6+lYf2bx9O3GjttiZ88MX91NpxAsEb6014+PjSUtLi07Pgb0EyCH0tRSo+rB3fRsbVpVwSR0zPrfE/VGIzqnxQ5//I3vm6z/Z2PrPPuoVuz+Xjk2a99uWfLgGJ3lKLT7l1nnaab46fe11Xx/8zvFPhdmuRMlcygKZIDNInjNEypH3HKOHnv7Za97wlt9XItHLdQg65yB2mGqM1xKmAZgsZp9cgYDb1m69rCf4uZDJ+//uOtVo4PLldAyuU/hOpV/cGERMyhj0ICTCtrYyMzVz7NCD9+Dmb6q1SKmd4spryRXPmUYRoVgseoVCwfN9/yVRrTYdcMPuHjq7PFw2z1EtheWYiLlTj7wvGPrmTyqvidfWMRUUuziv1ipgY8XQwXGcjRdMttIKv2vL/3Bh68eNmQtR56pVzqlzfcbOI9YGz1r8XEj19Au7R44fvKtnzbpvufjlWlBStU3q0Bi3BK0hXs5CcuWT4b3K6MjlPSHMMzdy9poAk/U8eiiJ0y5DEbRTCz42nTDnsOLRu+WaI/liYQHuVOKYI2AsOT9XEBHiONa1Wq2UDj9zl8htoVjQtLbl0+jbu8SNEA/rZlZW+7/8H8rxODNrP/KHKzfc9C2sn7ER5l2Poj4wgS9NVE6dBwwUuntfnFi96cm5k0/coXNFDBaxKQxqVLa5PBO1cimzMmcTTt//rV/su+vu75hoeRjlPIHQjnsknr7s3mDdtxTp7lT/ZdhnAdHe3Nmz23yts5xVo2jg8M6dbHQ6mskprDXoYvvsqp03HAlLhXNAiECzGXNk/xkacXLePOcgCGYmJyel2WxecuRBHFvWri3j+Ytec6lbpnPMHLn/X+TGn9tcb7m+0r5h329RPRkvFKwX/apv8nSvLl/EQJBcyMp9d/7F/qOP3NEaxAtzKNNNoWk51IlF27Rwb5zD83NUTrx413jbP9peXrHmRRcvv883uaBydgWsWbxVW3ctX75KETWba49/b3ad5/kpd0hAOb3ANLRybgZnSvGJkVL5+OETx0fFxOdxmRoqxC+0k+P8DsN6vW4nJiZ8Y8wlBRxFhltv7AXrZwX5pRy1R3Pm7K3uxJ9/Mu8sZuP7fj3fd90RXJ2lVqfPjc4Q1evnZmQsvFlMrnfVl6Vj1X9Q1ZFOrQOMpLVfbecb6OQ8RdBiUJUxGX7+iQ/19Pb+sm3WfiwMf+/E/icuS8CNubk9KprLp3CM5cJh+y4jKNhsZ0JsFKUVG050bdsRJ/XaonvgaMaCHwfnuRmlFFNTU7n29vbWMAwvaaKTxNJVaIdZgeQSAvZy1I4d+LDMnczXuu8+2LH2tt+lUV86TBWHSizaXNwJKMZQLpVHe/e85qsT9335Y4Vi+vxEhMA6kvm6dQZ222x/YxAWGH34+//IXrvvP/q5YNpZ9+oLeO2ea5f/y7mQM08/ds10Yw5VyC9QbWShWHhu9JBVaZOpUx5+ozFUe/w+7GIzZRLcym2U1l/L4mhWKUUcx9VVq1YV1q9f/xJVGMec05ycVJfMK7Sfo39sbSmf/9iLW697689LsTSHiS9Ve8RJZWGFz4X4vE0MHRu2/dlIkP9YIkm63U0ssVIpSSBj9hmVLiVxCBLkcUP9K88cPfreNbuu/YMkbr6iwiwtJbO5Zn35Guws48MnbxJlMSqt8Sp3rolqfs/RPI1Ri8FqYcWqFQc7g3SO47nYVjOe1Dly8sS5EcFZmjQ2NtZsNBr1FStWEF/CdylRFPNtC9Sci4SrNOMTY1tfHLUtb7rzk/sK7WYWkqULpKKwtQb106ewyaXdQks+91DL2g0HGqcP7AyCAM9F6SAVd25Kj0OjnMnyWUfOsxx/9J6fbenp+CMbR+ZVF/DqjduXHWA5pYMDs5M7PKUxZDNU5rd4O7WwoCodyeBwVoh9n46b9u0vr1wNiweKaMXc2UmGnj6N76kL80JXKpUKo6OjS+bBzjnyhTxhmFs0Le58nVPi6xOHD/7KjvXdfxZ44ezkZBXPc5eOxap1Su2dCxNzlrQIuVzUtevGL5499uKvSZBkU3JSzrTJ0Cxt073kiTh84+NykAy8eLNtJnd0rd7wA5vEr66JHvjW3yyzmApotcVMjK/1PQ8v4yQ7mZ88M9/yo7DKkK54bZAPuyZHR6vHpmZOnAfFicBAQ5HP59EX3FNjjCRJ0mqMEbeEE7bW4nk+sTNLHgDP8zgzcOqnmqj6ytUb/qrRaFKrBZcMTJ2Dbu1T7mx/aaK51qzee8sXz9z31V9yUaMo3jxNVyNuflWdy0h6kiFcmqA+xZkXnvzZnu3X/ID6qxtseXrbdctTYN+n1n9yjavMhNZXC/Od5kcqgWWBgEcK2xnbpK2r90RP7/ph16xxPnHc0Qg98t35czTXc1dzYmKi7vv+/O6J87RXBErFMr7yF2Y1zx9CUUIcxd6Zk/0f2r17169qT7AmuOgQnU8p0jRnBnFzoy/b6ahzuaOlzdc8MPfcY2/JeQrmuxMW04XnHYekK3WDXJGR5x995/ie6zfmC4UTV7qr+ZLAz4a9SwAdOliegL0cs/X6dj+OIPCxCz1FWdtKyq3GZgV05RxN67CF/EBUGbMual5kFsebATN29qLJmM45d+LEiXypVLpIwMYY2ts76OtbtaSp9TyPQ4cOfaq1tfWhjo7OF5Jl7b13hOVWCgXv5VhMSBCw/rY3/cHT+594iz+/6f2lGJDOYXUOPT1UHB84+eGtr33Tv7eLsolXXIM3rl2xvN/MhTz+g6/fJiQ4F6ZmOQuwLAol6ZJIJyysj3NOCNraD1SHTmGS8+E65RxJaS3iFZcKkoxzLkqSRC4GOGLCMERrzYXCU0oxOTm5bmJi4q7rr7/+g/X6cgJIQWxMY3qAaFk7GgUvX/52rnf1SRk9scEG3sscHYPFp6gdA8898Z5VN772/1Gio6uyt+5CES0l4OnDh5b1ZOUHhfrgmT0u0NkCDrVQSZmPICVrkLYiOJWmCit27H1h1c69XKjBIgI1TSVxF+mMiMSVSiVpNBrqwppwLpeju7uHer1+nv8VEbTWHD58+BNr1qz+vXK5PL087RXEgLJJFiO8jIM0kAvDevf26/9mrP/Iv/B9sIvWWF+ceWRJl+cTTw1vFJesDPPFU86+OuQd7+SzTy8T5PA6zcxEn1Iqm9fhsjnP7vxZHtmXciSosGQHz5w6PT46cn6t0zlUkKd83RtoKecvwlxFxBaLxRKg1RJRbRiG5ILgvCXWnudx6tSpa51zydq1a78RLxMadDiUKMK27qVigaUPu9as2HPb5wcfvuef+a7mIZd2czqdBkaiNM4lUp8ZLxHXLlklu+oaXK9Ns8xvlRPbzCsUotJuBeXSnI80WUi7GCSd15jYGN3SNr1i084zS43ndaSr70R754P+mTY2Gg27efNmr1AonIdmOefQ2j9vCmsGoeb6+/t/ZuPGTZ9xDrdcmqpDCFxEmC+x3PBWROhe0/5cy8adD9UP/uBOL+9fEjt2mHSKHQ4Jwrh15drxXKGIs68OjdZbvfeG5cnXC8YPjJ8ZDUZm1zhxiMpgOdGca/DMuvFEp4NZ2nvOtHZ3D2GSiwRsRfHIwYNMVRvpxpYLfOnBgwdnW1paJEmShdPunKNUbkV7sqC9IoI1hmPHjr27vb39bE9P12AULR8xcqIwzSrTjWncMpfQOMALcnTsuvVPTx14+M6yMTSVn3YiiVuY/aVJOwvFgqnXKGy78WlrGatNz/BK+OBSzxICLqxev7xSVBBOB6s33xcNHPmwH/gIGs9CoiyJSgeziFPgfISYmvHoXLv7m/XpKZs0m+cphwBzRhGLR7lcWlJDdu/e7RljdKVSOU+D48QuGjecIl/9/f3vn5yc/PhNN934k3EcnWe6X+4y1tBRLNHdXeZysGJRinLXa/+q/9F7/vVc/zOb/HwZ5+azi3Q1XiQ+iZdQrjWZkgI33HrXpwvlNpPi4a9SFN0YGVr2F9q8edvvHHj+8Q8E0UyQ5EISrQmsIchWozvnk7cJDVthdsWm8R3bdv1BjMNdsPFaZb1WJjFL1rOzilKH53nTIjLhFjWkV6rjmUan09NNkrQ++dRTn+3s7HxqcnK6drnjd2PjCPta6W1pS6k+y9d9wkJYueanPvDJx/9w6G/bGzPFgm9paI9YIDAO3wjW1JhqwKqf+Jnf61y3+WszEyOviPYCtC1poltLy/5CqqPtqfE3vPWX+v/ui/+lrVHBC3LEyk/LZlg81yBu1pkI2pMb3/Gxn1+xacvpuNm8yLMpJbi5BvWh/vmVPhdpcLPZ/EFra2uslEqbsZVCKUXg++cFVkePHvuosza57bbbPtnV1WUvt0Uk7RIVbGIvfy5kEtPe1XPP7g984u3Pffdv/1th6PDOYhKjjcFoTcNBPeyorXrzm//rxlte+++sTbDGvLpIVnAZ9WCAm7ft+kxH94qZw9/663+nx06uT6ihxMePHPWwRGPVxoPXveUD/+faTdu/HSdJNkFWLhKwyKV3GGT/3lis0bOzs0xNTaHOb05fc/z40Z+74YbrP97Z1XlMAK3V5QsY+FGZbkkc0dbd/b21N96+T8/ueWfl5OG7W8rl1mp12rR3dh5s61z5Zys2b3/BWbPsLaNXVcCXbS6ShL4NW//Yf9c//srYqYPviudmX2OMLZEw2trWe49uCe9t7+6uJHEECz05V2aSlFJMT09z8uRJPO+cuY+iKPD94HdvvPGGrxiTBmM/yu270ntukgSbxDNdvSs+F81Of+6GD32SA/d/E9WoUPc8TBS9Ymb55SuAP6Y3/l/Xq3P9/wMA9B77MB/waGYAAAAASUVORK5CYII="><p>GoReporter</p></li><li class="nav-item active" id="navSummary"><i class="fa fa-home" aria-hidden="true"></i><p id="homepage" data-i18n="homepage"></p></li><li class="nav-item" id="navUnitTest"><i class="fa fa-umbrella" aria-hidden="true"></i><p id="unit_test" data-i18n="unit_test"></p></li><li class="nav-item" id="navCodeStyle"><i class="fa fa-glass" aria-hidden="true"></i><p id="code_style" data-i18n="code_style"></p></li><li class="nav-item" id="navCodeOpt"><i class="fa fa-envira" aria-hidden="true"></i><p id="code_opt" data-i18n="code_opt"></p></li><li class="nav-item" id="navCodeCount"><i class="fa fa-code" aria-hidden="true"></i><p id="code_count" data-i18n="code_count"></p></li><li class="nav-item" id="navCodeSmell"><i class="fa fa-spinner" aria-hidden="true"></i><p id="code_main" data-i18n="code_main"></p></li><li class="nav-item" id="navBenchmark"><i class="fa fa-tachometer" aria-hidden="true"></i><p id="benchmark" data-i18n="benchmark"></p></li><li class="nav-item" id="navMutation"><i class="fa fa-bug" aria-hidden="true"></i><p id="mutation" data-i18n="mutation"></p></li><li class="nav-item" id="navUntested"><i class="fa fa-chain-broken" aria-hidden="true"></i><p id="untested" data-i18n="untested"></p></li><li class="nav-item" id="navFuncLen"><i class="fa fa-arrows-v" aria-hidden="true"></i><p id="func_len" data-i18n="func_len"></p></li><li class="nav-item" id="navMaintainability"><i class="fa fa-wrench" aria-hidden="true"></i><p id="maintainability" data-i18n="maintainability"></p></li><li class="nav-item" id="navDepend"><i class="fa fa-sitemap" aria-hidden="true"></i><p id="depend" data-i18n="depend"></p></li><li class="nav-item" id="navModules"><i class="fa fa-cubes" aria-hidden="true"></i><p id="modules" data-i18n="modules"></p></li><li class="nav-item" id="navVuln"><i class="fa fa-shield" aria-hidden="true"></i><p id="vuln" data-i18n="vuln"></p></li></ul></div><div class="main"><nav class="navbar"><div class="container-fluid"><div class="navbar-collapse collapse" id="navbar"><ul class="nav navbar-nav navbar-right"><li><a href="https://github.com/360EntSecGroup-Skylar/goreporter" target="_blank"><i class="fa fa-github fa-lg" aria-hidden="true"></i></a></li><li><a id="changeLang">中文</a></li></ul></div></div></nav><div class="content-container" id="summary"><ul class="summary-list"><li><i class="fa fa-star-o" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_score" data-i18n="hp_score"></h4><span><span class="emphasize-big" id="score"> </span><span>/100</span></span></div></li><li><i class="fa fa-check" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_cover_pct" data-i18n="hp_cover_pct"></h4><div><span class="descp" id="hp_code_cover_pct" data-i18n="hp_code_cover_pct"></span><span class="emphasize-num" id="testCover"></span><span>%</span></div><div><span class="descp" id="hp_pkg_cover_pct" data-i18n="hp_pkg_cover_pct"></span><span class="emphasize-num" id="testPkgCover"></span><span>%</span></div></div></li><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_issues">Issues</h4><div><span class="descp" id="hp_issues_subtitle" data-i18n="hp_issues_subtitle"></span><span class="emphasize-big" id="goIssueNum"></span></div></div></li><li><i class="fa fa-circle-o" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_circle_complexity" data-i18n="hp_circle_complexity"></h4><div><div><span class="descp" id="hp_circle_comp_middle" data-i18n="hp_circle_comp_middle"></span><span class="emphasize-num" id="mediumCycleNum"></span></div><div><span class="descp" id="hp_circle_comp_high" data-i18n="hp_circle_comp_high"></span><span class="emphasize-num" id="highCycleNum"></span></div></div></div></li><li><i class="fa fa-code" aria-hidden="true"></i><div class="summary-content"><h4 id="hp_code_amount" data-i18n="hp_code_amount"></h4><div></div><span class="emphasize-big" id="codeLineNum"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="hp_unit_test_title" data-i18n="hp_unit_test_title"></div><div class="row"><div class="col-sm-9 col-md-9" id="gotestChart"></div><div class="col-sm-3 col-md-3"><ul class="gotestSummary"><li id="hp_unit_test_result_summary" data-i18n="hp_unit_test_result_summary"></li><li><span id="noTestCount"></span><span id="hp_files_no_test" data-i18n="hp_files_no_test"></span></li><li><span id="coverLessCount"></span><span id="hp_files_less_coverage" data-i18n="hp_files_less_coverage"></span></li><li><span id="timeGreaterCount"></span><span id="hp_files_more_time" data-i18n="hp_files_more_time"></span></li></ul></div></div></div><div class="divider"><div class="line"></div><div class="icon"></div><div class="line"></div></div><div class="row chart-container"><div class="pie-chart"><div><div class="chart-title" id="hp_pkg_circle_comp" data-i18n="hp_pkg_circle_comp">包圈复杂度 </div><div id="gocycleChart"></div></div><div><div class="chart-title" id="hp_pkg_issues_count" data-i18n="hp_pkg_issues_count">包Issue</div><div id="goIssue"></div></div><div><div class="chart-title" id="hp_pkg_code_amount" data-i18n="hp_pkg_code_amount">包代码量占比</div><div id="goPercentage"></div></div></div></div><div class="divider"><div class="line"></div><div class="icon"></div><div class="line"></div></div><div class="chart-container">DependGraph</div></div></div><div class="none content-container" id="unitTest"><ul class="summary-list"><li><i class="fa fa-star-o" aria-hidden="true"></i><div class="summary-content"><h4 id="ut_avg_cover_pct" data-i18n="ut_avg_cover_pct"></h4><span class="emphasize-big" id="coverPct"></span><span>%</span></div></li><li><i class="fa fa-check" aria-hidden="true"></i><div class="summary-content"><h4 id="ut_pkg_cover_pct" data-i18n="ut_pkg_cover_pct"></h4><span class="emphasize-big" id="pkgPct"></span><span>%</span></div></li><li><i class="fa fa-clock-o" aria-hidden="true"></i><div class="summary-content"><h4 id="ut_unit_test_time" data-i18n="ut_unit_test_time"></h4><span class="emphasize-big" id="unitTestTime"></span><span>s</span></div></li><li><i class="fa fa-balance-scale" aria-hidden="true"></i><div class="summary-content"><h4 id="ut_test_code_ratio" data-i18n="ut_test_code_ratio"></h4><span class="emphasize-big" id="testCodeRatio"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="ut_cover_title" data-i18n="ut_cover_title"></div><div id="unitCover"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container row"><div class="col-sm-6 col-md-6"><div class="chart-title" id="ut_time_pct_title" data-i18n="ut_time_pct_title"></div><div id="pie-chart"></div></div><div class="col-sm-6 col-md-6"><div class="list-title chart-title" id="ut_lack_test_list" data-i18n="ut_lack_test_list"></div><ul class="list" id="unitAbsentFiles"></ul><div class="list-title chart-title" id="ut_failed_list" data-i18n="ut_failed_list"></div><ul class="list" id="unitFailedPkgs"></ul></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="ut_quality_title" data-i18n="ut_quality_title"></div><table class="table table-striped" id="unitTestQuality"><thead><tr><th id="ut_quality_col_pkg" data-i18n="ut_quality_col_pkg"></th><th id="ut_quality_col_tests" data-i18n="ut_quality_col_tests"></th><th id="ut_quality_col_subtests" data-i18n="ut_quality_col_subtests"></th><th id="ut_quality_col_skips" data-i18n="ut_quality_col_skips"></th><th id="ut_quality_col_no_fail" data-i18n="ut_quality_col_no_fail"></th><th id="ut_quality_col_no_output" data-i18n="ut_quality_col_no_output"></th><th id="ut_quality_col_ratio" data-i18n="ut_quality_col_ratio"></th></tr></thead><tbody></tbody></table></div></div></div><div class="content-container none" id="codeStyle"><ul class="summary-list"><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4>ISSUES</h4><span class="emphasize-big" id="styleIssueNum"></span></div></li><li><i class="fa fa-file-o" aria-hidden="true"></i><div class="summary-content"><h4 id="cs_file_nums" data-i18n="cs_file_nums"></h4><span class="emphasize-big" id="styleFileNum"></span></div></li><li><i class="fa fa-font" aria-hidden="true"></i><div class="summary-content"><h4 id="cs_quality_class" data-i18n="cs_quality_class"></h4><span class="emphasize-big" id="styleQualityRank"></span></div></li></ul><div class="right-content"><div class="sub-nav"><ul id="styleSubNav"><li>Index</li></ul></div><div class="sub-content" id="styleContent"></div></div></div><div class="content-container none" id="codeOpt"><ul class="summary-list"><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4>ISSUES</h4><span class="emphasize-big" id="optIssueNum"></span></div></li><li><i class="fa fa-file-o" aria-hidden="true"></i><div class="summary-content"><h4 id="co_file_nums" data-i18n="co_file_nums"></h4><span class="emphasize-big" id="optFileNum"></span></div></li><li><i class="fa fa-font" aria-hidden="true"></i><div class="summary-content"><h4 id="co_quality_class" data-i18n="co_quality_class"></h4><span class="emphasize-big" id="optQualityRank"></span></div></li></ul><div class="right-content"><div class="sub-nav"><ul id="optSubNav"><li>Index</li></ul></div><div class="sub-content" id="optContent"></div></div></div><div class="content-container none" id="codeCount"> <ul class="summary-list"><li><i class="fa fa-code" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_code_lines" data-i18n="cc_code_lines"></h4><span class="emphasize-big" id="line_count"></span></div><div class="progress-bar"></div></li><li><i class="fa fa-question" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_comment_lines" data-i18n="cc_comment_lines"></h4><span class="emphasize-big" id="comment_count"></span></div></li><li><i class="fa fa-info" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_function_lines" data-i18n="cc_function_lines"></h4><span class="emphasize-big" id="function_count"></span></div></li><li><i class="fa fa-file-o" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_file_lines" data-i18n="cc_file_lines"></h4><span class="emphasize-big" id="file_count"></span></div></li><li><i class="fa fa-square-o" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_blank_lines" data-i18n="cc_blank_lines"></h4><span class="emphasize-big" id="blank_count"></span></div></li><li><i class="fa fa-flask" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_test_lines" data-i18n="cc_test_lines"></h4><span class="emphasize-big" id="test_count"></span></div></li><li><i class="fa fa-cogs" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_generated_lines" data-i18n="cc_generated_lines"></h4><span class="emphasize-big" id="generated_count"></span></div></li><li><i class="fa fa-language" aria-hidden="true"></i><div class="summary-content"><h4 id="cc_languages" data-i18n="cc_languages"></h4><span class="emphasize-big" id="language_count"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="cc_pkg_title" data-i18n="cc_pkg_title"></div><div id="lineCountChart"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="cc_file_title" data-i18n="cc_file_title"></div><div id="fileCountChart"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="cc_lang_title" data-i18n="cc_lang_title"></div><table class="table table-striped" id="languageCountTable"><thead><tr><th data-i18n="cc_col_name"></th><th data-i18n="cc_col_files"></th><th data-i18n="cc_col_code"></th><th data-i18n="cc_col_test"></th><th data-i18n="cc_col_generated"></th><th data-i18n="cc_col_comment"></th><th data-i18n="cc_col_blank"></th><th data-i18n="cc_col_total"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="cc_pkg_total_title" data-i18n="cc_pkg_total_title"></div><table class="table table-striped" id="pkgCountTable"><thead><tr><th data-i18n="cc_col_name"></th><th data-i18n="cc_col_files"></th><th data-i18n="cc_col_code"></th><th data-i18n="cc_col_test"></th><th data-i18n="cc_col_generated"></th><th data-i18n="cc_col_comment"></th><th data-i18n="cc_col_blank"></th><th data-i18n="cc_col_total"></th></tr></thead><tbody></tbody></table></div></div></div><div class="content-container none" id="codeSmell"><ul class="summary-list"><li><i class="fa fa-circle-o avg" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_avg_circle_comp" data-i18n="cm_avg_circle_comp"></h4><span class="emphasize-big" id="cyclo_avg"></span></div><div class="progress-bar"></div></li><li><i class="fa fa-circle-o high" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_circle_comp_high" data-i18n="cm_circle_comp_high"></h4><span class="emphasize-big" id="cyclo_high"></span></div></li><li><i class="fa fa-circle-o series" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_circle_comp_serious" data-i18n="cm_circle_comp_serious"></h4><span class="emphasize-big" id="cyclo_grave"></span></div></li><li><i class="fa fa-circle-o avg" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_avg_cognitive" data-i18n="cm_avg_cognitive"></h4><span class="emphasize-big" id="cognitive_avg"></span></div></li><li><i class="fa fa-circle-o high" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_cognitive_high" data-i18n="cm_cognitive_high"></h4><span class="emphasize-big" id="cognitive_high"></span></div></li><li><i class="fa fa-circle-o series" aria-hidden="true"></i><div class="summary-content"><h4 id="cm_cognitive_serious" data-i18n="cm_cognitive_serious"></h4><span class="emphasize-big" id="cognitive_grave"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="col-sm-4 col-md-4"><div class="chart-title" id="cm_circle_comp_pct_title" data-i18n="cm_circle_comp_pct_title"></div><div id="cycloPertChart"></div></div><div class="col-sm-8 col-md-8"><div class="list-title chart-title" id="cm_circle_comp_list_title" data-i18n="cm_circle_comp_list_title"></div><div class="btn-group" id="cycloListOrder"><button type="button" class="btn btn-default btn-xs active" data-order="cyclo" id="cm_order_cyclo" data-i18n="cm_order_cyclo"></button><button type="button" class="btn btn-default btn-xs" data-order="cognitive" id="cm_order_cognitive" data-i18n="cm_order_cognitive"></button></div><ul class="list" id="cycloList"><li><span id="cm_circle_comp_list_col1" data-i18n="cm_circle_comp_list_col1"></span><span id="cm_circle_comp_list_col2" data-i18n="cm_circle_comp_list_col2"></span><span class="metric" id="cm_circle_comp_list_col3" data-i18n="cm_circle_comp_list_col3"></span><span class="metric" id="cm_circle_comp_list_col4" data-i18n="cm_circle_comp_list_col4"></span><span id="cm_circle_comp_list_col5" data-i18n="cm_circle_comp_list_col5"></span></li></ul></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="cm_circle_comp_rank_title" data-i18n="cm_circle_comp_rank_title"></div><div id="cycloRankChart"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="benchmarkTest"><ul class="summary-list"><li><i class="fa fa-circle-o avg" aria-hidden="true"></i><div class="summary-content"><h4 id="bm_benchmark_num" data-i18n="bm_benchmark_num"></h4><span class="emphasize-big" id="benchmark_num"></span></div></li><li><i class="fa fa-circle-o series" aria-hidden="true"></i><div class="summary-content"><h4 id="bm_regression_num" data-i18n="bm_regression_num"></h4><span class="emphasize-big" id="regression_num"></span></div></li><li><i class="fa fa-circle-o high" aria-hidden="true"></i><div class="summary-content"><h4 id="bm_improvement_num" data-i18n="bm_improvement_num"></h4><span class="emphasize-big" id="improvement_num"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="bm_delta_title" data-i18n="bm_delta_title"></div><table class="table table-striped" id="benchmarkDeltaTable"><thead><tr><th id="bm_delta_col_pkg" data-i18n="bm_delta_col_pkg"></th><th id="bm_delta_col_name" data-i18n="bm_delta_col_name"></th><th id="bm_delta_col_unit" data-i18n="bm_delta_col_unit"></th><th id="bm_delta_col_old" data-i18n="bm_delta_col_old"></th><th id="bm_delta_col_new" data-i18n="bm_delta_col_new"></th><th id="bm_delta_col_change" data-i18n="bm_delta_col_change"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="bm_result_title" data-i18n="bm_result_title"></div><table class="table table-striped" id="benchmarkTable"><thead><tr><th id="bm_result_col_pkg" data-i18n="bm_result_col_pkg"></th><th id="bm_result_col_name" data-i18n="bm_result_col_name"></th><th id="bm_result_col_ns" data-i18n="bm_result_col_ns"></th><th id="bm_result_col_bytes" data-i18n="bm_result_col_bytes"></th><th id="bm_result_col_allocs" data-i18n="bm_result_col_allocs"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="mutationTest"><ul class="summary-list"><li><i class="fa fa-star-o" aria-hidden="true"></i><div class="summary-content"><h4 id="mt_score" data-i18n="mt_score"></h4><span class="emphasize-big" id="mutationScore"></span><span>%</span></div></li><li><i class="fa fa-check" aria-hidden="true"></i><div class="summary-content"><h4 id="mt_killed" data-i18n="mt_killed"></h4><span class="emphasize-big" id="mutationKilled"></span></div></li><li><i class="fa fa-circle-o series" aria-hidden="true"></i><div class="summary-content"><h4 id="mt_survived" data-i18n="mt_survived"></h4><span class="emphasize-big" id="mutationSurvived"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="mt_pkg_title" data-i18n="mt_pkg_title"></div><div id="mutationScoreChart"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="mt_survivor_title" data-i18n="mt_survivor_title"></div><div id="mutationSurvivors"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="untestedApi"><ul class="summary-list"><li><i class="fa fa-folder-open-o" aria-hidden="true"></i><div class="summary-content"><h4 id="ua_no_test_num" data-i18n="ua_no_test_num"></h4><span class="emphasize-big" id="untestedNoTestNum"></span></div></li><li><i class="fa fa-pie-chart" aria-hidden="true"></i><div class="summary-content"><h4 id="ua_ratio" data-i18n="ua_ratio"></h4><span class="emphasize-big" id="untestedRatio"></span><span>%</span></div></li><li><i class="fa fa-check" aria-hidden="true"></i><div class="summary-content"><h4 id="ua_tested" data-i18n="ua_tested"></h4><span class="emphasize-big" id="untestedTested"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="ua_pkg_title" data-i18n="ua_pkg_title"></div><table class="table table-striped" id="untestedPackages"><thead><tr><th id="ua_col_pkg" data-i18n="ua_col_pkg"></th><th id="ua_col_tests" data-i18n="ua_col_tests"></th><th id="ua_col_tested" data-i18n="ua_col_tested"></th><th id="ua_col_ratio" data-i18n="ua_col_ratio"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="ua_untested_title" data-i18n="ua_untested_title"></div><table class="table table-striped" id="untestedFuncs"><thead><tr><th id="ua_col_func" data-i18n="ua_col_func"></th><th id="ua_col_location" data-i18n="ua_col_location"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="funcLenPage"><ul class="summary-list"><li><i class="fa fa-code" aria-hidden="true"></i><div class="summary-content"><h4 id="fl_funcs" data-i18n="fl_funcs"></h4><span class="emphasize-big" id="funcLenFuncs"></span></div></li><li><i class="fa fa-exclamation-triangle" aria-hidden="true"></i><div class="summary-content"><h4 id="fl_too_long" data-i18n="fl_too_long"></h4><span class="emphasize-big" id="funcLenTooLong"></span></div></li><li><i class="fa fa-arrows-v" aria-hidden="true"></i><div class="summary-content"><h4 id="fl_average" data-i18n="fl_average"></h4><span class="emphasize-big" id="funcLenAverage"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="fl_histogram_title" data-i18n="fl_histogram_title"></div><div id="funcLenHistogram"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="fl_longest_title" data-i18n="fl_longest_title"></div><table class="table table-striped" id="funcLenLongest"><thead><tr><th id="fl_col_pkg" data-i18n="fl_col_pkg"></th><th id="fl_col_func" data-i18n="fl_col_func"></th><th id="fl_col_location" data-i18n="fl_col_location"></th><th id="fl_col_lines" data-i18n="fl_col_lines"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container" id="funcLenExternalBlock"><div class="chart-title" id="fl_external_title" data-i18n="fl_external_title"></div><ul class="list" id="funcLenExternal"></ul></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="maintainabilityPage"><ul class="summary-list"><li><i class="fa fa-wrench" aria-hidden="true"></i><div class="summary-content"><h4 id="mi_index" data-i18n="mi_index"></h4><span class="emphasize-big" id="maintainabilityIndex"></span></div></li><li><i class="fa fa-exclamation-triangle" aria-hidden="true"></i><div class="summary-content"><h4 id="mi_low" data-i18n="mi_low"></h4><span class="emphasize-big" id="maintainabilityLow"></span></div></li><li><i class="fa fa-exclamation-circle" aria-hidden="true"></i><div class="summary-content"><h4 id="mi_moderate" data-i18n="mi_moderate"></h4><span class="emphasize-big" id="maintainabilityModerate"></span></div></li><li><i class="fa fa-bug" aria-hidden="true"></i><div class="summary-content"><h4 id="mi_bugs" data-i18n="mi_bugs"></h4><span class="emphasize-big" id="maintainabilityBugs"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="mi_heatmap_title" data-i18n="mi_heatmap_title"></div><div id="maintainabilityHeatmap"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="mi_funcs_title" data-i18n="mi_funcs_title"></div><table class="table table-striped" id="maintainabilityFuncs"><thead><tr><th id="mi_col_pkg" data-i18n="mi_col_pkg"></th><th id="mi_col_func" data-i18n="mi_col_func"></th><th id="mi_col_location" data-i18n="mi_col_location"></th><th id="mi_col_lines" data-i18n="mi_col_lines"></th><th id="mi_col_cyclo" data-i18n="mi_col_cyclo"></th><th id="mi_col_volume" data-i18n="mi_col_volume"></th><th id="mi_col_difficulty" data-i18n="mi_col_difficulty"></th><th id="mi_col_effort" data-i18n="mi_col_effort"></th><th id="mi_col_bugs" data-i18n="mi_col_bugs"></th><th id="mi_col_mi" data-i18n="mi_col_mi"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="dependPage"><ul class="summary-list"><li><i class="fa fa-cubes" aria-hidden="true"></i><div class="summary-content"><h4 id="pm_packages" data-i18n="pm_packages"></h4><span class="emphasize-big" id="packageMetricsPackages"></span></div></li><li><i class="fa fa-exclamation-triangle" aria-hidden="true"></i><div class="summary-content"><h4 id="pm_pain" data-i18n="pm_pain"></h4><span class="emphasize-big" id="packageMetricsPain"></span></div></li><li><i class="fa fa-exclamation-circle" aria-hidden="true"></i><div class="summary-content"><h4 id="pm_uselessness" data-i18n="pm_uselessness"></h4><span class="emphasize-big" id="packageMetricsUselessness"></span></div></li><li><i class="fa fa-arrows-h" aria-hidden="true"></i><div class="summary-content"><h4 id="pm_distance" data-i18n="pm_distance"></h4><span class="emphasize-big" id="packageMetricsDistance"></span></div></li></ul><div class="right-content"><div class="chart-container" id="dependGraphBlock"><div class="chart-title" id="dp_graph_title" data-i18n="dp_graph_title"></div><div class="depend-toolbar"><div class="btn-group" id="dependGraphZoom"><button type="button" class="btn btn-default btn-xs" data-zoom="in">+</button><button type="button" class="btn btn-default btn-xs" data-zoom="out">-</button><button type="button" class="btn btn-default btn-xs" data-zoom="reset" id="dp_zoom_reset" data-i18n="dp_zoom_reset"></button></div><input type="text" class="form-control input-sm" id="dependGraphFilter"><span id="dp_export" data-i18n="dp_export"></span><div class="btn-group" id="dependGraphExport"><button type="button" class="btn btn-default btn-xs" data-format="dot">DOT</button><button type="button" class="btn btn-default btn-xs" data-format="mermaid">Mermaid</button><button type="button" class="btn btn-default btn-xs" data-format="json">JSON</button></div><span id="dependGraphCount"></span></div><div id="dependGraph">{{.DepGraph}}</div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="pm_chart_title" data-i18n="pm_chart_title"></div><div id="packageMetricsChart"></div></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container"><div class="chart-title" id="pm_table_title" data-i18n="pm_table_title"></div><table class="table table-striped" id="packageMetricsTable"><thead><tr><th id="pm_col_pkg" data-i18n="pm_col_pkg"></th><th id="pm_col_ca" data-i18n="pm_col_ca"></th><th id="pm_col_ce" data-i18n="pm_col_ce"></th><th id="pm_col_i" data-i18n="pm_col_i"></th><th id="pm_col_types" data-i18n="pm_col_types"></th><th id="pm_col_a" data-i18n="pm_col_a"></th><th id="pm_col_d" data-i18n="pm_col_d"></th><th id="pm_col_zone" data-i18n="pm_col_zone"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div><div class="chart-container" id="architectureBlock"><div class="chart-title" id="ar_title" data-i18n="ar_title"></div><table class="table table-striped" id="architectureTable"><thead><tr><th id="ar_col_from" data-i18n="ar_col_from"></th><th id="ar_col_to" data-i18n="ar_col_to"></th><th id="ar_col_location" data-i18n="ar_col_location"></th><th id="ar_col_rule" data-i18n="ar_col_rule"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="modulesPage"><ul class="summary-list"><li><i class="fa fa-cubes" aria-hidden="true"></i><div class="summary-content"><h4 id="md_modules" data-i18n="md_modules"></h4><span class="emphasize-big" id="modulesModules"></span></div></li><li><i class="fa fa-link" aria-hidden="true"></i><div class="summary-content"><h4 id="md_direct" data-i18n="md_direct"></h4><span class="emphasize-big" id="modulesDirect"></span></div></li><li><i class="fa fa-chain-broken" aria-hidden="true"></i><div class="summary-content"><h4 id="md_indirect" data-i18n="md_indirect"></h4><span class="emphasize-big" id="modulesIndirect"></span></div></li><li><i class="fa fa-exchange" aria-hidden="true"></i><div class="summary-content"><h4 id="md_replaced" data-i18n="md_replaced"></h4><span class="emphasize-big" id="modulesReplaced"></span></div></li><li><i class="fa fa-exclamation-triangle" aria-hidden="true"></i><div class="summary-content"><h4 id="md_unlicensed" data-i18n="md_unlicensed"></h4><span class="emphasize-big" id="modulesUnlicensed"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="md_title" data-i18n="md_title"></div><div class="depend-toolbar"><button type="button" class="btn btn-default btn-xs" id="md_export_csv" data-i18n="md_export_csv"></button></div><table class="table table-striped" id="modulesTable"><thead><tr><th id="md_col_path" data-i18n="md_col_path"></th><th id="md_col_version" data-i18n="md_col_version"></th><th id="md_col_direct" data-i18n="md_col_direct"></th><th id="md_col_replace" data-i18n="md_col_replace"></th><th id="md_col_license" data-i18n="md_col_license"></th><th id="md_col_packages" data-i18n="md_col_packages"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div><div class="content-container none" id="vulnPage"><ul class="summary-list"><li><i class="fa fa-bug" aria-hidden="true"></i><div class="summary-content"><h4 id="vl_findings" data-i18n="vl_findings"></h4><span class="emphasize-big" id="vulnFindings"></span></div></li><li><i class="fa fa-exclamation-triangle" aria-hidden="true"></i><div class="summary-content"><h4 id="vl_reachable" data-i18n="vl_reachable"></h4><span class="emphasize-big" id="vulnReachable"></span></div></li><li><i class="fa fa-cubes" aria-hidden="true"></i><div class="summary-content"><h4 id="vl_modules" data-i18n="vl_modules"></h4><span class="emphasize-big" id="vulnModules"></span></div></li><li><i class="fa fa-wrench" aria-hidden="true"></i><div class="summary-content"><h4 id="vl_fixable" data-i18n="vl_fixable"></h4><span class="emphasize-big" id="vulnFixable"></span></div></li></ul><div class="right-content"><div class="chart-container"><div class="chart-title" id="vl_title" data-i18n="vl_title"></div><table class="table table-striped" id="vulnTable"><thead><tr><th id="vl_col_id" data-i18n="vl_col_id"></th><th id="vl_col_module" data-i18n="vl_col_module"></th><th id="vl_col_version" data-i18n="vl_col_version"></th><th id="vl_col_fixed" data-i18n="vl_col_fixed"></th><th id="vl_col_status" data-i18n="vl_col_status"></th><th id="vl_col_path" data-i18n="vl_col_path"></th></tr></thead><tbody></tbody></table></div><div class="divider"><span class="line"></span><span class="icon"></span><span class="line"></span></div></div></div></div></div><script>
 
 var resData = {
 	"score": {{.Score}},    
@@ -23,7 +23,17 @@ var resData = {
 	codeStyle: {{.CodeStyle}},
 	goIssue: {{.CodeOptimization}},
 	countCode: {{.CodeCount}},
-	codeSmell: {{.CodeSmell}}    
+	codeSmell: {{.CodeSmell}},
+	benchmark: {{.Benchmark}},
+	mutation: {{.Mutation}},
+	untested: {{.Untested}},
+	funcLen: {{.FuncLen}},
+	maintainability: {{.Maintainability}},
+	packageMetrics: {{.PackageMetrics}},
+	architecture: {{.Architecture}},
+	dependGraph: {{.DepGraphExport}},
+	modules: {{.Modules}},
+	vuln: {{.Vuln}}
 }
 
 </script><script>
@@ -34,6 +44,14 @@ var ch_text= {
 	"code_opt": "代码优化",
 	"code_count": "代码统计",
 	"code_main": "可维护性",
+	"benchmark": "基准测试",
+	"mutation": "变异测试",
+	"untested": "未测试API",
+	"func_len": "函数长度",
+	"maintainability": "可维护性",
+	"depend": "包依赖",
+	"modules": "第三方模块",
+	"vuln": "已知漏洞",
 	"hp_score": "分数",
 	"hp_cover_pct": "覆盖率",
 	"hp_code_cover_pct": "代码覆盖率",
@@ -63,6 +81,15 @@ var ch_text= {
 	"ut_time_pct_title": "包耗时占比",
 	"ut_time_pct_tooltip": "耗时",
 	"ut_lack_test_list": "缺少单元测试的文件",
+	"ut_test_code_ratio": "测试代码比",
+	"ut_quality_title": "测试质量",
+	"ut_quality_col_pkg": "包",
+	"ut_quality_col_tests": "测试函数",
+	"ut_quality_col_subtests": "子测试",
+	"ut_quality_col_skips": "跳过",
+	"ut_quality_col_no_fail": "无失败路径的测试",
+	"ut_quality_col_no_output": "无输出的示例",
+	"ut_quality_col_ratio": "测试/代码行数",
 	"co_file_nums": "文件数",
 	"co_quality_class": "质量等级",
 	"cs_file_nums": "文件数",
@@ -79,6 +106,20 @@ var ch_text= {
 	"cc_code_legend": "代码行数详情",
 	"cc_comment_legend": "注释行数详情",
 	"cc_function_legend": "函数个数详情",
+	"cc_blank_lines": "空行数",
+	"cc_test_lines": "测试代码行数",
+	"cc_generated_lines": "生成代码行数",
+	"cc_languages": "语言种类",
+	"cc_lang_title": "按语言统计",
+	"cc_pkg_total_title": "按目录统计",
+	"cc_col_name": "名称",
+	"cc_col_files": "文件",
+	"cc_col_code": "代码",
+	"cc_col_test": "测试",
+	"cc_col_generated": "生成",
+	"cc_col_comment": "注释",
+	"cc_col_blank": "空行",
+	"cc_col_total": "合计",
 	"cm_avg_circle_comp": "平均圈复杂度",
 	"cm_circle_comp_high": "圈复杂度高的文件个数",
 	"cm_circle_comp_serious": "圈复杂度严重的文件个数",
@@ -89,6 +130,156 @@ var ch_text= {
 	"cm_circle_comp_list_col3": "圈复杂度",
 	"cm_circle_comp_rank_title": "包圈复杂度排名",
 	"cm_circle_comp_legend": "圈复杂度",
+	"cm_avg_cognitive": "平均认知复杂度",
+	"cm_cognitive_high": "认知复杂度高的函数个数",
+	"cm_cognitive_serious": "认知复杂度严重的函数个数",
+	"cm_circle_comp_list_col4": "认知复杂度",
+	"cm_circle_comp_list_col5": "最大深度",
+	"cm_order_cyclo": "按圈复杂度排序",
+	"cm_order_cognitive": "按认知复杂度排序",
+	"bm_benchmark_num": "基准测试个数",
+	"bm_regression_num": "性能退化",
+	"bm_improvement_num": "性能提升",
+	"bm_delta_title": "与基线报告相比的显著变化",
+	"bm_delta_col_pkg": "包",
+	"bm_delta_col_name": "基准测试",
+	"bm_delta_col_unit": "单位",
+	"bm_delta_col_old": "基线",
+	"bm_delta_col_new": "当前",
+	"bm_delta_col_change": "变化",
+	"bm_result_title": "基准测试结果",
+	"bm_result_col_pkg": "包",
+	"bm_result_col_name": "基准测试",
+	"bm_result_col_ns": "ns/op",
+	"bm_result_col_bytes": "B/op",
+	"bm_result_col_allocs": "allocs/op",
+	"mt_score": "变异得分",
+	"mt_killed": "被杀死的变异体",
+	"mt_survived": "存活的变异体",
+	"mt_pkg_title": "包变异得分",
+	"mt_score_legend": "变异得分",
+	"mt_survivor_title": "存活的变异体",
+	"ut_failed_list": "测试失败的包",
+	"ua_no_test_num": "没有测试的包",
+	"ua_ratio": "已测试的导出API",
+	"ua_tested": "已测试/导出",
+	"ua_pkg_title": "包的导出API测试情况",
+	"ua_col_pkg": "包",
+	"ua_col_tests": "测试文件",
+	"ua_col_tested": "已测试/导出",
+	"ua_col_ratio": "比例",
+	"ua_no_test_title": "没有测试的包",
+	"ua_untested_title": "测试从未调用的导出函数和方法",
+	"ua_col_func": "函数",
+	"ua_col_location": "位置",
+	"fl_funcs": "函数个数",
+	"fl_too_long": "过长的函数",
+	"fl_average": "平均长度",
+	"fl_histogram_title": "函数长度分布",
+	"fl_histogram_legend": "函数个数",
+	"fl_lines": "行数",
+	"fl_longest_title": "各包最长的函数",
+	"fl_col_pkg": "包",
+	"fl_col_func": "函数",
+	"fl_col_location": "位置",
+	"fl_col_lines": "行数",
+	"fl_external_title": "由汇编或运行时实现的函数",
+	"mi_index": "可维护性指数",
+	"mi_low": "难以维护的函数数",
+	"mi_moderate": "较难维护的函数数",
+	"mi_bugs": "估计缺陷数",
+	"mi_heatmap_title": "包和文件的可维护性热力图",
+	"mi_funcs_title": "最难维护的函数",
+	"mi_col_pkg": "包",
+	"mi_col_func": "函数",
+	"mi_col_location": "位置",
+	"mi_col_lines": "行数",
+	"mi_col_cyclo": "圈复杂度",
+	"mi_col_volume": "容量",
+	"mi_col_difficulty": "难度",
+	"mi_col_effort": "工作量",
+	"mi_col_bugs": "缺陷",
+	"mi_col_mi": "可维护性指数",
+	"pm_packages": "包数",
+	"pm_pain": "痛苦区的包",
+	"pm_uselessness": "无用区的包",
+	"pm_distance": "与主序列的平均距离",
+	"pm_chart_title": "抽象度与不稳定度",
+	"pm_instability": "不稳定度 (I)",
+	"pm_abstractness": "抽象度 (A)",
+	"pm_main_sequence": "主序列",
+	"pm_zone_none": "靠近主序列",
+	"pm_zone_pain": "痛苦区",
+	"pm_zone_uselessness": "无用区",
+	"pm_table_title": "包度量",
+	"pm_col_pkg": "包",
+	"pm_col_ca": "传入耦合 (Ca)",
+	"pm_col_ce": "传出耦合 (Ce)",
+	"pm_col_i": "不稳定度 (I)",
+	"pm_col_types": "接口/类型",
+	"pm_col_a": "抽象度 (A)",
+	"pm_col_d": "距离 (D)",
+	"pm_col_zone": "区域",
+	"dp_graph_title": "包依赖图，违反架构规则的依赖为红色",
+	"ar_title": "违反架构规则的依赖",
+	"ar_col_from": "包",
+	"ar_col_to": "依赖",
+	"ar_col_location": "位置",
+	"ar_col_rule": "规则",
+	"dp_zoom_reset": "原始大小",
+	"dp_export": "导出",
+	"dp_filter": "按包名过滤",
+	"dp_count": "$1 个包，$2 个依赖",
+	"md_modules": "模块",
+	"md_direct": "直接依赖",
+	"md_indirect": "间接依赖",
+	"md_replaced": "被替换",
+	"md_unlicensed": "许可证缺失或未知",
+	"md_title": "第三方模块",
+	"md_export_csv": "导出 CSV",
+	"md_col_path": "模块",
+	"md_col_version": "版本",
+	"md_col_direct": "依赖方式",
+	"md_col_replace": "替换为",
+	"md_col_license": "许可证",
+	"md_col_packages": "使用的包",
+	"md_direct_yes": "直接",
+	"md_direct_no": "间接",
+	"md_license_none": "无许可证文件",
+	"vl_findings": "漏洞",
+	"vl_reachable": "可达",
+	"vl_modules": "受影响模块",
+	"vl_fixable": "可修复",
+	"vl_title": "第三方模块的已知漏洞",
+	"vl_col_id": "编号",
+	"vl_col_module": "模块",
+	"vl_col_version": "版本",
+	"vl_col_fixed": "修复版本",
+	"vl_col_status": "状态",
+	"vl_col_path": "调用路径",
+	"vl_not_fixed": "未修复",
+	"vl_status_reachable": "被调用",
+	"vl_status_imported": "已导入，未调用",
+	"vl_status_unused": "未导入",
+	"co_copy_similarity": "相似度",
+	"co_copy_gapped": "含间隔",
+	"co_copy_corpus": "其他仓库",
+	"co_copy_duplicated": "重复行数",
+	"co_copy_lines": "总行数",
+	"co_copy_package": "包",
+	"co_align_struct": "结构体",
+	"co_align_size": "大小",
+	"co_align_optimal": "最优大小",
+	"co_align_padding": "填充字节",
+	"co_align_wasted": "浪费字节",
+	"co_align_order": "建议字段顺序",
+	"co_static_check": "检查项",
+	"co_static_doc": "说明",
+	"co_static_severity": "级别",
+	"co_static_issues": "问题数",
+	"co_static_error": "错误",
+	"co_static_warning": "警告",
+	"co_static_info": "提示",
 	"unit_piece": "个数：",
 	"unit_pct": "占比："
 
@@ -101,6 +292,14 @@ var en_text = {
 	"code_opt": "Best Prac",
 	"code_count": "Code Stat",
 	"code_main": "Code Main",
+	"benchmark": "Benchmark",
+	"mutation": "Mutation",
+	"untested": "Untested API",
+	"func_len": "Function Length",
+	"maintainability": "Maintainability",
+	"depend": "Dependencies",
+	"modules": "Modules",
+	"vuln": "Vulnerabilities",
 	"hp_score": "Score",
 	"hp_cover_pct": "Coverage",
 	"hp_code_cover_pct": "Code Coverage: ",
@@ -130,6 +329,15 @@ var en_text = {
 	"ut_time_pct_title": "Pkgs Test Time-Consuming Pie Chart",
 	"ut_time_pct_tooltip": "Time",
 	"ut_lack_test_list": "File Lists Lacking Unit Test ",
+	"ut_test_code_ratio": "Test To Code Ratio",
+	"ut_quality_title": "Test quality",
+	"ut_quality_col_pkg": "Package",
+	"ut_quality_col_tests": "Tests",
+	"ut_quality_col_subtests": "Subtests",
+	"ut_quality_col_skips": "Skips",
+	"ut_quality_col_no_fail": "Tests without failure path",
+	"ut_quality_col_no_output": "Examples without output",
+	"ut_quality_col_ratio": "Test/code lines",
 	"co_file_nums": "File Number",
 	"co_quality_class": "Quality",
 	"cs_file_nums": "File Number",
@@ -146,6 +354,20 @@ var en_text = {
 	"cc_code_legend": "Code Line Detail",
 	"cc_comment_legend": "Comment Line Detail",
 	"cc_function_legend": "Function Number Detail",
+	"cc_blank_lines": "Blank Lines",
+	"cc_test_lines": "Test Code Lines",
+	"cc_generated_lines": "Generated Code Lines",
+	"cc_languages": "Languages",
+	"cc_lang_title": "Lines by Language",
+	"cc_pkg_total_title": "Lines by Directory",
+	"cc_col_name": "Name",
+	"cc_col_files": "Files",
+	"cc_col_code": "Code",
+	"cc_col_test": "Test",
+	"cc_col_generated": "Generated",
+	"cc_col_comment": "Comment",
+	"cc_col_blank": "Blank",
+	"cc_col_total": "Total",
 	"cm_avg_circle_comp": "Average Circle Complexity",
 	"cm_circle_comp_high": "Number of Files with High Complexity",
 	"cm_circle_comp_serious": "Number of Files with Serious Complexity",
@@ -156,6 +378,156 @@ var en_text = {
 	"cm_circle_comp_list_col3": "Circle Complexity",
 	"cm_circle_comp_rank_title": "Pkgs Circle Complexity Ranking",
 	"cm_circle_comp_legend": "Circle Complexity",
+	"cm_avg_cognitive": "Average Cognitive Complexity",
+	"cm_cognitive_high": "Number of Functions with High Cognitive Complexity",
+	"cm_cognitive_serious": "Number of Functions with Serious Cognitive Complexity",
+	"cm_circle_comp_list_col4": "Cognitive Complexity",
+	"cm_circle_comp_list_col5": "Max Depth",
+	"cm_order_cyclo": "By Circle Complexity",
+	"cm_order_cognitive": "By Cognitive Complexity",
+	"bm_benchmark_num": "Benchmarks",
+	"bm_regression_num": "Regressions",
+	"bm_improvement_num": "Improvements",
+	"bm_delta_title": "Significant changes since the baseline report",
+	"bm_delta_col_pkg": "Package",
+	"bm_delta_col_name": "Benchmark",
+	"bm_delta_col_unit": "Unit",
+	"bm_delta_col_old": "Baseline",
+	"bm_delta_col_new": "Current",
+	"bm_delta_col_change": "Change",
+	"bm_result_title": "Benchmark results",
+	"bm_result_col_pkg": "Package",
+	"bm_result_col_name": "Benchmark",
+	"bm_result_col_ns": "ns/op",
+	"bm_result_col_bytes": "B/op",
+	"bm_result_col_allocs": "allocs/op",
+	"mt_score": "Mutation Score",
+	"mt_killed": "Killed Mutants",
+	"mt_survived": "Surviving Mutants",
+	"mt_pkg_title": "Mutation score of packages",
+	"mt_score_legend": "Mutation score",
+	"mt_survivor_title": "Surviving mutants",
+	"ut_failed_list": "Packages whose tests failed",
+	"ua_no_test_num": "Packages without tests",
+	"ua_ratio": "Tested exported API",
+	"ua_tested": "Tested / exported",
+	"ua_pkg_title": "Tested exported API of packages",
+	"ua_col_pkg": "Package",
+	"ua_col_tests": "Test files",
+	"ua_col_tested": "Tested / exported",
+	"ua_col_ratio": "Ratio",
+	"ua_no_test_title": "Packages without tests",
+	"ua_untested_title": "Exported functions and methods never called from a test",
+	"ua_col_func": "Function",
+	"ua_col_location": "Location",
+	"fl_funcs": "Functions",
+	"fl_too_long": "Too Long Functions",
+	"fl_average": "Average Length",
+	"fl_histogram_title": "Distribution of function lengths",
+	"fl_histogram_legend": "Functions",
+	"fl_lines": "Lines",
+	"fl_longest_title": "Longest functions of every package",
+	"fl_col_pkg": "Package",
+	"fl_col_func": "Function",
+	"fl_col_location": "Location",
+	"fl_col_lines": "Lines",
+	"fl_external_title": "Functions implemented in assembly or by the runtime",
+	"mi_index": "Maintainability Index",
+	"mi_low": "Hard to Maintain Functions",
+	"mi_moderate": "Moderately Maintainable Functions",
+	"mi_bugs": "Estimated Bugs",
+	"mi_heatmap_title": "Maintainability Heat-map of Packages and Files",
+	"mi_funcs_title": "Least Maintainable Functions",
+	"mi_col_pkg": "Package",
+	"mi_col_func": "Function",
+	"mi_col_location": "Location",
+	"mi_col_lines": "Lines",
+	"mi_col_cyclo": "Cyclo",
+	"mi_col_volume": "Volume",
+	"mi_col_difficulty": "Difficulty",
+	"mi_col_effort": "Effort",
+	"mi_col_bugs": "Bugs",
+	"mi_col_mi": "Index",
+	"pm_packages": "Packages",
+	"pm_pain": "Packages in the Zone of Pain",
+	"pm_uselessness": "Packages in the Zone of Uselessness",
+	"pm_distance": "Average Distance from the Main Sequence",
+	"pm_chart_title": "Abstractness and Instability",
+	"pm_instability": "Instability (I)",
+	"pm_abstractness": "Abstractness (A)",
+	"pm_main_sequence": "Main Sequence",
+	"pm_zone_none": "Near the Main Sequence",
+	"pm_zone_pain": "Zone of Pain",
+	"pm_zone_uselessness": "Zone of Uselessness",
+	"pm_table_title": "Package Metrics",
+	"pm_col_pkg": "Package",
+	"pm_col_ca": "Afferent (Ca)",
+	"pm_col_ce": "Efferent (Ce)",
+	"pm_col_i": "Instability (I)",
+	"pm_col_types": "Interfaces/Types",
+	"pm_col_a": "Abstractness (A)",
+	"pm_col_d": "Distance (D)",
+	"pm_col_zone": "Zone",
+	"dp_graph_title": "Dependency Graph, imports breaking the architecture rules in red",
+	"ar_title": "Imports Breaking the Architecture Rules",
+	"ar_col_from": "Package",
+	"ar_col_to": "Import",
+	"ar_col_location": "Location",
+	"ar_col_rule": "Rule",
+	"dp_zoom_reset": "Reset",
+	"dp_export": "Export",
+	"dp_filter": "Filter by package",
+	"dp_count": "$1 packages, $2 imports",
+	"md_modules": "Modules",
+	"md_direct": "Direct",
+	"md_indirect": "Indirect",
+	"md_replaced": "Replaced",
+	"md_unlicensed": "License Missing or Unknown",
+	"md_title": "Third-party Modules",
+	"md_export_csv": "Export CSV",
+	"md_col_path": "Module",
+	"md_col_version": "Version",
+	"md_col_direct": "Requirement",
+	"md_col_replace": "Replaced By",
+	"md_col_license": "License",
+	"md_col_packages": "Used By",
+	"md_direct_yes": "direct",
+	"md_direct_no": "indirect",
+	"md_license_none": "no license file",
+	"vl_findings": "Findings",
+	"vl_reachable": "Reachable",
+	"vl_modules": "Modules affected",
+	"vl_fixable": "Fixable",
+	"vl_title": "Known vulnerabilities of the third-party modules",
+	"vl_col_id": "ID",
+	"vl_col_module": "Module",
+	"vl_col_version": "Version",
+	"vl_col_fixed": "Fixed in",
+	"vl_col_status": "Status",
+	"vl_col_path": "Call path",
+	"vl_not_fixed": "not fixed",
+	"vl_status_reachable": "called",
+	"vl_status_imported": "imported, not called",
+	"vl_status_unused": "not imported",
+	"co_copy_similarity": "similarity",
+	"co_copy_gapped": "gapped",
+	"co_copy_corpus": "other repository",
+	"co_copy_duplicated": "duplicated lines",
+	"co_copy_lines": "lines",
+	"co_copy_package": "package",
+	"co_align_struct": "struct",
+	"co_align_size": "size",
+	"co_align_optimal": "optimal size",
+	"co_align_padding": "padding bytes",
+	"co_align_wasted": "wasted bytes",
+	"co_align_order": "suggested field order",
+	"co_static_check": "check",
+	"co_static_doc": "description",
+	"co_static_severity": "severity",
+	"co_static_issues": "issues",
+	"co_static_error": "error",
+	"co_static_warning": "warning",
+	"co_static_info": "info",
 	"unit_piece": "number: ",
 	"unit_pct": "percentage: "
 
@@ -1002,12 +1374,30 @@ resData.codeStyle = JSON.parse(resData.codeStyle);
 resData.goIssue = JSON.parse(resData.goIssue);
 resData.codeSmell = JSON.parse(resData.codeSmell);
 resData.countCode = JSON.parse(resData.countCode);
+resData.benchmark = JSON.parse(resData.benchmark);
+resData.mutation = JSON.parse(resData.mutation);
+resData.untested = JSON.parse(resData.untested);
+resData.funcLen = JSON.parse(resData.funcLen);
+resData.maintainability = JSON.parse(resData.maintainability);
+resData.packageMetrics = JSON.parse(resData.packageMetrics);
+resData.architecture = JSON.parse(resData.architecture);
+resData.dependGraph = JSON.parse(resData.dependGraph);
+resData.modules = JSON.parse(resData.modules);
+resData.vuln = JSON.parse(resData.vuln);
 
 initData(resData.gotest);
 initData(resData.codeStyle, 'detail');
 initData(resData.goIssue, 'detail');
 initData(resData.codeSmell);
 initData(resData.countCode);
+initData(resData.benchmark);
+initData(resData.mutation);
+initData(resData.untested);
+initData(resData.funcLen);
+initData(resData.maintainability);
+initData(resData.packageMetrics);
+initData(resData.architecture);
+initData(resData.modules);
 
 function initData(data, value){
 	Object.keys(data.content).forEach(function(d){
@@ -1034,6 +1424,30 @@ var subPageMap = {
 	},
 	navCodeStyle: {
 		id: 'codeStyle'
+	},
+	navBenchmark: {
+		id: 'benchmarkTest'
+	},
+	navMutation: {
+		id: 'mutationTest'
+	},
+	navUntested: {
+		id: 'untestedApi'
+	},
+	navFuncLen: {
+		id: 'funcLenPage'
+	},
+	navMaintainability: {
+		id: 'maintainabilityPage'
+	},
+	navDepend: {
+		id: 'dependPage'
+	},
+	navModules: {
+		id: 'modulesPage'
+	},
+	navVuln: {
+		id: 'vulnPage'
 	}
 }
 
@@ -1088,6 +1502,16 @@ var codeSmellPage = require('./subpage/codeSmell.js')(resData.codeSmell);
 require('./subpage/codeStyle.js')(resData.codeStyle);
 var summaryPage = require('./subpage/summary.js')(resData);
 var unitTestPage = require('./subpage/unitTest.js')(resData.gotest);
+require('./subpage/benchmark.js')(resData.benchmark);
+var mutationPage = require('./subpage/mutation.js')(resData.mutation);
+require('./subpage/untested.js')(resData.untested);
+var funcLenPage = require('./subpage/funcLen.js')(resData.funcLen);
+require('./subpage/maintainability.js')(resData.maintainability);
+var packageMetricsPage = require('./subpage/packageMetrics.js')(resData.packageMetrics);
+require('./subpage/architecture.js')(resData.architecture);
+var dependGraphPage = require('./subpage/dependGraph.js')(resData.dependGraph);
+var modulesPage = require('./subpage/modules.js')(resData.modules);
+var vulnPage = require('./subpage/vuln.js')(resData.vuln);
 
 /**
  * 监听语言切换按钮
@@ -1114,19 +1538,44 @@ function redrawAllCharts(){
 	unitTestPage.updateHighcharts();
 	codeCountPage.updateHighcharts();
 	codeSmellPage.updateHighcharts();
+	mutationPage.updateHighcharts();
+	funcLenPage.updateHighcharts();
+	packageMetricsPage.updateHighcharts();
+		dependGraphPage.updateHighcharts();
+		modulesPage.updateHighcharts();
+		vulnPage.updateHighcharts();
 }
 
 
 
 
 
-},{"./subpage/codeCount.js":2,"./subpage/codeOpt.js":3,"./subpage/codeSmell.js":4,"./subpage/codeStyle.js":5,"./subpage/summary.js":6,"./subpage/unitTest.js":7}],2:[function(require,module,exports){
+},{"./subpage/benchmark.js":8,"./subpage/codeCount.js":2,"./subpage/codeOpt.js":3,"./subpage/codeSmell.js":4,"./subpage/codeStyle.js":5,"./subpage/funcLen.js":11,"./subpage/maintainability.js":12,"./subpage/architecture.js":14,"./subpage/dependGraph.js":15,"./subpage/modules.js":16,"./subpage/vuln.js":17,"./subpage/packageMetrics.js":13,"./subpage/mutation.js":9,"./subpage/summary.js":6,"./subpage/unitTest.js":7,"./subpage/untested.js":10}],2:[function(require,module,exports){
 /*************************************code count************************************************/
 module.exports = function codeCount(codeCount){
 	$("#line_count").text(codeCount.summary.line_count);
 	$("#comment_count").text(codeCount.summary.comment_count);
 	$("#function_count").text(codeCount.summary.function_count);
 	$("#file_count").text(codeCount.summary.file_count);
+	$("#blank_count").text(codeCount.summary.blank_count || 0);
+	$("#test_count").text(codeCount.summary.test_count || 0);
+	$("#generated_count").text(codeCount.summary.generated_count || 0);
+	$("#language_count").text(codeCount.summary.language_count || 0);
+	/**
+	 * lines of every language and directory, the test and generated Go code
+	 * apart from the code
+	 */
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+	function renderTable(id, items){
+		var tbody = $(id + " tbody").empty();
+		items.forEach(function(d){
+			tbody.append("<tr><td>" + escapeHtml(d.name) + "</td><td>" + d.files + "</td><td>" + d.code + "</td><td>" + d.test + "</td><td>" + d.generated + "</td><td>" + d.comment + "</td><td>" + d.blank + "</td><td>" + d.total + "</td></tr>");
+		});
+	}
+	renderTable("#languageCountTable", codeCount.content.languages);
+	renderTable("#pkgCountTable", codeCount.content.packages);
 	/**
 	 * package coverage rate 
 	 */
@@ -1410,18 +1859,130 @@ module.exports = function codeOpt(goIssue){
 	keys.forEach(function(k){
 		indexHtml += "<li><a href='#" + k + "'>" + k + "</a></li>";
 		var content = "";
-		data[k].detail.forEach(function(d){
-			content += "<h5>" + d.rep + "</h5>" + d.content.map(function(cc){return "<a>" + cc + "<br/></a>"}).join("");
-		});
-		var issueNum = data[k].detail.reduce(function(sum, d){
-										return sum + d.content.length;
-									},0);
+		var issueNum = 0;
+		if (k == "copy_code") {
+			var groups = data[k].groups || [];
+			content = copyDuplicationHtml(data[k].duplication) + groups.map(copyGroupHtml).join("");
+			issueNum = groups.length;
+		} else if (k == "align_code") {
+			var structs = data[k].structs || [];
+			content = alignHtml(data[k].wasted, structs);
+			issueNum = structs.length;
+		} else if (k == "static_code") {
+			var checks = data[k].checks || [];
+			content = staticCheckHtml(checks, data[k].detail || []);
+			issueNum = checks.reduce(function(sum, c){
+											return sum + c.issues;
+										},0);
+		} else {
+			(data[k].detail || []).forEach(function(d){
+				content += "<h5>" + d.rep + "</h5>" + d.content.map(function(cc){return "<a>" + cc + "<br/></a>"}).join("");
+			});
+			issueNum = (data[k].detail || []).reduce(function(sum, d){
+											return sum + d.content.length;
+										},0);
+		}
 		contentHtml += "<section id=" + k + "><h4><span>" + k  + "</span><span class='emp-num'>" + issueNum + "</span><span class='description'>" + data[k].label + "</span></h4><div>" + content + "</div></div></div></section>"
 	});
 
 	//底部内容
 	$("#optSubNav").append(indexHtml);
 	$("#optContent").append(contentHtml);
+
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+	/**
+	 * the lines duplicated in the project and in its packages, the most
+	 * duplicated first
+	 */
+	function copyDuplicationHtml(d){
+		if (!d || !d.lines) {
+			return "";
+		}
+		var head = "<h5>" + $.i18n('co_copy_duplicated') + " " + d.duplicated + " / " + d.lines + "<span class='copy-meta'>" + d.percentage.toFixed(1) + "%</span></h5>";
+		var rows = (d.packages || []).filter(function(p){
+			return p.duplicated > 0;
+		}).sort(function(a, b){
+			return b.percentage - a.percentage;
+		}).map(function(p){
+			return "<tr><td>" + escapeHtml(p.package) + "</td><td class='dup-num'>" + p.duplicated + "</td><td class='dup-num'>" + p.lines + "</td><td class='dup-num'>" + p.percentage.toFixed(1) + "%</td></tr>";
+		}).join("");
+		if (rows == "") {
+			return head;
+		}
+		return head + "<table class='copy-dup'><thead><tr><th>" + $.i18n('co_copy_package') + "</th><th>" + $.i18n('co_copy_duplicated') + "</th><th>" + $.i18n('co_copy_lines') + "</th><th>%</th></tr></thead><tbody>" + rows + "</tbody></table>";
+	}
+	/**
+	 * the structs whose fields may be reordered, the most wasteful first,
+	 * with their sizes and the suggested order of their fields
+	 */
+	function alignHtml(wasted, structs){
+		if (structs.length == 0) {
+			return "";
+		}
+		var head = "<h5>" + $.i18n('co_align_wasted') + " " + wasted + "</h5>";
+		var rows = structs.map(function(s){
+			return "<tr><td>" + escapeHtml(s.name) + "<br/>" + escapeHtml(s.file + ":" + s.line) + "</td><td class='dup-num'>" + s.size + "</td><td class='dup-num'>" + s.optimal_size + "</td><td class='dup-num'>" + s.padding + "</td><td class='dup-num'>" + s.wasted + "</td><td>" + s.order.map(escapeHtml).join(", ") + "</td></tr>";
+		}).join("");
+		return head + "<table class='copy-dup'><thead><tr><th>" + $.i18n('co_align_struct') + "</th><th>" + $.i18n('co_align_size') + "</th><th>" + $.i18n('co_align_optimal') + "</th><th>" + $.i18n('co_align_padding') + "</th><th>" + $.i18n('co_align_wasted') + "</th><th>" + $.i18n('co_align_order') + "</th></tr></thead><tbody>" + rows + "</tbody></table>";
+	}
+	/**
+	 * the catalog of the checks of staticcheck and gosimple that were run,
+	 * those with problems first, then their problems grouped by check
+	 */
+	function staticCheckHtml(checks, detail){
+		if (checks.length == 0) {
+			return "";
+		}
+		var rows = checks.slice().sort(function(a, b){
+			return (b.issues > 0) - (a.issues > 0) || (a.id < b.id ? -1 : 1);
+		}).map(function(c){
+			return "<tr><td>" + escapeHtml(c.id) + "</td><td>" + escapeHtml(c.doc) + "</td><td>" + severityLabel(c.severity) + "</td><td class='dup-num'>" + c.issues + "</td></tr>";
+		}).join("");
+		var docs = {};
+		checks.forEach(function(c){
+			docs[c.id] = c.doc;
+		});
+		var groups = detail.map(function(d){
+			return "<h5>" + escapeHtml(d.rep) + "<span class='copy-meta'>" + escapeHtml(docs[d.rep] || "") + "</span></h5>" + d.content.map(function(cc){return "<a>" + escapeHtml(cc) + "<br/></a>"}).join("");
+		}).join("");
+		return "<table class='copy-dup'><thead><tr><th>" + $.i18n('co_static_check') + "</th><th>" + $.i18n('co_static_doc') + "</th><th>" + $.i18n('co_static_severity') + "</th><th>" + $.i18n('co_static_issues') + "</th></tr></thead><tbody>" + rows + "</tbody></table>" + groups;
+	}
+	/**
+	 * the translated severity of a check, as configured if it has none
+	 */
+	function severityLabel(severity){
+		var label = $.i18n('co_static_' + severity);
+		return label == 'co_static_' + severity ? escapeHtml(severity) : label;
+	}
+	/**
+	 * a clone group with its similarity and the side-by-side diffs of its
+	 * first fragment with the others
+	 */
+	function copyGroupHtml(g){
+		var diffs = g.diffs || [];
+		var head = "<h5>" + g.files.map(escapeHtml).join("<br/>");
+		var meta = [];
+		if (diffs.length > 0) {
+			meta.push($.i18n('co_copy_similarity') + " " + g.similarity + "%");
+		}
+		if (g.gapped) {
+			meta.push($.i18n('co_copy_gapped'));
+		}
+		if (g.corpus) {
+			meta.push($.i18n('co_copy_corpus'));
+		}
+		if (meta.length > 0) {
+			head += "<span class='copy-meta'>" + meta.join(", ") + "</span>";
+		}
+		return head + "</h5>" + diffs.map(function(diff){
+			var rows = diff.lines.map(function(l){
+				return "<tr class='diff-" + {"=": "equal", "~": "changed", "-": "deleted", "+": "added"}[l.op] + "'><td class='diff-num'>" + (l.left_line || "") + "</td><td>" + escapeHtml(l.left) + "</td><td class='diff-num'>" + (l.right_line || "") + "</td><td>" + escapeHtml(l.right) + "</td></tr>";
+			}).join("");
+			return "<table class='copy-diff'><thead><tr><th colspan='2'>" + escapeHtml(diff.left) + "</th><th colspan='2'>" + escapeHtml(diff.right) + "</th></tr></thead><tbody>" + rows + "</tbody></table>";
+		}).join("");
+	}
 }
 },{}],4:[function(require,module,exports){
 /********************************code smell***************************************/
@@ -1429,6 +1990,10 @@ module.exports = function(codeSmell){
 	$("#cyclo_avg").text(codeSmell.summary.cyclo_avg);
 	$("#cyclo_high").text(codeSmell.summary.cyclo_high);
 	$("#cyclo_grave").text(codeSmell.summary.cyclo_grave);
+	var thresholds = codeSmell.content.cognitive_thresholds;
+	$("#cognitive_avg").text(codeSmell.summary.cognitive_avg);
+	$("#cognitive_high").text(codeSmell.summary.cognitive_high).attr("title", ">= " + thresholds.high);
+	$("#cognitive_grave").text(codeSmell.summary.cognitive_grave).attr("title", ">= " + thresholds.grave);
 	/**
 	 * time cost of package unit test distribution 
 	 */
@@ -1565,10 +2130,30 @@ module.exports = function(codeSmell){
 	/**
 	 * list for files no having test code
 	 */
-	codeSmell.content.list.forEach(function(d,index){
-		var li = "<li><span>" + (index+1) + "</span><span title=" + d.path+ ">" + d.path + "</span><span>" + d.cyclo + "</span></li>";
-		$("#cycloList").append(li);
-	})
+	function escapeHtml(t){
+		return $("<div>").text(t).html();
+	}
+	/**
+	 * rank the functions by cyclo or by cognitive complexity
+	 */
+	function renderList(order){
+		$("#cycloList li:gt(0)").remove();
+		var list = codeSmell.content.list.slice();
+		if (order == 'cognitive') {
+			list.sort(function(a, b){return b.cognitive - a.cognitive || b.cyclo - a.cyclo});
+		}
+		list.forEach(function(d,index){
+			var cognitive = d.cognitive >= thresholds.high ? "<span class=\"metric text-danger\">" : "<span class=\"metric\">";
+			var li = "<li><span>" + (index+1) + "</span><span title=\"" + escapeHtml(d.path) + "\">" + escapeHtml(d.path) + "</span><span class=\"metric\">" + d.cyclo + "</span>" + cognitive + d.cognitive + "</span><span>" + d.depth + "</span></li>";
+			$("#cycloList").append(li);
+		});
+	}
+	renderList('cyclo');
+	$("#cycloListOrder button").click(function(){
+		$("#cycloListOrder button").removeClass("active");
+		$(this).addClass("active");
+		renderList($(this).data("order"));
+	});
 	/**
 	 * update Highcharts after changing language
 	 */
@@ -1600,6 +2185,22 @@ module.exports = function(codeStyle){
 	$("#styleFileNum").text(summary.file_num);
 	$("#styleQualityRank").text(summary.quality);
 
+	function escapeHtml(t){
+		return $("<div>").text(t).html();
+	}
+	/**
+	 * unified diff of the fix of a file, the deleted and added lines colored
+	 */
+	function diffHtml(diff){
+		if (!diff) {
+			return "";
+		}
+		var lines = diff.replace(/\n$/, "").split("\n").map(function(l){
+			var c = l.indexOf("@@") == 0 ? "fmt-hunk" : l.indexOf("---") == 0 || l.indexOf("+++") == 0 ? "fmt-file" : l[0] == "-" ? "fmt-del" : l[0] == "+" ? "fmt-add" : "";
+			return "<span class='" + c + "'>" + escapeHtml(l) + "</span>";
+		});
+		return "<pre class='fmt-diff'>" + lines.join("\n") + "</pre>";
+	}
 	//底部图表
 	key = Object.keys(data),
 	indexHtml = "",
@@ -1608,7 +2209,7 @@ module.exports = function(codeStyle){
 		indexHtml += "<li><a href='#" + k + "'>" + k + "</a></li>";
 		var content = "";
 		data[k].detail.forEach(function(d){
-			content += "<h5>" + d.rep + "</h5>" + d.content.map(function(cc){return "<a>" + cc + "<br/></a>"}).join("");
+			content += "<h5>" + escapeHtml(d.rep) + "</h5>" + d.content.map(function(cc){return "<a>" + escapeHtml(cc) + "<br/></a>"}).join("") + diffHtml(d.diff);
 		});
 		var issueNum = data[k].detail.reduce(function(sum, d){
 												return sum + d.content.length;
@@ -1987,6 +2588,7 @@ module.exports = function(gotest){
 	$("#coverPct").text(gotest.summary.code_cover);
 	$("#pkgPct").text(gotest.summary.pkg_cover);
 	$("#unitTestTime").text(gotest.summary.total_time);
+	$("#testCodeRatio").text(gotest.summary.test_code_ratio);
 	/**
 	 * package coverage rate 
 	 */
@@ -2177,6 +2779,21 @@ module.exports = function(gotest){
 		var li = "<li>" + d + "</li>";
 		$("#unitAbsentFiles").append(li);
 	})
+	gotest.content.failed.forEach(function(d){
+		var li = "<li>" + d + "</li>";
+		$("#unitFailedPkgs").append(li);
+	})
+
+	/**
+	 * test quality of every package
+	 */
+	gotest.content.quality.forEach(function(d){
+		var noFail = d.no_failure_path || [],
+			noOutput = d.examples_no_output || [],
+			ratio = d.code_lines > 0 ? (d.test_lines / d.code_lines).toFixed(2) : "-";
+		var tr = "<tr><td>" + d.pkg + "</td><td>" + d.test_funcs + "</td><td>" + d.sub_tests + "</td><td>" + d.skips + "</td><td title='" + noFail.join(" ") + "'>" + noFail.length + "</td><td title='" + noOutput.join(" ") + "'>" + noOutput.length + "</td><td>" + d.test_lines + "/" + d.code_lines + " (" + ratio + ")</td></tr>";
+		$("#unitTestQuality tbody").append(tr);
+	})
 	
 	function getTimeArr(){
 		if(gotest.content.pkg.length !== gotest.content.time.length){
@@ -2222,6 +2839,668 @@ module.exports = function(gotest){
 	}
 }
 
+},{}],8:[function(require,module,exports){
+/********************************benchmark***************************************/
+module.exports = function(benchmark){
+	$("#benchmark_num").text(benchmark.summary.benchmark_num);
+	$("#regression_num").text(benchmark.summary.regression_num);
+	$("#improvement_num").text(benchmark.summary.improvement_num);
+	// benchmarks are optional, hide the page when they were not run.
+	if(benchmark.summary.benchmark_num === 0){
+		$("#navBenchmark").addClass("none");
+	}
+
+	function format(value){
+		return Number(value.toFixed(2));
+	}
+
+	/**
+	 * significant changes, regressions in red
+	 */
+	benchmark.content.deltas.forEach(function(d){
+		var tr = "<tr" + (d.regression ? " class='danger'" : "") + "><td>" + d.pkg + "</td><td>" + d.name + "</td><td>" + d.unit + "</td><td>" + format(d.old) + "</td><td>" + format(d.new) + "</td><td>" + (d.change > 0 ? "+" : "") + format(d.change) + "% (p=" + d.p.toFixed(3) + ")</td></tr>";
+		$("#benchmarkDeltaTable tbody").append(tr);
+	});
+
+	/**
+	 * mean of all benchmark results
+	 */
+	benchmark.content.list.forEach(function(d){
+		var tr = "<tr><td>" + d.pkg + "</td><td>" + d.name + "</td><td>" + format(d.ns_per_op) + "</td><td>" + format(d.bytes_per_op) + "</td><td>" + format(d.allocs_per_op) + "</td></tr>";
+		$("#benchmarkTable tbody").append(tr);
+	});
+}
+},{}],9:[function(require,module,exports){
+/********************************mutation***************************************/
+module.exports = function(mutation){
+	$("#mutationScore").text(mutation.summary.score);
+	$("#mutationKilled").text(mutation.summary.killed);
+	$("#mutationSurvived").text(mutation.summary.survived);
+	// mutation testing is optional, hide the page when it was not run.
+	if(mutation.summary.killed + mutation.summary.survived === 0){
+		$("#navMutation").addClass("none");
+	}
+
+	/**
+	 * mutation score of packages
+	 */
+	var barChart = new Highcharts.Chart({
+		chart: {
+			renderTo: 'mutationScoreChart',
+			type: 'bar',
+			height: mutation.content.pkg.length * 30 + 120
+		},
+		title: {
+			text: ''
+		},
+		xAxis: {
+			categories: mutation.content.pkg,
+			title: {
+				text: null
+			},
+			tickLength: 0,
+			lineWidth: 0,
+			labels: {
+				style: {
+					color: "#596679",
+					fontSize: '12px'
+				}
+			}
+		},
+		yAxis: {
+			min: 0,
+			max: 100,
+			title: {
+				text: ''
+			},
+			gridLineWidth: 0,
+			labels: {
+				enabled: false
+			}
+		},
+		credits: {
+			enabled: false
+		},
+		legend: {
+			enabled: false
+		},
+		plotOptions: {
+			bar: {
+				dataLabels: {
+					enabled: true,
+					format: '{y}%'
+				}
+			}
+		},
+		series: [{
+			id: 'mutation-score',
+			name: $.i18n('mt_score_legend'),
+			color: 'rgba(0,169,239,1)',
+			data: mutation.content.score
+		}]
+	});
+
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+
+	/**
+	 * surviving mutants with their diffs
+	 */
+	mutation.content.survivors.forEach(function(d){
+		var html = "<h5>" + escapeHtml(d.pkg) + " - " + escapeHtml(d.operator) + "</h5><pre>" + escapeHtml(d.diff) + "</pre>";
+		$("#mutationSurvivors").append(html);
+	});
+
+	/**
+	 * update Highcharts after changing language
+	 */
+	function updateHighcharts(){
+		barChart.update({
+			series: [{
+				id: 'mutation-score',
+				name: $.i18n('mt_score_legend')
+			}]
+		});
+	}
+	return {
+		updateHighcharts: updateHighcharts
+	}
+}
+},{}],10:[function(require,module,exports){
+/********************************untested***************************************/
+module.exports = function(untested){
+	$("#untestedNoTestNum").text(untested.summary.no_test_num);
+	$("#untestedRatio").text(untested.summary.ratio);
+	$("#untestedTested").text(untested.summary.tested + "/" + untested.summary.exported);
+	if(untested.content.packages.length === 0){
+		$("#navUntested").addClass("none");
+	}
+
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+
+	/**
+	 * tested exported API of every package, packages without tests in red
+	 */
+	untested.content.packages.forEach(function(d){
+		var tests = d.has_tests ? "<i class='fa fa-check'></i>" : "<i class='fa fa-times'></i>",
+			ratio = d.exported > 0 ? d.ratio + "%" : "-";
+		var tr = "<tr" + (d.has_tests ? "" : " class='danger'") + "><td>" + escapeHtml(d.pkg) + "</td><td>" + tests + "</td><td>" + d.tested + "/" + d.exported + "</td><td>" + ratio + "</td></tr>";
+		$("#untestedPackages tbody").append(tr);
+	});
+
+	/**
+	 * exported functions and methods that no test calls
+	 */
+	untested.content.packages.forEach(function(d){
+		(d.untested || []).forEach(function(f){
+			var tr = "<tr><td>" + escapeHtml(d.pkg + "." + f.name) + "</td><td>" + escapeHtml(f.file + ":" + f.line) + "</td></tr>";
+			$("#untestedFuncs tbody").append(tr);
+		});
+	});
+}
+},{}],11:[function(require,module,exports){
+/********************************function length***************************************/
+module.exports = function(funcLen){
+	$("#funcLenFuncs").text(funcLen.summary.funcs);
+	$("#funcLenTooLong").text(funcLen.summary.too_long);
+	$("#funcLenAverage").text(funcLen.summary.average);
+	if(funcLen.summary.funcs === 0){
+		$("#navFuncLen").addClass("none");
+	}
+
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+
+	/**
+	 * histogram of function lengths, bar i holds the functions of
+	 * i*bucket_size+1 to (i+1)*bucket_size lines
+	 */
+	var size = funcLen.summary.bucket_size;
+	var categories = funcLen.content.histogram.map(function(d, i){
+		return (i * size + 1) + "-" + ((i + 1) * size);
+	});
+	var histogram = new Highcharts.Chart({
+		chart: {
+			renderTo: 'funcLenHistogram',
+			type: 'column'
+		},
+		title: {
+			text: ''
+		},
+		xAxis: {
+			categories: categories,
+			title: {
+				text: $.i18n('fl_lines')
+			}
+		},
+		yAxis: {
+			min: 0,
+			allowDecimals: false,
+			title: {
+				text: ''
+			}
+		},
+		credits: {
+			enabled: false
+		},
+		legend: {
+			enabled: false
+		},
+		series: [{
+			id: 'func-len',
+			name: $.i18n('fl_histogram_legend'),
+			color: 'rgba(0,169,239,1)',
+			data: funcLen.content.histogram
+		}]
+	});
+
+	/**
+	 * longest functions, ranked per package
+	 */
+	funcLen.content.packages.forEach(function(p){
+		p.funcs.forEach(function(d, i){
+			var pkg = i === 0 ? "<td rowspan='" + p.funcs.length + "'>" + escapeHtml(p.pkg) + "</td>" : "";
+			var tr = "<tr" + (d.size > p.max_lines ? " class='danger'" : "") + ">" + pkg + "<td>" + escapeHtml(d.name) + "</td><td>" + escapeHtml(d.file + ":" + d.line) + "</td><td>" + d.size + "</td></tr>";
+			$("#funcLenLongest tbody").append(tr);
+		});
+	});
+
+	/**
+	 * functions without body, implemented in assembly or by the runtime
+	 */
+	funcLen.content.external.forEach(function(p){
+		p.funcs.forEach(function(d){
+			$("#funcLenExternal").append("<li>" + escapeHtml(p.pkg + "." + d.name + " (" + d.file + ":" + d.line + ")") + "</li>");
+		});
+	});
+	if(funcLen.content.external.length === 0){
+		$("#funcLenExternalBlock").addClass("none");
+	}
+
+	/**
+	 * update Highcharts after changing language
+	 */
+	function updateHighcharts(){
+		histogram.update({
+			xAxis: {
+				title: {
+					text: $.i18n('fl_lines')
+				}
+			},
+			series: [{
+				id: 'func-len',
+				name: $.i18n('fl_histogram_legend')
+			}]
+		});
+	}
+	return {
+		updateHighcharts: updateHighcharts
+	}
+}
+},{}],12:[function(require,module,exports){
+/********************************maintainability***************************************/
+module.exports = function(maintainability){
+	var content = maintainability.content;
+	$("#maintainabilityIndex").text(maintainability.summary.mi.toFixed(1));
+	$("#maintainabilityLow").text(maintainability.summary.low);
+	$("#maintainabilityModerate").text(maintainability.summary.moderate);
+	$("#maintainabilityBugs").text(maintainability.summary.bugs.toFixed(2));
+	if(maintainability.summary.funcs === 0){
+		$("#navMaintainability").addClass("none");
+	}
+
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+	/**
+	 * red for an index of 0, green from 83 on
+	 */
+	function color(mi){
+		return "hsl(" + Math.round(Math.min(mi, 83) * 1.45) + ",70%,50%)";
+	}
+	function cell(title, mi){
+		return "<span class='mi-cell' style='background:" + color(mi) + "' title='" + escapeHtml(title + ": " + mi.toFixed(1)).replace(/'/g, "&#39;") + "'></span>";
+	}
+
+	/**
+	 * heat-map, a row per package, the least maintainable first, and a
+	 * cell per file
+	 */
+	content.packages.forEach(function(p){
+		var files = p.files.map(function(f){
+			return cell(f.file + " (" + f.funcs + ")", f.mi);
+		}).join("");
+		var row = "<div class='mi-row'><span class='mi-pkg'>" + cell(p.pkg, p.mi) + " " + escapeHtml(p.pkg) + " " + p.mi.toFixed(1) + "</span><span class='mi-cells'>" + files + "</span></div>";
+		$("#maintainabilityHeatmap").append(row);
+	});
+
+	/**
+	 * least maintainable functions of the project
+	 */
+	content.funcs.forEach(function(d){
+		var level = d.mi < content.low ? " class='danger'" : d.mi < content.moderate ? " class='warning'" : "";
+		var tr = "<tr" + level + "><td>" + escapeHtml(d.pkg) + "</td><td>" + escapeHtml(d.name) + "</td><td>" + escapeHtml(d.file + ":" + d.line) + "</td><td>" + d.lines + "</td><td>" + d.cyclo + "</td><td>" + d.volume.toFixed(1) + "</td><td>" + d.difficulty.toFixed(1) + "</td><td>" + Math.round(d.effort) + "</td><td>" + d.bugs.toFixed(2) + "</td><td>" + d.mi.toFixed(1) + "</td></tr>";
+		$("#maintainabilityFuncs tbody").append(tr);
+	});
+}
+},{}],13:[function(require,module,exports){
+/********************************package metrics***************************************/
+module.exports = function(packageMetrics){
+	var packages = packageMetrics.content.packages;
+	$("#packageMetricsPackages").text(packageMetrics.summary.packages);
+	$("#packageMetricsPain").text(packageMetrics.summary.pain);
+	$("#packageMetricsUselessness").text(packageMetrics.summary.uselessness);
+	$("#packageMetricsDistance").text(packageMetrics.summary.distance.toFixed(2));
+	if(packageMetrics.summary.packages === 0){
+		$("#navDepend").addClass("none");
+	}
+
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+	function points(zone){
+		return packages.filter(function(d){
+			return d.zone === zone;
+		}).map(function(d){
+			return {x: d.instability, y: d.abstractness, name: d.pkg};
+		});
+	}
+
+	/**
+	 * abstractness against instability, the packages far from the main
+	 * sequence are in the zone of pain, bottom left, or of uselessness, top
+	 * right
+	 */
+	var scatterChart = new Highcharts.Chart({
+		chart: {
+			renderTo: 'packageMetricsChart',
+			type: 'scatter',
+			height: 500
+		},
+		title: {
+			text: ''
+		},
+		xAxis: {
+			min: 0,
+			max: 1,
+			title: {
+				text: $.i18n('pm_instability')
+			}
+		},
+		yAxis: {
+			min: 0,
+			max: 1,
+			title: {
+				text: $.i18n('pm_abstractness')
+			}
+		},
+		credits: {
+			enabled: false
+		},
+		tooltip: {
+			formatter: function(){
+				if(this.series.type === 'line'){
+					return false;
+				}
+				return escapeHtml(this.point.name) + '<br>I: ' + this.x.toFixed(2) + ', A: ' + this.y.toFixed(2);
+			}
+		},
+		series: [{
+			id: 'main-sequence',
+			type: 'line',
+			name: $.i18n('pm_main_sequence'),
+			color: 'rgba(150,150,150,1)',
+			dashStyle: 'Dash',
+			marker: {
+				enabled: false
+			},
+			enableMouseTracking: false,
+			data: [[0, 1], [1, 0]]
+		}, {
+			id: 'zone-none',
+			name: $.i18n('pm_zone_none'),
+			color: 'rgba(0,169,239,1)',
+			data: points('')
+		}, {
+			id: 'zone-pain',
+			name: $.i18n('pm_zone_pain'),
+			color: 'rgba(223,83,83,1)',
+			data: points('pain')
+		}, {
+			id: 'zone-uselessness',
+			name: $.i18n('pm_zone_uselessness'),
+			color: 'rgba(240,173,78,1)',
+			data: points('uselessness')
+		}]
+	});
+
+	/**
+	 * metrics of every package, the farthest from the main sequence first
+	 */
+	function renderTable(){
+		$("#packageMetricsTable tbody").empty();
+		packages.forEach(function(d){
+			var level = d.zone === 'pain' ? " class='danger'" : d.zone === 'uselessness' ? " class='warning'" : "";
+			var zone = $.i18n('pm_zone_' + (d.zone || 'none'));
+			var tr = "<tr" + level + "><td>" + escapeHtml(d.pkg) + "</td><td>" + d.afferent + "</td><td>" + d.efferent + "</td><td>" + d.instability.toFixed(2) + "</td><td>" + d.interfaces + "/" + d.types + "</td><td>" + d.abstractness.toFixed(2) + "</td><td>" + d.distance.toFixed(2) + "</td><td>" + zone + "</td></tr>";
+			$("#packageMetricsTable tbody").append(tr);
+		});
+	}
+	renderTable();
+
+	/**
+	 * update Highcharts after changing language
+	 */
+	function updateHighcharts(){
+		scatterChart.update({
+			xAxis: {
+				title: {
+					text: $.i18n('pm_instability')
+				}
+			},
+			yAxis: {
+				title: {
+					text: $.i18n('pm_abstractness')
+				}
+			},
+			series: [{
+				id: 'main-sequence',
+				name: $.i18n('pm_main_sequence')
+			}, {
+				id: 'zone-none',
+				name: $.i18n('pm_zone_none')
+			}, {
+				id: 'zone-pain',
+				name: $.i18n('pm_zone_pain')
+			}, {
+				id: 'zone-uselessness',
+				name: $.i18n('pm_zone_uselessness')
+			}]
+		});
+		renderTable();
+	}
+	return {
+		updateHighcharts: updateHighcharts
+	}
+}
+},{}],14:[function(require,module,exports){
+/********************************architecture***************************************/
+module.exports = function(architecture){
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+	if(architecture.summary.violations === 0){
+		$("#architectureBlock").addClass("none");
+		return;
+	}
+	$("#navDepend").removeClass("none");
+
+	/**
+	 * imports breaking the architecture rules, by importing package
+	 */
+	architecture.content.violations.forEach(function(d){
+		var tr = "<tr class='danger'><td>" + escapeHtml(d.from) + "</td><td>" + escapeHtml(d.to) + "</td><td>" + escapeHtml(d.file + ":" + d.line) + "</td><td>" + escapeHtml(d.rule) + "</td></tr>";
+		$("#architectureTable tbody").append(tr);
+	});
+}
+},{}],15:[function(require,module,exports){
+/********************************dependGraph***************************************/
+module.exports = function(dependGraph){
+	var svg = $("#dependGraph svg");
+	if(svg.length === 0){
+		$("#dependGraphBlock").addClass("none");
+		return {
+			updateHighcharts: function(){}
+		};
+	}
+	$("#navDepend").removeClass("none");
+
+	/**
+	 * zoom, by the buttons or by the mouse wheel with the ctrl key down
+	 */
+	var width = parseFloat(svg.attr("width")),
+		height = parseFloat(svg.attr("height")),
+		scale = 1;
+	function zoom(s){
+		scale = Math.min(Math.max(s, 0.1), 8);
+		svg.attr("width", width * scale).attr("height", height * scale);
+	}
+	$("#dependGraphZoom button").on("click", function(){
+		var action = $(this).data("zoom");
+		zoom(action === "in" ? scale * 1.25 : action === "out" ? scale / 1.25 : 1);
+	});
+	$("#dependGraph").on("wheel", function(e){
+		if(!e.ctrlKey){
+			return;
+		}
+		e.preventDefault();
+		zoom(e.originalEvent.deltaY < 0 ? scale * 1.1 : scale / 1.1);
+	});
+
+	/**
+	 * filter, the packages whose name contains the text and their imports
+	 * stay visible
+	 */
+	$("#dependGraphFilter").on("input", function(){
+		var text = $.trim($(this).val()),
+			visible = {};
+		svg.find(".link").each(function(){
+			var from = $(this).attr("data-from"),
+				to = $(this).attr("data-to"),
+				match = text === "" || from.indexOf(text) !== -1 || to.indexOf(text) !== -1;
+			if(match){
+				visible[from] = true;
+				visible[to] = true;
+			}
+			$(this).css("opacity", match ? 1 : 0.1);
+		});
+		svg.find(".node").each(function(){
+			var name = $(this).attr("data-name"),
+				match = text === "" || visible[name] || name.indexOf(text) !== -1;
+			$(this).css("opacity", match ? 1 : 0.15);
+		});
+	});
+
+	/**
+	 * export to dot, Mermaid and json
+	 */
+	var formats = {
+		dot: { type: "text/vnd.graphviz", file: "depend.gv" },
+		mermaid: { type: "text/plain", file: "depend.mmd" },
+		json: { type: "application/json", file: "depend.json" }
+	};
+	$("#dependGraphExport button").on("click", function(){
+		var format = formats[$(this).data("format")],
+			blob = new Blob([dependGraph.content[$(this).data("format")]], { type: format.type }),
+			link = document.createElement("a");
+		link.href = URL.createObjectURL(blob);
+		link.download = format.file;
+		document.body.appendChild(link);
+		link.click();
+		document.body.removeChild(link);
+		URL.revokeObjectURL(link.href);
+	});
+
+	return {
+		updateHighcharts: function(){
+			$("#dependGraphFilter").attr("placeholder", $.i18n("dp_filter"));
+			$("#dependGraphCount").text($.i18n("dp_count", dependGraph.summary.nodes, dependGraph.summary.links));
+		}
+	};
+}
+},{}],16:[function(require,module,exports){
+/********************************modules***************************************/
+module.exports = function(modules){
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+	$("#modulesModules").text(modules.summary.modules);
+	$("#modulesDirect").text(modules.summary.direct);
+	$("#modulesIndirect").text(modules.summary.indirect);
+	$("#modulesReplaced").text(modules.summary.replaced);
+	$("#modulesUnlicensed").text(modules.summary.unlicensed);
+	if(modules.summary.modules === 0){
+		$("#navModules").addClass("none");
+	}
+
+	function replacement(d){
+		if(!d.replace){
+			return "";
+		}
+		return d.replace.version ? d.replace.path + " " + d.replace.version : d.replace.path;
+	}
+
+	/**
+	 * the modules, the direct ones first, in the language of the report
+	 */
+	function render(){
+		var tbody = $("#modulesTable tbody").empty();
+		modules.content.modules.forEach(function(d){
+			var license = d.license || $.i18n("md_license_none"),
+				cls = !d.license || d.license === "Unknown" ? " class='warning'" : "";
+			var tr = "<tr" + cls + "><td>" + escapeHtml(d.path) + "</td><td>" + escapeHtml(d.version) + "</td><td>" + $.i18n(d.direct ? "md_direct_yes" : "md_direct_no") + "</td><td>" + escapeHtml(replacement(d)) + "</td><td title='" + escapeHtml(d.license_file) + "'>" + escapeHtml(license) + "</td><td title='" + escapeHtml(d.packages.join("\n")) + "'>" + d.packages.length + "</td></tr>";
+			tbody.append(tr);
+		});
+	}
+
+	/**
+	 * export to csv, one module per line, the packages separated by spaces
+	 */
+	function csvField(value){
+		value = String(value);
+		return /[",\n]/.test(value) ? '"' + value.replace(/"/g, '""') + '"' : value;
+	}
+	$("#md_export_csv").on("click", function(){
+		var lines = [["module", "version", "direct", "replace", "license", "license_file", "hash", "go_mod_hash", "packages"].join(",")];
+		modules.content.modules.forEach(function(d){
+			lines.push([d.path, d.version, d.direct, replacement(d), d.license, d.license_file, d.hash, d.go_mod_hash, d.packages.join(" ")].map(csvField).join(","));
+		});
+		var blob = new Blob([lines.join("\n") + "\n"], { type: "text/csv" }),
+			link = document.createElement("a");
+		link.href = URL.createObjectURL(blob);
+		link.download = "modules.csv";
+		document.body.appendChild(link);
+		link.click();
+		document.body.removeChild(link);
+		URL.revokeObjectURL(link.href);
+	});
+
+	return {
+		updateHighcharts: function(){
+			render();
+		}
+	};
+}
+},{}],17:[function(require,module,exports){
+/********************************vuln******************************************/
+module.exports = function(vuln){
+	function escapeHtml(text){
+		return $("<div>").text(text).html();
+	}
+	$("#vulnFindings").text(vuln.summary.findings);
+	$("#vulnReachable").text(vuln.summary.reachable);
+	$("#vulnModules").text(vuln.summary.modules);
+	$("#vulnFixable").text(vuln.summary.fixable);
+	if(vuln.summary.findings === 0){
+		$("#navVuln").addClass("none");
+	}
+
+	function status(d){
+		if(d.reachable){
+			return "vl_status_reachable";
+		}
+		return d.imported ? "vl_status_imported" : "vl_status_unused";
+	}
+
+	/**
+	 * the findings, the reachable ones first, with the call path from the
+	 * project code down to the vulnerable symbol
+	 */
+	function render(){
+		var tbody = $("#vulnTable tbody").empty();
+		vuln.content.findings.forEach(function(d){
+			var id = escapeHtml(d.id);
+			if(d.url){
+				id = "<a href='" + escapeHtml(d.url) + "' target='_blank'>" + id + "</a>";
+			}
+			var path = d.call_path.map(function(call){
+				return "<span title='" + escapeHtml(call.file + ":" + call.line) + "'>" + escapeHtml(call.func) + "</span>";
+			}).join(" &rarr; ");
+			var tr = "<tr" + (d.reachable ? " class='danger'" : "") + "><td title='" + escapeHtml(d.summary) + "'>" + id + "</td><td>" + escapeHtml(d.module) + "</td><td>" + escapeHtml(d.version) + "</td><td>" + (d.fixed ? escapeHtml(d.fixed) : $.i18n("vl_not_fixed")) + "</td><td>" + $.i18n(status(d)) + "</td><td>" + path + "</td></tr>";
+			tbody.append(tr);
+		});
+	}
+
+	return {
+		updateHighcharts: function(){
+			render();
+		}
+	};
+}
 },{}]},{},[1]);
 
 </script></body></html>
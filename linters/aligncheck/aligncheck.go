@@ -14,106 +14,365 @@
 package aligncheck
 
 import (
-	"fmt"
+	"bytes"
+	"go/ast"
 	"go/build"
-	"log"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"os"
 	"sort"
-	"unsafe"
+	"strings"
 
-	"go/types"
 	"golang.org/x/tools/go/loader"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/staticcheck/gcsizes"
 )
 
-type LinterAligncheck struct {
+// Options configures AlignCheck. Arch is the GOARCH whose sizes and
+// alignments are used, the default one if empty. Tests adds the test files
+// and Tags are the build tags.
+type Options struct {
+	Arch  string
+	Tests bool
+	Tags  []string
 }
 
-func (l *LinterAligncheck) ComputeMetric(projectPath string) []string {
-	importPaths := []string{projectPath}
-	if len(importPaths) == 0 {
-		importPaths = []string{"."}
-	}
+// Struct is the layout of the struct type Name of the package Package,
+// declared at File:Line:Column. Size is its size in bytes and Padding the
+// bytes of it between and after its fields, declared in the order of Fields.
+// With its fields in the order of Order, its size is OptimalSize and Wasted
+// bytes are saved. Fixable tells whether Fix can reorder its declaration,
+// which it cannot if a comment is not attached to a field or if a composite
+// literal of the checked packages lists its fields without keys.
+type Struct struct {
+	Package     string   `json:"package"`
+	Name        string   `json:"name"`
+	File        string   `json:"file"`
+	Line        int      `json:"line"`
+	Column      int      `json:"column"`
+	Size        int64    `json:"size"`
+	OptimalSize int64    `json:"optimal_size"`
+	Padding     int64    `json:"padding"`
+	Wasted      int64    `json:"wasted"`
+	Fields      []string `json:"fields"`
+	Order       []string `json:"order"`
+	Fixable     bool     `json:"fixable"`
 
+	// pos and end are the offsets of the struct type in File and decl its
+	// declaration with the fields reordered.
+	pos, end int
+	decl     string
+}
+
+// AlignCheck returns the struct types of the packages importPaths whose
+// fields may be ordered so that they take less memory, the most wasteful
+// first. The fields are ordered by decreasing alignment, the fields of size
+// zero first so that none ends the struct. The generic types, whose layout
+// depends on their type arguments, the structs with blank fields, which are
+// laid out on purpose, the generated files and the packages that do not type
+// check are skipped.
+func AlignCheck(importPaths []string, opts Options) ([]Struct, error) {
 	ctx := build.Default
-	loadcfg := loader.Config{
-		Build: &ctx,
+	ctx.BuildTags = append(ctx.BuildTags, opts.Tags...)
+	conf := loader.Config{Build: &ctx, ParserMode: parser.ParseComments}
+	conf.AllowErrors = true
+	conf.TypeChecker.Error = func(error) {}
+	for _, path := range importPaths {
+		if opts.Tests {
+			conf.ImportWithTests(path)
+		} else {
+			conf.Import(path)
+		}
 	}
-	rest, err := loadcfg.FromArgs(importPaths, false)
+	lprog, err := conf.Load()
 	if err != nil {
-		log.Fatalf("could not parse arguments: %s", err)
+		return nil, err
 	}
-	if len(rest) > 0 {
-		log.Fatalf("unhandled extra arguments: %v", rest)
+	return Structs(lprog, opts)
+}
+
+// Structs is AlignCheck on the initial packages of lprog, which must have
+// been loaded with their comments. The build tags of opts are not used.
+func Structs(lprog *loader.Program, opts Options) ([]Struct, error) {
+	sizes := gcsizes.ForArch(opts.Arch)
+	unkeyed := unkeyedTypes(lprog)
+
+	structs := make([]Struct, 0)
+	for _, pkgInfo := range lprog.InitialPackages() {
+		if len(pkgInfo.Errors) > 0 {
+			continue
+		}
+		for _, file := range pkgInfo.Files {
+			filename := lprog.Fset.File(file.Pos()).Name()
+			if lint.IsGenerated(file) || (!opts.Tests && strings.HasSuffix(filename, "_test.go")) {
+				continue
+			}
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			ast.Inspect(file, func(node ast.Node) bool {
+				spec, ok := node.(*ast.TypeSpec)
+				if !ok || spec.TypeParams != nil {
+					return true
+				}
+				decl, ok := spec.Type.(*ast.StructType)
+				if !ok {
+					return true
+				}
+				obj, ok := pkgInfo.Defs[spec.Name].(*types.TypeName)
+				if !ok {
+					return true
+				}
+				strukt, ok := obj.Type().Underlying().(*types.Struct)
+				if !ok {
+					return true
+				}
+				if s, ok := layout(strukt, sizes); ok {
+					pos := lprog.Fset.Position(spec.Name.Pos())
+					s.Package = pkgInfo.Pkg.Path()
+					s.Name = obj.Name()
+					s.File, s.Line, s.Column = pos.Filename, pos.Line, pos.Column
+					s.pos = lprog.Fset.File(decl.Pos()).Offset(decl.Pos())
+					s.end = lprog.Fset.File(decl.End()).Offset(decl.End())
+					if !unkeyed[obj] {
+						s.decl = reorder(lprog.Fset, src, file, decl, s.order)
+					}
+					s.Fixable = s.decl != ""
+					structs = append(structs, s.Struct)
+				}
+				return true
+			})
+		}
 	}
 
-	program, err := loadcfg.Load()
-	if err != nil {
-		log.Fatalf("could not type check: %s", err)
+	sort.SliceStable(structs, func(i, j int) bool {
+		if structs[i].Wasted != structs[j].Wasted {
+			return structs[i].Wasted > structs[j].Wasted
+		}
+		if structs[i].File != structs[j].File {
+			return structs[i].File < structs[j].File
+		}
+		return structs[i].Line < structs[j].Line
+	})
+	return structs, nil
+}
+
+// Edit returns the offsets in File of the struct type of s and its
+// declaration with the fields in optimal order, empty if s is not Fixable.
+func (s Struct) Edit() (pos, end int, decl string) {
+	return s.pos, s.end, s.decl
+}
+
+// unkeyedTypes returns the named types of the composite literals of the
+// initial packages of lprog that list values without keys, which depend on
+// the order of the fields.
+func unkeyedTypes(lprog *loader.Program) map[*types.TypeName]bool {
+	unkeyed := make(map[*types.TypeName]bool)
+	for _, pkgInfo := range lprog.InitialPackages() {
+		for _, file := range pkgInfo.Files {
+			ast.Inspect(file, func(node ast.Node) bool {
+				lit, ok := node.(*ast.CompositeLit)
+				if !ok || len(lit.Elts) == 0 {
+					return true
+				}
+				if _, ok := lit.Elts[0].(*ast.KeyValueExpr); ok {
+					return true
+				}
+				typ := pkgInfo.TypeOf(lit)
+				if ptr, ok := typ.(*types.Pointer); ok {
+					typ = ptr.Elem()
+				}
+				if named, ok := typ.(*types.Named); ok {
+					unkeyed[named.Obj()] = true
+				}
+				return true
+			})
+		}
 	}
+	return unkeyed
+}
 
-	var lines []string
+// structLayout is a Struct with the indexes of its fields in optimal order.
+type structLayout struct {
+	Struct
+	order []int
+}
 
-	for _, pkgInfo := range program.InitialPackages() {
-		for _, obj := range pkgInfo.Defs {
-			if obj == nil {
-				continue
-			}
+// layout returns the layout of strukt, false if its fields are ordered
+// optimally or if it has a blank field.
+func layout(strukt *types.Struct, sizes *gcsizes.Sizes) (structLayout, bool) {
+	var s structLayout
+	n := strukt.NumFields()
+	if n < 2 {
+		return s, false
+	}
+	fields := make([]*types.Var, n)
+	var used int64
+	for i := range fields {
+		fields[i] = strukt.Field(i)
+		if fields[i].Name() == "_" {
+			return s, false
+		}
+		used += sizes.Sizeof(fields[i].Type())
+	}
 
-			if _, ok := obj.(*types.TypeName); !ok {
-				continue
-			}
+	s.order = optimalOrder(fields, sizes)
+	optimal := make([]*types.Var, n)
+	for i, index := range s.order {
+		optimal[i] = fields[index]
+	}
+	s.Size = sizes.Sizeof(strukt)
+	s.OptimalSize = sizes.Sizeof(types.NewStruct(optimal, nil))
+	if s.OptimalSize >= s.Size {
+		return s, false
+	}
+	s.Padding = s.Size - used
+	s.Wasted = s.Size - s.OptimalSize
+	for i := range fields {
+		s.Fields = append(s.Fields, fields[i].Name())
+		s.Order = append(s.Order, optimal[i].Name())
+	}
+	return s, true
+}
 
-			typ, ok := obj.Type().(*types.Named)
-			if !ok {
-				continue
-			}
+// optimalOrder returns the indexes of fields ordered by decreasing alignment,
+// the fields of size zero first, then by decreasing size and declaration.
+func optimalOrder(fields []*types.Var, sizes *gcsizes.Sizes) []int {
+	order := make([]int, len(fields))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := fields[order[i]].Type(), fields[order[j]].Type()
+		zeroA, zeroB := sizes.Sizeof(a) == 0, sizes.Sizeof(b) == 0
+		if zeroA != zeroB {
+			return zeroA
+		}
+		if alignA, alignB := sizes.Alignof(a), sizes.Alignof(b); alignA != alignB {
+			return alignA > alignB
+		}
+		return sizes.Sizeof(a) > sizes.Sizeof(b)
+	})
+	return order
+}
 
-			strukt, ok := typ.Underlying().(*types.Struct)
-			if !ok {
-				continue
-			}
+// fieldText is the source of a field of a struct declaration. A field
+// declaring several names is split, the first one keeping its comments.
+type fieldText struct {
+	field *ast.Field
+	name  int
+}
 
-			structAlign := int(stdSizes.Alignof(strukt))
-			structSize := int(stdSizes.Sizeof(strukt))
-			if structSize%structAlign != 0 {
-				structSize += structAlign - structSize%structAlign
-			}
+// reorder returns the struct type decl of file, whose source is src, with
+// its fields in the order of the indexes order, formatted as in the file. It
+// returns an empty string if a comment of decl is neither attached to a field
+// nor in the type of a field.
+func reorder(fset *token.FileSet, src []byte, file *ast.File, decl *ast.StructType, order []int) string {
+	tokFile := fset.File(decl.Pos())
+	text := func(node ast.Node) string {
+		return string(src[tokFile.Offset(node.Pos()):tokFile.Offset(node.End())])
+	}
 
-			minSize := 0
-			for i := 0; i < strukt.NumFields(); i++ {
-				field := strukt.Field(i)
-				fieldType := field.Type()
-				typeSize := int(stdSizes.Sizeof(fieldType))
-				minSize += typeSize
-			}
-			if minSize%structAlign != 0 {
-				minSize += structAlign - minSize%structAlign
+	var fields []fieldText
+	attached := make(map[*ast.CommentGroup]bool)
+	for _, field := range decl.Fields.List {
+		attached[field.Doc], attached[field.Comment] = true, true
+		for i := 0; i == 0 || i < len(field.Names); i++ {
+			fields = append(fields, fieldText{field: field, name: i})
+		}
+	}
+	if len(fields) != len(order) {
+		return ""
+	}
+	for _, group := range file.Comments {
+		if group.Pos() < decl.Fields.Opening || group.End() > decl.Fields.Closing || attached[group] {
+			continue
+		}
+		inType := false
+		for _, field := range decl.Fields.List {
+			if group.Pos() >= field.Type.Pos() && group.End() <= field.Type.End() {
+				inType = true
 			}
+		}
+		if !inType {
+			return ""
+		}
+	}
 
-			if minSize != structSize {
-				pos := program.Fset.Position(obj.Pos())
-				lines = append(lines, fmt.Sprintf(
-					"%s: %s:%d:%d: struct %s could have size %d (currently %d)",
-					obj.Pkg().Path(),
-					pos.Filename,
-					pos.Line,
-					pos.Column,
-					obj.Name(),
-					minSize,
-					structSize,
-				))
-			}
+	var buf bytes.Buffer
+	buf.WriteString("package p\n\ntype _ struct {\n")
+	for _, index := range order {
+		f := fields[index]
+		if f.name == 0 && f.field.Doc != nil {
+			buf.WriteString(text(f.field.Doc) + "\n")
+		}
+		if len(f.field.Names) > 0 {
+			buf.WriteString(f.field.Names[f.name].Name + " ")
+		}
+		buf.WriteString(text(f.field.Type))
+		if f.field.Tag != nil {
+			buf.WriteString(" " + text(f.field.Tag))
 		}
+		if f.name == 0 && f.field.Comment != nil {
+			buf.WriteString(" " + text(f.field.Comment))
+		}
+		buf.WriteString("\n")
+	}
+	buf.WriteString("}\n")
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		return ""
 	}
+	formatted = formatted[bytes.Index(formatted, []byte("struct {")):]
+	formatted = bytes.TrimRight(formatted, "\n")
 
-	sort.Strings(lines)
-	// for _, line := range lines {
-	// 	fmt.Println(line)
-	// }
-	return lines
-	// os.Exit(exitStatus)
+	// indent the fields as the line of the declaration
+	offset := tokFile.Offset(decl.Pos())
+	start := bytes.LastIndexByte(src[:offset], '\n') + 1
+	indent := src[start:offset]
+	indent = indent[:len(indent)-len(bytes.TrimLeft(indent, " \t"))]
+	return strings.Replace(string(formatted), "\n", "\n"+string(indent), -1)
 }
 
-var stdSizes = types.StdSizes{
-	WordSize: int64(unsafe.Sizeof(int(0))),
-	MaxAlign: 8,
+// Fix rewrites the declarations of the fixable structs with their fields in
+// optimal order. The files are formatted as before and the comments of the
+// fields move with them.
+func Fix(structs []Struct) error {
+	byFile := make(map[string][]Struct)
+	for _, s := range structs {
+		if s.Fixable {
+			byFile[s.File] = append(byFile[s.File], s)
+		}
+	}
+	for filename, fileStructs := range byFile {
+		info, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return err
+		}
+		// rewrite from the end so that the offsets stay valid
+		sort.Slice(fileStructs, func(i, j int) bool {
+			return fileStructs[i].pos > fileStructs[j].pos
+		})
+		last := len(src) + 1
+		for _, s := range fileStructs {
+			if s.end > last || s.end > len(src) {
+				continue
+			}
+			src = append(src[:s.pos:s.pos], append([]byte(s.decl), src[s.end:]...)...)
+			last = s.pos
+		}
+		if err := ioutil.WriteFile(filename, src, info.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,66 @@
+// aligncheck reports the struct types whose fields may be reordered to take
+// less memory and, with -fix, reorders them.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/aligncheck"
+)
+
+var (
+	arch  = flag.String("arch", "", "GOARCH whose sizes and alignments are used, the default one if empty")
+	tags  = flag.String("tags", "", "comma-separated list of build tags")
+	tests = flag.Bool("test", false, "check the test files too")
+	fix   = flag.Bool("fix", false, "rewrite the struct declarations with their fields in optimal order")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of %s:\n", os.Args[0])
+	fmt.Fprintf(os.Stderr, "\taligncheck [flags] # runs on package in current directory\n")
+	fmt.Fprintf(os.Stderr, "\taligncheck [flags] [packages]\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	importPaths := flag.Args()
+	if len(importPaths) == 0 {
+		importPaths = []string{"."}
+	}
+	opts := aligncheck.Options{Arch: *arch, Tests: *tests}
+	if *tags != "" {
+		opts.Tags = strings.Split(*tags, ",")
+	}
+
+	structs, err := aligncheck.AlignCheck(importPaths, opts)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	var wasted int64
+	for _, s := range structs {
+		fmt.Printf("%s:%d:%d: struct %s is %d bytes, could be %d with fields %s\n",
+			s.File, s.Line, s.Column, s.Name, s.Size, s.OptimalSize, strings.Join(s.Order, ", "))
+		if *fix && !s.Fixable {
+			fmt.Fprintf(os.Stderr, "%s:%d:%d: struct %s not fixed: a comment is not attached to a field or a literal depends on the field order\n", s.File, s.Line, s.Column, s.Name)
+		}
+		wasted += s.Wasted
+	}
+	if len(structs) > 0 {
+		fmt.Printf("%d structs waste %d bytes\n", len(structs), wasted)
+	}
+
+	if *fix {
+		if err := aligncheck.Fix(structs); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
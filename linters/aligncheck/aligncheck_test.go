@@ -1,9 +1,88 @@
 package aligncheck
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
+const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/aligncheck/testdata/layout"
+
 func Test_AlignCheck(t *testing.T) {
-	LinterAligncheck{}.ComputeMetric("net/http")
+	testCases := []struct {
+		arch     string
+		expected []string
+	}{
+		{"amd64", []string{
+			"Padded 48 40 13 [F B E A C D] true",
+			"Tail 16 8 8 [B A] true",
+			"Floating 24 16 14 [B A C] false",
+			"Positional 24 16 14 [B A C] false",
+			"pair 24 16 14 [value ok set] true",
+		}},
+		{"386", []string{
+			"Padded 36 32 5 [F B E A C D] true",
+			"Tail 12 8 4 [B A] true",
+			"Floating 16 12 6 [B A C] false",
+			"Positional 16 12 6 [B A C] false",
+			"pair 16 12 6 [value ok set] true",
+		}},
+	}
+	for _, tc := range testCases {
+		structs, err := AlignCheck([]string{testdata}, Options{Arch: tc.arch})
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, 0, len(structs))
+		for _, s := range structs {
+			if s.Wasted != s.Size-s.OptimalSize {
+				t.Errorf("%s: wasted %d, want %d", s.Name, s.Wasted, s.Size-s.OptimalSize)
+			}
+			got = append(got, fmt.Sprintf("%s %d %d %d %v %v", s.Name, s.Size, s.OptimalSize, s.Padding, s.Order, s.Fixable))
+		}
+		if strings.Join(got, "\n") != strings.Join(tc.expected, "\n") {
+			t.Errorf("%s: got\n%s\nwant\n%s", tc.arch, strings.Join(got, "\n"), strings.Join(tc.expected, "\n"))
+		}
+	}
+}
+
+func Test_Fix(t *testing.T) {
+	structs, err := AlignCheck([]string{testdata}, Options{Arch: "amd64"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := ioutil.ReadFile("testdata/layout/layout.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "aligncheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "layout.go")
+	if err := ioutil.WriteFile(file, src, 0644); err != nil {
+		t.Fatal(err)
+	}
+	for i := range structs {
+		structs[i].File = file
+	}
+
+	if err := Fix(structs); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := ioutil.ReadFile("testdata/layout.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("got\n%s\nwant\n%s", got, want)
+	}
 }
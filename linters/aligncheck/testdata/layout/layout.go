@@ -0,0 +1,69 @@
+package layout
+
+// Padded pads every bool to the alignment of the next field.
+type Padded struct {
+	// A is documented.
+	A    bool
+	B    int64 // B has a line comment.
+	C, D bool
+	E    int64 `json:"e"`
+	F    struct {
+		X bool
+		Y int64
+	}
+}
+
+// Ordered is laid out optimally.
+type Ordered struct {
+	A int64
+	B int32
+	C bool
+}
+
+// Tail ends with a field of size zero, which is padded.
+type Tail struct {
+	A int64
+	B struct{}
+}
+
+// Floating has a comment attached to no field.
+type Floating struct {
+	A bool
+	// nothing here
+
+	B int64
+	C bool
+}
+
+// Blank is laid out on purpose.
+type Blank struct {
+	A bool
+	_ [7]byte
+	B int64
+	C bool
+}
+
+// Generic depends on its type argument.
+type Generic[T any] struct {
+	A bool
+	B T
+	C bool
+}
+
+// Positional has a literal that depends on the order of its fields.
+type Positional struct {
+	A bool
+	B int64
+	C bool
+}
+
+var positional = []*Positional{{true, 1, false}}
+
+func local() int {
+	type pair struct {
+		ok    bool
+		value int64
+		set   bool
+	}
+	return len([]pair{})
+}
@@ -0,0 +1,234 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package benchmark runs the benchmarks of a package and compares them with
+// the results of an earlier run, in the spirit of benchstat.
+package benchmark
+
+import (
+	"bufio"
+	"bytes"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// DefaultCount is the number of times every benchmark is run, enough
+	// samples for the significance test to say something.
+	DefaultCount = 5
+	// DefaultAlpha is the significance level of the comparison.
+	DefaultAlpha = 0.05
+	// DefaultThreshold is the change in percent above which a significant
+	// slowdown is reported as a regression.
+	DefaultThreshold = 5.0
+)
+
+// Units measured by go test -benchmem, in the order they are reported.
+const (
+	UnitNsPerOp     = "ns/op"
+	UnitBytesPerOp  = "B/op"
+	UnitAllocsPerOp = "allocs/op"
+)
+
+// Result contains all samples of one benchmark. Every run of the benchmark
+// adds one value to each slice.
+type Result struct {
+	Name        string    `json:"name"`
+	NsPerOp     []float64 `json:"ns_per_op"`
+	BytesPerOp  []float64 `json:"bytes_per_op"`
+	AllocsPerOp []float64 `json:"allocs_per_op"`
+}
+
+// Samples returns the values of the given unit.
+func (r Result) Samples(unit string) []float64 {
+	switch unit {
+	case UnitNsPerOp:
+		return r.NsPerOp
+	case UnitBytesPerOp:
+		return r.BytesPerOp
+	case UnitAllocsPerOp:
+		return r.AllocsPerOp
+	}
+	return nil
+}
+
+// Mean returns the mean of the values of the given unit, outliers excluded.
+func (r Result) Mean(unit string) float64 {
+	return mean(removeOutliers(r.Samples(unit)))
+}
+
+// Delta is the comparison of one benchmark unit between two runs. Old and
+// New are the means of the samples without outliers, Change is in percent.
+type Delta struct {
+	Name        string  `json:"name"`
+	Unit        string  `json:"unit"`
+	Old         float64 `json:"old"`
+	New         float64 `json:"new"`
+	Change      float64 `json:"change"`
+	P           float64 `json:"p"`
+	Significant bool    `json:"significant"`
+	Regression  bool    `json:"regression"`
+}
+
+// Benchmark runs all benchmarks of the package count times, without running
+// its tests, and returns the parsed results.
+func Benchmark(packagePath string, count int) ([]Result, error) {
+	if count <= 0 {
+		count = DefaultCount
+	}
+	cmd := exec.Command("go", "test", packagePath, "-run=^$", "-bench", ".", "-benchmem", "-count", strconv.Itoa(count))
+	var out bytes.Buffer
+	cmd.Stdout = &out
+	err := cmd.Run()
+	return Parse(out.String()), err
+}
+
+// Parse extracts the benchmark lines from the output of go test -bench.
+// Results of the same benchmark are merged in order of appearance.
+func Parse(out string) []Result {
+	results := make([]Result, 0)
+	index := make(map[string]int, 0)
+
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 4 || !strings.HasPrefix(fields[0], "Benchmark") {
+			continue
+		}
+		if _, err := strconv.Atoi(fields[1]); err != nil {
+			continue
+		}
+		name := trimProcs(fields[0])
+		i, ok := index[name]
+		if !ok {
+			i = len(results)
+			index[name] = i
+			results = append(results, Result{Name: name})
+		}
+		for j := 2; j+1 < len(fields); j += 2 {
+			value, err := strconv.ParseFloat(fields[j], 64)
+			if err != nil {
+				continue
+			}
+			switch fields[j+1] {
+			case UnitNsPerOp:
+				results[i].NsPerOp = append(results[i].NsPerOp, value)
+			case UnitBytesPerOp:
+				results[i].BytesPerOp = append(results[i].BytesPerOp, value)
+			case UnitAllocsPerOp:
+				results[i].AllocsPerOp = append(results[i].AllocsPerOp, value)
+			}
+		}
+	}
+	return results
+}
+
+// trimProcs removes the -GOMAXPROCS suffix of a benchmark name, so runs
+// on machines with different numbers of CPUs stay comparable.
+func trimProcs(name string) string {
+	i := strings.LastIndex(name, "-")
+	if i < 0 {
+		return name
+	}
+	if _, err := strconv.Atoi(name[i+1:]); err != nil {
+		return name
+	}
+	return name[:i]
+}
+
+// Compare matches the benchmarks of old and new by name and compares every
+// unit with a Mann-Whitney U-test. A change is significant if its p-value is
+// below alpha, and a significant increase of more than threshold percent is
+// a regression.
+func Compare(old, new []Result, alpha, threshold float64) []Delta {
+	if alpha <= 0 {
+		alpha = DefaultAlpha
+	}
+	if threshold <= 0 {
+		threshold = DefaultThreshold
+	}
+	olds := make(map[string]Result, len(old))
+	for _, r := range old {
+		olds[r.Name] = r
+	}
+
+	deltas := make([]Delta, 0)
+	for _, r := range new {
+		o, ok := olds[r.Name]
+		if !ok {
+			continue
+		}
+		for _, unit := range []string{UnitNsPerOp, UnitBytesPerOp, UnitAllocsPerOp} {
+			x, y := removeOutliers(o.Samples(unit)), removeOutliers(r.Samples(unit))
+			if len(x) == 0 || len(y) == 0 {
+				continue
+			}
+			d := Delta{
+				Name: r.Name,
+				Unit: unit,
+				Old:  mean(x),
+				New:  mean(y),
+				P:    MannWhitneyU(x, y),
+			}
+			if d.Old != 0 {
+				d.Change = (d.New - d.Old) / d.Old * 100
+			}
+			d.Significant = d.P < alpha
+			d.Regression = d.Significant && d.Change > threshold
+			deltas = append(deltas, d)
+		}
+	}
+	return deltas
+}
+
+func mean(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	sum := 0.
+	for _, x := range xs {
+		sum += x
+	}
+	return sum / float64(len(xs))
+}
+
+// removeOutliers drops the samples outside of 1.5 times the interquartile
+// range, as benchstat does.
+func removeOutliers(xs []float64) []float64 {
+	if len(xs) < 4 {
+		return xs
+	}
+	sorted := append([]float64(nil), xs...)
+	sort.Float64s(sorted)
+	q1, q3 := quantile(sorted, 0.25), quantile(sorted, 0.75)
+	lo, hi := q1-1.5*(q3-q1), q3+1.5*(q3-q1)
+	kept := make([]float64, 0, len(xs))
+	for _, x := range xs {
+		if x >= lo && x <= hi {
+			kept = append(kept, x)
+		}
+	}
+	return kept
+}
+
+// quantile returns the q-quantile of sorted samples with linear interpolation.
+func quantile(sorted []float64, q float64) float64 {
+	pos := q * float64(len(sorted)-1)
+	i := int(pos)
+	if i+1 >= len(sorted) {
+		return sorted[len(sorted)-1]
+	}
+	return sorted[i] + (pos-float64(i))*(sorted[i+1]-sorted[i])
+}
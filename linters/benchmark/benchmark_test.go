@@ -0,0 +1,71 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+const benchOutput = `goos: linux
+goarch: amd64
+pkg: github.com/360EntSecGroup-Skylar/goreporter/linters/benchmark
+BenchmarkParse-8   	  200000	      6120 ns/op	    2048 B/op	      12 allocs/op
+BenchmarkParse-8   	  200000	      6080 ns/op	    2048 B/op	      12 allocs/op
+BenchmarkMean-8    	50000000	        25.5 ns/op	       0 B/op	       0 allocs/op
+PASS
+ok  	github.com/360EntSecGroup-Skylar/goreporter/linters/benchmark	3.012s
+`
+
+func Test_Parse(t *testing.T) {
+	want := []Result{
+		{Name: "BenchmarkParse", NsPerOp: []float64{6120, 6080}, BytesPerOp: []float64{2048, 2048}, AllocsPerOp: []float64{12, 12}},
+		{Name: "BenchmarkMean", NsPerOp: []float64{25.5}, BytesPerOp: []float64{0}, AllocsPerOp: []float64{0}},
+	}
+	if got := Parse(benchOutput); !reflect.DeepEqual(got, want) {
+		t.Errorf("want %v, but got %v", want, got)
+	}
+}
+
+func Test_MannWhitneyU(t *testing.T) {
+	x := []float64{1, 2, 3, 4, 5}
+	y := []float64{6, 7, 8, 9, 10}
+	// Completely separated samples of five: 2/C(10,5).
+	if p := MannWhitneyU(x, y); math.Abs(p-2.0/252) > 1e-9 {
+		t.Errorf("want p %v, but got %v", 2.0/252, p)
+	}
+	if p := MannWhitneyU(x, x); p != 1 {
+		t.Errorf("want p 1 for identical samples, but got %v", p)
+	}
+}
+
+func Test_Compare(t *testing.T) {
+	old := []Result{{Name: "BenchmarkA", NsPerOp: []float64{100, 101, 99, 100, 100}}}
+	new := []Result{{Name: "BenchmarkA", NsPerOp: []float64{120, 121, 119, 122, 120}}}
+	deltas := Compare(old, new, 0, 0)
+	if len(deltas) != 1 {
+		t.Fatalf("want 1 delta, but got %v", deltas)
+	}
+	if !deltas[0].Regression {
+		t.Errorf("want a regression, but got %+v", deltas[0])
+	}
+	if deltas := Compare(old, old, 0, 0); deltas[0].Regression {
+		t.Errorf("want no regression, but got %+v", deltas[0])
+	}
+}
+
+func Test_Benchmark(t *testing.T) {
+	Benchmark("../benchmark", 1)
+}
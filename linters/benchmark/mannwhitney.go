@@ -0,0 +1,133 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package benchmark
+
+import (
+	"math"
+	"sort"
+)
+
+// exactLimit is the largest sample size for which the exact distribution
+// of U is computed. Bigger samples use the normal approximation.
+const exactLimit = 50
+
+// MannWhitneyU returns the two-sided p-value of the Mann-Whitney U-test for
+// the samples x and y. It makes no assumption about the distribution of
+// benchmark timings, which are rarely normal.
+func MannWhitneyU(x, y []float64) float64 {
+	n1, n2 := len(x), len(y)
+	if n1 == 0 || n2 == 0 {
+		return 1
+	}
+
+	type sample struct {
+		value float64
+		first bool
+	}
+	all := make([]sample, 0, n1+n2)
+	for _, v := range x {
+		all = append(all, sample{v, true})
+	}
+	for _, v := range y {
+		all = append(all, sample{v, false})
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].value < all[j].value })
+
+	// Rank the samples, giving tied values their average rank.
+	var rankSum, tieCorrection float64
+	ties := false
+	for i := 0; i < len(all); {
+		j := i
+		for j < len(all) && all[j].value == all[i].value {
+			j++
+		}
+		rank := float64(i+j+1) / 2
+		for k := i; k < j; k++ {
+			if all[k].first {
+				rankSum += rank
+			}
+		}
+		if t := float64(j - i); t > 1 {
+			ties = true
+			tieCorrection += t*t*t - t
+		}
+		i = j
+	}
+	u := rankSum - float64(n1*(n1+1))/2
+
+	if !ties && n1 <= exactLimit && n2 <= exactLimit {
+		return exactP(n1, n2, u)
+	}
+
+	n := float64(n1 + n2)
+	mu := float64(n1*n2) / 2
+	sigma := math.Sqrt(float64(n1*n2) / 12 * ((n + 1) - tieCorrection/(n*(n-1))))
+	if sigma == 0 {
+		return 1
+	}
+	// Continuity correction towards the mean.
+	z := (math.Abs(u-mu) - 0.5) / sigma
+	if z < 0 {
+		z = 0
+	}
+	return math.Min(1, math.Erfc(z/math.Sqrt2))
+}
+
+// exactP computes the two-sided p-value of u from the exact distribution of
+// the U statistic for samples of size n1 and n2 without ties.
+func exactP(n1, n2 int, u float64) float64 {
+	dist := uDistribution(n1, n2)
+	total := 0.
+	for _, c := range dist {
+		total += c
+	}
+	var lower, upper float64
+	for k, c := range dist {
+		if float64(k) <= u {
+			lower += c
+		}
+		if float64(k) >= u {
+			upper += c
+		}
+	}
+	return math.Min(1, 2*math.Min(lower, upper)/total)
+}
+
+// uDistribution returns the number of orderings of n1 and n2 samples that
+// give each value of U, using the recurrence
+// f(m, n, u) = f(m-1, n, u-n) + f(m, n-1, u).
+func uDistribution(n1, n2 int) []float64 {
+	// prev[n][u] holds f(m-1, n, u) while row m is computed.
+	prev := make([][]float64, n2+1)
+	for n := range prev {
+		prev[n] = []float64{1}
+	}
+	for m := 1; m <= n1; m++ {
+		cur := make([][]float64, n2+1)
+		cur[0] = []float64{1}
+		for n := 1; n <= n2; n++ {
+			cur[n] = make([]float64, m*n+1)
+			for u := range cur[n] {
+				if u < len(cur[n-1]) {
+					cur[n][u] += cur[n-1][u]
+				}
+				if u-n >= 0 && u-n < len(prev[n]) {
+					cur[n][u] += prev[n][u-n]
+				}
+			}
+		}
+		prev = cur
+	}
+	return prev[n2]
+}
@@ -0,0 +1,332 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package cognitive calculates the cognitive complexity of Go functions and
+// methods, a measure of how hard their control flow is to read. Unlike the
+// cyclomatic complexity it weighs nested structures more than flat ones:
+//
+//   - if, switch, select, for and range add one, plus one for every
+//     structure they are nested in, function literals included;
+//   - else and else if add one, a switch or select adds one whatever the
+//     number of its cases;
+//   - goto, break and continue to a label add one;
+//   - a sequence of && or || operators adds one, plus one for every change
+//     of operator;
+//   - a recursive call adds one.
+package cognitive
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// DefaultHigh and DefaultGrave are the complexities from which a function is
+// reported as high and grave.
+const (
+	DefaultHigh  = 15
+	DefaultGrave = 30
+)
+
+// Stat is the cognitive complexity of one function, named as in "Name" or
+// "(*Type).Name".
+type Stat struct {
+	PkgName    string
+	FuncName   string
+	Complexity int
+	Pos        token.Position
+}
+
+// String returns the stat in the format of gocyclo:
+// <complexity> <package> <function> <file:row:column>
+func (s Stat) String() string {
+	return fmt.Sprintf("%d %s %s %s", s.Complexity, s.PkgName, s.FuncName, s.Pos)
+}
+
+// Cognitive returns the cognitive complexity of the functions of the go files
+// in the directory packagePath, the most complex first. Directories matching
+// one of the comma separated except packages are skipped.
+func Cognitive(packagePath, except string) ([]Stat, error) {
+	stats := make([]Stat, 0)
+	if checkExcept(packagePath, except) {
+		return stats, nil
+	}
+	files, err := filepath.Glob(filepath.Join(packagePath, "*.go"))
+	if err != nil {
+		return stats, err
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return stats, err
+		}
+		stats = buildStats(f, fset, stats)
+	}
+	sort.SliceStable(stats, func(i, j int) bool {
+		return stats[i].Complexity > stats[j].Complexity
+	})
+	return stats, nil
+}
+
+// Average returns the average complexity of stats, 0 if there are none.
+func Average(stats []Stat) float64 {
+	if len(stats) == 0 {
+		return 0
+	}
+	total := 0
+	for _, s := range stats {
+		total += s.Complexity
+	}
+	return float64(total) / float64(len(stats))
+}
+
+func checkExcept(path, except string) bool {
+	for _, val := range strings.Split(except, ",") {
+		if val = strings.TrimSpace(val); val != "" && strings.Contains(path, val) {
+			return true
+		}
+	}
+	return false
+}
+
+func buildStats(f *ast.File, fset *token.FileSet, stats []Stat) []Stat {
+	for _, decl := range f.Decls {
+		if fn, ok := decl.(*ast.FuncDecl); ok {
+			stats = append(stats, Stat{
+				PkgName:    f.Name.Name,
+				FuncName:   funcName(fn),
+				Complexity: Complexity(fn),
+				Pos:        fset.Position(fn.Pos()),
+			})
+		}
+	}
+	return stats
+}
+
+// funcName returns the name representation of a function or method:
+// "(Type).Name" for methods or simply "Name" for functions.
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv != nil && fn.Recv.NumFields() > 0 {
+		return fmt.Sprintf("(%s).%s", recvString(fn.Recv.List[0].Type), fn.Name)
+	}
+	return fn.Name.Name
+}
+
+// recvString returns a string representation of recv of the
+// form "T", "*T", or "BADRECV" (if not a proper receiver type).
+func recvString(recv ast.Expr) string {
+	switch t := recv.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + recvString(t.X)
+	case *ast.IndexExpr:
+		return recvString(t.X)
+	case *ast.IndexListExpr:
+		return recvString(t.X)
+	}
+	return "BADRECV"
+}
+
+// Complexity calculates the cognitive complexity of a function.
+func Complexity(fn *ast.FuncDecl) int {
+	if fn.Body == nil {
+		return 0
+	}
+	v := complexityVisitor{
+		name:    fn.Name.Name,
+		counted: make(map[*ast.BinaryExpr]bool),
+	}
+	if fn.Recv != nil && len(fn.Recv.List) > 0 && len(fn.Recv.List[0].Names) > 0 {
+		v.recv = fn.Recv.List[0].Names[0].Name
+	}
+	ast.Walk(&v, fn.Body)
+	return v.complexity
+}
+
+type complexityVisitor struct {
+	// name and recv are the name and the receiver name of the function,
+	// used to find recursive calls.
+	name string
+	recv string
+	// counted holds the logical expressions that are part of a sequence
+	// already counted.
+	counted    map[*ast.BinaryExpr]bool
+	complexity int
+	nesting    int
+}
+
+// Visit implements the ast.Visitor interface. The nodes that increase the
+// nesting walk their children themselves.
+func (v *complexityVisitor) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.IfStmt:
+		v.visitIf(n, false)
+		return nil
+	case *ast.SwitchStmt:
+		v.structure()
+		v.walk(n.Init, n.Tag)
+		v.nested(n.Body)
+		return nil
+	case *ast.TypeSwitchStmt:
+		v.structure()
+		v.walk(n.Init, n.Assign)
+		v.nested(n.Body)
+		return nil
+	case *ast.SelectStmt:
+		v.structure()
+		v.nested(n.Body)
+		return nil
+	case *ast.ForStmt:
+		v.structure()
+		v.walk(n.Init, n.Cond, n.Post)
+		v.nested(n.Body)
+		return nil
+	case *ast.RangeStmt:
+		v.structure()
+		v.walk(n.Key, n.Value, n.X)
+		v.nested(n.Body)
+		return nil
+	case *ast.FuncLit:
+		v.nested(n.Body)
+		return nil
+	case *ast.BranchStmt:
+		if n.Label != nil {
+			v.complexity++
+		}
+	case *ast.BinaryExpr:
+		if (n.Op == token.LAND || n.Op == token.LOR) && !v.counted[n] {
+			v.complexity += v.sequences(n)
+		}
+	case *ast.CallExpr:
+		if v.recursive(n) {
+			v.complexity++
+		}
+	}
+	return v
+}
+
+// structure counts a control flow structure at the current nesting.
+func (v *complexityVisitor) structure() {
+	v.complexity += 1 + v.nesting
+}
+
+// walk walks the nodes that are not nil at the current nesting.
+func (v *complexityVisitor) walk(nodes ...ast.Node) {
+	for _, n := range nodes {
+		if n != nil {
+			ast.Walk(v, n)
+		}
+	}
+}
+
+// nested walks body one nesting level deeper.
+func (v *complexityVisitor) nested(body *ast.BlockStmt) {
+	v.nesting++
+	ast.Walk(v, body)
+	v.nesting--
+}
+
+// visitIf counts an if statement and its else branches. An else if only adds
+// one, like an else.
+func (v *complexityVisitor) visitIf(n *ast.IfStmt, elseIf bool) {
+	if elseIf {
+		v.complexity++
+	} else {
+		v.structure()
+	}
+	v.walk(n.Init, n.Cond)
+	v.nested(n.Body)
+	switch e := n.Else.(type) {
+	case *ast.IfStmt:
+		v.visitIf(e, true)
+	case *ast.BlockStmt:
+		v.complexity++
+		v.nested(e)
+	}
+}
+
+// sequences returns the number of sequences of like logical operators in the
+// expression n, parentheses aside, and marks its operands as counted.
+func (v *complexityVisitor) sequences(n *ast.BinaryExpr) int {
+	ops := v.operators(n, nil)
+	count := 1
+	for i := 1; i < len(ops); i++ {
+		if ops[i] != ops[i-1] {
+			count++
+		}
+	}
+	return count
+}
+
+func (v *complexityVisitor) operators(e ast.Expr, ops []token.Token) []token.Token {
+	switch e := e.(type) {
+	case *ast.ParenExpr:
+		return v.operators(e.X, ops)
+	case *ast.BinaryExpr:
+		if e.Op == token.LAND || e.Op == token.LOR {
+			v.counted[e] = true
+			ops = v.operators(e.X, ops)
+			ops = append(ops, e.Op)
+			return v.operators(e.Y, ops)
+		}
+	}
+	return ops
+}
+
+// recursive reports whether call calls the function itself. A method is taken
+// to call itself when the method of the same name is called on its receiver
+// or on a field of it, as in t.left.depth().
+func (v *complexityVisitor) recursive(call *ast.CallExpr) bool {
+	fun := call.Fun
+	for {
+		switch f := fun.(type) {
+		case *ast.ParenExpr:
+			fun = f.X
+		case *ast.IndexExpr:
+			fun = f.X
+		case *ast.IndexListExpr:
+			fun = f.X
+		case *ast.Ident:
+			return v.recv == "" && f.Name == v.name
+		case *ast.SelectorExpr:
+			return v.recv != "" && f.Sel.Name == v.name && rootIdent(f.X) == v.recv
+		default:
+			return false
+		}
+	}
+}
+
+// rootIdent returns the name of the identifier a selector expression starts
+// with, or "".
+func rootIdent(e ast.Expr) string {
+	for {
+		switch x := e.(type) {
+		case *ast.Ident:
+			return x.Name
+		case *ast.SelectorExpr:
+			e = x.X
+		case *ast.ParenExpr:
+			e = x.X
+		case *ast.StarExpr:
+			e = x.X
+		default:
+			return ""
+		}
+	}
+}
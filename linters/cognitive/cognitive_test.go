@@ -0,0 +1,40 @@
+package cognitive
+
+import (
+	"testing"
+)
+
+func TestCognitive(t *testing.T) {
+	want := map[string]int{
+		"sumOfPrimes":   7,
+		"words":         1,
+		"fact":          2,
+		"logical":       3,
+		"negated":       2,
+		"sign":          3,
+		"positive":      2,
+		"(*tree).depth": 2,
+		"abs":           2,
+		"drain":         3,
+		"flat":          0,
+	}
+	stats, err := Cognitive("testdata/p1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != len(want) {
+		t.Fatalf("got %d functions, want %d", len(stats), len(want))
+	}
+	for i, stat := range stats {
+		if stat.Complexity != want[stat.FuncName] {
+			t.Errorf("%s: got complexity %d, want %d", stat.FuncName, stat.Complexity, want[stat.FuncName])
+		}
+		if i > 0 && stat.Complexity > stats[i-1].Complexity {
+			t.Errorf("%s is not sorted by complexity", stat.FuncName)
+		}
+	}
+
+	if stats, _ := Cognitive("testdata/p1", "p1"); len(stats) != 0 {
+		t.Errorf("got %d functions of an except package", len(stats))
+	}
+}
@@ -0,0 +1,93 @@
+package p1
+
+func sumOfPrimes(max int) int {
+	total := 0
+OUT:
+	for i := 1; i <= max; i++ {
+		for j := 2; j < i; j++ {
+			if i%j == 0 {
+				continue OUT
+			}
+		}
+		total += i
+	}
+	return total
+}
+
+func words(n int) string {
+	switch n {
+	case 1:
+		return "one"
+	case 2:
+		return "a couple"
+	default:
+		return "lots"
+	}
+}
+
+func fact(n int) int {
+	if n <= 1 {
+		return 1
+	}
+	return n * fact(n-1)
+}
+
+func logical(a, b, c, d, e, f bool) bool {
+	return a && b && c || d || e && f
+}
+
+func negated(a, b, c bool) bool {
+	return a && !(b && c)
+}
+
+func sign(x int) int {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	} else {
+		return 0
+	}
+}
+
+func positive() func(int) bool {
+	return func(x int) bool {
+		if x > 0 {
+			return true
+		}
+		return false
+	}
+}
+
+type tree struct {
+	left *tree
+}
+
+func (t *tree) depth() int {
+	if t == nil {
+		return 0
+	}
+	return 1 + t.left.depth()
+}
+
+func abs(x int) int {
+	if x > 0 {
+		goto end
+	}
+	x = -x
+end:
+	return x
+}
+
+func drain(c, d chan int) {
+	for {
+		select {
+		case <-c:
+			return
+		case <-d:
+			break
+		}
+	}
+}
+
+func flat() {}
@@ -0,0 +1,215 @@
+package copycheck
+
+import (
+	"bytes"
+	"io/ioutil"
+	"sort"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/job"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/syntax"
+	"github.com/golang/glog"
+)
+
+// Fragment is a copy of a clone group, the lines Start to End of File. Repo
+// is the repository of the copies found in an Index, empty in the project.
+type Fragment struct {
+	File  string `json:"file"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Code  string `json:"code"`
+	Repo  string `json:"repo,omitempty"`
+
+	pos, end int
+}
+
+// Group is a group of clones. Similarity is the lowest similarity between the
+// first fragment and the others, between 0 and 1, computed on their tokens
+// names included: a normalized or gapped clone is less similar than an exact
+// copy. Gapped tells whether the fragments were merged across edits.
+type Group struct {
+	Similarity float64    `json:"similarity"`
+	Gapped     bool       `json:"gapped"`
+	Fragments  []Fragment `json:"fragments"`
+
+	size int
+}
+
+// Clones finds the clone groups of the Go files under projectPath, except
+// those matching except, ordered by file and line.
+func Clones(projectPath string, except string, opts Options) []Group {
+	schan := job.Parse(crawlPaths([]string{projectPath}, except, opts))
+	if !opts.Normalize {
+		schan = job.Intern(schan)
+	}
+	t, data, done := job.BuildTree(schan)
+	<-done
+
+	// finish stream
+	t.Update(&syntax.Node{Type: -1})
+
+	threshold := opts.threshold()
+	minSize := threshold
+	if opts.Gap > 0 {
+		minSize = threshold / 2
+	}
+	matches := make(map[string][][]*syntax.Node)
+	hashes := make([]string, 0)
+	for m := range t.FindDuplOver(minSize) {
+		var match syntax.Match
+		if opts.Gap > 0 {
+			match = syntax.FindLargestUnits(*data, m)
+		} else {
+			match = syntax.FindSyntaxUnits(*data, m, threshold)
+		}
+		if len(match.Frags) == 0 {
+			continue
+		}
+		if _, ok := matches[match.Hash]; !ok {
+			hashes = append(hashes, match.Hash)
+		}
+		matches[match.Hash] = append(matches[match.Hash], match.Frags...)
+	}
+
+	files := make(map[string][]byte)
+	groups := make([]Group, 0)
+	for _, hash := range hashes {
+		uniq := unique(matches[hash])
+		if len(uniq) < opts.minGroupSize() {
+			continue
+		}
+		group := Group{Fragments: make([]Fragment, 0, len(uniq))}
+		for _, unit := range uniq[0] {
+			group.size += unit.Owns + 1
+		}
+		if group.size < minSize {
+			continue
+		}
+		for _, seq := range uniq {
+			first, last := seq[0], seq[len(seq)-1]
+			group.Fragments = append(group.Fragments, Fragment{File: first.Filename, pos: first.Pos, end: last.End})
+		}
+		sortFragments(group.Fragments)
+		groups = append(groups, group)
+	}
+	sortGroups(groups)
+	if opts.Gap > 0 {
+		groups = mergeGaps(groups, files, opts.Gap)
+	}
+
+	clones := make([]Group, 0, len(groups))
+	for _, group := range groups {
+		if group.size < threshold {
+			continue
+		}
+		for i := range group.Fragments {
+			if !setLines(&group.Fragments[i], files) {
+				group.Fragments = nil
+				break
+			}
+		}
+		if group.Fragments == nil {
+			continue
+		}
+		group.Similarity = similarity(group.Fragments)
+		clones = append(clones, group)
+	}
+	return clones
+}
+
+// mergeGaps merges every group into the previous one it follows, fragment by
+// fragment, within gap lines.
+func mergeGaps(groups []Group, files map[string][]byte, gap int) []Group {
+	merged := make([]Group, 0, len(groups))
+	for _, group := range groups {
+		joined := false
+		for i := range merged {
+			if follows(merged[i], group, files, gap) {
+				for j := range merged[i].Fragments {
+					merged[i].Fragments[j].end = group.Fragments[j].end
+				}
+				merged[i].Gapped = true
+				merged[i].size += group.size
+				joined = true
+				break
+			}
+		}
+		if !joined {
+			merged = append(merged, group)
+		}
+	}
+	return merged
+}
+
+// follows reports whether every fragment of b starts after the fragment of a
+// of the same rank, in the same file, and at most gap lines below its end.
+func follows(a, b Group, files map[string][]byte, gap int) bool {
+	if len(a.Fragments) != len(b.Fragments) {
+		return false
+	}
+	for i, fa := range a.Fragments {
+		fb := b.Fragments[i]
+		if fa.File != fb.File || fb.pos < fa.end {
+			return false
+		}
+		file, ok := readFile(fa.File, files)
+		if !ok || fb.pos > len(file) {
+			return false
+		}
+		if bytes.Count(file[fa.end:fb.pos], []byte("\n"))-1 > gap {
+			return false
+		}
+	}
+	return true
+}
+
+// setLines sets the lines and the code of f, it returns false if its file
+// cannot be read.
+func setLines(f *Fragment, files map[string][]byte) bool {
+	file, ok := readFile(f.File, files)
+	if !ok || f.end > len(file) {
+		return false
+	}
+	f.Start = bytes.Count(file[:f.pos], []byte("\n")) + 1
+	f.End = bytes.Count(file[:f.end-1], []byte("\n")) + 1
+	begin := bytes.LastIndexByte(file[:f.pos], '\n') + 1
+	end := f.end
+	if i := bytes.IndexByte(file[f.end:], '\n'); i >= 0 {
+		end += i
+	} else {
+		end = len(file)
+	}
+	f.Code = string(file[begin:end])
+	return true
+}
+
+// readFile returns the content of the file path, read once into files.
+func readFile(path string, files map[string][]byte) ([]byte, bool) {
+	if file, ok := files[path]; ok {
+		return file, file != nil
+	}
+	file, err := ioutil.ReadFile(path)
+	if err != nil {
+		glog.Errorln(err)
+	}
+	files[path] = file
+	return file, err == nil
+}
+
+func sortFragments(fragments []Fragment) {
+	sort.Slice(fragments, func(i, j int) bool {
+		if fragments[i].File != fragments[j].File {
+			return fragments[i].File < fragments[j].File
+		}
+		return fragments[i].pos < fragments[j].pos
+	})
+}
+
+func sortGroups(groups []Group) {
+	sort.SliceStable(groups, func(i, j int) bool {
+		a, b := groups[i].Fragments[0], groups[j].Fragments[0]
+		if a.File != b.File {
+			return a.File < b.File
+		}
+		return a.pos < b.pos
+	})
+}
@@ -1,36 +1,22 @@
 package copycheck
 
 import (
-	"bufio"
-	"flag"
+	"bytes"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
 
-	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/job"
-	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/output"
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/syntax"
 	"github.com/golang/glog"
 )
 
-const defaultThreshold = 15
-
-var (
-	//flag.Bool("vendor", false, "check files in vendor directory")
-	vendor = false
-	//flag.Bool("verbose", false, "explain what is being done")
-	verbose = false
-	//flag.Int("threshold", defaultThreshold, "minimum token sequence as a clone")
-	threshold = 50
-	//flag.Bool("files", false, "files names from stdin")
-	files = false
-
-	// flag.Bool("html", false, "html output")
-	html = false
-	//flag.Bool("plumbing", false, "plumbing output for consumption by scripts or tools")
-	plumbing = false
+const (
+	// DefaultThreshold is the minimum number of syntax nodes of a clone.
+	DefaultThreshold = 50
+	// DefaultMinGroupSize is the minimum number of fragments of a group.
+	DefaultMinGroupSize = 2
 )
 
 const (
@@ -38,58 +24,137 @@ const (
 	vendorDirInPath = string(filepath.Separator) + "vendor" + string(filepath.Separator)
 )
 
-func CopyCheck(projectPath string, except string) (result [][]string) {
-	flag.Parse()
-	if html && plumbing {
-		glog.Errorln("you can have either plumbing or HTML output")
-		return result
+// Options configures the search of clones. A clone covers at least Threshold
+// syntax nodes and a group holds at least MinGroupSize fragments, their
+// defaults if not set. The files of the vendor directories and the test
+// files are skipped unless Vendor and IncludeTests are set.
+//
+// With Normalize, the names of the identifiers, the values of the literals
+// and the operators are ignored, so renamed copies are clones; without it,
+// clones are exact copies. With a Gap greater than zero, the exact parts of a
+// clone separated by at most Gap lines of edited code, a changed or an
+// inserted statement for instance, are merged into a single gapped clone.
+type Options struct {
+	Threshold    int
+	MinGroupSize int
+	Vendor       bool
+	IncludeTests bool
+	Normalize    bool
+	Gap          int
+}
+
+func (o Options) threshold() int {
+	if o.Threshold <= 0 {
+		return DefaultThreshold
 	}
-	paths := []string{projectPath}
-	if verbose {
-		glog.Errorln("Building suffix tree")
+	return o.Threshold
+}
+
+func (o Options) minGroupSize() int {
+	if o.MinGroupSize < DefaultMinGroupSize {
+		return DefaultMinGroupSize
 	}
-	schan := job.Parse(filesFeed(paths, except))
-	t, data, done := job.BuildTree(schan)
-	<-done
+	return o.MinGroupSize
+}
+
+// Report is the result of CopyCheck, the clone groups and the lines of code
+// they duplicate in the whole project and by directory.
+type Report struct {
+	Groups   []Group       `json:"groups"`
+	Project  Duplication   `json:"project"`
+	Packages []Duplication `json:"packages"`
+}
+
+// Duplication is the number of lines of the Go files of the directory Dir,
+// or of the whole project, and the number of them in a fragment of a clone,
+// Duplicated, which are Percentage percent of them.
+type Duplication struct {
+	Dir        string  `json:"dir"`
+	Lines      int     `json:"lines"`
+	Duplicated int     `json:"duplicated"`
+	Percentage float64 `json:"percentage"`
+}
 
-	// finish stream
-	t.Update(&syntax.Node{Type: -1})
+// CopyCheck finds the clone groups of the Go files under projectPath, except
+// those matching except, and measures the code they duplicate.
+func CopyCheck(projectPath string, except string, opts Options) Report {
+	groups := Clones(projectPath, except, opts)
+	project, packages := Measure(projectPath, except, opts, groups)
+	return Report{Groups: groups, Project: project, Packages: packages}
+}
 
-	if verbose {
-		glog.Errorln("Searching for clones")
+// Measure counts the lines of the Go files under projectPath, except those
+// matching except, and the lines of them in a fragment of groups, once even
+// if several fragments overlap. The fragments of other repositories are not
+// counted. The directories are ordered by path.
+func Measure(projectPath string, except string, opts Options, groups []Group) (project Duplication, packages []Duplication) {
+	covered := make(map[string]map[int]bool)
+	for _, group := range groups {
+		for _, f := range group.Fragments {
+			if f.Repo != "" {
+				continue
+			}
+			lines, ok := covered[f.File]
+			if !ok {
+				lines = make(map[int]bool)
+				covered[f.File] = lines
+			}
+			for line := f.Start; line <= f.End; line++ {
+				lines[line] = true
+			}
+		}
 	}
-	mchan := t.FindDuplOver(threshold)
-	duplChan := make(chan syntax.Match)
-	go func() {
-		for m := range mchan {
-			match := syntax.FindSyntaxUnits(*data, m, threshold)
-			if len(match.Frags) > 0 {
-				duplChan <- match
+
+	project.Dir = projectPath
+	dirs := make(map[string]*Duplication)
+	for path := range crawlPaths([]string{projectPath}, except, opts) {
+		file, err := ioutil.ReadFile(path)
+		if err != nil {
+			glog.Errorln(err)
+			continue
+		}
+		lines := bytes.Count(file, []byte("\n"))
+		if len(file) > 0 && file[len(file)-1] != '\n' {
+			lines++
+		}
+		duplicated := 0
+		for line := range covered[path] {
+			if line <= lines {
+				duplicated++
 			}
 		}
-		close(duplChan)
-	}()
-	return printDupls(duplChan)
+		dir := filepath.Dir(path)
+		d, ok := dirs[dir]
+		if !ok {
+			d = &Duplication{Dir: dir}
+			dirs[dir] = d
+		}
+		d.Lines += lines
+		d.Duplicated += duplicated
+		project.Lines += lines
+		project.Duplicated += duplicated
+	}
+
+	packages = make([]Duplication, 0, len(dirs))
+	for _, d := range dirs {
+		d.Percentage = percentage(d.Duplicated, d.Lines)
+		packages = append(packages, *d)
+	}
+	sort.Slice(packages, func(i, j int) bool {
+		return packages[i].Dir < packages[j].Dir
+	})
+	project.Percentage = percentage(project.Duplicated, project.Lines)
+	return project, packages
 }
 
-func filesFeed(paths []string, except string) chan string {
-	if files {
-		fchan := make(chan string)
-		go func() {
-			s := bufio.NewScanner(os.Stdin)
-			for s.Scan() {
-				f := s.Text()
-				f = strings.TrimPrefix(f, "./")
-				fchan <- f
-			}
-			close(fchan)
-		}()
-		return fchan
+func percentage(part, total int) float64 {
+	if total == 0 {
+		return 0
 	}
-	return crawlPaths(paths, except)
+	return float64(part) * 100 / float64(total)
 }
 
-func crawlPaths(paths []string, except string) chan string {
+func crawlPaths(paths []string, except string, opts Options) chan string {
 	fchan := make(chan string)
 	go func() {
 		for _, path := range paths {
@@ -106,11 +171,12 @@ func crawlPaths(paths []string, except string) chan string {
 				if checkExcept(path, except) {
 					return nil
 				}
-				if !vendor && (strings.HasPrefix(path, vendorDirPrefix) ||
+				if !opts.Vendor && (strings.HasPrefix(path, vendorDirPrefix) ||
 					strings.Contains(path, vendorDirInPath)) {
 					return nil
 				}
-				if !info.IsDir() && strings.HasSuffix(info.Name(), ".go") && !strings.HasSuffix(info.Name(), "_test.go") {
+				if !info.IsDir() && strings.HasSuffix(info.Name(), ".go") &&
+					(opts.IncludeTests || !strings.HasSuffix(info.Name(), "_test.go")) {
 					fchan <- path
 				}
 				return nil
@@ -136,45 +202,6 @@ func checkExcept(path, except string) bool {
 	return false
 }
 
-type LocalFileReader struct{}
-
-func (LocalFileReader) ReadFile(node *syntax.Node) ([]byte, error) {
-	return ioutil.ReadFile(node.Filename)
-}
-
-func printDupls(duplChan <-chan syntax.Match) (copys [][]string) {
-	groups := make(map[string][][]*syntax.Node)
-	for dupl := range duplChan {
-		groups[dupl.Hash] = append(groups[dupl.Hash], dupl.Frags...)
-	}
-	keys := make([]string, 0, len(groups))
-	for k := range groups {
-		keys = append(keys, k)
-	}
-	sort.Strings(keys)
-
-	p := getPrinter()
-	for _, k := range keys {
-		uniq := unique(groups[k])
-		if len(uniq) > 1 {
-			// p.Print(uniq)
-			copys = append(copys, p.SPrint(uniq))
-		}
-	}
-	// p.Finish()
-	return copys
-}
-
-func getPrinter() output.Printer {
-	fr := LocalFileReader{}
-	if html {
-		return output.NewHtmlPrinter(os.Stdout, fr)
-	} else if plumbing {
-		return output.NewPlumbingPrinter(os.Stdout, fr)
-	}
-	return output.NewTextPrinter(os.Stdout, fr)
-}
-
 func unique(group [][]*syntax.Node) [][]*syntax.Node {
 	fileMap := make(map[string]map[int]struct{})
 
@@ -1,9 +1,171 @@
 package copycheck
 
 import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 )
 
 func Test_CopyCheck(t *testing.T) {
-	CopyCheck("../copycheck", "job")
+	report := CopyCheck("testdata/near", "", Options{Normalize: true})
+	if len(report.Groups) != 1 {
+		t.Fatalf("got %d groups, want 1", len(report.Groups))
+	}
+	// the lines 12 to 29 and 31 to 48 of the 93 lines of near.go
+	expected := Duplication{Dir: "testdata/near", Lines: 93, Duplicated: 36, Percentage: 36 * 100 / 93.0}
+	if report.Project != expected {
+		t.Errorf("got %+v, want %+v", report.Project, expected)
+	}
+	if len(report.Packages) != 1 || report.Packages[0] != expected {
+		t.Errorf("got %+v, want [%+v]", report.Packages, expected)
+	}
+
+	report = CopyCheck("testdata/near", "", Options{Normalize: true, MinGroupSize: 3})
+	if len(report.Groups) != 0 || report.Project.Duplicated != 0 {
+		t.Errorf("got %+v, want no group of 3 fragments", report)
+	}
+	report = CopyCheck("testdata/near", "", Options{Normalize: true, Threshold: 200})
+	if len(report.Groups) != 0 {
+		t.Errorf("got %d groups, want none over 200 nodes", len(report.Groups))
+	}
+}
+
+func Test_Clones(t *testing.T) {
+	testCases := []struct {
+		opts     Options
+		expected []string
+	}{
+		{Options{}, nil},
+		{Options{Normalize: true}, []string{"12,29 31,48"}},
+		{Options{Normalize: true, Gap: 2}, []string{"12,29 31,48", "52,70 74,92 gapped"}},
+		{Options{Gap: 2}, []string{"52,70 74,92 gapped"}},
+	}
+
+	for _, tc := range testCases {
+		groups := Clones("testdata/near", "", tc.opts)
+		if len(groups) != len(tc.expected) {
+			t.Errorf("%+v: got %d groups, want %d", tc.opts, len(groups), len(tc.expected))
+			continue
+		}
+		for i, g := range groups {
+			if len(g.Fragments) != 2 {
+				t.Errorf("%+v: got %d fragments, want 2", tc.opts, len(g.Fragments))
+				continue
+			}
+			a, b := g.Fragments[0], g.Fragments[1]
+			got := fmt.Sprintf("%d,%d %d,%d", a.Start, a.End, b.Start, b.End)
+			if g.Gapped {
+				got += " gapped"
+			}
+			if got != tc.expected[i] {
+				t.Errorf("%+v: got %s, want %s", tc.opts, got, tc.expected[i])
+			}
+			if g.Similarity <= 0.8 || g.Similarity >= 1 {
+				t.Errorf("%+v: got similarity %f for %s", tc.opts, g.Similarity, got)
+			}
+		}
+	}
+}
+
+func Test_SideBySide(t *testing.T) {
+	expected := []DiffLine{
+		{Op: DiffEqual, Left: "a", Right: "a", LeftLine: 1, RightLine: 1},
+		{Op: DiffChanged, Left: "b", Right: "x", LeftLine: 2, RightLine: 2},
+		{Op: DiffAdded, Right: "y", RightLine: 3},
+		{Op: DiffEqual, Left: "c", Right: "c", LeftLine: 3, RightLine: 4},
+		{Op: DiffDeleted, Left: "d", LeftLine: 4},
+	}
+	lines := SideBySide("a\nb\nc\nd", "a\nx\ny\nc")
+	if len(lines) != len(expected) {
+		t.Fatalf("got %+v, want %+v", lines, expected)
+	}
+	for i := range lines {
+		if lines[i] != expected[i] {
+			t.Errorf("got %+v, want %+v", lines[i], expected[i])
+		}
+	}
+}
+
+func Test_TokenSimilarity(t *testing.T) {
+	if s := tokenSimilarity(tokens("a := b + 1"), tokens("a := b + 1 // sum")); s != 1 {
+		t.Errorf("got %f, want 1 ignoring comments", s)
+	}
+	if s := tokenSimilarity(tokens("a := b + 1"), tokens("c := b + 1")); s != 0.8 {
+		t.Errorf("got %f, want 0.8", s)
+	}
+}
+
+func Test_Index(t *testing.T) {
+	dir, err := ioutil.TempDir("", "copycheck")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	repo := filepath.Join(dir, "other")
+	orig, err := ioutil.ReadFile("testdata/corpus/other/orig.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Mkdir(repo, 0755); err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(repo, "orig.go")
+	if err = ioutil.WriteFile(file, orig, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	index := NewIndex(0)
+	if updated, removed, err := index.Update(repo); err != nil || updated != 1 || removed != 0 {
+		t.Fatalf("got %d updated and %d removed files, %v; want 1 and 0", updated, removed, err)
+	}
+	if updated, removed, _ := index.Update(repo); updated != 0 || removed != 0 {
+		t.Errorf("got %d updated and %d removed files again, want none", updated, removed)
+	}
+	if err = index.Save(filepath.Join(dir, "index.json")); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := LoadIndex(filepath.Join(dir, "index.json"), DefaultThreshold)
+	if err != nil || len(saved.Files) != 1 || len(saved.Files[file].Fingerprints) != len(index.Files[file].Fingerprints) {
+		t.Fatalf("got %+v, %v; want the saved index", saved, err)
+	}
+	if _, err = LoadIndex(filepath.Join(dir, "index.json"), 40); err == nil {
+		t.Errorf("got the saved index for the threshold 40, want an error")
+	}
+
+	// the copy is compared with the original while it is unchanged, then
+	// found from the fingerprints of the saved index only.
+	testCases := []struct {
+		expected string
+		code     bool
+	}{
+		{"18,38 13,33", true},
+		{"18,33 13,28", false},
+	}
+	for _, tc := range testCases {
+		groups := saved.Clones("testdata/corpus/project", "", Options{})
+		if len(groups) != 1 || len(groups[0].Fragments) != 2 {
+			t.Fatalf("got %+v, want a fragment and its copy", groups)
+		}
+		a, b := groups[0].Fragments[0], groups[0].Fragments[1]
+		if got := fmt.Sprintf("%d,%d %d,%d", a.Start, a.End, b.Start, b.End); got != tc.expected {
+			t.Errorf("got %s, want %s", got, tc.expected)
+		}
+		if b.File != file || b.Repo != repo || (b.Code != "") != tc.code {
+			t.Errorf("unexpected copy %+v", b)
+		}
+		if err = os.Chtimes(file, time.Unix(0, 0), time.Unix(0, 0)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if updated, removed, _ := index.Update(repo); updated != 1 || removed != 0 {
+		t.Errorf("got %d updated and %d removed files, want the modified one updated", updated, removed)
+	}
+	os.Remove(file)
+	if updated, removed, _ := index.Update(repo); updated != 0 || removed != 1 || len(index.Files) != 0 {
+		t.Errorf("got %d updated and %d removed files, want the deleted one removed", updated, removed)
+	}
 }
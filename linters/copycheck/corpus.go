@@ -0,0 +1,467 @@
+package copycheck
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/suffixtree"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/syntax"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/syntax/golang"
+	"github.com/golang/glog"
+)
+
+// indexVersion is the version of the index format.
+const indexVersion = 1
+
+// Index is an index of the Go files of other repositories, the corpus, to
+// find the code of a project copied from or to them. A file is indexed by
+// fingerprints, hashes of some of its sequences of K syntax nodes chosen by
+// winnowing: of every W consecutive sequences, the one of lowest hash is
+// kept, so that a copy of K+W-1 nodes, the clone threshold, always shares a
+// fingerprint with the original. As with the Normalize option of Clones, the
+// names of identifiers, the values of literals and the operators are ignored.
+type Index struct {
+	Version   int                     `json:"version"`
+	Threshold int                     `json:"threshold"`
+	Files     map[string]*IndexedFile `json:"files"`
+}
+
+// IndexedFile is a file of the repository Repo, indexed when it had the size
+// Size and the modification time ModTime, in nanoseconds since 1970.
+type IndexedFile struct {
+	Repo         string        `json:"repo"`
+	Size         int64         `json:"size"`
+	ModTime      int64         `json:"mod_time"`
+	Fingerprints []Fingerprint `json:"fingerprints"`
+}
+
+// Fingerprint is the hash of the K syntax nodes of a file from its node
+// Node, in its serialized syntax tree, on the lines Start to End.
+type Fingerprint struct {
+	Hash  uint64 `json:"h"`
+	Node  int    `json:"n"`
+	Start int    `json:"s"`
+	End   int    `json:"e"`
+}
+
+// NewIndex returns an empty index for clones of at least threshold syntax
+// nodes, DefaultThreshold if it is not set.
+func NewIndex(threshold int) *Index {
+	return &Index{
+		Version:   indexVersion,
+		Threshold: Options{Threshold: threshold}.threshold(),
+		Files:     make(map[string]*IndexedFile),
+	}
+}
+
+// LoadIndex reads the index saved in the file path. An index of another
+// format or of another clone threshold than threshold, DefaultThreshold if
+// not set, cannot be used and is returned as an error.
+func LoadIndex(path string, threshold int) (*Index, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	index := NewIndex(threshold)
+	threshold = index.Threshold
+	if err = json.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if index.Version != indexVersion || index.Threshold != threshold {
+		return nil, fmt.Errorf("%s: index of version %d and threshold %d, want version %d and threshold %d",
+			path, index.Version, index.Threshold, indexVersion, threshold)
+	}
+	if index.Files == nil {
+		index.Files = make(map[string]*IndexedFile)
+	}
+	return index, nil
+}
+
+// Save writes the index to the file path, replacing it at once.
+func (index *Index) Save(path string) error {
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if err = ioutil.WriteFile(path+".tmp", data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(path+".tmp", path)
+}
+
+// Update indexes the Go files of the repository root, except the test files
+// and the vendor directories. Only the files added or modified since the
+// last update are parsed again, the files removed from the repository are
+// removed from the index. It returns the number of files indexed and removed.
+func (index *Index) Update(root string) (updated, removed int, err error) {
+	repo, err := filepath.Abs(root)
+	if err != nil {
+		return 0, 0, err
+	}
+	if _, err = os.Stat(repo); err != nil {
+		return 0, 0, err
+	}
+	seen := make(map[string]bool)
+	for path := range crawlPaths([]string{repo}, "", Options{}) {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+		seen[path] = true
+		if f, ok := index.Files[path]; ok && f.Repo == repo && f.Size == info.Size() && f.ModTime == info.ModTime().UnixNano() {
+			continue
+		}
+		file, err := ioutil.ReadFile(path)
+		if err != nil {
+			glog.Errorln(err)
+			delete(index.Files, path)
+			continue
+		}
+		nodes, err := parseFile(path)
+		if err != nil {
+			glog.Warningln(err)
+			delete(index.Files, path)
+			continue
+		}
+		index.Files[path] = &IndexedFile{
+			Repo:         repo,
+			Size:         info.Size(),
+			ModTime:      info.ModTime().UnixNano(),
+			Fingerprints: index.fingerprints(nodes, file),
+		}
+		updated++
+	}
+	for path, f := range index.Files {
+		if f.Repo == repo && !seen[path] {
+			delete(index.Files, path)
+			removed++
+		}
+	}
+	return updated, removed, nil
+}
+
+// Retain removes from the index the files of the repositories other than
+// roots.
+func (index *Index) Retain(roots []string) {
+	repos := make(map[string]bool, len(roots))
+	for _, root := range roots {
+		if repo, err := filepath.Abs(root); err == nil {
+			repos[repo] = true
+		}
+	}
+	for path, f := range index.Files {
+		if !repos[f.Repo] {
+			delete(index.Files, path)
+		}
+	}
+}
+
+// Add adds the files of other to the index, the files already indexed are
+// kept. An index of another clone threshold cannot be added.
+func (index *Index) Add(other *Index) error {
+	if other.Threshold != index.Threshold {
+		return fmt.Errorf("index of threshold %d, want threshold %d", other.Threshold, index.Threshold)
+	}
+	for path, f := range other.Files {
+		if _, ok := index.Files[path]; !ok {
+			index.Files[path] = f
+		}
+	}
+	return nil
+}
+
+// Clones finds the code of the Go files under projectPath, except those
+// matching except or skipped by opts, copied in the files of the index; the
+// clone threshold is the one of the index. Every group holds a fragment of
+// the project followed by its copies in the corpus, their Repo set. When the
+// file of a copy has not changed since it was indexed, the copy is compared
+// with the fragment node by node and reported whole, with its code;
+// otherwise, as for an index without the sources, its lines are those of its
+// fingerprints and it has no code.
+func (index *Index) Clones(projectPath string, except string, opts Options) []Group {
+	project, err := filepath.Abs(projectPath)
+	if err != nil {
+		glog.Errorln(err)
+		return nil
+	}
+	postings := make(map[uint64][]posting)
+	for path, f := range index.Files {
+		if path == project || strings.HasPrefix(path, project+string(filepath.Separator)) {
+			continue
+		}
+		for i := range f.Fingerprints {
+			fp := &f.Fingerprints[i]
+			postings[fp.Hash] = append(postings[fp.Hash], posting{file: path, fp: fp})
+		}
+	}
+	if len(postings) == 0 {
+		return nil
+	}
+
+	k, w := index.windows()
+	c := &corpusClones{
+		index:   index,
+		files:   make(map[string][]byte),
+		sources: make(map[string][]*syntax.Node),
+		groups:  make(map[string]*Group),
+	}
+	for path := range crawlPaths([]string{projectPath}, except, opts) {
+		nodes, err := parseFile(path)
+		if err != nil {
+			glog.Warningln(err)
+			continue
+		}
+		chains := make(map[chainKey][]hit)
+		for i := 0; i+k <= len(nodes); i++ {
+			for _, p := range postings[hash64(nodes[i:i+k])] {
+				key := chainKey{file: p.file, diag: p.fp.Node - i}
+				chains[key] = append(chains[key], hit{node: i, fp: p.fp})
+			}
+		}
+		keys := make([]chainKey, 0, len(chains))
+		for key := range chains {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			if keys[i].file != keys[j].file {
+				return keys[i].file < keys[j].file
+			}
+			return keys[i].diag < keys[j].diag
+		})
+		for _, key := range keys {
+			hits := chains[key]
+			run := 0
+			for i := 1; i <= len(hits); i++ {
+				if i == len(hits) || hits[i].node-hits[i-1].node > w {
+					c.add(path, nodes, key, hits[run:i])
+					run = i
+				}
+			}
+		}
+	}
+
+	groups := make([]Group, 0, len(c.order))
+	for _, key := range c.order {
+		group := c.groups[key]
+		group.Similarity = similarity(group.Fragments)
+		groups = append(groups, *group)
+	}
+	sortGroups(groups)
+	return groups
+}
+
+type posting struct {
+	file string
+	fp   *Fingerprint
+}
+
+// chainKey is a file of the corpus and the offset of its nodes matching the
+// nodes of a project file.
+type chainKey struct {
+	file string
+	diag int
+}
+
+// hit is a node of a project file starting a sequence of the fingerprint fp.
+type hit struct {
+	node int
+	fp   *Fingerprint
+}
+
+// corpusClones gathers the clones of the project in the corpus, by fragment
+// of the project.
+type corpusClones struct {
+	index   *Index
+	files   map[string][]byte
+	sources map[string][]*syntax.Node
+	groups  map[string]*Group
+	order   []string
+}
+
+// add adds the clone of the nodes of the project file path found by the
+// consecutive hits of the same offset in the corpus file key.file.
+func (c *corpusClones) add(path string, nodes []*syntax.Node, key chainKey, hits []hit) {
+	k, _ := c.index.windows()
+	first, last := hits[0], hits[len(hits)-1]
+	repo := c.index.Files[key.file].Repo
+	var fragment, other Fragment
+	if source := c.source(key.file); source != nil {
+		begin, end := first.node, last.node+k
+		for begin > 0 && begin+key.diag > 0 && nodes[begin-1].Val() == source[begin-1+key.diag].Val() {
+			begin--
+		}
+		for end < len(nodes) && end+key.diag < len(source) && nodes[end].Val() == source[end+key.diag].Val() {
+			end++
+		}
+		data := make([]*syntax.Node, 0, len(nodes)+len(source))
+		data = append(append(data, nodes...), source...)
+		m := syntax.FindLargestUnits(data, suffixtree.Match{
+			Ps:  []suffixtree.Pos{suffixtree.Pos(begin), suffixtree.Pos(len(nodes) + begin + key.diag)},
+			Len: suffixtree.Pos(end - begin),
+		})
+		if len(m.Frags) != 2 {
+			return
+		}
+		size := 0
+		for _, unit := range m.Frags[0] {
+			size += unit.Owns + 1
+		}
+		if size < c.index.Threshold {
+			return
+		}
+		units, copies := m.Frags[0], m.Frags[1]
+		fragment = Fragment{File: path, pos: units[0].Pos, end: units[len(units)-1].End}
+		other = Fragment{File: key.file, Repo: repo, pos: copies[0].Pos, end: copies[len(copies)-1].End}
+		if !setLines(&other, c.files) {
+			return
+		}
+	} else {
+		if last.node+k-first.node < c.index.Threshold {
+			return
+		}
+		fragment = Fragment{File: path}
+		fragment.pos, _ = extent(nodes[first.node : first.node+k])
+		_, fragment.end = extent(nodes[last.node : last.node+k])
+		other = Fragment{File: key.file, Repo: repo, Start: first.fp.Start, End: last.fp.End}
+	}
+	if !setLines(&fragment, c.files) {
+		return
+	}
+
+	id := fmt.Sprintf("%s:%d,%d", fragment.File, fragment.Start, fragment.End)
+	group, ok := c.groups[id]
+	if !ok {
+		group = &Group{Fragments: []Fragment{fragment}}
+		c.groups[id] = group
+		c.order = append(c.order, id)
+	}
+	for _, f := range group.Fragments[1:] {
+		if f.File == other.File && f.Start == other.Start && f.End == other.End {
+			return
+		}
+	}
+	group.Fragments = append(group.Fragments, other)
+}
+
+// source returns the syntax nodes of the corpus file path, or nil if it
+// changed since it was indexed.
+func (c *corpusClones) source(path string) []*syntax.Node {
+	if nodes, ok := c.sources[path]; ok {
+		return nodes
+	}
+	var nodes []*syntax.Node
+	f := c.index.Files[path]
+	if info, err := os.Stat(path); err == nil && info.Size() == f.Size && info.ModTime().UnixNano() == f.ModTime {
+		nodes, _ = parseFile(path)
+	}
+	c.sources[path] = nodes
+	return nodes
+}
+
+// windows returns the number of nodes K hashed by a fingerprint and the
+// number of consecutive sequences W a fingerprint is chosen from.
+func (index *Index) windows() (k, w int) {
+	k = index.Threshold / 2
+	if k < 1 {
+		k = 1
+	}
+	w = index.Threshold - k
+	if w < 1 {
+		w = 1
+	}
+	return k, w
+}
+
+// fingerprints returns the fingerprints of the syntax nodes of file, chosen
+// by winnowing.
+func (index *Index) fingerprints(nodes []*syntax.Node, file []byte) []Fingerprint {
+	k, w := index.windows()
+	prints := make([]Fingerprint, 0)
+	if len(nodes) < k {
+		return prints
+	}
+	hashes := make([]uint64, len(nodes)-k+1)
+	for i := range hashes {
+		hashes[i] = hash64(nodes[i : i+k])
+	}
+	lines := lineStarts(file)
+	chosen := -1
+	for start := 0; ; start++ {
+		end := start + w
+		if end > len(hashes) {
+			end = len(hashes)
+		}
+		min := start
+		for i := start + 1; i < end; i++ {
+			if hashes[i] <= hashes[min] {
+				min = i
+			}
+		}
+		if min != chosen {
+			chosen = min
+			pos, endPos := extent(nodes[min : min+k])
+			prints = append(prints, Fingerprint{
+				Hash:  hashes[min],
+				Node:  min,
+				Start: lineAt(lines, pos),
+				End:   lineAt(lines, endPos-1),
+			})
+		}
+		if end == len(hashes) {
+			break
+		}
+	}
+	return prints
+}
+
+// hash64 returns the first 64 bits of the hash of nodes.
+func hash64(nodes []*syntax.Node) uint64 {
+	return binary.BigEndian.Uint64([]byte(syntax.HashSeq(nodes)))
+}
+
+// extent returns the offsets of the code of nodes, a sequence of the
+// serialized syntax tree: from the start of the first node to the end of the
+// last node whose subtree is in the sequence.
+func extent(nodes []*syntax.Node) (pos, end int) {
+	pos, end = nodes[0].Pos, nodes[0].Pos+1
+	for i, n := range nodes {
+		if i+n.Owns < len(nodes) && n.End > end {
+			end = n.End
+		}
+	}
+	return pos, end
+}
+
+// lineStarts returns the offsets of the lines of file.
+func lineStarts(file []byte) []int {
+	starts := []int{0}
+	for i, b := range file {
+		if b == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return starts
+}
+
+// lineAt returns the line, from 1, of the offset of a file whose lines start
+// at starts.
+func lineAt(starts []int, offset int) int {
+	return sort.Search(len(starts), func(i int) bool {
+		return starts[i] > offset
+	})
+}
+
+// parseFile returns the serialized syntax tree of the Go file path.
+func parseFile(path string) ([]*syntax.Node, error) {
+	ast, err := golang.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	return syntax.Serialize(ast), nil
+}
@@ -0,0 +1,150 @@
+package copycheck
+
+import (
+	"go/scanner"
+	"go/token"
+	"strings"
+)
+
+// Operations of a DiffLine.
+const (
+	DiffEqual   = "="
+	DiffChanged = "~"
+	DiffDeleted = "-"
+	DiffAdded   = "+"
+)
+
+// DiffLine is a row of a side-by-side diff, the line LeftLine of the left
+// text and the line RightLine of the right one, numbered from 1. A deleted
+// line has no right line and an added one no left line, their number is 0.
+type DiffLine struct {
+	Op        string `json:"op"`
+	Left      string `json:"left"`
+	Right     string `json:"right"`
+	LeftLine  int    `json:"left_line"`
+	RightLine int    `json:"right_line"`
+}
+
+// SideBySide returns the side-by-side diff of the lines of left and right.
+// The deleted lines followed by added ones are paired as changed lines.
+func SideBySide(left, right string) []DiffLine {
+	a, b := strings.Split(left, "\n"), strings.Split(right, "\n")
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	lines := make([]DiffLine, 0, len(a)+len(b))
+	var deleted, added []int
+	flush := func() {
+		for k := 0; k < len(deleted) || k < len(added); k++ {
+			line := DiffLine{Op: DiffChanged}
+			if k < len(deleted) {
+				line.Left, line.LeftLine = a[deleted[k]], deleted[k]+1
+			} else {
+				line.Op = DiffAdded
+			}
+			if k < len(added) {
+				line.Right, line.RightLine = b[added[k]], added[k]+1
+			} else {
+				line.Op = DiffDeleted
+			}
+			lines = append(lines, line)
+		}
+		deleted, added = deleted[:0], added[:0]
+	}
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			lines = append(lines, DiffLine{Op: DiffEqual, Left: a[i], Right: b[j], LeftLine: i + 1, RightLine: j + 1})
+			i, j = i+1, j+1
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			deleted = append(deleted, i)
+			i++
+		default:
+			added = append(added, j)
+			j++
+		}
+	}
+	flush()
+	return lines
+}
+
+// similarity returns the lowest token similarity between the first fragment
+// and the others, 0 if none of the others has code.
+func similarity(fragments []Fragment) float64 {
+	first := tokens(fragments[0].Code)
+	min, compared := 1.0, false
+	for _, f := range fragments[1:] {
+		if f.Code == "" {
+			continue
+		}
+		if s := tokenSimilarity(first, tokens(f.Code)); s < min {
+			min = s
+		}
+		compared = true
+	}
+	if !compared {
+		return 0
+	}
+	return min
+}
+
+// tokenSimilarity returns 2*LCS(a, b)/(len(a)+len(b)), 1 if both are empty.
+func tokenSimilarity(a, b []string) float64 {
+	if len(a)+len(b) == 0 {
+		return 1
+	}
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else if prev[j+1] >= cur[j] {
+				cur[j+1] = prev[j+1]
+			} else {
+				cur[j+1] = cur[j]
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return float64(2*prev[len(b)]) / float64(len(a)+len(b))
+}
+
+// tokens returns the Go tokens of code, the literal of the identifiers and
+// the literals, the comments skipped.
+func tokens(code string) []string {
+	var s scanner.Scanner
+	fset := token.NewFileSet()
+	src := []byte(code)
+	s.Init(fset.AddFile("", fset.Base(), len(src)), src, func(token.Position, string) {}, 0)
+	toks := make([]string, 0)
+	for {
+		_, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+		if lit != "" {
+			toks = append(toks, lit)
+		} else {
+			toks = append(toks, tok.String())
+		}
+	}
+	return toks
+}
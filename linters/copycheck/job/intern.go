@@ -0,0 +1,30 @@
+package job
+
+import (
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/syntax"
+)
+
+// Intern sets the value of every named node of the sequences to a key of its
+// name, so that the suffix tree only matches sequences with the same names.
+func Intern(schan chan []*syntax.Node) chan []*syntax.Node {
+	keys := make(map[string]int)
+	ichan := make(chan []*syntax.Node)
+	go func() {
+		for seq := range schan {
+			for _, node := range seq {
+				if node.Name == "" {
+					continue
+				}
+				key, ok := keys[node.Name]
+				if !ok {
+					key = len(keys) + 1
+					keys[node.Name] = key
+				}
+				node.Value = key
+			}
+			ichan <- seq
+		}
+		close(ichan)
+	}()
+	return ichan
+}
@@ -96,6 +96,7 @@ func (t *transformer) trans(node ast.Node) (o *syntax.Node) {
 
 	case *ast.AssignStmt:
 		o.Type = AssignStmt
+		o.Name = n.Tok.String()
 		for _, e := range n.Rhs {
 			o.AddChildren(t.trans(e))
 		}
@@ -106,9 +107,11 @@ func (t *transformer) trans(node ast.Node) (o *syntax.Node) {
 
 	case *ast.BasicLit:
 		o.Type = BasicLit
+		o.Name = n.Value
 
 	case *ast.BinaryExpr:
 		o.Type = BinaryExpr
+		o.Name = n.Op.String()
 		o.AddChildren(t.trans(n.X), t.trans(n.Y))
 
 	case *ast.BlockStmt:
@@ -119,6 +122,7 @@ func (t *transformer) trans(node ast.Node) (o *syntax.Node) {
 
 	case *ast.BranchStmt:
 		o.Type = BranchStmt
+		o.Name = n.Tok.String()
 		if n.Label != nil {
 			o.AddChildren(t.trans(n.Label))
 		}
@@ -251,6 +255,7 @@ func (t *transformer) trans(node ast.Node) (o *syntax.Node) {
 
 	case *ast.Ident:
 		o.Type = Ident
+		o.Name = n.Name
 
 	case *ast.IfStmt:
 		o.Type = IfStmt
@@ -264,6 +269,7 @@ func (t *transformer) trans(node ast.Node) (o *syntax.Node) {
 
 	case *ast.IncDecStmt:
 		o.Type = IncDecStmt
+		o.Name = n.Tok.String()
 		o.AddChildren(t.trans(n.X))
 
 	case *ast.IndexExpr:
@@ -369,6 +375,7 @@ func (t *transformer) trans(node ast.Node) (o *syntax.Node) {
 
 	case *ast.UnaryExpr:
 		o.Type = UnaryExpr
+		o.Name = n.Op.String()
 		o.AddChildren(t.trans(n.X))
 
 	case *ast.ValueSpec:
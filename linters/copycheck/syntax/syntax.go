@@ -2,16 +2,25 @@ package syntax
 
 import (
 	"crypto/sha1"
+	"encoding/binary"
 
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/copycheck/suffixtree"
 )
 
+// Node is a node of the uniform syntax tree. Name is the name of an
+// identifier, the value of a literal or the operator of an expression, and
+// Value, if not zero, its key once interned: a serialized stream only
+// matches another one with the same names if the values are set. Depth is
+// the depth of the node in the tree, set by Serialize.
 type Node struct {
 	Type     int
 	Filename string
 	Pos, End int
 	Children []*Node
 	Owns     int
+	Name     string
+	Value    int
+	Depth    int
 }
 
 func NewNode() *Node {
@@ -22,8 +31,9 @@ func (n *Node) AddChildren(children ...*Node) {
 	n.Children = append(n.Children, children...)
 }
 
+// Val is the symbol of the node in the suffix tree, its type and value.
 func (n *Node) Val() int {
-	return n.Type
+	return n.Type | n.Value<<8
 }
 
 type Match struct {
@@ -33,15 +43,16 @@ type Match struct {
 
 func Serialize(n *Node) []*Node {
 	stream := make([]*Node, 0, 10)
-	serial(n, &stream)
+	serial(n, &stream, 0)
 	return stream
 }
 
-func serial(n *Node, stream *[]*Node) int {
+func serial(n *Node, stream *[]*Node, depth int) int {
 	*stream = append(*stream, n)
+	n.Depth = depth
 	var count int
 	for _, child := range n.Children {
-		count += serial(child, stream)
+		count += serial(child, stream, depth+1)
 	}
 	n.Owns = count
 	return count + 1
@@ -54,8 +65,24 @@ func FindSyntaxUnits(data []*Node, m suffixtree.Match, threshold int) Match {
 		return Match{}
 	}
 	firstSeq := data[m.Ps[0] : m.Ps[0]+m.Len]
-	indexes := getUnitsIndexes(firstSeq, threshold)
+	return unitsMatch(data, m, firstSeq, getUnitsIndexes(firstSeq, threshold))
+}
+
+// FindLargestUnits is like FindSyntaxUnits but keeps the run of consecutive
+// complete syntax units covering the most nodes, whatever the size of each
+// unit. It finds the exact parts of a clone cut by an edit, which rarely end
+// on large units.
+func FindLargestUnits(data []*Node, m suffixtree.Match) Match {
+	if len(m.Ps) == 0 {
+		return Match{}
+	}
+	firstSeq := data[m.Ps[0] : m.Ps[0]+m.Len]
+	return unitsMatch(data, m, firstSeq, getLargestUnitsIndexes(firstSeq))
+}
 
+// unitsMatch returns the nodes at indexes of every sequence of m, firstSeq
+// being the first one.
+func unitsMatch(data []*Node, m suffixtree.Match, firstSeq []*Node, indexes []int) Match {
 	// TODO: is this really working?
 	indexCnt := len(indexes)
 	if indexCnt > 0 {
@@ -82,10 +109,37 @@ func FindSyntaxUnits(data []*Node, m suffixtree.Match, threshold int) Match {
 	}
 
 	lastIndex := indexes[len(indexes)-1]
-	match.Hash = hashSeq(firstSeq[indexes[0] : lastIndex+firstSeq[lastIndex].Owns])
+	match.Hash = HashSeq(firstSeq[indexes[0] : lastIndex+firstSeq[lastIndex].Owns])
 	return match
 }
 
+// getLargestUnitsIndexes returns the indexes of the run of complete sibling
+// syntax units of nodeSeq owning the most nodes. A sequence starting inside a
+// syntax unit first holds the ends of its children, a run ends when going up.
+func getLargestUnitsIndexes(nodeSeq []*Node) []int {
+	var indexes, best []int
+	var size, bestSize int
+	for i := 0; i < len(nodeSeq); {
+		n := nodeSeq[i]
+		if n.Owns >= len(nodeSeq)-i {
+			// not complete syntax unit
+			indexes, size = nil, 0
+			i++
+			continue
+		}
+		if len(indexes) > 0 && n.Depth != nodeSeq[indexes[0]].Depth {
+			indexes, size = nil, 0
+		}
+		indexes = append(indexes, i)
+		size += n.Owns + 1
+		if size > bestSize {
+			best, bestSize = indexes, size
+		}
+		i += n.Owns + 1
+	}
+	return best
+}
+
 func getUnitsIndexes(nodeSeq []*Node, threshold int) []int {
 	var indexes []int
 	var split bool
@@ -134,7 +188,7 @@ func isCyclic(indexes []int, nodes []*Node) bool {
 				index := i + indexes[j]
 				if index < len(nodes) {
 					nalt := nodes[index]
-					if nstart.Owns == nalt.Owns && nstart.Type == nalt.Type {
+					if nstart.Owns == nalt.Owns && nstart.Val() == nalt.Val() {
 						continue
 					}
 				} else if i >= indexes[alt] {
@@ -164,12 +218,19 @@ func spansMultipleFiles(indexes []int, nodes []*Node) bool {
 	return false
 }
 
-func hashSeq(nodes []*Node) string {
+// HashSeq hashes the types of nodes, followed by their values if any is set.
+func HashSeq(nodes []*Node) string {
 	h := sha1.New()
 	bytes := make([]byte, len(nodes))
+	values := make([]byte, 0)
 	for i, node := range nodes {
 		bytes[i] = byte(node.Type)
+		if node.Value != 0 {
+			values = binary.AppendUvarint(values, uint64(i))
+			values = binary.AppendUvarint(values, uint64(node.Value))
+		}
 	}
 	h.Write(bytes)
+	h.Write(values)
 	return string(h.Sum(nil))
 }
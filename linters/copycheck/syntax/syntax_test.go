@@ -68,6 +68,35 @@ Loop:
 	}
 }
 
+func TestGetLargestUnitsIndexes(t *testing.T) {
+	n := genNodes(6)
+	n[0].AddChildren(n[1], n[4], n[5])
+	n[1].AddChildren(n[2], n[3])
+	stream := Serialize(n[0])
+	testCases := []struct {
+		seq      []*Node
+		expected []int
+	}{
+		{stream[3:], []int{1, 2}},
+		{stream[:4], []int{1}},
+		{stream[1:], []int{0, 3, 4}},
+	}
+
+	for _, tc := range testCases {
+		indexes := getLargestUnitsIndexes(tc.seq)
+		if len(indexes) != len(tc.expected) {
+			t.Errorf("got %v, want %v", indexes, tc.expected)
+			continue
+		}
+		for i := range indexes {
+			if indexes[i] != tc.expected[i] {
+				t.Errorf("got %v, want %v", indexes, tc.expected)
+				break
+			}
+		}
+	}
+}
+
 func TestCyclicDupl(t *testing.T) {
 	testCases := []struct {
 		seq      string
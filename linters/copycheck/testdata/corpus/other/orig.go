@@ -0,0 +1,33 @@
+package other
+
+import (
+	"fmt"
+	"strings"
+)
+
+func Version() string {
+	return "1.0"
+}
+
+// Render renders a table of names and values.
+func Render(names []string, values map[string]int) string {
+	width := 0
+	for _, name := range names {
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+	var b strings.Builder
+	for i, name := range names {
+		value, ok := values[name]
+		if !ok {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("%d. %-*s %d\n", i+1, width, name, value))
+		if value > 100 {
+			b.WriteString(strings.Repeat("*", value/100))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
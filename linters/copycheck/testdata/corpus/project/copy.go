@@ -0,0 +1,38 @@
+package project
+
+import (
+	"fmt"
+	"strings"
+)
+
+type report struct {
+	keys   []string
+	counts map[string]int
+}
+
+func (r *report) String() string {
+	return table(r.keys, r.counts)
+}
+
+// table is Render of the other repository, renamed.
+func table(keys []string, counts map[string]int) string {
+	max := 0
+	for _, key := range keys {
+		if len(key) > max {
+			max = len(key)
+		}
+	}
+	var out strings.Builder
+	for n, key := range keys {
+		count, found := counts[key]
+		if !found {
+			continue
+		}
+		out.WriteString(fmt.Sprintf("%d. %-*s %d\n", n+1, max, key, count))
+		if count > 100 {
+			out.WriteString(strings.Repeat("*", count/100))
+			out.WriteString("\n")
+		}
+	}
+	return out.String()
+}
@@ -0,0 +1,93 @@
+package near
+
+import "strings"
+
+type record struct {
+	name  string
+	tags  []string
+	score int
+}
+
+// parseRecords and parseEntries are the same code with other names.
+func parseRecords(lines []string) []record {
+	records := make([]record, 0, len(lines))
+	for _, line := range lines {
+		fields := strings.Split(line, ";")
+		if len(fields) < 3 {
+			continue
+		}
+		r := record{name: strings.TrimSpace(fields[0])}
+		for _, tag := range strings.Split(fields[1], ",") {
+			if tag != "" {
+				r.tags = append(r.tags, strings.ToLower(tag))
+			}
+		}
+		r.score = len(fields[2]) * 2
+		records = append(records, r)
+	}
+	return records
+}
+
+func parseEntries(rows []string) []record {
+	entries := make([]record, 0, len(rows))
+	for _, row := range rows {
+		cols := strings.Split(row, ";")
+		if len(cols) < 3 {
+			continue
+		}
+		e := record{name: strings.TrimSpace(cols[0])}
+		for _, label := range strings.Split(cols[1], ",") {
+			if label != "" {
+				e.tags = append(e.tags, strings.ToLower(label))
+			}
+		}
+		e.score = len(cols[2]) * 2
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+// summarize and describe only differ by one statement.
+func summarize(records []record) string {
+	var b strings.Builder
+	total := 0
+	for _, r := range records {
+		b.WriteString(strings.ToUpper(r.name))
+		b.WriteString(": ")
+		b.WriteString(strings.Join(r.tags, ", "))
+		b.WriteString("\n")
+		total += r.score
+	}
+	b.WriteString(strings.Repeat("-", 20))
+	b.WriteString("\n")
+	for i, r := range records {
+		if r.score > total/len(records) {
+			b.WriteString(strings.Repeat(" ", i))
+			b.WriteString(strings.ToLower(r.name))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+func describe(records []record) string {
+	var b strings.Builder
+	total := 0
+	for _, r := range records {
+		b.WriteString(strings.ToUpper(r.name))
+		b.WriteString(": ")
+		b.WriteString(strings.Join(r.tags, ", "))
+		b.WriteString("\n")
+		total += r.score
+	}
+	panic(total)
+	b.WriteString("\n")
+	for i, r := range records {
+		if r.score > total/len(records) {
+			b.WriteString(strings.Repeat(" ", i))
+			b.WriteString(strings.ToLower(r.name))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
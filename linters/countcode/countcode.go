@@ -1,30 +1,77 @@
 package countcode
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 
 	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 )
 
+// languages are the languages counted, the first one matching a file name
+// wins.
 var languages = []Language{
-	Language{"Go", mExt(".go"), cComments},
+	Language{"Go", mExt(".go"), goComments},
+	Language{"Assembly", mExt(".s"), Commenter{`//`, `/*`, `*/`, false, []Quote{dQuote, sQuote}}},
+	Language{"C", mExt(".c", ".h"), cComments},
+	Language{"C++", mExt(".cc", ".cpp", ".cxx", ".hpp", ".hh"), cComments},
+	Language{"Protocol Buffers", mExt(".proto"), cComments},
+	Language{"JavaScript", mExt(".js", ".mjs", ".cjs", ".jsx"), jsComments},
+	Language{"TypeScript", mExt(".ts", ".tsx"), jsComments},
+	Language{"CSS", mExt(".css", ".scss", ".less"), Commenter{"", `/*`, `*/`, false, []Quote{dQuote, sQuote}}},
+	Language{"HTML", mExt(".html", ".htm", ".tmpl", ".gohtml"), xmlComments},
+	Language{"XML", mExt(".xml", ".svg"), xmlComments},
+	Language{"SQL", mExt(".sql"), Commenter{`--`, `/*`, `*/`, false, []Quote{rawSQuote, dQuote}}},
+	Language{"Shell", mExt(".sh", ".bash", ".zsh"), hashComments},
+	Language{"Python", mExt(".py"), Commenter{`#`, "", "", false, []Quote{
+		Quote{`"""`, `"""`, true, true}, Quote{`'''`, `'''`, true, true}, dQuote, sQuote}}},
+	Language{"YAML", mExt(".yml", ".yaml"), hashComments},
+	Language{"TOML", mExt(".toml"), hashComments},
+	Language{"Makefile", mOr(mName("Makefile", "makefile", "GNUmakefile"), mExt(".mk")), hashComments},
+	Language{"Dockerfile", mOr(mName("Dockerfile"), mExt(".dockerfile")), hashComments},
+	Language{"JSON", mExt(".json"), Commenter{"", "", "", false, []Quote{dQuote}}},
+	Language{"Markdown", mExt(".md", ".markdown"), xmlComments},
 }
 
+// Commenter is the comment syntax of a language. The comment markers are
+// ignored inside the Quotes, and a block comment may contain other block
+// comments if Nesting is set. Empty markers are never matched.
 type Commenter struct {
 	LineComment  string
 	StartComment string
 	EndComment   string
 	Nesting      bool
+	Quotes       []Quote
+}
+
+// Quote is a string literal delimited by Start and End. A backslash escapes
+// the next character if Escape is set, and only a Multiline string goes on
+// after the end of the line.
+type Quote struct {
+	Start     string
+	End       string
+	Escape    bool
+	Multiline bool
 }
 
 var (
-	noComments = Commenter{"\000", "\000", "\000", false}
-	cComments  = Commenter{`//`, `/*`, `*/`, false}
+	dQuote    = Quote{`"`, `"`, true, false}
+	sQuote    = Quote{`'`, `'`, true, false}
+	rawSQuote = Quote{`'`, `'`, false, false}
+
+	noComments   = Commenter{}
+	cComments    = Commenter{`//`, `/*`, `*/`, false, []Quote{dQuote, sQuote}}
+	goComments   = Commenter{`//`, `/*`, `*/`, false, []Quote{dQuote, sQuote, Quote{"`", "`", false, true}}}
+	jsComments   = Commenter{`//`, `/*`, `*/`, false, []Quote{dQuote, sQuote, Quote{"`", "`", true, true}}}
+	xmlComments  = Commenter{"", `<!--`, `-->`, false, nil}
+	hashComments = Commenter{`#`, "", "", false, []Quote{dQuote, rawSQuote}}
 )
 
 type Language struct {
@@ -33,73 +80,98 @@ type Language struct {
 	Commenter
 }
 
-// TODO work properly with unicode
+// Update adds the lines of the file content c to s. A line holding any code,
+// string literals included, is a code line, a line holding only comments is
+// a comment line and the other ones are blank. A last line without newline
+// is counted as well.
 func (l Language) Update(c []byte, s *Stats) {
 	s.FileCount++
 
-	inComment := 0 // this is an int for nesting
-	inLComment := false
-	blank := true
-	lc := []byte(l.LineComment)
-	sc := []byte(l.StartComment)
-	ec := []byte(l.EndComment)
-	lp, sp, ep := 0, 0, 0
-
-	for _, b := range c {
-		if inComment == 0 && b == lc[lp] {
-			lp++
-			if lp == len(lc) {
-				inLComment = true
-				lp = 0
-			}
-		} else {
-			lp = 0
-		}
-		if !inLComment && b == sc[sp] {
-			sp++
-			if sp == len(sc) {
-				inComment++
-				if inComment > 1 && !l.Nesting {
-					inComment = 1
-				}
-				sp = 0
-			}
-		} else {
-			sp = 0
+	depth := 0 // block comments may nest
+	var quote *Quote
+	code, comment := false, false
+	endLine := func() {
+		s.TotalLines++
+		switch {
+		case code || quote != nil:
+			s.CodeLines++
+		case comment || depth > 0:
+			s.CommentLines++
+		default:
+			s.BlankLines++
 		}
-		if !inLComment && inComment > 0 && b == ec[ep] {
-			ep++
-			if ep == len(ec) {
-				if inComment > 0 {
-					inComment--
-				}
-				ep = 0
-			}
-		} else {
-			ep = 0
+		code, comment = false, false
+		if quote != nil && !quote.Multiline {
+			quote = nil
 		}
+	}
 
-		if b != byte(' ') && b != byte('\t') && b != byte('\n') && b != byte('\r') {
-			blank = false
+	for i := 0; i < len(c); {
+		b := c[i]
+		if b == '\n' {
+			endLine()
+			i++
+			continue
 		}
-
-		// BUG(srl): lines with comment don't count towards code
-		// Note that lines with both code and comment count towards
-		// each, but are not counted twice in the total.
-		if b == byte('\n') {
-			s.TotalLines++
-			if inComment > 0 || inLComment {
-				inLComment = false
-				s.CommentLines++
-			} else if blank {
-				s.BlankLines++
+		switch {
+		case quote != nil:
+			code = true
+			if quote.Escape && b == '\\' && i+1 < len(c) && c[i+1] != '\n' {
+				i += 2
+			} else if bytes.HasPrefix(c[i:], []byte(quote.End)) {
+				i += len(quote.End)
+				quote = nil
 			} else {
-				s.CodeLines++
+				i++
 			}
-			blank = true
-			continue
+		case depth > 0:
+			comment = true
+			if l.Nesting && bytes.HasPrefix(c[i:], []byte(l.StartComment)) {
+				depth++
+				i += len(l.StartComment)
+			} else if bytes.HasPrefix(c[i:], []byte(l.EndComment)) {
+				depth--
+				i += len(l.EndComment)
+			} else {
+				i++
+			}
+		case l.LineComment != "" && bytes.HasPrefix(c[i:], []byte(l.LineComment)):
+			comment = true
+			if n := bytes.IndexByte(c[i:], '\n'); n >= 0 {
+				i += n
+			} else {
+				i = len(c)
+			}
+		case l.StartComment != "" && bytes.HasPrefix(c[i:], []byte(l.StartComment)):
+			comment = true
+			depth = 1
+			i += len(l.StartComment)
+		default:
+			if q := l.quote(c[i:]); q != nil {
+				code = true
+				quote = q
+				i += len(q.Start)
+				continue
+			}
+			if b != ' ' && b != '\t' && b != '\r' {
+				code = true
+			}
+			i++
 		}
 	}
+	if len(c) > 0 && c[len(c)-1] != '\n' {
+		endLine()
+	}
+}
+
+// quote returns the quote starting c, or nil if it starts no string.
+func (l Language) quote(c []byte) *Quote {
+	for i := range l.Quotes {
+		if bytes.HasPrefix(c, []byte(l.Quotes[i].Start)) {
+			return &l.Quotes[i]
+		}
+	}
+	return nil
 }
 
 type Namer string
@@ -132,12 +204,23 @@ func mName(names ...string) Matcher {
 	}
 }
 
+func mOr(matchers ...Matcher) Matcher {
+	return func(fname string) bool {
+		for _, m := range matchers {
+			if m(fname) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
 type Stats struct {
-	FileCount    int
-	TotalLines   int
-	CodeLines    int
-	BlankLines   int
-	CommentLines int
+	FileCount    int `json:"file_count"`
+	TotalLines   int `json:"total_lines"`
+	CodeLines    int `json:"code_lines"`
+	BlankLines   int `json:"blank_lines"`
+	CommentLines int `json:"comment_lines"`
 }
 
 var info = map[string]*Stats{}
@@ -300,6 +383,120 @@ func CountCode(projectPath, except string) (codeCounts map[string][]int) {
 	return codeCounts
 }
 
+// CountLines returns the line statistics of the given files. Unlike
+// CountCode it keeps no global state, so it can be called concurrently.
+func CountLines(fileNames []string) (stats Stats) {
+	for _, fname := range fileNames {
+		for _, lang := range languages {
+			if !lang.Match(fname) {
+				continue
+			}
+			c, err := ioutil.ReadFile(fname)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "  ! %s\n", fname)
+				break
+			}
+			lang.Update(c, &stats)
+			break
+		}
+	}
+	return stats
+}
+
+// The kinds of files. The Go test files and the Go files marked as generated
+// are counted apart from the code.
+const (
+	KindCode      = "code"
+	KindTest      = "test"
+	KindGenerated = "generated"
+)
+
+// generated matches the comment of the generated Go files, see go help
+// generate.
+var generated = regexp.MustCompile(`(?m)^// Code generated .* DO NOT EDIT\.$`)
+
+// File is the line statistics of a file of Path in one of the languages.
+type File struct {
+	Path     string `json:"path"`
+	Language string `json:"language"`
+	Kind     string `json:"kind"`
+	Stats
+}
+
+// CountFiles returns the line statistics of every file of the project in a
+// known language, sorted by path. The vendor and hidden directories and the
+// paths containing one of the comma separated except are skipped.
+func CountFiles(projectPath, except string) ([]File, error) {
+	skip := []string{}
+	for _, e := range strings.Split(except, ",") {
+		if e != "" {
+			skip = append(skip, e)
+		}
+	}
+	files := make([]File, 0)
+	err := filepath.Walk(projectPath, func(fname string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		for _, e := range skip {
+			if strings.Contains(fname, e) {
+				if info.IsDir() {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+		}
+		if info.IsDir() {
+			if fname != projectPath && (info.Name() == "vendor" || strings.HasPrefix(info.Name(), ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		for _, lang := range languages {
+			if !lang.Match(fname) {
+				continue
+			}
+			c, err := ioutil.ReadFile(fname)
+			if err != nil {
+				return err
+			}
+			file := File{Path: fname, Language: lang.Name(), Kind: kind(fname, c)}
+			lang.Update(c, &file.Stats)
+			files = append(files, file)
+			break
+		}
+		return nil
+	})
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Path < files[j].Path
+	})
+	return files, err
+}
+
+// kind returns the kind of the file fname of content c.
+func kind(fname string, c []byte) string {
+	if path.Ext(fname) != ".go" {
+		return KindCode
+	}
+	if strings.HasSuffix(fname, "_test.go") {
+		return KindTest
+	}
+	// The comment has to come before the package clause.
+	if bytes.HasPrefix(c, []byte("package ")) {
+		return KindCode
+	}
+	if i := bytes.Index(c, []byte("\npackage ")); i >= 0 {
+		c = c[:i]
+	}
+	if generated.Match(c) {
+		return KindGenerated
+	}
+	return KindCode
+}
+
 // exceptPkg is a function that will determine whether the package is an exception.
 func exceptPkg(pkg string) bool {
 	if len(excepts) == 0 {
@@ -1,9 +1,42 @@
 package countcode
 
 import (
+	"path/filepath"
 	"testing"
 )
 
 func Test_CountCode(t *testing.T) {
 	CountCode("../../../goreporter", "")
 }
+
+func Test_CountFiles(t *testing.T) {
+	files, err := CountFiles("testdata", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]File{
+		"app.js":        {Language: "JavaScript", Kind: KindCode, Stats: Stats{1, 5, 3, 0, 2}},
+		"config.yaml":   {Language: "YAML", Kind: KindCode, Stats: Stats{1, 3, 2, 0, 1}},
+		"deploy.sh":     {Language: "Shell", Kind: KindCode, Stats: Stats{1, 5, 2, 1, 2}},
+		"main.go":       {Language: "Go", Kind: KindCode, Stats: Stats{1, 15, 8, 3, 4}},
+		"main_test.go":  {Language: "Go", Kind: KindTest, Stats: Stats{1, 5, 3, 2, 0}},
+		"schema.sql":    {Language: "SQL", Kind: KindCode, Stats: Stats{1, 6, 3, 0, 3}},
+		"sub/api.pb.go": {Language: "Go", Kind: KindGenerated, Stats: Stats{1, 6, 2, 2, 2}},
+		"sub/api.proto": {Language: "Protocol Buffers", Kind: KindCode, Stats: Stats{1, 4, 2, 1, 1}},
+	}
+	if len(files) != len(want) {
+		t.Fatalf("got %d files, want %d without vendor and hidden directories: %+v", len(files), len(want), files)
+	}
+	for _, file := range files {
+		rel, _ := filepath.Rel("testdata", file.Path)
+		w, ok := want[filepath.ToSlash(rel)]
+		if !ok {
+			t.Errorf("unexpected file %s", file.Path)
+			continue
+		}
+		w.Path = file.Path
+		if file != w {
+			t.Errorf("%s: got %+v, want %+v", rel, file, w)
+		}
+	}
+}
@@ -0,0 +1,5 @@
+// app
+var url = 'http://example.com'; /* trailing
+comment */
+var t = `multi
+line`;
@@ -0,0 +1,5 @@
+#!/bin/sh
+# deploy
+echo "# not a comment"
+
+exit 0
@@ -0,0 +1,15 @@
+// Package main is counted as Go code.
+package main
+
+/*
+A block comment.
+*/
+
+var s = `raw
+// not a comment
+
+`
+
+func main() { // code and comment
+	println("/* not a comment */", s)
+}
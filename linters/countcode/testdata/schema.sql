@@ -0,0 +1,6 @@
+-- users
+CREATE TABLE users (
+    name TEXT DEFAULT '--' /* inline */
+);
+/* multi
+   line */
@@ -191,7 +191,7 @@ func buildStats(f *ast.File, fset *token.FileSet, stats []stat) []stat {
 			stats = append(stats, stat{
 				PkgName:    f.Name.Name,
 				FuncName:   funcName(fn),
-				Complexity: complexity(fn),
+				Complexity: Complexity(fn),
 				Pos:        fset.Position(fn.Pos()),
 			})
 		}
@@ -223,8 +223,8 @@ func recvString(recv ast.Expr) string {
 	return "BADRECV"
 }
 
-// complexity calculates the cyclomatic complexity of a function.
-func complexity(fn *ast.FuncDecl) int {
+// Complexity calculates the cyclomatic complexity of a function.
+func Complexity(fn *ast.FuncDecl) int {
 	v := complexityVisitor{}
 	ast.Walk(&v, fn)
 	return v.Complexity
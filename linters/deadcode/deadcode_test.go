@@ -1,6 +1,7 @@
 package deadcode
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -15,3 +16,33 @@ func Test_P2(t *testing.T) {
 func Test_P3(t *testing.T) {
 	DeadCode("./testdata/p3")
 }
+
+const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/deadcode/testdata/program/"
+
+func Test_Unreachable(t *testing.T) {
+	always := []string{
+		"cmd method point.norm", "cmd field config.debug", "cmd const lonely",
+		"cmd type orphan", "cmd func dead",
+	}
+	testCases := []struct {
+		opts     Options
+		expected []string
+	}{
+		{Options{}, append(always, "lib type Handler", "lib type impl", "lib func New", "lib func twice", "lib func untested")},
+		{Options{Tests: true}, append(always, "lib type Handler", "lib type impl", "lib func New", "lib func untested")},
+		{Options{Exported: true}, append(always, "lib func untested")},
+	}
+	for _, tc := range testCases {
+		unused, err := Unreachable([]string{testdata + "cmd", testdata + "lib"}, tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, 0, len(unused))
+		for _, u := range unused {
+			got = append(got, strings.TrimPrefix(u.Package, testdata)+" "+u.Kind+" "+u.Name)
+		}
+		if strings.Join(got, ", ") != strings.Join(tc.expected, ", ") {
+			t.Errorf("%+v: got %v, want %v", tc.opts, got, tc.expected)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/deadcode/testdata/program/lib"
+)
+
+type byName []string
+
+func (b byName) Len() int           { return len(b) }
+func (b byName) Less(i, j int) bool { return b[i] < b[j] }
+func (b byName) Swap(i, j int)      { b[i], b[j] = b[j], b[i] }
+
+type point struct {
+	x, y  int
+	label string
+}
+
+func (p point) String() string { return fmt.Sprint(p.x, p.y) }
+
+func (p point) norm() int { return p.x*p.x + p.y*p.y }
+
+type config struct {
+	name  string
+	debug bool
+}
+
+const (
+	red = iota
+	green
+)
+
+const lonely = 3
+
+type orphan struct{}
+
+func (orphan) Run() {}
+
+func helper() int {
+	c := config{name: "x"}
+	return lib.Sum(len(c.name), red)
+}
+
+func dead() int { return lonely }
+
+func run(f func()) { f() }
+
+func main() {
+	names := byName{"b", "a"}
+	sort.Sort(names)
+	fmt.Println(point{x: 1, y: 2}, helper(), names)
+	run(func() {})
+}
@@ -0,0 +1,20 @@
+package lib
+
+// Handler handles something.
+type Handler interface {
+	Handle() int
+}
+
+type impl struct{}
+
+func (impl) Handle() int { return twice(1) }
+
+// New returns a Handler.
+func New() Handler { return impl{} }
+
+// Sum returns a+b.
+func Sum(a, b int) int { return a + b }
+
+func twice(n int) int { return 2 * n }
+
+func untested() {}
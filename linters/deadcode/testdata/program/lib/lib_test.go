@@ -0,0 +1,9 @@
+package lib
+
+import "testing"
+
+func TestTwice(t *testing.T) {
+	if twice(2) != 4 {
+		t.Fail()
+	}
+}
@@ -0,0 +1,602 @@
+package deadcode
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/loader"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/internal/program"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/ssa"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/ssa/ssautil"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/staticcheck/callgraph/rta"
+)
+
+// testPrefixes are the names of the functions run by go test.
+var testPrefixes = []string{"Test", "Benchmark", "Example", "Fuzz"}
+
+// Options selects the roots of the program analyzed by Unreachable, the code
+// that runs whatever the rest does. The main functions and the package
+// initializers are always roots. With Tests, the tests, benchmarks, examples
+// and fuzz targets of the packages are roots too and, with Exported, the
+// exported functions, methods, types, fields and constants of the packages
+// other than main, as the API of a library. Tags are the build tags.
+type Options struct {
+	Tests    bool
+	Exported bool
+	Tags     []string
+}
+
+// Unused is a declaration of the project that the program never reaches. Kind
+// is "func", "method", "type", "field" or "const"; the name of a method or a
+// field is qualified by its type, as in "T.M".
+type Unused struct {
+	Kind    string `json:"kind"`
+	Package string `json:"package"`
+	Name    string `json:"name"`
+	File    string `json:"file"`
+	Line    int    `json:"line"`
+	Column  int    `json:"column"`
+}
+
+// Unreachable analyzes the packages importPaths as a whole program and
+// returns the declarations of their non-test files that are not reachable
+// from the roots of opts, ordered by position.
+//
+// The functions and methods are reached through the call graph built by
+// rapid type analysis, where an interface method call may dispatch to the
+// matching method of every type converted to an interface. The exported
+// methods of those types may be called through reflection and their fields
+// read, they are all reachable. A type or a constant is reachable when the
+// reachable code or the declaration of another reachable one refers to it,
+// the constants of a group being reachable together, and a field when the
+// reachable code selects it or builds its struct without field names. The
+// code of a package that cannot be built, of the package variables and the
+// functions marked //export or //go:linkname are reachable as well. The
+// methods of an unused type and the generated files are not reported.
+func Unreachable(importPaths []string, opts Options) ([]Unused, error) {
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, opts.Tags...)
+	conf := loader.Config{Build: &ctx, ParserMode: parser.ParseComments}
+	conf.AllowErrors = true
+	conf.TypeChecker.Error = func(error) {}
+	for _, path := range importPaths {
+		if opts.Tests {
+			conf.ImportWithTests(path)
+		} else {
+			conf.Import(path)
+		}
+	}
+	lprog, err := conf.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	a := newAnalysis(lprog, ssautil.CreateProgram(lprog, 0), opts)
+	var failed []string
+	for _, info := range lprog.InitialPackages() {
+		pkg := a.prog.Package(info.Pkg)
+		if pkg == nil {
+			a.failed[info.Pkg] = true
+			continue
+		}
+		if err := program.BuildPackage(pkg); err != nil {
+			a.failed[info.Pkg] = true
+			failed = append(failed, fmt.Sprintf("%s: %v", info.Pkg.Path(), err))
+			clearPackage(pkg)
+		}
+	}
+
+	roots := a.roots()
+	for len(roots) > 0 {
+		a.runtime = nil
+		for _, fn := range roots {
+			a.reached[fn] = true
+		}
+		if result := rta.Analyze(roots, false); result != nil {
+			for fn := range result.Reachable {
+				a.reached[fn] = true
+			}
+			result.RuntimeTypes.Iterate(func(t types.Type, _ interface{}) {
+				a.runtime = append(a.runtime, t)
+			})
+		}
+		// the code reached refers to new functions when it is not in the
+		// call graph, that of the packages that could not be built, or
+		// calls an interface method that rta could not dispatch.
+		extra := a.mark()
+		if len(extra) == 0 {
+			break
+		}
+		roots = append(roots, extra...)
+	}
+
+	unused := a.unused()
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return unused, fmt.Errorf("could not build %s", strings.Join(failed, ", "))
+	}
+	return unused, nil
+}
+
+// clearPackage removes the code of the functions and methods of pkg, which may
+// be partly built after a panic of the builder.
+func clearPackage(pkg *ssa.Package) {
+	var clear func(fn *ssa.Function)
+	clear = func(fn *ssa.Function) {
+		if fn == nil {
+			return
+		}
+		fn.Blocks = nil
+		for _, anon := range fn.AnonFuncs {
+			clear(anon)
+		}
+	}
+	for _, member := range pkg.Members {
+		switch member := member.(type) {
+		case *ssa.Function:
+			clear(member)
+		case *ssa.Type:
+			if types.IsInterface(member.Type()) || isGeneric(member.Type()) {
+				continue
+			}
+			for _, t := range []types.Type{member.Type(), types.NewPointer(member.Type())} {
+				methods := pkg.Prog.MethodSets.MethodSet(t)
+				for i := 0; i < methods.Len(); i++ {
+					if fn := pkg.Prog.MethodValue(methods.At(i)); fn != nil && fn.Pkg == pkg {
+						clear(fn)
+					}
+				}
+			}
+		}
+	}
+}
+
+// analysis is the state of Unreachable. The declarations of the project are
+// indexed once, the objects used are marked again after every call graph.
+type analysis struct {
+	lprog *loader.Program
+	prog  *ssa.Program
+	opts  Options
+
+	project map[*types.Package]*loader.PackageInfo
+	failed  map[*types.Package]bool
+	// specs are the declarations of the types and constants of the project,
+	// the whole group for a constant.
+	specs map[types.Object]ast.Node
+	infos map[ast.Node]*loader.PackageInfo
+	// funcs are the function declarations and literals of the project, by
+	// position.
+	funcs   map[token.Pos]ast.Node
+	reached map[*ssa.Function]bool
+	runtime []types.Type
+
+	used      map[types.Object]bool
+	walked    map[ast.Node]bool
+	allFields map[*types.TypeName]bool
+	abstract  []*types.Func
+}
+
+func newAnalysis(lprog *loader.Program, prog *ssa.Program, opts Options) *analysis {
+	a := &analysis{
+		lprog:   lprog,
+		prog:    prog,
+		opts:    opts,
+		project: make(map[*types.Package]*loader.PackageInfo),
+		failed:  make(map[*types.Package]bool),
+		specs:   make(map[types.Object]ast.Node),
+		infos:   make(map[ast.Node]*loader.PackageInfo),
+		funcs:   make(map[token.Pos]ast.Node),
+		reached: make(map[*ssa.Function]bool),
+	}
+	for _, info := range lprog.InitialPackages() {
+		a.project[info.Pkg] = info
+		for _, file := range info.Files {
+			ast.Inspect(file, func(n ast.Node) bool {
+				switch n.(type) {
+				case *ast.FuncDecl, *ast.FuncLit:
+					a.funcs[n.Pos()] = n
+				}
+				return true
+			})
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						a.specs[info.Defs[spec.Name]] = spec
+						a.infos[spec] = info
+					case *ast.ValueSpec:
+						if gen.Tok != token.CONST {
+							continue
+						}
+						for _, name := range spec.Names {
+							if obj := info.Defs[name]; obj != nil {
+								a.specs[obj] = gen
+							}
+						}
+						a.infos[gen] = info
+					}
+				}
+			}
+		}
+	}
+	return a
+}
+
+// roots returns the functions that run whatever the rest of the program does.
+func (a *analysis) roots() []*ssa.Function {
+	var roots []*ssa.Function
+	add := func(fn *ssa.Function) {
+		if fn != nil {
+			roots = append(roots, fn)
+		}
+	}
+	for _, info := range a.lprog.InitialPackages() {
+		pkg := a.prog.Package(info.Pkg)
+		if pkg == nil {
+			continue
+		}
+		add(pkg.Func("init"))
+		if info.Pkg.Name() == "main" {
+			add(pkg.Func("main"))
+		}
+		for name, member := range pkg.Members {
+			switch member := member.(type) {
+			case *ssa.Function:
+				file := a.lprog.Fset.Position(member.Pos()).Filename
+				if a.opts.Tests && isTest(name) && strings.HasSuffix(file, "_test.go") ||
+					a.opts.Exported && info.Pkg.Name() != "main" && ast.IsExported(name) {
+					add(member)
+				}
+			case *ssa.Type:
+				if !a.opts.Exported || info.Pkg.Name() == "main" || types.IsInterface(member.Type()) || isGeneric(member.Type()) {
+					continue
+				}
+				for _, t := range []types.Type{member.Type(), types.NewPointer(member.Type())} {
+					methods := a.prog.MethodSets.MethodSet(t)
+					for i := 0; i < methods.Len(); i++ {
+						if sel := methods.At(i); sel.Obj().Exported() {
+							add(a.prog.MethodValue(sel))
+						}
+					}
+				}
+			}
+		}
+		for _, file := range info.Files {
+			for _, decl := range file.Decls {
+				if fn, ok := decl.(*ast.FuncDecl); ok && isLinked(fn) {
+					if obj, ok := info.Defs[fn.Name].(*types.Func); ok {
+						add(a.prog.FuncValue(obj))
+					}
+				}
+			}
+		}
+	}
+	return roots
+}
+
+// mark marks the objects of the project used by the code reached, and returns
+// the functions it refers to that are not reached yet.
+func (a *analysis) mark() []*ssa.Function {
+	a.used = make(map[types.Object]bool)
+	a.walked = make(map[ast.Node]bool)
+	a.allFields = make(map[*types.TypeName]bool)
+	a.abstract = nil
+
+	for fn := range a.reached {
+		if fn.Pkg == nil || a.project[fn.Pkg.Pkg] == nil {
+			continue
+		}
+		// the builder only keeps the extent of the syntax of a function,
+		// the package initializers have none: the variables they
+		// initialize are walked below.
+		if syntax := fn.Syntax(); syntax != nil && a.funcs[syntax.Pos()] != nil {
+			a.walk(a.project[fn.Pkg.Pkg], a.funcs[syntax.Pos()])
+		}
+	}
+	for _, info := range a.lprog.InitialPackages() {
+		exported := a.opts.Exported && info.Pkg.Name() != "main"
+		for _, file := range info.Files {
+			if a.failed[info.Pkg] {
+				a.walk(info, file)
+				continue
+			}
+			for _, decl := range file.Decls {
+				gen, ok := decl.(*ast.GenDecl)
+				if !ok {
+					continue
+				}
+				if gen.Tok == token.VAR {
+					a.walk(info, gen)
+					continue
+				}
+				if !exported {
+					continue
+				}
+				for _, spec := range gen.Specs {
+					switch spec := spec.(type) {
+					case *ast.TypeSpec:
+						if spec.Name.IsExported() {
+							a.use(info.Defs[spec.Name])
+						}
+					case *ast.ValueSpec:
+						for _, name := range spec.Names {
+							if name.IsExported() {
+								a.use(info.Defs[name])
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	for _, t := range a.runtime {
+		if obj := a.typeName(t); obj != nil {
+			a.use(obj)
+			a.allFields[obj] = true
+		}
+	}
+
+	var extra []*ssa.Function
+	add := func(fn *ssa.Function) {
+		if fn != nil && !a.reached[fn] && fn.Pkg != nil && a.project[fn.Pkg.Pkg] != nil {
+			a.reached[fn] = true
+			extra = append(extra, fn)
+		}
+	}
+	for obj := range a.used {
+		if fn, ok := obj.(*types.Func); ok {
+			add(a.prog.FuncValue(fn))
+		}
+	}
+	// an interface method may be called from code that was not built, such
+	// as the libraries, on any type implementing it.
+	for _, method := range a.abstract {
+		iface, ok := method.Type().(*types.Signature).Recv().Type().Underlying().(*types.Interface)
+		if !ok {
+			continue
+		}
+		for obj := range a.specs {
+			tn, ok := obj.(*types.TypeName)
+			if !ok || types.IsInterface(tn.Type()) || isGeneric(tn.Type()) || !types.Implements(types.NewPointer(tn.Type()), iface) {
+				continue
+			}
+			sel := a.prog.MethodSets.MethodSet(types.NewPointer(tn.Type())).Lookup(method.Pkg(), method.Name())
+			if sel != nil {
+				add(a.prog.MethodValue(sel))
+			}
+		}
+	}
+	return extra
+}
+
+// walk marks the objects used by the code of node.
+func (a *analysis) walk(info *loader.PackageInfo, node ast.Node) {
+	if a.walked[node] {
+		return
+	}
+	a.walked[node] = true
+	ast.Inspect(node, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.Ident:
+			if obj := info.Uses[n]; obj != nil {
+				a.use(obj)
+			}
+		case *ast.CompositeLit:
+			if len(n.Elts) > 0 {
+				if _, keyed := n.Elts[0].(*ast.KeyValueExpr); !keyed {
+					a.useFields(info.TypeOf(n))
+				}
+			}
+		case *ast.CallExpr:
+			// a conversion between struct types uses all their fields.
+			if tv, ok := info.Types[n.Fun]; ok && tv.IsType() && len(n.Args) == 1 {
+				a.useFields(tv.Type)
+				a.useFields(info.TypeOf(n.Args[0]))
+			}
+		}
+		return true
+	})
+}
+
+// use marks obj used, with the objects its declaration refers to.
+func (a *analysis) use(obj types.Object) {
+	if obj == nil || a.used[obj] {
+		return
+	}
+	a.used[obj] = true
+	if fn, ok := obj.(*types.Func); ok {
+		if recv := fn.Type().(*types.Signature).Recv(); recv != nil && types.IsInterface(recv.Type()) {
+			a.abstract = append(a.abstract, fn)
+		}
+		return
+	}
+	spec, ok := a.specs[obj]
+	if !ok {
+		return
+	}
+	if gen, ok := spec.(*ast.GenDecl); ok {
+		for _, s := range gen.Specs {
+			for _, name := range s.(*ast.ValueSpec).Names {
+				if other := a.infos[gen].Defs[name]; other != nil {
+					a.used[other] = true
+				}
+			}
+		}
+	}
+	a.walk(a.infos[spec], spec)
+}
+
+// useFields marks all the fields of the struct type t used.
+func (a *analysis) useFields(t types.Type) {
+	if obj := a.typeName(t); obj != nil {
+		a.allFields[obj] = true
+	}
+}
+
+// typeName returns the named type of the project of t, or of the type t
+// points to, nil if there is none.
+func (a *analysis) typeName(t types.Type) *types.TypeName {
+	if t == nil {
+		return nil
+	}
+	if ptr, ok := t.(*types.Pointer); ok {
+		t = ptr.Elem()
+	}
+	named, ok := t.(*types.Named)
+	if !ok || a.project[named.Obj().Pkg()] == nil {
+		return nil
+	}
+	return named.Obj()
+}
+
+// unused returns the declarations of the non-test files of the project that
+// are not reached nor used.
+func (a *analysis) unused() []Unused {
+	unused := make([]Unused, 0)
+	report := func(kind, name string, obj types.Object) {
+		position := a.lprog.Fset.Position(obj.Pos())
+		unused = append(unused, Unused{
+			Kind:    kind,
+			Package: obj.Pkg().Path(),
+			Name:    name,
+			File:    position.Filename,
+			Line:    position.Line,
+			Column:  position.Column,
+		})
+	}
+	for _, info := range a.lprog.InitialPackages() {
+		if a.failed[info.Pkg] {
+			continue
+		}
+		for _, file := range info.Files {
+			if strings.HasSuffix(a.lprog.Fset.Position(file.Pos()).Filename, "_test.go") || lint.IsGenerated(file) {
+				continue
+			}
+			for _, decl := range file.Decls {
+				switch decl := decl.(type) {
+				case *ast.FuncDecl:
+					obj, ok := info.Defs[decl.Name].(*types.Func)
+					if !ok || decl.Name.Name == "_" || a.reached[a.prog.FuncValue(obj)] {
+						continue
+					}
+					if decl.Recv == nil {
+						if decl.Name.Name != "init" && (decl.Name.Name != "main" || info.Pkg.Name() != "main") {
+							report("func", obj.Name(), obj)
+						}
+						continue
+					}
+					if recv := a.typeName(obj.Type().(*types.Signature).Recv().Type()); recv != nil && a.used[recv] {
+						report("method", recv.Name()+"."+obj.Name(), obj)
+					}
+				case *ast.GenDecl:
+					for _, spec := range decl.Specs {
+						switch spec := spec.(type) {
+						case *ast.TypeSpec:
+							obj, ok := info.Defs[spec.Name].(*types.TypeName)
+							if !ok || spec.Name.Name == "_" {
+								continue
+							}
+							if !a.used[obj] {
+								report("type", obj.Name(), obj)
+								continue
+							}
+							a.unusedFields(obj, spec, report)
+						case *ast.ValueSpec:
+							for _, name := range spec.Names {
+								obj := info.Defs[name]
+								if _, ok := obj.(*types.Const); ok && name.Name != "_" && !a.used[obj] {
+									report("const", obj.Name(), obj)
+								}
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	sort.Slice(unused, func(i, j int) bool {
+		if unused[i].File != unused[j].File {
+			return unused[i].File < unused[j].File
+		}
+		if unused[i].Line != unused[j].Line {
+			return unused[i].Line < unused[j].Line
+		}
+		return unused[i].Column < unused[j].Column
+	})
+	return unused
+}
+
+// unusedFields reports the named fields of the struct type obj, declared by
+// spec, that are not used. Under Exported, the exported fields of an
+// exported type of a package other than main are part of the API.
+func (a *analysis) unusedFields(obj *types.TypeName, spec *ast.TypeSpec, report func(kind, name string, obj types.Object)) {
+	st, ok := spec.Type.(*ast.StructType)
+	if !ok || a.allFields[obj] {
+		return
+	}
+	info := a.infos[spec]
+	api := a.opts.Exported && obj.Exported() && obj.Pkg().Name() != "main"
+	for _, field := range st.Fields.List {
+		for _, name := range field.Names {
+			f := info.Defs[name]
+			if f == nil || name.Name == "_" || a.used[f] || api && name.IsExported() {
+				continue
+			}
+			report("field", obj.Name()+"."+name.Name, f)
+		}
+	}
+}
+
+// isGeneric reports whether t is a generic type, which has no method set
+// until it is instantiated.
+func isGeneric(t types.Type) bool {
+	named, ok := t.(*types.Named)
+	return ok && named.TypeParams().Len() > 0
+}
+
+// isLinked reports whether fn is exported to C or linked by name, so it may
+// be called from outside the Go code.
+func isLinked(fn *ast.FuncDecl) bool {
+	if fn.Doc == nil {
+		return false
+	}
+	for _, comment := range fn.Doc.List {
+		if strings.HasPrefix(comment.Text, "//export ") || strings.HasPrefix(comment.Text, "//go:linkname ") {
+			return true
+		}
+	}
+	return false
+}
+
+// isTest reports whether name is the name of a test, benchmark, example or
+// fuzz target, or TestMain.
+func isTest(name string) bool {
+	if name == "TestMain" {
+		return true
+	}
+	for _, prefix := range testPrefixes {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if len(name) == len(prefix) {
+			return true
+		}
+		r, _ := utf8.DecodeRuneInString(name[len(prefix):])
+		return !unicode.IsLower(r)
+	}
+	return false
+}
@@ -1,23 +1,22 @@
 package depend
 
 import (
-	"bytes"
 	"fmt"
 	"go/build"
-	"io/ioutil"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
+	"sync"
 
 	"github.com/golang/glog"
 )
 
 var (
-	pkgs   map[string]*build.Package
-	ids    map[string]int
-	nextId int
+	// mu guards the state of the walker below, shared by Depend and Edges.
+	mu sync.Mutex
+
+	pkgs map[string]*build.Package
 
 	ignored = map[string]bool{
 		"C": true,
@@ -30,8 +29,7 @@ var (
 	// a comma-separated list of packages to ignore
 	ignorePackages = ""
 	// a comma-separated list of build tags to consider satisified during the build
-	tagList    = ""
-	horizontal = true
+	tagList = ""
 	// include test packages
 	includeTests = false
 
@@ -41,120 +39,110 @@ var (
 	vendors []string
 )
 
-func Depend(path, expect string) string {
-	ignorePackages = expect
-	vendors = getVendorlist(path)
-	// add root vendor
-	vendors = append(vendors, "vendor")
-	pkgs = make(map[string]*build.Package)
-	ids = make(map[string]int)
+// Import is an import of the package To by the package From, To as written
+// in the import declaration.
+type Import struct {
+	From string
+	To   string
+}
 
-	args := []string{path}
+// Edge is an Import declared at Line of File. Std tells whether To is a
+// package of the standard library.
+type Edge struct {
+	Import
+	File string
+	Line int
+	Std  bool
+}
 
-	if len(args) != 1 {
-		glog.Errorln("need one package name to process")
-		return ""
-	}
-
-	if ignorePrefixes != "" {
-		if runtime.GOOS == `windows` {
-			ignorePrefixes = strings.Replace(ignorePrefixes, "/", `\`, -1)
-		}
-		ignoredPrefixes = strings.Split(ignorePrefixes, ",")
-	}
-	if ignorePackages != "" {
-		for _, p := range strings.Split(ignorePackages, ",") {
-			ignored[p] = true
-		}
-	}
-	if tagList != "" {
-		buildTags = strings.Split(tagList, ",")
-	}
-	buildContext.BuildTags = buildTags
+// Depend returns the dependency graph of the packages under path, with the
+// given build tags satisfied. The imports of highlight are highlighted.
+func Depend(path, expect string, highlight map[Import]bool, tags ...string) (*Graph, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	setup(path, expect, tags)
 
 	cwd, err := os.Getwd()
 	if err != nil {
-		glog.Errorf("failed to get cwd: %s", err)
-		return ""
+		return nil, fmt.Errorf("failed to get cwd: %s", err)
 	}
-	if err := processPackage(cwd, strings.Replace(args[0], `\`, "/", -1), path); err != nil {
-		glog.Errorln(err)
-		return ""
+	if err := processPackage(cwd, strings.Replace(path, `\`, "/", -1), path); err != nil {
+		return nil, err
 	}
+	return newGraph(highlight), nil
+}
 
-	graph := "digraph godep {"
-	// fmt.Println("digraph godep {")
-	if horizontal {
-		// fmt.Println(`rankdir="LR"`)
-		graph += `rankdir="LR"`
-	}
-	for pkgName, pkg := range pkgs {
-		pkgId := getId(pkgName)
-
-		if isIgnored(pkg) {
-			continue
-		}
+// Edges returns the imports of the packages importPaths of the project under
+// path, with the given build tags satisfied. A package that cannot be walked
+// is skipped and its error returned with the edges found.
+func Edges(path, expect string, importPaths []string, tags ...string) ([]Edge, error) {
+	mu.Lock()
+	defer mu.Unlock()
+	setup(path, expect, tags)
 
-		var color string
-		if pkg.Goroot {
-			color = "palegreen"
-		} else if len(pkg.CgoFiles) > 0 {
-			color = "darkgoldenrod1"
-		} else {
-			color = "paleturquoise"
+	cwd, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	var failed []string
+	for _, importPath := range importPaths {
+		if err := processPackage(cwd, importPath, path); err != nil {
+			failed = append(failed, err.Error())
 		}
-		graph += fmt.Sprintf("%d [label=\"%s\" style=\"filled\" color=\"%s\"];\n", pkgId, pkgName, color)
-		// fmt.Printf("%d [label=\"%s\" style=\"filled\" color=\"%s\"];\n", pkgId, pkgName, color)
+	}
 
-		// Don't render imports from packages in Goroot
-		if pkg.Goroot && !delveGoroot {
+	edges := make([]Edge, 0)
+	for _, importPath := range importPaths {
+		pkg := pkgs[importPath]
+		if pkg == nil {
 			continue
 		}
-
 		for _, imp := range getImports(pkg) {
-			impPkg := pkgs[imp]
-			if impPkg == nil || isIgnored(impPkg) {
+			if imp == "C" {
 				continue
 			}
-
-			impId := getId(imp)
-			graph += fmt.Sprintf("%d -> %d;\n", pkgId, impId)
-			// fmt.Printf("%d -> %d;\n", pkgId, impId)
+			edge := Edge{
+				Import: Import{From: importPath, To: imp},
+				Std:    isStandard(imp),
+			}
+			if positions := pkg.ImportPos[imp]; len(positions) > 0 {
+				edge.File = positions[0].Filename
+				edge.Line = positions[0].Line
+			}
+			edges = append(edges, edge)
 		}
 	}
-	graph += `}`
-
-	err = ioutil.WriteFile("graph.gv", []byte(graph), 0666)
-	if err != nil {
-		glog.Errorln(err)
+	if len(failed) > 0 {
+		return edges, fmt.Errorf("%s", strings.Join(failed, "; "))
 	}
+	return edges, nil
+}
 
-	// convert file formate
-	cmdsvg := exec.Command("dot", "-Tsvg", "-o", "pkgdep.svg", "graph.gv")
-	var outsvg bytes.Buffer
-	cmdsvg.Stdout = &outsvg
-	cmdsvg.Stderr = os.Stderr
-	err = cmdsvg.Run()
-	if err != nil {
-		glog.Errorln(err)
-	}
+// setup resets the state of the walker for the project under path.
+func setup(path, expect string, tags []string) {
+	ignorePackages = expect
+	tagList = strings.Join(tags, ",")
+	vendors = getVendorlist(path)
+	// add root vendor
+	vendors = append(vendors, "vendor")
+	pkgs = make(map[string]*build.Package)
 
-	svg, err := ioutil.ReadFile("pkgdep.svg")
-	if err != nil {
-		glog.Errorln(err)
+	if ignorePrefixes != "" {
+		if runtime.GOOS == `windows` {
+			ignorePrefixes = strings.Replace(ignorePrefixes, "/", `\`, -1)
+		}
+		ignoredPrefixes = strings.Split(ignorePrefixes, ",")
 	}
-
-	err = os.Remove("pkgdep.svg")
-	if err != nil {
-		glog.Errorln(err)
+	if ignorePackages != "" {
+		for _, p := range strings.Split(ignorePackages, ",") {
+			ignored[p] = true
+		}
 	}
-
-	err = os.Remove("graph.gv")
-	if err != nil {
-		glog.Errorln(err)
+	buildTags = nil
+	if tagList != "" {
+		buildTags = strings.Split(tagList, ",")
 	}
-
-	return string(svg)
+	buildContext.BuildTags = buildTags
 }
 
 func processPackage(root string, pkgName, path string) error {
@@ -227,16 +215,6 @@ func getImports(pkg *build.Package) []string {
 	return imports
 }
 
-func getId(name string) int {
-	id, ok := ids[name]
-	if !ok {
-		id = nextId
-		nextId++
-		ids[name] = id
-	}
-	return id
-}
-
 func hasPrefixes(s string, prefixes []string) bool {
 	for _, p := range prefixes {
 		if strings.HasPrefix(s, p) {
@@ -2,10 +2,134 @@ package depend
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
 func Test_Depend(t *testing.T) {
-	h := Depend("../../../goreporter", "")
-	fmt.Println(h)
+	graph, err := Depend("../../../goreporter", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	fmt.Println(graph.SVG())
+}
+
+// testGraph is a -> b -> c, a -> c and c -> a, where c -> a closes a cycle.
+func testGraph() *Graph {
+	return &Graph{
+		Nodes: []Node{
+			{Name: "p/a", Kind: KindProject, Packages: 1},
+			{Name: "p/b", Kind: KindProject, Packages: 1},
+			{Name: "p/b/c", Kind: KindProject, Packages: 1},
+		},
+		Links: []Link{{From: 0, To: 1}, {From: 0, To: 2}, {From: 1, To: 2, Highlight: true}, {From: 2, To: 0}},
+	}
+}
+
+func Test_Layout(t *testing.T) {
+	graph := testGraph()
+	l := newLayout(graph)
+	if want := []int{0, 1, 2}; l.layer[0] != want[0] || l.layer[1] != want[1] || l.layer[2] != want[2] {
+		t.Errorf("want the layers %v, but got %v", want, l.layer[:3])
+	}
+	if len(l.layer) != 5 {
+		t.Errorf("want a dummy vertex for a -> c and c -> a, but got %d vertices", len(l.layer))
+	}
+	if crossings := l.crossings(); crossings != 0 {
+		t.Errorf("want no crossings, but got %d", crossings)
+	}
+	if path := l.paths[3]; path[0] != 2 || path[len(path)-1] != 0 {
+		t.Errorf("want the reversed link c -> a drawn from c to a, but got %v", path)
+	}
+	for _, layer := range l.layers {
+		for i := 1; i < len(layer); i++ {
+			if l.y[layer[i]]-l.y[layer[i-1]] < rowGap {
+				t.Errorf("vertices %d and %d overlap", layer[i-1], layer[i])
+			}
+		}
+	}
+
+	svg := graph.SVG()
+	for _, want := range []string{`data-name="p/b/c"`, `data-from="p/b" data-to="p/b/c"`, `stroke="red"`} {
+		if !strings.Contains(svg, want) {
+			t.Errorf("want %s in the svg %s", want, svg)
+		}
+	}
+	if svg := (&Graph{}).SVG(); svg != "" {
+		t.Errorf("want no svg for an empty graph, but got %s", svg)
+	}
+}
+
+func Test_Collapse(t *testing.T) {
+	graph := testGraph().Collapse([]string{"p/b/..."})
+	if len(graph.Nodes) != 2 || graph.Nodes[1].Name != "p/b" || graph.Nodes[1].Packages != 2 {
+		t.Fatalf("want p/b/c collapsed into p/b, but got %+v", graph.Nodes)
+	}
+	want := []Link{{From: 0, To: 1}, {From: 1, To: 0}}
+	if len(graph.Links) != len(want) || graph.Links[0] != want[0] || graph.Links[1] != want[1] {
+		t.Errorf("want the links %+v, but got %+v", want, graph.Links)
+	}
+}
+
+func Test_Export(t *testing.T) {
+	graph := testGraph()
+	dot := graph.DOT()
+	for _, want := range []string{`0 [label="p/a" style="filled" color="paleturquoise"];`, `1 -> 2 [color="red" penwidth=2];`, "2 -> 0;"} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("want %s in the dot graph %s", want, dot)
+		}
+	}
+	mermaid := graph.Mermaid()
+	for _, want := range []string{"graph LR\n", `n2["p/b/c"]`, "n1 --> n2", "linkStyle 2 stroke:red"} {
+		if !strings.Contains(mermaid, want) {
+			t.Errorf("want %s in the mermaid graph %s", want, mermaid)
+		}
+	}
+}
+
+func Test_Metrics(t *testing.T) {
+	const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/depend/testdata/p1/"
+	metrics, err := Metrics([]string{testdata + "a", testdata + "b", testdata + "c"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := map[string]PackageMetrics{
+		"a": {Afferent: 2, Interfaces: 1, Types: 1, Abstractness: 1},
+		"b": {Afferent: 1, Efferent: 1, Instability: 0.5, Types: 1, Distance: 0.5},
+		"c": {Efferent: 2, Instability: 1},
+	}
+	for name, w := range want {
+		if got := metrics[testdata+name]; got != w {
+			t.Errorf("%s: want %+v, but got %+v", name, w, got)
+		}
+	}
+}
+
+func Test_Edges(t *testing.T) {
+	const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/depend/testdata/p1/"
+	edges, err := Edges("testdata/p1", "", []string{testdata + "a", testdata + "b"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(edges) != 2 {
+		t.Fatalf("want the 2 imports of b, but got %+v", edges)
+	}
+	for _, edge := range edges {
+		if edge.From != testdata+"b" || filepath.Base(edge.File) != "b.go" {
+			t.Errorf("want an import of b.go, but got %+v", edge)
+		}
+		switch edge.To {
+		case "fmt":
+			if !edge.Std || edge.Line != 4 {
+				t.Errorf("want fmt imported at line 4 from the standard library, but got %+v", edge)
+			}
+		case testdata + "a":
+			if edge.Std || edge.Line != 6 {
+				t.Errorf("want a imported at line 6, but got %+v", edge)
+			}
+		default:
+			t.Errorf("unexpected import %+v", edge)
+		}
+	}
 }
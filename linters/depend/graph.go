@@ -0,0 +1,194 @@
+package depend
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Kinds of the nodes of a Graph, drawn in the colors of godepgraph.
+const (
+	KindProject = "project"
+	KindStd     = "std"
+	KindCgo     = "cgo"
+)
+
+// nodeColors are the fill colors of the node kinds.
+var nodeColors = map[string]string{
+	KindProject: "paleturquoise",
+	KindStd:     "palegreen",
+	KindCgo:     "darkgoldenrod1",
+}
+
+// Node is a package of a Graph, or the packages collapsed under a path
+// prefix, Packages telling how many.
+type Node struct {
+	Name     string `json:"name"`
+	Kind     string `json:"kind"`
+	Packages int    `json:"packages"`
+}
+
+// Link is an import between the nodes of index From and To of a Graph.
+// Highlighted links are drawn in red.
+type Link struct {
+	From      int  `json:"from"`
+	To        int  `json:"to"`
+	Highlight bool `json:"highlight,omitempty"`
+}
+
+// Graph is a dependency graph, its nodes sorted by name and its links by
+// source and target.
+type Graph struct {
+	Nodes []Node `json:"nodes"`
+	Links []Link `json:"links"`
+}
+
+// newGraph returns the graph of the packages walked, leaving out the ignored
+// ones. The imports of highlight are highlighted.
+func newGraph(highlight map[Import]bool) *Graph {
+	names := make([]string, 0, len(pkgs))
+	for pkgName, pkg := range pkgs {
+		if !isIgnored(pkg) {
+			names = append(names, pkgName)
+		}
+	}
+	sort.Strings(names)
+
+	graph := &Graph{Nodes: make([]Node, 0, len(names)), Links: make([]Link, 0)}
+	index := make(map[string]int, len(names))
+	for i, pkgName := range names {
+		pkg := pkgs[pkgName]
+		kind := KindProject
+		if pkg.Goroot {
+			kind = KindStd
+		} else if len(pkg.CgoFiles) > 0 {
+			kind = KindCgo
+		}
+		graph.Nodes = append(graph.Nodes, Node{Name: pkgName, Kind: kind, Packages: 1})
+		index[pkgName] = i
+	}
+	for i, pkgName := range names {
+		pkg := pkgs[pkgName]
+		// Don't render imports from packages in Goroot
+		if pkg.Goroot && !delveGoroot {
+			continue
+		}
+		for _, imp := range getImports(pkg) {
+			j, ok := index[imp]
+			if !ok {
+				continue
+			}
+			graph.Links = append(graph.Links, Link{
+				From:      i,
+				To:        j,
+				Highlight: highlight[Import{From: pkgName, To: imp}],
+			})
+		}
+	}
+	graph.sortLinks()
+	return graph
+}
+
+func (g *Graph) sortLinks() {
+	sort.Slice(g.Links, func(i, j int) bool {
+		if g.Links[i].From != g.Links[j].From {
+			return g.Links[i].From < g.Links[j].From
+		}
+		return g.Links[i].To < g.Links[j].To
+	})
+}
+
+// Collapse returns the graph where the packages matching one of prefixes, an
+// import path or an import path followed by "/...", are merged into one node
+// named after the prefix. The longest matching prefix wins, the imports
+// inside a collapsed node are dropped and the others merged.
+func (g *Graph) Collapse(prefixes []string) *Graph {
+	if len(prefixes) == 0 {
+		return g
+	}
+	collapsed := &Graph{Nodes: make([]Node, 0, len(g.Nodes)), Links: make([]Link, 0, len(g.Links))}
+	index := make(map[string]int)
+	mapping := make([]int, len(g.Nodes))
+	for i, node := range g.Nodes {
+		name := collapsedName(node.Name, prefixes)
+		j, ok := index[name]
+		if !ok {
+			j = len(collapsed.Nodes)
+			index[name] = j
+			collapsed.Nodes = append(collapsed.Nodes, Node{Name: name, Kind: node.Kind})
+		} else if collapsed.Nodes[j].Kind != node.Kind {
+			collapsed.Nodes[j].Kind = KindProject
+		}
+		collapsed.Nodes[j].Packages += node.Packages
+		mapping[i] = j
+	}
+
+	links := make(map[[2]int]int)
+	for _, link := range g.Links {
+		from, to := mapping[link.From], mapping[link.To]
+		if from == to {
+			continue
+		}
+		if k, ok := links[[2]int{from, to}]; ok {
+			collapsed.Links[k].Highlight = collapsed.Links[k].Highlight || link.Highlight
+			continue
+		}
+		links[[2]int{from, to}] = len(collapsed.Links)
+		collapsed.Links = append(collapsed.Links, Link{From: from, To: to, Highlight: link.Highlight})
+	}
+	collapsed.sortLinks()
+	return collapsed
+}
+
+// collapsedName returns the longest of prefixes that pkgName matches, or
+// pkgName.
+func collapsedName(pkgName string, prefixes []string) string {
+	name := pkgName
+	longest := -1
+	for _, prefix := range prefixes {
+		prefix = strings.TrimSuffix(prefix, "/...")
+		if len(prefix) > longest && (pkgName == prefix || strings.HasPrefix(pkgName, prefix+"/")) {
+			name, longest = prefix, len(prefix)
+		}
+	}
+	return name
+}
+
+// DOT returns the graph in the dot language of Graphviz.
+func (g *Graph) DOT() string {
+	var buf bytes.Buffer
+	buf.WriteString("digraph godep {\nrankdir=\"LR\"\n")
+	for i, node := range g.Nodes {
+		fmt.Fprintf(&buf, "%d [label=%q style=\"filled\" color=\"%s\"];\n", i, node.Name, nodeColors[node.Kind])
+	}
+	for _, link := range g.Links {
+		if link.Highlight {
+			fmt.Fprintf(&buf, "%d -> %d [color=\"red\" penwidth=2];\n", link.From, link.To)
+			continue
+		}
+		fmt.Fprintf(&buf, "%d -> %d;\n", link.From, link.To)
+	}
+	buf.WriteString("}\n")
+	return buf.String()
+}
+
+// Mermaid returns the graph as a Mermaid flowchart.
+func (g *Graph) Mermaid() string {
+	var buf bytes.Buffer
+	buf.WriteString("graph LR\n")
+	for i, node := range g.Nodes {
+		fmt.Fprintf(&buf, "    n%d[\"%s\"]\n", i, strings.Replace(node.Name, `"`, "#quot;", -1))
+	}
+	var highlighted []string
+	for i, link := range g.Links {
+		fmt.Fprintf(&buf, "    n%d --> n%d\n", link.From, link.To)
+		if link.Highlight {
+			highlighted = append(highlighted, fmt.Sprint(i))
+		}
+	}
+	if len(highlighted) > 0 {
+		fmt.Fprintf(&buf, "    linkStyle %s stroke:red,stroke-width:2px\n", strings.Join(highlighted, ","))
+	}
+	return buf.String()
+}
@@ -0,0 +1,439 @@
+package depend
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"sort"
+)
+
+// Sizes of the drawing, in pixels. The width of a label is estimated from
+// the number of its characters.
+const (
+	charWidth   = 7.
+	nodeHeight  = 24.
+	nodePadding = 8.
+	layerGap    = 60.
+	rowGap      = 14.
+	margin      = 10.
+	sweeps      = 12
+)
+
+// svgColors are the svg colors of the node kinds.
+var svgColors = map[string]string{
+	KindProject: "#afeeee",
+	KindStd:     "#98fb98",
+	KindCgo:     "#ffb90f",
+}
+
+// layout is a layered drawing of a Graph from left to right, computed as
+// Sugiyama et al. do: the cycles are broken by reversing the links closing
+// them, the nodes are put in layers so that every link points to a later
+// layer, the links spanning several layers are split by dummy vertices and
+// the vertices of every layer are ordered by the barycenter of their
+// neighbours to reduce the crossings. The vertices 0 to len(Nodes)-1 are the
+// nodes, the others dummies.
+type layout struct {
+	labels []string
+	layer  []int
+	layers [][]int
+	pos    []int
+	up     [][]int
+	down   [][]int
+	paths  [][]int
+	x, y   []float64
+	width  []float64
+	height []float64
+}
+
+// label returns the text drawn in node.
+func label(node Node) string {
+	if node.Packages > 1 {
+		return fmt.Sprintf("%s/... (%d)", node.Name, node.Packages)
+	}
+	return node.Name
+}
+
+// newLayout lays g out.
+func newLayout(g *Graph) *layout {
+	l := &layout{labels: make([]string, len(g.Nodes))}
+	for i, node := range g.Nodes {
+		l.labels[i] = label(node)
+		l.width = append(l.width, float64(len(l.labels[i]))*charWidth+2*nodePadding)
+		l.height = append(l.height, nodeHeight)
+	}
+	reversed := g.feedbackLinks()
+	l.assignLayers(g, reversed)
+	l.addDummies(g, reversed)
+	l.order()
+	l.place()
+	return l
+}
+
+// feedbackLinks returns which links close a cycle found by a depth-first
+// search and must be reversed to make the graph acyclic.
+func (g *Graph) feedbackLinks() []bool {
+	out := make([][]int, len(g.Nodes))
+	for i, link := range g.Links {
+		out[link.From] = append(out[link.From], i)
+	}
+	reversed := make([]bool, len(g.Links))
+	// 0: not visited, 1: on the stack, 2: done
+	state := make([]int, len(g.Nodes))
+	var visit func(v int)
+	visit = func(v int) {
+		state[v] = 1
+		for _, i := range out[v] {
+			switch w := g.Links[i].To; state[w] {
+			case 0:
+				visit(w)
+			case 1:
+				reversed[i] = true
+			}
+		}
+		state[v] = 2
+	}
+	for v := range g.Nodes {
+		if state[v] == 0 {
+			visit(v)
+		}
+	}
+	return reversed
+}
+
+// direction returns the source and target of link once made acyclic.
+func direction(link Link, reversed bool) (int, int) {
+	if reversed {
+		return link.To, link.From
+	}
+	return link.From, link.To
+}
+
+// assignLayers puts every node in the layer after the longest path leading
+// to it, in topological order.
+func (l *layout) assignLayers(g *Graph, reversed []bool) {
+	out := make([][]int, len(g.Nodes))
+	in := make([]int, len(g.Nodes))
+	for i, link := range g.Links {
+		from, to := direction(link, reversed[i])
+		out[from] = append(out[from], to)
+		in[to]++
+	}
+	l.layer = make([]int, len(g.Nodes))
+	var queue []int
+	for v := range g.Nodes {
+		if in[v] == 0 {
+			queue = append(queue, v)
+		}
+	}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, w := range out[v] {
+			if l.layer[v]+1 > l.layer[w] {
+				l.layer[w] = l.layer[v] + 1
+			}
+			if in[w]--; in[w] == 0 {
+				queue = append(queue, w)
+			}
+		}
+	}
+	for v := range g.Nodes {
+		for len(l.layers) <= l.layer[v] {
+			l.layers = append(l.layers, nil)
+		}
+		l.layers[l.layer[v]] = append(l.layers[l.layer[v]], v)
+	}
+}
+
+// addDummies splits the links spanning several layers by a dummy vertex in
+// every layer crossed and records the path of every link, from its source to
+// its target.
+func (l *layout) addDummies(g *Graph, reversed []bool) {
+	l.up = make([][]int, len(g.Nodes))
+	l.down = make([][]int, len(g.Nodes))
+	for i, link := range g.Links {
+		from, to := direction(link, reversed[i])
+		path := []int{from}
+		for layer := l.layer[from] + 1; layer < l.layer[to]; layer++ {
+			dummy := len(l.layer)
+			l.layer = append(l.layer, layer)
+			l.layers[layer] = append(l.layers[layer], dummy)
+			l.labels = append(l.labels, "")
+			l.width = append(l.width, 0)
+			l.height = append(l.height, 0)
+			l.up = append(l.up, nil)
+			l.down = append(l.down, nil)
+			path = append(path, dummy)
+		}
+		path = append(path, to)
+		for j := 1; j < len(path); j++ {
+			l.down[path[j-1]] = append(l.down[path[j-1]], path[j])
+			l.up[path[j]] = append(l.up[path[j]], path[j-1])
+		}
+		if reversed[i] {
+			for j, k := 0, len(path)-1; j < k; j, k = j+1, k-1 {
+				path[j], path[k] = path[k], path[j]
+			}
+		}
+		l.paths = append(l.paths, path)
+	}
+}
+
+// order sorts the vertices of every layer by the barycenter of their
+// neighbours in the previous layer, then in the next one, sweeping back and
+// forth, and keeps the order with the fewest crossings.
+func (l *layout) order() {
+	l.pos = make([]int, len(l.layer))
+	l.updatePos()
+	best := l.crossings()
+	bestLayers := copyLayers(l.layers)
+	for sweep := 0; sweep < sweeps && best > 0; sweep++ {
+		if sweep%2 == 0 {
+			for layer := 1; layer < len(l.layers); layer++ {
+				l.sortLayer(layer, l.up)
+			}
+		} else {
+			for layer := len(l.layers) - 2; layer >= 0; layer-- {
+				l.sortLayer(layer, l.down)
+			}
+		}
+		if crossings := l.crossings(); crossings < best {
+			best = crossings
+			bestLayers = copyLayers(l.layers)
+		}
+	}
+	l.layers = bestLayers
+	l.updatePos()
+}
+
+func copyLayers(layers [][]int) [][]int {
+	c := make([][]int, len(layers))
+	for i, layer := range layers {
+		c[i] = append([]int(nil), layer...)
+	}
+	return c
+}
+
+func (l *layout) updatePos() {
+	for _, layer := range l.layers {
+		for i, v := range layer {
+			l.pos[v] = i
+		}
+	}
+}
+
+// sortLayer sorts the vertices of layer by the barycenter of their
+// neighbours; the vertices without any keep their position.
+func (l *layout) sortLayer(layer int, neighbours [][]int) {
+	vertices := l.layers[layer]
+	barycenter := make(map[int]float64, len(vertices))
+	for _, v := range vertices {
+		if len(neighbours[v]) == 0 {
+			barycenter[v] = float64(l.pos[v])
+			continue
+		}
+		sum := 0.
+		for _, w := range neighbours[v] {
+			sum += float64(l.pos[w])
+		}
+		barycenter[v] = sum / float64(len(neighbours[v]))
+	}
+	sort.SliceStable(vertices, func(i, j int) bool {
+		return barycenter[vertices[i]] < barycenter[vertices[j]]
+	})
+	for i, v := range vertices {
+		l.pos[v] = i
+	}
+}
+
+// crossings returns the number of crossings between the segments of
+// adjacent layers.
+func (l *layout) crossings() int {
+	crossings := 0
+	for _, layer := range l.layers {
+		var segments [][2]int
+		for _, v := range layer {
+			for _, w := range l.down[v] {
+				segments = append(segments, [2]int{l.pos[v], l.pos[w]})
+			}
+		}
+		for i := range segments {
+			for j := i + 1; j < len(segments); j++ {
+				a, b := segments[i], segments[j]
+				if (a[0]-b[0])*(a[1]-b[1]) < 0 {
+					crossings++
+				}
+			}
+		}
+	}
+	return crossings
+}
+
+// place computes the center of every vertex. The layers are columns as wide
+// as their widest node. In a column, every vertex is pulled to the mean
+// ordinate of its neighbours, keeping the order and the gaps of the column.
+func (l *layout) place() {
+	l.x = make([]float64, len(l.layer))
+	l.y = make([]float64, len(l.layer))
+	left := margin
+	for _, layer := range l.layers {
+		width := 0.
+		for _, v := range layer {
+			if l.width[v] > width {
+				width = l.width[v]
+			}
+		}
+		top := 0.
+		for _, v := range layer {
+			l.x[v] = left + width/2
+			l.y[v] = top + l.height[v]/2
+			top += l.height[v] + rowGap
+		}
+		left += width + layerGap
+	}
+
+	for pass := 0; pass < 4; pass++ {
+		if pass%2 == 0 {
+			for layer := 1; layer < len(l.layers); layer++ {
+				l.align(l.layers[layer], l.up)
+			}
+		} else {
+			for layer := len(l.layers) - 2; layer >= 0; layer-- {
+				l.align(l.layers[layer], l.down)
+			}
+		}
+	}
+
+	top := 0.
+	for v := range l.y {
+		if t := l.y[v] - l.height[v]/2; v == 0 || t < top {
+			top = t
+		}
+	}
+	for v := range l.y {
+		l.y[v] += margin - top
+	}
+}
+
+// align moves the vertices of a column toward the mean ordinate of their
+// neighbours. The vertices are placed once pushed down from the top and once
+// pushed up from the bottom, both placements keeping the gaps, and the mean
+// of both is taken.
+func (l *layout) align(vertices []int, neighbours [][]int) {
+	n := len(vertices)
+	if n == 0 {
+		return
+	}
+	desired := make([]float64, n)
+	for i, v := range vertices {
+		desired[i] = l.y[v]
+		if len(neighbours[v]) > 0 {
+			sum := 0.
+			for _, w := range neighbours[v] {
+				sum += l.y[w]
+			}
+			desired[i] = sum / float64(len(neighbours[v]))
+		}
+	}
+	gap := func(i int) float64 {
+		return (l.height[vertices[i-1]]+l.height[vertices[i]])/2 + rowGap
+	}
+	down := make([]float64, n)
+	for i := range vertices {
+		down[i] = desired[i]
+		if i > 0 && down[i-1]+gap(i) > down[i] {
+			down[i] = down[i-1] + gap(i)
+		}
+	}
+	up := make([]float64, n)
+	for i := n - 1; i >= 0; i-- {
+		up[i] = desired[i]
+		if i < n-1 && up[i+1]-gap(i+1) < up[i] {
+			up[i] = up[i+1] - gap(i+1)
+		}
+	}
+	for i, v := range vertices {
+		l.y[v] = (down[i] + up[i]) / 2
+	}
+}
+
+// size returns the width and height of the drawing.
+func (l *layout) size() (float64, float64) {
+	width, height := 0., 0.
+	for v := range l.x {
+		if r := l.x[v] + l.width[v]/2; r > width {
+			width = r
+		}
+		if b := l.y[v] + l.height[v]/2; b > height {
+			height = b
+		}
+	}
+	return width + margin, height + margin
+}
+
+// SVG draws the graph from left to right, importers before their imports,
+// and returns the svg document, or "" if the graph is empty. The nodes and
+// links carry the package names in data-name, data-from and data-to
+// attributes, for the report to filter them.
+func (g *Graph) SVG() string {
+	if len(g.Nodes) == 0 {
+		return ""
+	}
+	l := newLayout(g)
+	width, height := l.size()
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%.0f" height="%.0f" viewBox="0 0 %.0f %.0f" font-family="sans-serif" font-size="12">`, width, height, width, height)
+	buf.WriteString(`<defs>`)
+	for _, marker := range [][2]string{{"depend-arrow", "#555"}, {"depend-arrow-red", "red"}} {
+		fmt.Fprintf(&buf, `<marker id="%s" viewBox="0 0 10 10" refX="10" refY="5" markerWidth="7" markerHeight="7" orient="auto"><path d="M0,0L10,5L0,10z" fill="%s"/></marker>`, marker[0], marker[1])
+	}
+	buf.WriteString(`</defs><g class="links">`)
+	for i, link := range g.Links {
+		stroke, width, marker := "#555", 1, "depend-arrow"
+		if link.Highlight {
+			stroke, width, marker = "red", 2, "depend-arrow-red"
+		}
+		fmt.Fprintf(&buf, `<path class="link" data-from="%s" data-to="%s" d="%s" fill="none" stroke="%s" stroke-width="%d" marker-end="url(#%s)"/>`,
+			html.EscapeString(g.Nodes[link.From].Name), html.EscapeString(g.Nodes[link.To].Name), l.curve(l.paths[i]), stroke, width, marker)
+	}
+	buf.WriteString(`</g><g class="nodes">`)
+	for v, node := range g.Nodes {
+		name := html.EscapeString(node.Name)
+		fmt.Fprintf(&buf, `<g class="node" data-name="%s"><title>%s</title>`, name, name)
+		fmt.Fprintf(&buf, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" rx="4" fill="%s" stroke="#555"/>`,
+			l.x[v]-l.width[v]/2, l.y[v]-l.height[v]/2, l.width[v], l.height[v], svgColors[node.Kind])
+		fmt.Fprintf(&buf, `<text x="%.1f" y="%.1f" text-anchor="middle" dominant-baseline="central">%s</text></g>`,
+			l.x[v], l.y[v], html.EscapeString(l.labels[v]))
+	}
+	buf.WriteString(`</g></svg>`)
+	return buf.String()
+}
+
+// curve returns the svg path of a link through the vertices of path, leaving
+// and entering the nodes by their side facing the next vertex.
+func (l *layout) curve(path []int) string {
+	points := make([][2]float64, len(path))
+	for i, v := range path {
+		points[i] = [2]float64{l.x[v], l.y[v]}
+	}
+	side := func(v int, toward float64) float64 {
+		if toward >= l.x[v] {
+			return l.x[v] + l.width[v]/2
+		}
+		return l.x[v] - l.width[v]/2
+	}
+	last := len(path) - 1
+	points[0][0] = side(path[0], l.x[path[1]])
+	points[last][0] = side(path[last], l.x[path[last-1]])
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "M%.1f,%.1f", points[0][0], points[0][1])
+	for i := 1; i <= last; i++ {
+		p, q := points[i-1], points[i]
+		mid := (p[0] + q[0]) / 2
+		fmt.Fprintf(&buf, "C%.1f,%.1f %.1f,%.1f %.1f,%.1f", mid, p[1], mid, q[1], q[0], q[1])
+	}
+	return buf.String()
+}
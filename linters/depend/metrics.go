@@ -0,0 +1,112 @@
+package depend
+
+import (
+	"go/build"
+	"go/types"
+	"math"
+	"strings"
+
+	"golang.org/x/tools/go/loader"
+)
+
+// Packages whose distance from the main sequence is above ZoneDistance are in
+// the zone of pain, concrete and depended upon, or in the zone of
+// uselessness, abstract and without dependents.
+const (
+	ZoneDistance    = 0.5
+	ZonePain        = "pain"
+	ZoneUselessness = "uselessness"
+)
+
+// PackageMetrics are the package metrics of Robert C. Martin. Afferent is the
+// number of project packages that import the package, Efferent the number of
+// packages it imports, the standard library aside. Instability is
+// Efferent/(Afferent+Efferent) and Abstractness the ratio of Interfaces to
+// all the named Types the package declares. Distance is the distance
+// |Abstractness+Instability-1| from the main sequence and Zone the zone the
+// package is in, if any.
+type PackageMetrics struct {
+	Afferent     int     `json:"afferent"`
+	Efferent     int     `json:"efferent"`
+	Instability  float64 `json:"instability"`
+	Interfaces   int     `json:"interfaces"`
+	Types        int     `json:"types"`
+	Abstractness float64 `json:"abstractness"`
+	Distance     float64 `json:"distance"`
+	Zone         string  `json:"zone"`
+}
+
+// Metrics type-checks the packages importPaths, built with the given build
+// tags, and returns their metrics by import path. Test files are left out.
+func Metrics(importPaths []string, tags ...string) (map[string]PackageMetrics, error) {
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, tags...)
+
+	conf := loader.Config{Build: &ctx}
+	conf.AllowErrors = true
+	conf.TypeChecker.Error = func(error) {}
+	project := make(map[string]bool, len(importPaths))
+	for _, path := range importPaths {
+		conf.Import(path)
+		project[path] = true
+	}
+	lprog, err := conf.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	metrics := make(map[string]PackageMetrics, len(importPaths))
+	dependents := make(map[string]int)
+	for _, path := range importPaths {
+		info := lprog.Imported[path]
+		if info == nil {
+			continue
+		}
+		var m PackageMetrics
+		for _, imp := range info.Pkg.Imports() {
+			if !project[imp.Path()] && isStandard(imp.Path()) {
+				continue
+			}
+			m.Efferent++
+			dependents[imp.Path()]++
+		}
+		scope := info.Pkg.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || obj.IsAlias() {
+				continue
+			}
+			m.Types++
+			if types.IsInterface(obj.Type()) {
+				m.Interfaces++
+			}
+		}
+		metrics[path] = m
+	}
+
+	for path, m := range metrics {
+		m.Afferent = dependents[path]
+		if m.Afferent+m.Efferent > 0 {
+			m.Instability = float64(m.Efferent) / float64(m.Afferent+m.Efferent)
+		}
+		if m.Types > 0 {
+			m.Abstractness = float64(m.Interfaces) / float64(m.Types)
+		}
+		m.Distance = math.Abs(m.Abstractness + m.Instability - 1)
+		if m.Distance > ZoneDistance {
+			if m.Abstractness+m.Instability < 1 {
+				m.Zone = ZonePain
+			} else {
+				m.Zone = ZoneUselessness
+			}
+		}
+		metrics[path] = m
+	}
+	return metrics, nil
+}
+
+// isStandard reports whether path is a package of the standard library,
+// whose first element has no dot.
+func isStandard(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
@@ -0,0 +1,5 @@
+package a
+
+type Shape interface {
+	Area() float64
+}
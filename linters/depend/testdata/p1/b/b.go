@@ -0,0 +1,19 @@
+package b
+
+import (
+	"fmt"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend/testdata/p1/a"
+)
+
+type Square struct {
+	Side float64
+}
+
+func (s Square) Area() float64 {
+	return s.Side * s.Side
+}
+
+func Describe(s a.Shape) string {
+	return fmt.Sprint(s.Area())
+}
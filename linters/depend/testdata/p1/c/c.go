@@ -0,0 +1,8 @@
+package c
+
+import (
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend/testdata/p1/a"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/depend/testdata/p1/b"
+)
+
+var Default a.Shape = b.Square{Side: 1}
@@ -33,11 +33,29 @@ var (
 	ErrNoGoFiles = errors.New("package contains no go source files")
 )
 
-// UncheckedError indicates the position of an unchecked error return.
+// Kinds of an UncheckedError.
+const (
+	// KindUnchecked is a call, or a go statement, whose error is not used.
+	KindUnchecked = "unchecked"
+	// KindBlank is an error assigned to the blank identifier.
+	KindBlank = "blank"
+	// KindAssert is a type assertion whose success is not checked.
+	KindAssert = "assert"
+	// KindDefer is a deferred call whose error is discarded.
+	KindDefer = "defer"
+	// KindOverwritten is an error assigned to a variable and assigned again
+	// before being read.
+	KindOverwritten = "overwritten"
+)
+
+// UncheckedError indicates the position of an unchecked error return. FuncName
+// is the full name of the called function, as "(*os.File).Close", empty for a
+// type assertion or a call of a function value.
 type UncheckedError struct {
 	Pos      token.Position
 	Line     string
 	FuncName string
+	Kind     string
 }
 
 // UncheckedErrors is returned from the CheckPackage function if the package contains
@@ -88,6 +106,46 @@ func (e byName) Less(i, j int) bool {
 	return ei.Line < ej.Line
 }
 
+// Options selects the unchecked errors reported by ErrorCheck. The calls
+// whose error is not used are always reported; with Blank, the errors
+// assigned to the blank identifier too, with Asserts the type assertions
+// whose success is not checked, with Defer the errors of the deferred calls,
+// as in defer f.Close(), and with Overwrite the errors assigned to a variable
+// that is assigned again before being read.
+//
+// Exclude lists the full names of the functions whose errors may be ignored,
+// as "(*bytes.Buffer).Write" or "io/ioutil.WriteFile", a trailing * matching
+// any suffix, as in "fmt.Print*". They are added to the default exclusions of
+// the standard library. Tests adds the test files and Tags are the build tags.
+type Options struct {
+	Exclude   []string
+	Blank     bool
+	Asserts   bool
+	Defer     bool
+	Overwrite bool
+	Tests     bool
+	Tags      []string
+}
+
+// ErrorCheck checks the packages importPaths and returns their unchecked
+// errors selected by opts, ordered by position.
+func ErrorCheck(importPaths []string, opts Options) ([]UncheckedError, error) {
+	errorcheck := NewChecker()
+	errorcheck.Blank = opts.Blank
+	errorcheck.Asserts = opts.Asserts
+	errorcheck.Defer = opts.Defer
+	errorcheck.Overwrite = opts.Overwrite
+	errorcheck.WithoutTests = !opts.Tests
+	errorcheck.Tags = opts.Tags
+	exclude := make(map[string]bool, len(opts.Exclude))
+	for _, name := range opts.Exclude {
+		exclude[name] = true
+	}
+	errorcheck.SetExclude(exclude)
+
+	return errorcheck.Check(importPaths...)
+}
+
 type Checker struct {
 	// ignore is a map of package names to regular expressions. Identifiers from a package are
 	// checked against its regular expressions and if any of the expressions match the call
@@ -101,6 +159,13 @@ type Checker struct {
 	// If asserts is true then ignored type assertion results are also checked
 	Asserts bool
 
+	// If defer is true then the errors of deferred calls are also checked
+	Defer bool
+
+	// If overwrite is true then errors assigned to a variable that is assigned
+	// again before being read are also reported
+	Overwrite bool
+
 	// build tags
 	Tags []string
 
@@ -113,11 +178,14 @@ type Checker struct {
 }
 
 func NewChecker() *Checker {
-	c := Checker{}
+	c := Checker{Defer: true}
 	c.SetExclude(map[string]bool{})
 	return &c
 }
 
+// SetExclude sets the full names of the functions whose errors are not
+// checked, besides the default ones. A name ending with * matches the names
+// with its prefix.
 func (c *Checker) SetExclude(l map[string]bool) {
 	// Default exclude for stdlib functions
 	c.exclude = map[string]bool{
@@ -144,8 +212,10 @@ func (c *Checker) load(paths ...string) (*loader.Program, error) {
 	ctx := build.Default
 	ctx.BuildTags = append(ctx.BuildTags, c.Tags...)
 	loadcfg := loader.Config{
-		Build: &ctx,
+		Build:       &ctx,
+		AllowErrors: true,
 	}
+	loadcfg.TypeChecker.Error = func(error) {}
 	rest, err := loadcfg.FromArgs(paths, !c.WithoutTests)
 	if err != nil {
 		return nil, fmt.Errorf("could not parse arguments: %s", err)
@@ -157,23 +227,18 @@ func (c *Checker) load(paths ...string) (*loader.Program, error) {
 	return loadcfg.Load()
 }
 
-func ErrorCheck(projectPath string) []string {
-	errorcheck := NewChecker()
-	errorcheck.Asserts = false
-	errorcheck.Blank = false
-	errorcheck.WithoutTests = true
-	errorcheck.Verbose = true
-
-	uncheckedErrors, err := errorcheck.CheckPackages(projectPath)
-	if err != nil {
-		return nil
+// CheckPackages checks packages for errors and formats them as
+// "file:line:col:\tfunc\tline".
+func (c *Checker) CheckPackages(paths ...string) ([]string, error) {
+	uncheckedErrors, err := c.Check(paths...)
+	if err != nil || len(uncheckedErrors) == 0 {
+		return nil, err
 	}
-
-	return uncheckedErrors
+	return reportUncheckedErrors(&UncheckedErrors{Errors: uncheckedErrors}, c.Verbose), nil
 }
 
-// CheckPackages checks packages for errors.
-func (c *Checker) CheckPackages(paths ...string) ([]string, error) {
+// Check checks packages for errors and returns them ordered by position.
+func (c *Checker) Check(paths ...string) ([]UncheckedError, error) {
 	program, err := c.load(paths...)
 	if err != nil {
 		return nil, fmt.Errorf("could not type check: %s", err)
@@ -193,17 +258,20 @@ func (c *Checker) CheckPackages(paths ...string) ([]string, error) {
 			c.logf("Checking %s", pkgInfo.Pkg.Path())
 
 			v := &visitor{
-				prog:    program,
-				pkg:     pkgInfo,
-				ignore:  c.Ignore,
-				blank:   c.Blank,
-				asserts: c.Asserts,
-				lines:   make(map[string][]string),
-				exclude: c.exclude,
-				errors:  []UncheckedError{},
+				prog:      program,
+				pkg:       pkgInfo,
+				ignore:    c.Ignore,
+				blank:     c.Blank,
+				asserts:   c.Asserts,
+				deferred:  c.Defer,
+				overwrite: c.Overwrite,
+				lines:     make(map[string][]string),
+				exclude:   c.exclude,
+				errors:    []UncheckedError{},
 			}
 
 			for _, astFile := range v.pkg.Files {
+				v.escaped = escapedVars(v.pkg, astFile)
 				ast.Walk(v, astFile)
 			}
 			u.Append(v.errors...)
@@ -211,52 +279,70 @@ func (c *Checker) CheckPackages(paths ...string) ([]string, error) {
 	}
 
 	wg.Wait()
-	if u.Len() > 0 {
-		sort.Sort(byName{u})
-
-		return reportUncheckedErrors(u, c.Verbose), nil
-	}
-	return nil, nil
+	sort.Sort(byName{u})
+	return u.Errors, nil
 }
 
 // visitor implements the errcheck algorithm
 type visitor struct {
-	prog    *loader.Program
-	pkg     *loader.PackageInfo
-	ignore  map[string]*regexp.Regexp
-	blank   bool
-	asserts bool
-	lines   map[string][]string
-	exclude map[string]bool
+	prog      *loader.Program
+	pkg       *loader.PackageInfo
+	ignore    map[string]*regexp.Regexp
+	blank     bool
+	asserts   bool
+	deferred  bool
+	overwrite bool
+	lines     map[string][]string
+	exclude   map[string]bool
+	escaped   map[types.Object]bool
 
 	errors []UncheckedError
 }
 
 func (v *visitor) fullName(call *ast.CallExpr) (string, bool) {
-	sel, ok := call.Fun.(*ast.SelectorExpr)
-	if !ok {
+	var id *ast.Ident
+	switch fun := ast.Unparen(call.Fun).(type) {
+	case *ast.Ident:
+		id = fun
+	case *ast.SelectorExpr:
+		id = fun.Sel
+	default:
 		return "", false
 	}
-	fn, ok := v.pkg.ObjectOf(sel.Sel).(*types.Func)
+	if v.isRecover(call) {
+		return "recover", true
+	}
+	fn, ok := v.pkg.ObjectOf(id).(*types.Func)
 	if !ok {
 		// Shouldn't happen, but be paranoid
 		return "", false
 	}
 	// The name is fully qualified by the import path, possible type,
-	// function/method name and pointer receiver.
-	//
-	// TODO(dh): vendored packages will have /vendor/ in their name,
-	// thus not matching vendored standard library packages. If we
-	// want to support vendored stdlib packages, we need to implement
-	// FullName with our own logic.
-	return fn.FullName(), true
+	// function/method name and pointer receiver. The import path of a
+	// vendored package is the unvendored one, so that it matches the
+	// exclusions and groups with the other copies of the package.
+	name := fn.FullName()
+	if fn.Pkg() != nil {
+		if nonVendoredPkg, ok := nonVendoredPkgPath(fn.Pkg().Path()); ok {
+			name = strings.Replace(name, fn.Pkg().Path(), nonVendoredPkg, 1)
+		}
+	}
+	return name, true
 }
 
 func (v *visitor) excludeCall(call *ast.CallExpr) bool {
-	if name, ok := v.fullName(call); ok {
-		return v.exclude[name]
+	name, ok := v.fullName(call)
+	if !ok {
+		return false
+	}
+	if v.exclude[name] {
+		return true
+	}
+	for pattern := range v.exclude {
+		if strings.HasSuffix(pattern, "*") && strings.HasPrefix(name, pattern[:len(pattern)-1]) {
+			return true
+		}
 	}
-
 	return false
 }
 
@@ -371,7 +457,7 @@ func (v *visitor) isRecover(call *ast.CallExpr) bool {
 	return false
 }
 
-func (v *visitor) addErrorAtPosition(position token.Pos, call *ast.CallExpr) {
+func (v *visitor) addErrorAtPosition(position token.Pos, call *ast.CallExpr, kind string) {
 	pos := v.prog.Fset.Position(position)
 	lines, ok := v.lines[pos.Filename]
 	if !ok {
@@ -389,7 +475,7 @@ func (v *visitor) addErrorAtPosition(position token.Pos, call *ast.CallExpr) {
 		name, _ = v.fullName(call)
 	}
 
-	v.errors = append(v.errors, UncheckedError{pos, line, name})
+	v.errors = append(v.errors, UncheckedError{pos, line, name, kind})
 }
 
 func readfile(filename string) []string {
@@ -397,6 +483,7 @@ func readfile(filename string) []string {
 	if err != nil {
 		return nil
 	}
+	defer f.Close()
 
 	var lines []string
 	var scanner = bufio.NewScanner(f)
@@ -411,16 +498,28 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 	case *ast.ExprStmt:
 		if call, ok := stmt.X.(*ast.CallExpr); ok {
 			if !v.ignoreCall(call) && v.callReturnsError(call) {
-				v.addErrorAtPosition(call.Lparen, call)
+				v.addErrorAtPosition(call.Lparen, call, KindUnchecked)
 			}
 		}
 	case *ast.GoStmt:
 		if !v.ignoreCall(stmt.Call) && v.callReturnsError(stmt.Call) {
-			v.addErrorAtPosition(stmt.Call.Lparen, stmt.Call)
+			v.addErrorAtPosition(stmt.Call.Lparen, stmt.Call, KindUnchecked)
 		}
 	case *ast.DeferStmt:
-		if !v.ignoreCall(stmt.Call) && v.callReturnsError(stmt.Call) {
-			v.addErrorAtPosition(stmt.Call.Lparen, stmt.Call)
+		if v.deferred && !v.ignoreCall(stmt.Call) && v.callReturnsError(stmt.Call) {
+			v.addErrorAtPosition(stmt.Call.Lparen, stmt.Call, KindDefer)
+		}
+	case *ast.BlockStmt:
+		if v.overwrite {
+			v.checkOverwrites(stmt.List)
+		}
+	case *ast.CaseClause:
+		if v.overwrite {
+			v.checkOverwrites(stmt.Body)
+		}
+	case *ast.CommClause:
+		if v.overwrite {
+			v.checkOverwrites(stmt.Body)
 		}
 	case *ast.AssignStmt:
 		if len(stmt.Rhs) == 1 {
@@ -438,7 +537,7 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 						// We shortcut calls to recover() because errorsByArg can't
 						// check its return types for errors since it returns interface{}.
 						if id.Name == "_" && (v.isRecover(call) || isError[i]) {
-							v.addErrorAtPosition(id.NamePos, call)
+							v.addErrorAtPosition(id.NamePos, call, KindBlank)
 						}
 					}
 				}
@@ -452,10 +551,10 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 				}
 				if len(stmt.Lhs) < 2 {
 					// assertion result not read
-					v.addErrorAtPosition(stmt.Rhs[0].Pos(), nil)
+					v.addErrorAtPosition(stmt.Rhs[0].Pos(), nil, KindAssert)
 				} else if id, ok := stmt.Lhs[1].(*ast.Ident); ok && v.blank && id.Name == "_" {
 					// assertion result ignored
-					v.addErrorAtPosition(id.NamePos, nil)
+					v.addErrorAtPosition(id.NamePos, nil, KindAssert)
 				}
 			}
 		} else {
@@ -471,7 +570,7 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 							continue
 						}
 						if id.Name == "_" && v.callReturnsError(call) {
-							v.addErrorAtPosition(id.NamePos, call)
+							v.addErrorAtPosition(id.NamePos, call, KindBlank)
 						}
 					} else if assert, ok := stmt.Rhs[i].(*ast.TypeAssertExpr); ok {
 						if !v.asserts {
@@ -481,7 +580,7 @@ func (v *visitor) Visit(node ast.Node) ast.Visitor {
 							// Shouldn't happen anyway, no multi assignment in type switches
 							continue
 						}
-						v.addErrorAtPosition(id.NamePos, nil)
+						v.addErrorAtPosition(id.NamePos, nil, KindAssert)
 					}
 				}
 			}
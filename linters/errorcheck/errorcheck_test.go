@@ -1,9 +1,98 @@
 package errorcheck
 
 import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"sort"
+	"strings"
 	"testing"
 )
 
-func Test_ErrorcCeck(t *testing.T) {
-	ErrorCheck("./test")
+const testPackage = "github.com/360EntSecGroup-Skylar/goreporter/linters/errorcheck/test"
+
+// markers maps the comments of the files of ./test to the kinds of the
+// unchecked errors expected on their lines.
+var markers = map[string]string{
+	"UNCHECKED":   KindUnchecked,
+	"BLANK":       KindBlank,
+	"ASSERT":      KindAssert,
+	"DEFER":       KindDefer,
+	"OVERWRITTEN": KindOverwritten,
+}
+
+// expected returns the "file:line kind" of the markers of ./test whose kind
+// is in kinds.
+func expected(t *testing.T, kinds ...string) []string {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, "test", nil, parser.ParseComments)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for _, pkg := range pkgs {
+		for _, file := range pkg.Files {
+			for _, group := range file.Comments {
+				for _, c := range group.List {
+					kind := markers[strings.TrimSpace(strings.TrimPrefix(c.Text, "//"))]
+					for _, k := range kinds {
+						if kind == k {
+							pos := fset.Position(c.Pos())
+							want = append(want, fmt.Sprintf("%s:%d %s", filepath.Base(pos.Filename), pos.Line, kind))
+						}
+					}
+				}
+			}
+		}
+	}
+	sort.Strings(want)
+	return want
+}
+
+func Test_ErrorCheck(t *testing.T) {
+	cases := []struct {
+		opts  Options
+		kinds []string
+	}{
+		{Options{}, []string{KindUnchecked}},
+		{Options{Blank: true, Asserts: true}, []string{KindUnchecked, KindBlank, KindAssert}},
+		{Options{Defer: true, Overwrite: true}, []string{KindUnchecked, KindDefer, KindOverwritten}},
+	}
+	for _, c := range cases {
+		errs, err := ErrorCheck([]string{testPackage}, c.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make([]string, 0, len(errs))
+		for _, e := range errs {
+			got = append(got, fmt.Sprintf("%s:%d %s", filepath.Base(e.Pos.Filename), e.Pos.Line, e.Kind))
+		}
+		sort.Strings(got)
+		want := expected(t, c.kinds...)
+		if strings.Join(got, "\n") != strings.Join(want, "\n") {
+			t.Errorf("%+v: got\n%s\nwant\n%s", c.opts, strings.Join(got, "\n"), strings.Join(want, "\n"))
+		}
+	}
+}
+
+func Test_ErrorCheckExclude(t *testing.T) {
+	errs, err := ErrorCheck([]string{testPackage}, Options{Exclude: []string{"fmt.Print*", testPackage + ".customError"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	names := make(map[string]bool)
+	for _, e := range errs {
+		names[e.FuncName] = true
+	}
+	for _, name := range []string{"fmt.Println", testPackage + ".customError"} {
+		if names[name] {
+			t.Errorf("%s is not excluded", name)
+		}
+	}
+	for _, name := range []string{"io/ioutil.ReadFile", "(" + testPackage + ".t).a", testPackage + ".customConcreteError"} {
+		if !names[name] {
+			t.Errorf("no unchecked error of %s in %v", name, names)
+		}
+	}
 }
@@ -0,0 +1,174 @@
+package errorcheck
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/loader"
+)
+
+// assignedError is an error returned by call and assigned to the variable obj
+// through the identifier id.
+type assignedError struct {
+	id   *ast.Ident
+	obj  *types.Var
+	call *ast.CallExpr
+}
+
+// checkOverwrites reports the errors assigned to a local variable by a
+// statement of list that a later statement of list assigns again before any
+// statement reads the variable. The scan stops at a statement that may jump,
+// as a return or a break, or that is labeled. The named results, the
+// variables captured by a closure and those whose address is taken may be
+// read elsewhere, they are not checked.
+func (v *visitor) checkOverwrites(list []ast.Stmt) {
+	for i, stmt := range list {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok {
+			continue
+		}
+		for _, e := range v.assignedErrors(assign) {
+			if v.overwritten(e.obj, list[i+1:]) {
+				v.addErrorAtPosition(e.id.NamePos, e.call, KindOverwritten)
+			}
+		}
+	}
+}
+
+// assignedErrors returns the errors of the calls of assign that it assigns to
+// local variables.
+func (v *visitor) assignedErrors(assign *ast.AssignStmt) []assignedError {
+	if assign.Tok != token.ASSIGN && assign.Tok != token.DEFINE {
+		return nil
+	}
+	var errs []assignedError
+	add := func(lhs ast.Expr, call *ast.CallExpr) {
+		id, ok := lhs.(*ast.Ident)
+		if !ok || id.Name == "_" {
+			return
+		}
+		obj, ok := v.pkg.ObjectOf(id).(*types.Var)
+		if !ok || v.escaped[obj] || obj.Pkg() == nil || obj.Parent() == obj.Pkg().Scope() {
+			return
+		}
+		errs = append(errs, assignedError{id: id, obj: obj, call: call})
+	}
+	if len(assign.Rhs) == 1 {
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok || v.ignoreCall(call) {
+			return nil
+		}
+		isError := v.errorsByArg(call)
+		for i, lhs := range assign.Lhs {
+			if i < len(isError) && isError[i] {
+				add(lhs, call)
+			}
+		}
+		return errs
+	}
+	for i, rhs := range assign.Rhs {
+		call, ok := rhs.(*ast.CallExpr)
+		if !ok || i >= len(assign.Lhs) || v.ignoreCall(call) {
+			continue
+		}
+		if isError := v.errorsByArg(call); len(isError) == 1 && isError[0] {
+			add(assign.Lhs[i], call)
+		}
+	}
+	return errs
+}
+
+// overwritten reports whether a statement of list assigns obj before any
+// statement reads it or jumps.
+func (v *visitor) overwritten(obj *types.Var, list []ast.Stmt) bool {
+	for _, stmt := range list {
+		if assign, ok := stmt.(*ast.AssignStmt); ok && v.assigns(assign, obj) {
+			for _, rhs := range assign.Rhs {
+				if v.reads(rhs, obj) {
+					return false
+				}
+			}
+			return true
+		}
+		if v.reads(stmt, obj) || jumps(stmt) {
+			return false
+		}
+	}
+	return false
+}
+
+// assigns reports whether assign assigns obj.
+func (v *visitor) assigns(assign *ast.AssignStmt, obj *types.Var) bool {
+	if assign.Tok != token.ASSIGN && assign.Tok != token.DEFINE {
+		return false
+	}
+	for _, lhs := range assign.Lhs {
+		if id, ok := lhs.(*ast.Ident); ok && v.pkg.ObjectOf(id) == obj {
+			return true
+		}
+	}
+	return false
+}
+
+// reads reports whether node refers to obj.
+func (v *visitor) reads(node ast.Node, obj *types.Var) bool {
+	found := false
+	ast.Inspect(node, func(n ast.Node) bool {
+		if id, ok := n.(*ast.Ident); ok && v.pkg.Uses[id] == obj {
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// jumps reports whether stmt is labeled or holds a branch or a return
+// statement outside of a function literal.
+func jumps(stmt ast.Stmt) bool {
+	found := false
+	ast.Inspect(stmt, func(n ast.Node) bool {
+		switch n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.BranchStmt, *ast.ReturnStmt, *ast.LabeledStmt:
+			found = true
+		}
+		return !found
+	})
+	return found
+}
+
+// escapedVars returns the variables of file that may be read out of the
+// statements that assign them: the named results, the variables whose
+// address is taken and those that a function literal captures.
+func escapedVars(pkg *loader.PackageInfo, file *ast.File) map[types.Object]bool {
+	escaped := make(map[types.Object]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.FuncType:
+			if n.Results != nil {
+				for _, field := range n.Results.List {
+					for _, name := range field.Names {
+						escaped[pkg.Defs[name]] = true
+					}
+				}
+			}
+		case *ast.UnaryExpr:
+			if id, ok := ast.Unparen(n.X).(*ast.Ident); ok && n.Op == token.AND {
+				escaped[pkg.Uses[id]] = true
+			}
+		case *ast.FuncLit:
+			ast.Inspect(n.Body, func(m ast.Node) bool {
+				if id, ok := m.(*ast.Ident); ok {
+					if obj, ok := pkg.Uses[id].(*types.Var); ok && (obj.Pos() < n.Pos() || obj.Pos() >= n.End()) {
+						escaped[obj] = true
+					}
+				}
+				return true
+			})
+		}
+		return true
+	})
+	return escaped
+}
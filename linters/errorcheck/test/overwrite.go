@@ -0,0 +1,36 @@
+package main
+
+import "os"
+
+func overwritten() error {
+	err := a() // OVERWRITTEN
+	err = a()
+	if err != nil {
+		return err
+	}
+
+	_, err = b() // OVERWRITTEN
+	n, err := b()
+	if err != nil || n > 0 {
+		return err
+	}
+
+	err = a()
+	if n == 0 {
+		return nil
+	}
+	err = a()
+	return err
+}
+
+func closed(name string) (err error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close() // DEFER
+
+	err = a()
+	err = a()
+	return
+}
@@ -0,0 +1,330 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fix applies the safe fixes that the linters of GoReporter know:
+// the simplifications of simplecode, the field reordering of aligncheck, the
+// misspellings of the comments and the gofmt and goimports formatting.
+package fix
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"golang.org/x/tools/go/loader"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/aligncheck"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/gofmt"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/spellcheck/misspell"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// The rules that are not simplifications.
+const (
+	RuleAligncheck = "aligncheck"
+	RuleMisspell   = "misspell"
+	RuleGoimports  = "goimports"
+	RuleGofmt      = "gofmt"
+)
+
+// Simplifications maps the IDs of the simplification rules, those of the
+// checks of simplecode, to the rewrites they do.
+var Simplifications = map[string]string{
+	"S1002": "x == true to x and x == false to !x",
+	"S1005": "for _ = range x to for range x and _ = <-ch to <-ch",
+	"S1006": "for true {} to for {}",
+	"S1010": "s[a:len(s)] to s[a:]",
+	"S1012": "time.Now().Sub(t) to time.Since(t)",
+}
+
+// Rules returns the names of all the rules in the order they apply.
+func Rules() []string {
+	rules := make([]string, 0, len(Simplifications)+4)
+	for id := range Simplifications {
+		rules = append(rules, id)
+	}
+	sort.Strings(rules)
+	return append(rules, RuleAligncheck, RuleMisspell, RuleGoimports, RuleGofmt)
+}
+
+// Options configures Fix. Rules are the names of the rules to apply, all of
+// them if empty. Arch is the GOARCH of the sizes of aligncheck, Tests adds
+// the test files and Tags are the build tags.
+type Options struct {
+	Rules []string
+	Arch  string
+	Tests bool
+	Tags  []string
+}
+
+// Change is the fix of the file File, whose source Src becomes Fixed through
+// the rules Rules.
+type Change struct {
+	File  string
+	Rules []string
+	Src   []byte
+	Fixed []byte
+}
+
+// Diff returns the change in the unified format of gofmt -d.
+func (c Change) Diff() string {
+	return utils.UnifiedDiff(c.File+".orig", c.File, c.Src, c.Fixed)
+}
+
+// edit replaces the bytes start to end of a source with text for rule.
+type edit struct {
+	start, end int
+	text       string
+	rule       string
+}
+
+// Fix returns the changes that the rules of opts make to the files of the
+// packages importPaths, by file name. The generated files are skipped and
+// so are the simplifications of the packages that do not type check. A file
+// that would not parse once fixed is left as is.
+func Fix(importPaths []string, opts Options) ([]Change, error) {
+	rules := make(map[string]bool)
+	for _, rule := range opts.Rules {
+		rules[rule] = true
+	}
+	if len(rules) == 0 {
+		for _, rule := range Rules() {
+			rules[rule] = true
+		}
+	}
+	for rule := range rules {
+		if _, ok := Simplifications[rule]; !ok && rule != RuleAligncheck && rule != RuleMisspell &&
+			rule != RuleGoimports && rule != RuleGofmt {
+			return nil, fmt.Errorf("unknown rule %q, want one of %s", rule, strings.Join(Rules(), ", "))
+		}
+	}
+
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, opts.Tags...)
+	conf := loader.Config{Build: &ctx, ParserMode: parser.ParseComments}
+	conf.AllowErrors = true
+	conf.TypeChecker.Error = func(error) {}
+	for _, path := range importPaths {
+		if opts.Tests {
+			conf.ImportWithTests(path)
+		} else {
+			conf.Import(path)
+		}
+	}
+	lprog, err := conf.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	edits := make(map[string][]edit)
+	if rules[RuleAligncheck] {
+		structs, err := aligncheck.Structs(lprog, aligncheck.Options{Arch: opts.Arch, Tests: opts.Tests})
+		if err != nil {
+			return nil, err
+		}
+		for _, s := range structs {
+			if s.Fixable {
+				pos, end, decl := s.Edit()
+				edits[s.File] = append(edits[s.File], edit{start: pos, end: end, text: decl, rule: RuleAligncheck})
+			}
+		}
+	}
+
+	var replacer *misspell.Replacer
+	if rules[RuleMisspell] {
+		replacer = misspell.New()
+	}
+
+	changes := make([]Change, 0)
+	seen := make(map[string]bool)
+	for _, pkgInfo := range lprog.InitialPackages() {
+		for _, file := range pkgInfo.Files {
+			tokFile := lprog.Fset.File(file.Pos())
+			filename := tokFile.Name()
+			if seen[filename] || lint.IsGenerated(file) || (!opts.Tests && strings.HasSuffix(filename, "_test.go")) {
+				continue
+			}
+			seen[filename] = true
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				return nil, err
+			}
+			fileEdits := edits[filename]
+			if len(pkgInfo.Errors) == 0 {
+				fileEdits = append(fileEdits, simplifications(pkgInfo, file, tokFile, src, rules)...)
+			}
+			change, err := fixFile(filename, src, fileEdits, replacer, rules)
+			if err != nil {
+				glog.Warningf("%s not fixed: %v", filename, err)
+				continue
+			}
+			if len(change.Rules) > 0 {
+				changes = append(changes, change)
+			}
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].File < changes[j].File
+	})
+	return changes, nil
+}
+
+// fixFile applies to src, the source of filename, the edits, then the
+// misspelling replacements of replacer if not nil, then the formatting of
+// rules.
+func fixFile(filename string, src []byte, edits []edit, replacer *misspell.Replacer, rules map[string]bool) (Change, error) {
+	change := Change{File: filename, Src: src, Fixed: src}
+	applied := make(map[string]bool)
+	change.Fixed = applyEdits(change.Fixed, edits, applied)
+
+	if replacer != nil {
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, filename, change.Fixed, parser.ParseComments)
+		if err != nil {
+			return change, err
+		}
+		change.Fixed = applyEdits(change.Fixed, misspellings(fset, file, replacer), applied)
+	}
+
+	if rules[RuleGoimports] {
+		fixed, err := gofmt.GroupImports(filename, change.Fixed)
+		if err != nil {
+			return change, err
+		}
+		if string(fixed) != string(change.Fixed) {
+			applied[RuleGoimports] = true
+		}
+		change.Fixed = fixed
+	}
+
+	if rules[RuleGofmt] || rules[RuleGoimports] {
+		fixed, err := format.Source(change.Fixed)
+		if err != nil {
+			return change, err
+		}
+		if string(fixed) != string(change.Fixed) {
+			if rules[RuleGofmt] {
+				applied[RuleGofmt] = true
+			} else {
+				applied[RuleGoimports] = true
+			}
+		}
+		change.Fixed = fixed
+	} else if _, err := parser.ParseFile(token.NewFileSet(), filename, change.Fixed, parser.ParseComments); err != nil {
+		return change, err
+	}
+
+	for _, rule := range Rules() {
+		if applied[rule] {
+			change.Rules = append(change.Rules, rule)
+		}
+	}
+	return change, nil
+}
+
+// applyEdits returns src with edits applied, marking their rules in applied.
+// An edit that overlaps a previous one is dropped, a next run applying it.
+func applyEdits(src []byte, edits []edit, applied map[string]bool) []byte {
+	sort.SliceStable(edits, func(i, j int) bool {
+		if edits[i].start != edits[j].start {
+			return edits[i].start < edits[j].start
+		}
+		return edits[i].end > edits[j].end
+	})
+	out := make([]byte, 0, len(src))
+	last := 0
+	for _, e := range edits {
+		if e.start < last || e.end > len(src) {
+			continue
+		}
+		out = append(out, src[last:e.start]...)
+		out = append(out, e.text...)
+		last = e.end
+		applied[e.rule] = true
+	}
+	return append(out, src[last:]...)
+}
+
+// misspellings returns the edits correcting the misspelled words of the
+// comments of file. The directives, the cgo preambles and the outputs of the
+// examples are left as is.
+func misspellings(fset *token.FileSet, file *ast.File, replacer *misspell.Replacer) []edit {
+	skipped := make(map[*ast.CommentGroup]bool)
+	for _, spec := range file.Imports {
+		if spec.Path.Value == `"C"` {
+			skipped[spec.Doc] = true
+			for _, decl := range file.Decls {
+				if gen, ok := decl.(*ast.GenDecl); ok && gen.Pos() <= spec.Pos() && spec.End() <= gen.End() {
+					skipped[gen.Doc] = true
+				}
+			}
+		}
+	}
+
+	var edits []edit
+	tokFile := fset.File(file.Pos())
+	for _, group := range file.Comments {
+		if skipped[group] || strings.HasPrefix(strings.ToLower(group.Text()), "output:") ||
+			strings.HasPrefix(strings.ToLower(group.Text()), "unordered output:") {
+			continue
+		}
+		for _, c := range group.List {
+			if isDirective(c.Text) {
+				continue
+			}
+			if fixed, diffs := replacer.Replace(c.Text); len(diffs) > 0 {
+				edits = append(edits, edit{
+					start: tokFile.Offset(c.Pos()),
+					end:   tokFile.Offset(c.End()),
+					text:  fixed,
+					rule:  RuleMisspell,
+				})
+			}
+		}
+	}
+	return edits
+}
+
+// isDirective reports whether the comment text is read by a tool, as
+// //go:generate or a build constraint.
+func isDirective(text string) bool {
+	for _, prefix := range []string{"//go:", "//line ", "//export ", "//extern ", "// +build", "//nolint"} {
+		if strings.HasPrefix(text, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply writes the fixed sources of changes to their files.
+func Apply(changes []Change) error {
+	for _, change := range changes {
+		info, err := os.Stat(change.File)
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(change.File, change.Fixed, info.Mode()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
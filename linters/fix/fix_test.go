@@ -0,0 +1,66 @@
+package fix
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/fix/testdata/fixme"
+
+func Test_Fix(t *testing.T) {
+	changes, err := Fix([]string{testdata}, Options{Arch: "amd64"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("want 1 change, but got %d", len(changes))
+	}
+	want, err := ioutil.ReadFile("testdata/fixme.golden")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(changes[0].Fixed) != string(want) {
+		t.Errorf("got\n%s\nwant\n%s", changes[0].Fixed, want)
+	}
+	if got := strings.Join(changes[0].Rules, " "); got != strings.Join(Rules(), " ") {
+		t.Errorf("want rules %v, but got %s", Rules(), got)
+	}
+	if diff := changes[0].Diff(); !strings.Contains(diff, "-\tfor true {\n") || !strings.Contains(diff, "+\tfor {\n") {
+		t.Errorf("unexpected diff\n%s", diff)
+	}
+}
+
+func Test_FixRules(t *testing.T) {
+	changes, err := Fix([]string{testdata}, Options{Rules: []string{"S1012", RuleGofmt}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 {
+		t.Fatalf("want 1 change, but got %d", len(changes))
+	}
+	want := `@@ -25,7 +25,7 @@
+ 	C bool
+ }
+ 
+-var origin = Point{false,0,false}
++var origin = Point{false, 0, false}
+ 
+ func check(ok bool, flags Flags, ch chan int, s string, start time.Time) {
+ 	if ok == true {
+@@ -48,5 +48,5 @@
+ 	for true {
+ 		break
+ 	}
+-	fmt.Println(time.Now().Sub(start), utils.AbsPath(s))
++	fmt.Println(time.Since(start), utils.AbsPath(s))
+ }
+`
+	if diff := changes[0].Diff(); !strings.HasSuffix(diff, want) {
+		t.Errorf("got\n%s\nwant\n%s", diff, want)
+	}
+
+	if _, err := Fix([]string{testdata}, Options{Rules: []string{"S9999"}}); err == nil {
+		t.Error("want an error for an unknown rule")
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fix
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/loader"
+)
+
+// simplifications returns the edits of the simplification rules of file,
+// whose source is src. The rewrites that would drop a comment are skipped.
+func simplifications(pkgInfo *loader.PackageInfo, file *ast.File, tokFile *token.File, src []byte, rules map[string]bool) []edit {
+	var edits []edit
+	text := func(node ast.Node) string {
+		return string(src[tokFile.Offset(node.Pos()):tokFile.Offset(node.End())])
+	}
+	add := func(rule string, start, end token.Pos, replacement string) {
+		if !rules[rule] {
+			return
+		}
+		for _, group := range file.Comments {
+			if group.Pos() >= start && group.End() <= end {
+				return
+			}
+		}
+		edits = append(edits, edit{
+			start: tokFile.Offset(start),
+			end:   tokFile.Offset(end),
+			text:  replacement,
+			rule:  rule,
+		})
+	}
+
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.BinaryExpr:
+			// S1002: x == true is x and x == false is !x
+			if n.Op != token.EQL && n.Op != token.NEQ {
+				break
+			}
+			other, val, ok := n.X, false, false
+			if val, ok = boolLiteral(pkgInfo, n.Y); !ok {
+				other = n.Y
+				val, ok = boolLiteral(pkgInfo, n.X)
+			}
+			if _, isLiteral := boolLiteral(pkgInfo, other); !ok || isLiteral || !isBool(pkgInfo.TypeOf(other)) {
+				break
+			}
+			replacement := text(other)
+			if (n.Op == token.EQL) != val {
+				if _, ok := other.(*ast.BinaryExpr); ok {
+					replacement = "(" + replacement + ")"
+				}
+				replacement = "!" + replacement
+			}
+			add("S1002", n.Pos(), n.End(), replacement)
+		case *ast.RangeStmt:
+			// S1005: for _ = range x is for range x
+			if isBlank(n.Key) && (n.Value == nil || isBlank(n.Value)) {
+				add("S1005", n.Key.Pos(), n.Range, "")
+			}
+		case *ast.AssignStmt:
+			// S1005: _ = <-ch is <-ch
+			if n.Tok != token.ASSIGN || len(n.Lhs) != 1 || len(n.Rhs) != 1 || !isBlank(n.Lhs[0]) {
+				break
+			}
+			if recv, ok := n.Rhs[0].(*ast.UnaryExpr); ok && recv.Op == token.ARROW {
+				add("S1005", n.Pos(), recv.Pos(), "")
+			}
+		case *ast.ForStmt:
+			// S1006: for true {} is for {}
+			if n.Init != nil || n.Post != nil || n.Cond == nil {
+				break
+			}
+			if val, ok := boolLiteral(pkgInfo, n.Cond); ok && val {
+				add("S1006", n.Cond.Pos(), n.Body.Pos(), "")
+			}
+		case *ast.SliceExpr:
+			// S1010: s[a:len(s)] is s[a:]
+			s, ok := n.X.(*ast.Ident)
+			if !ok || n.Slice3 || pkgInfo.ObjectOf(s) == nil {
+				break
+			}
+			call, ok := n.High.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 || call.Ellipsis.IsValid() {
+				break
+			}
+			fun, ok := call.Fun.(*ast.Ident)
+			if !ok || fun.Name != "len" {
+				break
+			}
+			if _, ok := pkgInfo.Uses[fun].(*types.Builtin); !ok {
+				break
+			}
+			if arg, ok := call.Args[0].(*ast.Ident); ok && pkgInfo.ObjectOf(arg) == pkgInfo.ObjectOf(s) {
+				add("S1010", call.Pos(), call.End(), "")
+			}
+		case *ast.CallExpr:
+			// S1012: time.Now().Sub(t) is time.Since(t)
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok || sel.Sel.Name != "Sub" || len(n.Args) != 1 {
+				break
+			}
+			now, ok := sel.X.(*ast.CallExpr)
+			if !ok || len(now.Args) != 0 {
+				break
+			}
+			pkg, ok := isPkgFunc(pkgInfo, now.Fun, "time", "Now")
+			if ok {
+				add("S1012", n.Pos(), sel.Sel.End(), pkg.Name+".Since")
+			}
+		}
+		return true
+	})
+	return edits
+}
+
+// boolLiteral returns the value of expr if it is the predeclared true or
+// false.
+func boolLiteral(pkgInfo *loader.PackageInfo, expr ast.Expr) (bool, bool) {
+	id, ok := expr.(*ast.Ident)
+	if !ok || (id.Name != "true" && id.Name != "false") {
+		return false, false
+	}
+	if pkgInfo.Uses[id] != types.Universe.Lookup(id.Name) {
+		return false, false
+	}
+	return id.Name == "true", true
+}
+
+// isBool reports whether typ is bool, and not a named boolean type that
+// dropping the comparison would give to an expression.
+func isBool(typ types.Type) bool {
+	return typ == types.Typ[types.Bool] || typ == types.Typ[types.UntypedBool]
+}
+
+func isBlank(expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && id.Name == "_"
+}
+
+// isPkgFunc returns the identifier of the package of expr if expr selects
+// the function name of the package path.
+func isPkgFunc(pkgInfo *loader.PackageInfo, expr ast.Expr, path, name string) (*ast.Ident, bool) {
+	sel, ok := expr.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != name {
+		return nil, false
+	}
+	pkg, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	pkgName, ok := pkgInfo.Uses[pkg].(*types.PkgName)
+	if !ok || pkgName.Imported().Path() != path {
+		return nil, false
+	}
+	return pkg, true
+}
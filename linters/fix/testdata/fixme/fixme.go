@@ -0,0 +1,52 @@
+package fixme
+
+import (
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+	"fmt"
+	"time"
+)
+
+// Flags are the flags of a recieved message.
+type Flags bool
+
+// Message has its fields in the order they are recieved.
+type Message struct {
+	Seen  bool
+	Sent  time.Time
+	Valid bool
+}
+
+// Point depends on the order of its fields.
+type Point struct {
+	A bool
+	B int64
+	C bool
+}
+
+var origin = Point{false,0,false}
+
+func check(ok bool, flags Flags, ch chan int, s string, start time.Time) {
+	if ok == true {
+		fmt.Println(strings.ToUpper(s[1:len(s)]))
+	}
+	if ok != true && len(s) > 0 == false {
+		return
+	}
+	if flags == true {
+		return
+	}
+	if ok == /* keep me */ false {
+		return
+	}
+	for _ = range s {
+	}
+	for _, _ = range s {
+	}
+	_ = <-ch
+	for true {
+		break
+	}
+	fmt.Println(time.Now().Sub(start), utils.AbsPath(s))
+}
@@ -30,13 +30,23 @@ type funcType int
 
 const (
 	// Sentinel defines max length of func this pkg can handle
-	Sentinel                     = 1000000
-	defaultBucketSize            = 5
-	defaultIncludeTests          = false
-	streakChar                   = "∎"
-	lenLowerLimit                = 0
-	lenUpperLimit                = Sentinel
-	implemented         funcType = iota
+	Sentinel = 1000000
+	// DefaultBucketSize is the width in lines of the histogram buckets.
+	DefaultBucketSize   = 5
+	defaultIncludeTests = false
+	streakChar          = "∎"
+	lenLowerLimit       = 0
+	lenUpperLimit       = Sentinel
+	// DefaultMaxLines is the length above which a function is too long.
+	DefaultMaxLines = 50
+	// DefaultTop is the number of longest functions ranked per package.
+	DefaultTop = 10
+)
+
+const (
+	implemented funcType = iota
+	// implementedAtRuntime is a function declared without body, its code is
+	// in assembly or provided by the runtime, so it has no length.
 	implementedAtRuntime
 )
 
@@ -66,6 +76,7 @@ func FuncLen(packageName string) (lengAll []int, data [][]string) {
 
 	lengAll = flens.computeHistogram()
 
+	flens, _ = flens.Split()
 	for i, f := range flens {
 		data = append(data, []string{strconv.Itoa(i), f.Name, f.Filepath, strconv.Itoa(f.Lbrace), strconv.Itoa(f.Size)})
 	}
@@ -76,6 +87,33 @@ func FuncLen(packageName string) (lengAll []int, data [][]string) {
 // All the operation are done on this.
 type FuncLens []funcLen
 
+// External reports whether the function is implemented in assembly or by
+// the runtime.
+func (f funcLen) External() bool { return f.Type == implementedAtRuntime }
+
+// Split separates the functions with a body from the external ones.
+func (flens FuncLens) Split() (implemented, external FuncLens) {
+	implemented, external = make(FuncLens, 0), make(FuncLens, 0)
+	for _, f := range flens {
+		if f.External() {
+			external = append(external, f)
+		} else {
+			implemented = append(implemented, f)
+		}
+	}
+	return implemented, external
+}
+
+// Longest returns the n longest functions with a body, longest first.
+func (flens FuncLens) Longest(n int) FuncLens {
+	implemented, _ := flens.Split()
+	sort.Sort(sort.Reverse(&implemented))
+	if len(implemented) > n {
+		implemented = implemented[:n]
+	}
+	return implemented
+}
+
 // Sort interface is implemented for FuncLens type.
 // Sorting is needed for computing percentiles.
 func (flens *FuncLens) Len() int { return len(*flens) }
@@ -96,11 +134,20 @@ func (flens *FuncLens) Swap(i, j int) { (*flens)[i], (*flens)[j] = (*flens)[j],
 
 // createHistogram computes and returns slice of histogram data points.
 func (flens *FuncLens) computeHistogram() []int {
+	return flens.Histogram(opts.BucketSize)
+}
+
+// Histogram counts the functions by length. Bucket i holds the functions of
+// i*bucketSize+1 to (i+1)*bucketSize lines, external functions are left out.
+func (flens *FuncLens) Histogram(bucketSize int) []int {
 	var hg []int
 	var x int
 	if len(*flens) == 0 {
 		return nil
 	}
+	if bucketSize <= 0 {
+		bucketSize = DefaultBucketSize
+	}
 	// find max func len
 	var maxFlen int = -Sentinel
 	for _, flen := range *flens {
@@ -108,15 +155,17 @@ func (flens *FuncLens) computeHistogram() []int {
 			maxFlen = flen.Size
 		}
 	}
-	hglen := maxFlen / opts.BucketSize
-	hg = make([]int, hglen+1)
+	if maxFlen <= 0 {
+		return nil
+	}
+	hg = make([]int, (maxFlen-1)/bucketSize+1)
 	for _, v := range *flens {
-		if v.Size > 0 {
-			x = v.Size % opts.BucketSize
+		if v.Size > 0 && !v.External() {
+			x = v.Size % bucketSize
 			if x == 0 {
-				x = v.Size/opts.BucketSize - 1
+				x = v.Size/bucketSize - 1
 			} else {
-				x = v.Size / opts.BucketSize
+				x = v.Size / bucketSize
 			}
 			hg[x]++
 		}
@@ -132,23 +181,35 @@ func GenerateFuncLens(pkg string, options *Options) (FuncLens, string, error) {
 	if opts == nil {
 		opts = &Options{
 			IncludeTests: defaultIncludeTests,
-			BucketSize:   defaultBucketSize,
+			BucketSize:   DefaultBucketSize,
 		}
 	}
-	pkgpath, err := getPkgPath(pkg)
-	if err != nil {
-		return nil, pkgpath, err
+	pkgpath, perr := getPkgPath(pkg)
+	if perr != nil {
+		return nil, pkgpath, perr
 	}
+	flens, err := FuncLensOfDir(pkgpath, opts)
+	return flens, pkgpath, err
+}
 
+// FuncLensOfDir generates FuncLens for the package in the directory dir, like
+// GenerateFuncLens.
+func FuncLensOfDir(dir string, options *Options) (FuncLens, error) {
+	if options == nil {
+		options = &Options{
+			IncludeTests: defaultIncludeTests,
+			BucketSize:   DefaultBucketSize,
+		}
+	}
 	fset := token.NewFileSet()
-	pkgs, ferr := parser.ParseDir(fset, pkgpath, func(f os.FileInfo) bool {
-		if opts.IncludeTests {
+	pkgs, err := parser.ParseDir(fset, dir, func(f os.FileInfo) bool {
+		if options.IncludeTests {
 			return true
 		}
 		return !strings.HasSuffix(f.Name(), "_test.go")
 	}, parser.AllErrors)
-	if ferr != nil {
-		panic(ferr)
+	if err != nil {
+		return nil, err
 	}
 	flens := make(FuncLens, 0)
 	for _, v := range pkgs {
@@ -168,6 +229,7 @@ func GenerateFuncLens(pkg string, options *Options) (FuncLens, string, error) {
 						funcname = x.Name.Name
 						if x.Body == nil {
 							ftype = implementedAtRuntime // externally implemented
+							lln = fset.Position(x.Pos()).Line
 						} else {
 							lb = x.Body.Lbrace
 							rb = x.Body.Rbrace
@@ -197,7 +259,7 @@ func GenerateFuncLens(pkg string, options *Options) (FuncLens, string, error) {
 		}
 	}
 	sort.Sort(&flens)
-	return flens, pkgpath, nil
+	return flens, nil
 }
 
 // getPkgPath tries to get path of pkg. Path is platform dependent.
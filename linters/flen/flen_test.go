@@ -2,6 +2,7 @@ package flen
 
 import (
 	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 )
@@ -24,16 +25,12 @@ func trouble() {
 	println("hello trouble")
 }
 `
-	lengAllWant  = []int{3, 0, 1, 2, 0, 1, 0, 0, 0, 0, 0, 0, 0, 1, 0}
+	testdataPkg  = "github.com/360EntSecGroup-Skylar/goreporter/linters/flen/testdata/p1"
+	lengAllWant  = []int{2, 1}
 	funcLengWant = [][]string{
-		[]string{"0", "Len", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "81", "1"},
-		[]string{"1", "Swap", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "95", "1"},
-		[]string{"2", "rangeAsked", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "50", "1"},
-		[]string{"3", "Less", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "82", "11"},
-		[]string{"4", "getPkgPath", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "205", "17"},
-		[]string{"5", "FuncLen", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "52", "20"},
-		[]string{"6", "computeHistogram", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "98", "26"},
-		[]string{"7", "GenerateFuncLens", "/Users/wangguoliang/Documents/wgliang/src/github.com/360EntSecGroup-Skylar/goreporter/linters/flen/flen.go", "130", "70"},
+		[]string{"0", "single", "p1.go", "3", "1"},
+		[]string{"1", "double", "p1.go", "7", "2"},
+		[]string{"2", "trouble", "p1.go", "12", "6"},
 	}
 )
 
@@ -158,12 +155,38 @@ func TestGetPkgPath(t *testing.T) {
 }
 
 func TestFuncLen(t *testing.T) {
-	lengAll, funcLeng := FuncLen("github.com/360EntSecGroup-Skylar/goreporter/linters/flen")
+	lengAll, funcLeng := FuncLen(testdataPkg)
 	if !reflect.DeepEqual(lengAll, lengAllWant) {
-		t.Errorf("want %v, but got %v", lengAll, lengAllWant)
+		t.Errorf("want %v, but got %v", lengAllWant, lengAll)
 	}
 
+	for _, f := range funcLeng {
+		f[2] = filepath.Base(f[2])
+	}
 	if !reflect.DeepEqual(funcLeng, funcLengWant) {
-		t.Errorf("want %v, but got %v", funcLeng, funcLengWant)
+		t.Errorf("want %v, but got %v", funcLengWant, funcLeng)
+	}
+}
+
+func TestFuncLensOfDir(t *testing.T) {
+	flens, err := FuncLensOfDir(filepath.Join("testdata", "p1"), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	implemented, external := flens.Split()
+	if len(implemented) != 3 || len(external) != 1 {
+		t.Fatalf("want 3 implemented and 1 external funcs, but got %d and %d", len(implemented), len(external))
+	}
+	if external[0].Name != "external" || external[0].Size != 0 || external[0].Lbrace != 22 {
+		t.Errorf("want external at line 22 without size, but got %+v", external[0])
+	}
+
+	longest := flens.Longest(2)
+	if len(longest) != 2 || longest[0].Name != "trouble" || longest[1].Name != "double" {
+		t.Errorf("want [trouble double], but got %+v", longest)
+	}
+
+	if hg := flens.Histogram(2); !reflect.DeepEqual(hg, []int{2, 0, 1}) {
+		t.Errorf("want [2 0 1], but got %v", hg)
 	}
 }
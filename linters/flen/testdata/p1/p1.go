@@ -0,0 +1,22 @@
+package p1
+
+func single() {
+	println("hello single")
+}
+
+func double() {
+	println("hello double")
+	println("hello double")
+}
+
+func trouble() {
+	println("hello trouble")
+	println("hello trouble")
+	println("hello trouble")
+	println("hello trouble")
+	println("hello trouble")
+	println("hello trouble")
+}
+
+// external is implemented in assembly.
+func external(x int) int
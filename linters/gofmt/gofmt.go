@@ -15,21 +15,166 @@ package gofmt
 
 import (
 	"bytes"
-	"os/exec"
+	"fmt"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"sort"
 	"strings"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// The rules of the findings.
+const (
+	RuleFormat   = "gofmt"
+	RuleSimplify = "gofmt -s"
+	RuleImports  = "goimports"
 )
 
-// GoFmt if a function that will run command go fmt,return all result of
-// warnning issues.
-func GoFmt(packagePath []string) (goFmtData []string, err error) {
-	cmd := exec.Command("gofmt", append([]string{"-l"}, packagePath...)...)
-	var out, outerr bytes.Buffer
-	cmd.Stdout = &out
-	cmd.Stderr = &outerr
-	err = cmd.Run()
+// Options configures GoFmt. Simplify checks the files as gofmt -s does and
+// Imports checks that their imports are grouped as goimports does.
+type Options struct {
+	Simplify bool
+	Imports  bool
+}
+
+// Finding is the line Line of a file, whose text is Text, that the rule Rule
+// changes.
+type Finding struct {
+	Line int    `json:"line"`
+	Text string `json:"text"`
+	Rule string `json:"rule"`
+}
+
+// File is a file that is not formatted. Diff is the unified diff from its
+// source to the formatted one and Findings are its lines that differ.
+type File struct {
+	File     string    `json:"file"`
+	Diff     string    `json:"diff"`
+	Findings []Finding `json:"findings"`
+}
+
+// GoFmt formats the go files of the directories packagePaths and returns
+// those that are not formatted, by file name. Unlike gofmt -l, the
+// subdirectories are not walked. As with gofmt -l, the files that cannot be
+// read or parsed do not stop the check of the others: their errors are
+// joined in the returned error.
+func GoFmt(packagePaths []string, opts Options) ([]File, error) {
+	files := make([]File, 0)
+	var failed []string
+	for _, dir := range packagePaths {
+		filenames, err := filepath.Glob(filepath.Join(dir, "*.go"))
+		if err != nil {
+			failed = append(failed, err.Error())
+			continue
+		}
+		for _, filename := range filenames {
+			src, err := ioutil.ReadFile(filename)
+			if err != nil {
+				failed = append(failed, err.Error())
+				continue
+			}
+			file, err := Check(filename, src, opts)
+			if err != nil {
+				failed = append(failed, err.Error())
+				continue
+			}
+			if file != nil {
+				files = append(files, *file)
+			}
+		}
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].File < files[j].File
+	})
+	if len(failed) > 0 {
+		return files, fmt.Errorf("%s", strings.Join(failed, "; "))
+	}
+	return files, nil
+}
+
+// Check returns the diff and the findings of src, the source of filename, nil
+// if it is formatted. A line that gofmt changes is a finding of RuleFormat,
+// else the first of RuleImports and RuleSimplify that changes it.
+func Check(filename string, src []byte, opts Options) (*File, error) {
+	formatted, err := Format(filename, src, opts)
 	if err != nil {
-		return goFmtData, err
+		return nil, err
+	}
+	if bytes.Equal(src, formatted) {
+		return nil, nil
+	}
+
+	rules := make(map[int]string)
+	for _, rule := range []struct {
+		name string
+		opts Options
+		used bool
+	}{
+		{RuleFormat, Options{}, true},
+		{RuleImports, Options{Imports: true}, opts.Imports},
+		{RuleSimplify, Options{Simplify: true}, opts.Simplify},
+	} {
+		if !rule.used {
+			continue
+		}
+		fixed, err := Format(filename, src, rule.opts)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range utils.ChangedLines(src, fixed) {
+			if _, ok := rules[line]; !ok {
+				rules[line] = rule.name
+			}
+		}
+	}
+
+	file := &File{
+		File: filename,
+		Diff: utils.UnifiedDiff(filename+".orig", filename, src, formatted),
+	}
+	lines := strings.Split(string(src), "\n")
+	for _, line := range utils.ChangedLines(src, formatted) {
+		rule, ok := rules[line]
+		if !ok {
+			rule = RuleFormat
+		}
+		file.Findings = append(file.Findings, Finding{Line: line, Text: lines[line-1], Rule: rule})
+	}
+	return file, nil
+}
+
+// Format returns src, the source of filename, formatted by gofmt, with the
+// simplifications of gofmt -s and the imports grouped as goimports does if
+// set in opts.
+func Format(filename string, src []byte, opts Options) ([]byte, error) {
+	if opts.Imports {
+		grouped, err := GroupImports(filename, src)
+		if err != nil {
+			return nil, err
+		}
+		src = grouped
+	}
+	if !opts.Simplify {
+		formatted, err := format.Source(src)
+		if err != nil {
+			// the positions of the errors of format.Source have no file name
+			return nil, fmt.Errorf("%s:%v", filename, err)
+		}
+		return formatted, nil
+	}
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+	simplify(file)
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fset, file); err != nil {
+		return nil, err
 	}
-	goFmtData = strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
-	return goFmtData, nil
+	return buf.Bytes(), nil
 }
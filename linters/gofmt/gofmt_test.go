@@ -14,21 +14,121 @@
 package gofmt
 
 import (
-	"reflect"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 )
 
-var wantFmtResult = []string{
-	"../../engine/processbar/processbar.go",
+const ungrouped = `package p
+
+import (
+	"strings"
+
+	"github.com/golang/glog"
+	// fmt is documented
+	"fmt" // fmt has a comment
+	"go/build"
+)
+
+import "os"
+`
+
+const grouped = `package p
+
+import (
+	"strings"
+
+	// fmt is documented
+	"fmt" // fmt has a comment
+	"go/build"
+
+	"github.com/golang/glog"
+)
+
+import "os"
+`
+
+func Test_GroupImports(t *testing.T) {
+	res, err := GroupImports("p.go", []byte(ungrouped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != grouped {
+		t.Errorf("want\n%s\nbut got\n%s", grouped, res)
+	}
+	res, err = GroupImports("p.go", []byte(grouped))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res) != grouped {
+		t.Errorf("want grouped imports unchanged, but got\n%s", res)
+	}
 }
 
 func Test_GoFmt(t *testing.T) {
-	res, err := GoFmt([]string{"../../engine"})
+	testCases := []struct {
+		opts     Options
+		expected []string
+	}{
+		{Options{}, []string{
+			"21 gofmt func sum(a,b int) int {",
+			"22 gofmt \treturn a+b",
+		}},
+		{Options{Simplify: true, Imports: true}, []string{
+			"4 goimports \t\"github.com/golang/glog\"",
+			"6 goimports )",
+			"10 gofmt -s var points = []point{point{1, 2}, {3, 4}}",
+			"12 gofmt -s var refs = map[string]*point{\"a\": &point{5, 6}}",
+			"15 gofmt -s \tfor _ = range s {",
+			"18 gofmt -s \treturn strings.ToUpper(s[1:len(s)])",
+			"21 gofmt func sum(a,b int) int {",
+			"22 gofmt \treturn a+b",
+		}},
+	}
+	for _, tc := range testCases {
+		files, err := GoFmt([]string{"testdata"}, tc.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(files) != 1 || files[0].File != "testdata/unformatted.go" {
+			t.Fatalf("%+v: want testdata/unformatted.go, but got %v", tc.opts, files)
+		}
+		got := make([]string, 0, len(files[0].Findings))
+		for _, f := range files[0].Findings {
+			got = append(got, fmt.Sprintf("%d %s %s", f.Line, f.Rule, f.Text))
+		}
+		if strings.Join(got, "\n") != strings.Join(tc.expected, "\n") {
+			t.Errorf("%+v: got\n%s\nwant\n%s", tc.opts, strings.Join(got, "\n"), strings.Join(tc.expected, "\n"))
+		}
+		if !strings.HasPrefix(files[0].Diff, "--- testdata/unformatted.go.orig\n+++ testdata/unformatted.go\n") ||
+			!strings.Contains(files[0].Diff, "+\treturn a + b\n") {
+			t.Errorf("%+v: unexpected diff\n%s", tc.opts, files[0].Diff)
+		}
+	}
+}
+
+func Test_GoFmtErrors(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gofmt")
 	if err != nil {
-		t.Error("go vet failed.")
-	} else {
-		if !reflect.DeepEqual(res, wantFmtResult) {
-			t.Errorf("want %v, but got %v", wantFmtResult, res)
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, src := range map[string]string{
+		"a.go": "package p\n\nfunc f( {\n",
+		"b.go": "package p\nfunc  g() {}\n",
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
 		}
 	}
+	files, err := GoFmt([]string{dir}, Options{})
+	if err == nil || !strings.Contains(err.Error(), "a.go") {
+		t.Errorf("want the syntax error of a.go, but got %v", err)
+	}
+	if len(files) != 1 || filepath.Base(files[0].File) != "b.go" {
+		t.Errorf("want b.go checked after a.go, but got %v", files)
+	}
 }
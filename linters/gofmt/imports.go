@@ -0,0 +1,184 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gofmt
+
+import (
+	"bytes"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GroupImports returns src with the imports of each block of consecutive
+// import lines grouped as goimports does: the standard library first, then
+// the other packages, each group sorted and separated from the next by a
+// blank line. The import declarations holding a comment that is not attached
+// to an import are left as is. Unlike goimports, no import is added or
+// removed.
+func GroupImports(filename string, src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, filename, src, parser.ParseComments|parser.ImportsOnly)
+	if err != nil {
+		return nil, err
+	}
+	tokFile := fset.File(file.Pos())
+
+	type edit struct {
+		start, end int
+		text       string
+	}
+	var edits []edit
+	for _, decl := range file.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.IMPORT || !gen.Lparen.IsValid() || !attachedComments(file, gen) {
+			continue
+		}
+		runs, ok := importRuns(tokFile, gen)
+		if !ok {
+			continue
+		}
+		for _, run := range runs {
+			start := lineStart(src, tokFile.Offset(importStart(run[0])))
+			end := lineEnd(src, tokFile.Offset(importEnd(run[len(run)-1])))
+			text := groupRun(src, tokFile, run)
+			if text != string(src[start:end]) {
+				edits = append(edits, edit{start: start, end: end, text: text})
+			}
+		}
+	}
+
+	out := src
+	for i := len(edits) - 1; i >= 0; i-- {
+		e := edits[i]
+		out = append(out[:e.start:e.start], append([]byte(e.text), out[e.end:]...)...)
+	}
+	return out, nil
+}
+
+// attachedComments reports whether the comments of the import declaration
+// gen of file are all attached to its imports.
+func attachedComments(file *ast.File, gen *ast.GenDecl) bool {
+	attached := make(map[*ast.CommentGroup]bool)
+	for _, spec := range gen.Specs {
+		spec := spec.(*ast.ImportSpec)
+		attached[spec.Doc], attached[spec.Comment] = true, true
+	}
+	for _, group := range file.Comments {
+		if group.Pos() > gen.Lparen && group.End() < gen.Rparen && !attached[group] {
+			return false
+		}
+	}
+	return true
+}
+
+// importRuns splits the imports of gen into runs of consecutive lines, false
+// if two imports share a line.
+func importRuns(tokFile *token.File, gen *ast.GenDecl) ([][]*ast.ImportSpec, bool) {
+	var runs [][]*ast.ImportSpec
+	prev := 0
+	for _, spec := range gen.Specs {
+		spec := spec.(*ast.ImportSpec)
+		line := tokFile.Line(importStart(spec))
+		switch {
+		case line == prev:
+			return nil, false
+		case len(runs) == 0 || line > prev+1:
+			runs = append(runs, []*ast.ImportSpec{spec})
+		default:
+			runs[len(runs)-1] = append(runs[len(runs)-1], spec)
+		}
+		prev = tokFile.Line(importEnd(spec))
+	}
+	return runs, true
+}
+
+// groupRun returns the lines of the imports of run sorted by group and path,
+// with a blank line between the groups.
+func groupRun(src []byte, tokFile *token.File, run []*ast.ImportSpec) string {
+	sorted := append([]*ast.ImportSpec(nil), run...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		gi, gj := importGroup(sorted[i]), importGroup(sorted[j])
+		if gi != gj {
+			return gi < gj
+		}
+		return importPath(sorted[i]) < importPath(sorted[j])
+	})
+	var buf bytes.Buffer
+	for i, spec := range sorted {
+		if i > 0 {
+			buf.WriteByte('\n')
+			if importGroup(spec) != importGroup(sorted[i-1]) {
+				buf.WriteByte('\n')
+			}
+		}
+		start := lineStart(src, tokFile.Offset(importStart(spec)))
+		end := lineEnd(src, tokFile.Offset(importEnd(spec)))
+		buf.Write(src[start:end])
+	}
+	return buf.String()
+}
+
+// importGroup is 0 for the packages of the standard library, whose first
+// path element has no dot, and 1 for the others.
+func importGroup(spec *ast.ImportSpec) int {
+	path := importPath(spec)
+	if i := strings.IndexByte(path, '/'); i >= 0 {
+		path = path[:i]
+	}
+	if strings.Contains(path, ".") {
+		return 1
+	}
+	return 0
+}
+
+func importPath(spec *ast.ImportSpec) string {
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return spec.Path.Value
+	}
+	return path
+}
+
+// importStart is the position of spec with its doc comment.
+func importStart(spec *ast.ImportSpec) token.Pos {
+	if spec.Doc != nil {
+		return spec.Doc.Pos()
+	}
+	return spec.Pos()
+}
+
+// importEnd is the end of spec with its line comment.
+func importEnd(spec *ast.ImportSpec) token.Pos {
+	if spec.Comment != nil {
+		return spec.Comment.End()
+	}
+	return spec.End()
+}
+
+// lineStart is the offset of the line of src holding offset.
+func lineStart(src []byte, offset int) int {
+	return bytes.LastIndexByte(src[:offset], '\n') + 1
+}
+
+// lineEnd is the offset of the newline ending the line of src holding
+// offset.
+func lineEnd(src []byte, offset int) int {
+	if i := bytes.IndexByte(src[offset:], '\n'); i >= 0 {
+		return offset + i
+	}
+	return len(src)
+}
@@ -0,0 +1,107 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gofmt
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+)
+
+// simplify rewrites file as gofmt -s does:
+//
+//	[]T{T{}, T{}}         to []T{{}, {}}
+//	[]*T{&T{}}            to []*T{{}}
+//	s[a:len(s)]           to s[a:]
+//	for x, _ = range v {} to for x = range v {}
+//	for _ = range v {}    to for range v {}
+func simplify(file *ast.File) {
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.CompositeLit:
+			var keyType, eltType ast.Expr
+			switch typ := n.Type.(type) {
+			case *ast.ArrayType:
+				eltType = typ.Elt
+			case *ast.MapType:
+				keyType, eltType = typ.Key, typ.Value
+			}
+			if eltType == nil {
+				break
+			}
+			for i, elt := range n.Elts {
+				if kv, ok := elt.(*ast.KeyValueExpr); ok {
+					if keyType != nil {
+						kv.Key = simplifyLiteral(keyType, kv.Key)
+					}
+					kv.Value = simplifyLiteral(eltType, kv.Value)
+				} else {
+					n.Elts[i] = simplifyLiteral(eltType, elt)
+				}
+			}
+		case *ast.SliceExpr:
+			// only the identifiers are simple enough, len is assumed to be
+			// the builtin one as gofmt does
+			s, ok := n.X.(*ast.Ident)
+			if !ok || n.Max != nil {
+				break
+			}
+			call, ok := n.High.(*ast.CallExpr)
+			if !ok || len(call.Args) != 1 || call.Ellipsis.IsValid() {
+				break
+			}
+			if fun, ok := call.Fun.(*ast.Ident); ok && fun.Name == "len" {
+				if arg, ok := call.Args[0].(*ast.Ident); ok && arg.Name == s.Name {
+					n.High = nil
+				}
+			}
+		case *ast.RangeStmt:
+			if isBlank(n.Value) {
+				n.Value = nil
+			}
+			if isBlank(n.Key) && n.Value == nil {
+				n.Key = nil
+			}
+		}
+		return true
+	})
+}
+
+// simplifyLiteral returns x, an element of a composite literal whose elements
+// are of type typ, without its type if it is a composite literal of type typ
+// or a pointer to a composite literal of type *typ.
+func simplifyLiteral(typ, x ast.Expr) ast.Expr {
+	if inner, ok := x.(*ast.CompositeLit); ok && sameType(typ, inner.Type) {
+		inner.Type = nil
+	}
+	if ptr, ok := typ.(*ast.StarExpr); ok {
+		if addr, ok := x.(*ast.UnaryExpr); ok && addr.Op == token.AND {
+			if inner, ok := addr.X.(*ast.CompositeLit); ok && sameType(ptr.X, inner.Type) {
+				inner.Type = nil
+				return inner
+			}
+		}
+	}
+	return x
+}
+
+// sameType reports whether the type expressions x and y are written alike.
+func sameType(x, y ast.Expr) bool {
+	return x != nil && y != nil && types.ExprString(x) == types.ExprString(y)
+}
+
+func isBlank(expr ast.Expr) bool {
+	id, ok := expr.(*ast.Ident)
+	return ok && id.Name == "_"
+}
@@ -0,0 +1,23 @@
+package testdata
+
+import (
+	"github.com/golang/glog"
+	"strings"
+)
+
+type point struct{ x, y int }
+
+var points = []point{point{1, 2}, {3, 4}}
+
+var refs = map[string]*point{"a": &point{5, 6}}
+
+func upper(s string) string {
+	for _ = range s {
+		glog.Infoln(s)
+	}
+	return strings.ToUpper(s[1:len(s)])
+}
+
+func sum(a,b int) int {
+	return a+b
+}
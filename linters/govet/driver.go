@@ -0,0 +1,321 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package govet
+
+import (
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/token"
+	"go/types"
+	"io/ioutil"
+	"reflect"
+	"sort"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/loader"
+)
+
+// checkedPackage is a package of the program, type-checked for the
+// analyzers. IllTyped is set if it has errors, the analyzers are then not run
+// on it.
+type checkedPackage struct {
+	Pkg      *types.Package
+	Files    []*ast.File
+	Info     *types.Info
+	IllTyped bool
+}
+
+// typeCheck type-checks the packages of lprog again, each after those it
+// imports, as the analyzers need the instances of the generic functions and
+// types and the Go versions of the files, which the loader does not record.
+// The version of the toolchain applies to the files, as in GOPATH mode.
+func typeCheck(lprog *loader.Program, ctx *build.Context) []*checkedPackage {
+	imp := &importer{
+		ctx:      ctx,
+		packages: make(map[string]*types.Package),
+		resolved: make(map[string]*types.Package),
+	}
+	conf := types.Config{
+		Importer:  imp,
+		Sizes:     types.SizesFor("gc", ctx.GOARCH),
+		GoVersion: ctx.ReleaseTags[len(ctx.ReleaseTags)-1],
+	}
+
+	var packages []*checkedPackage
+	for _, info := range dependencyOrder(lprog) {
+		if info.Pkg == types.Unsafe {
+			continue
+		}
+		pkg := &checkedPackage{
+			Files: info.Files,
+			Info: &types.Info{
+				Types:        make(map[ast.Expr]types.TypeAndValue),
+				Instances:    make(map[*ast.Ident]types.Instance),
+				Defs:         make(map[*ast.Ident]types.Object),
+				Uses:         make(map[*ast.Ident]types.Object),
+				Implicits:    make(map[ast.Node]types.Object),
+				Selections:   make(map[*ast.SelectorExpr]*types.Selection),
+				Scopes:       make(map[ast.Node]*types.Scope),
+				FileVersions: make(map[*ast.File]string),
+			},
+			IllTyped: len(info.Errors) > 0,
+		}
+		conf.Error = func(error) {
+			pkg.IllTyped = true
+		}
+		pkg.Pkg, _ = conf.Check(info.Pkg.Path(), lprog.Fset, info.Files, pkg.Info)
+		imp.packages[info.Pkg.Path()] = pkg.Pkg
+		packages = append(packages, pkg)
+	}
+	return packages
+}
+
+// dependencyOrder returns the packages of lprog, each after the packages it
+// imports.
+func dependencyOrder(lprog *loader.Program) []*loader.PackageInfo {
+	infos := make([]*loader.PackageInfo, 0, len(lprog.AllPackages))
+	for _, info := range lprog.AllPackages {
+		infos = append(infos, info)
+	}
+	sort.Slice(infos, func(i, j int) bool {
+		return infos[i].Pkg.Path() < infos[j].Pkg.Path()
+	})
+
+	ordered := make([]*loader.PackageInfo, 0, len(infos))
+	seen := make(map[*types.Package]bool)
+	var visit func(info *loader.PackageInfo)
+	visit = func(info *loader.PackageInfo) {
+		if seen[info.Pkg] {
+			return
+		}
+		seen[info.Pkg] = true
+		for _, imp := range info.Pkg.Imports() {
+			if dep := lprog.AllPackages[imp]; dep != nil {
+				visit(dep)
+			}
+		}
+		ordered = append(ordered, info)
+	}
+	for _, info := range infos {
+		visit(info)
+	}
+	return ordered
+}
+
+// importer returns the packages type-checked by typeCheck, by import path.
+// The import paths of the files are resolved with go/build, from the
+// directory of the file, as the loader does for the vendor directories.
+type importer struct {
+	ctx      *build.Context
+	packages map[string]*types.Package
+	resolved map[string]*types.Package
+}
+
+func (imp *importer) Import(path string) (*types.Package, error) {
+	return imp.ImportFrom(path, "", 0)
+}
+
+func (imp *importer) ImportFrom(path, dir string, mode types.ImportMode) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	key := dir + "\x00" + path
+	if pkg, ok := imp.resolved[key]; ok {
+		return pkg, nil
+	}
+	bp, err := imp.ctx.Import(path, dir, build.FindOnly)
+	if err != nil {
+		return nil, err
+	}
+	pkg := imp.packages[bp.ImportPath]
+	if pkg == nil {
+		return nil, fmt.Errorf("package %s is not loaded", bp.ImportPath)
+	}
+	imp.resolved[key] = pkg
+	return pkg, nil
+}
+
+// runner runs the analyzers on the packages of a program. The results of an
+// analyzer are kept by package for the analyzers that require it, and the
+// facts for the packages that import it.
+type runner struct {
+	fset    *token.FileSet
+	sizes   types.Sizes
+	results map[actionKey]*action
+
+	objectFacts  map[objectFactKey]analysis.Fact
+	packageFacts map[packageFactKey]analysis.Fact
+}
+
+type actionKey struct {
+	analyzer *analysis.Analyzer
+	pkg      *types.Package
+}
+
+// action is the run of an analyzer on a package.
+type action struct {
+	result interface{}
+	err    error
+}
+
+type objectFactKey struct {
+	obj types.Object
+	typ reflect.Type
+}
+
+type packageFactKey struct {
+	pkg *types.Package
+	typ reflect.Type
+}
+
+func newRunner(fset *token.FileSet, sizes types.Sizes) *runner {
+	return &runner{
+		fset:         fset,
+		sizes:        sizes,
+		results:      make(map[actionKey]*action),
+		objectFacts:  make(map[objectFactKey]analysis.Fact),
+		packageFacts: make(map[packageFactKey]analysis.Fact),
+	}
+}
+
+// withFacts returns the analyzers, among analyzers and those they require,
+// that derive facts on the packages they check.
+func withFacts(analyzers []*analysis.Analyzer) []*analysis.Analyzer {
+	var result []*analysis.Analyzer
+	seen := make(map[*analysis.Analyzer]bool)
+	var visit func(a *analysis.Analyzer)
+	visit = func(a *analysis.Analyzer) {
+		if seen[a] {
+			return
+		}
+		seen[a] = true
+		for _, req := range a.Requires {
+			visit(req)
+		}
+		if len(a.FactTypes) > 0 {
+			result = append(result, a)
+		}
+	}
+	for _, a := range analyzers {
+		visit(a)
+	}
+	return result
+}
+
+// run runs the analyzer a on pkg, after the analyzers it requires. The
+// findings of a are added to diagnostics when report is set.
+func (r *runner) run(a *analysis.Analyzer, pkg *checkedPackage, report bool, diagnostics *[]Diagnostic) error {
+	key := actionKey{a, pkg.Pkg}
+	if act, ok := r.results[key]; ok {
+		return act.err
+	}
+	act := new(action)
+	r.results[key] = act
+
+	resultOf := make(map[*analysis.Analyzer]interface{}, len(a.Requires))
+	for _, req := range a.Requires {
+		if err := r.run(req, pkg, false, nil); err != nil {
+			act.err = err
+			return err
+		}
+		resultOf[req] = r.results[actionKey{req, pkg.Pkg}].result
+	}
+
+	pass := &analysis.Pass{
+		Analyzer:   a,
+		Fset:       r.fset,
+		Files:      pkg.Files,
+		Pkg:        pkg.Pkg,
+		TypesInfo:  pkg.Info,
+		TypesSizes: r.sizes,
+		ResultOf:   resultOf,
+		ReadFile:   ioutil.ReadFile,
+		Report: func(d analysis.Diagnostic) {
+			if !report {
+				return
+			}
+			*diagnostics = append(*diagnostics, Diagnostic{
+				Pos:      r.fset.Position(d.Pos),
+				Analyzer: a.Name,
+				Message:  d.Message,
+			})
+		},
+		ImportObjectFact: func(obj types.Object, fact analysis.Fact) bool {
+			return importFact(r.objectFacts[objectFactKey{obj, reflect.TypeOf(fact)}], fact)
+		},
+		ImportPackageFact: func(p *types.Package, fact analysis.Fact) bool {
+			return importFact(r.packageFacts[packageFactKey{p, reflect.TypeOf(fact)}], fact)
+		},
+		ExportObjectFact: func(obj types.Object, fact analysis.Fact) {
+			if obj.Pkg() != pkg.Pkg {
+				panic(fmt.Sprintf("%s: fact of %s exported by package %s", a.Name, obj, pkg.Pkg.Path()))
+			}
+			r.objectFacts[objectFactKey{obj, reflect.TypeOf(fact)}] = fact
+		},
+		ExportPackageFact: func(fact analysis.Fact) {
+			r.packageFacts[packageFactKey{pkg.Pkg, reflect.TypeOf(fact)}] = fact
+		},
+		AllObjectFacts: func() []analysis.ObjectFact {
+			var facts []analysis.ObjectFact
+			for key, fact := range r.objectFacts {
+				if isFactType(a, key.typ) {
+					facts = append(facts, analysis.ObjectFact{Object: key.obj, Fact: fact})
+				}
+			}
+			return facts
+		},
+		AllPackageFacts: func() []analysis.PackageFact {
+			var facts []analysis.PackageFact
+			for key, fact := range r.packageFacts {
+				if isFactType(a, key.typ) {
+					facts = append(facts, analysis.PackageFact{Package: key.pkg, Fact: fact})
+				}
+			}
+			return facts
+		},
+	}
+	act.result, act.err = runPass(pass)
+	return act.err
+}
+
+// runPass runs the analyzer of pass, returning a panic as an error so that
+// the failure of an analyzer on a package does not stop the others.
+func runPass(pass *analysis.Pass) (result interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return pass.Analyzer.Run(pass)
+}
+
+// importFact copies the fact stored, if any, to fact.
+func importFact(stored, fact analysis.Fact) bool {
+	if stored == nil {
+		return false
+	}
+	reflect.ValueOf(fact).Elem().Set(reflect.ValueOf(stored).Elem())
+	return true
+}
+
+// isFactType reports whether typ is one of the fact types of a.
+func isFactType(a *analysis.Analyzer, typ reflect.Type) bool {
+	for _, fact := range a.FactTypes {
+		if reflect.TypeOf(fact) == typ {
+			return true
+		}
+	}
+	return false
+}
@@ -11,30 +11,173 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
+// Package govet runs the checks of go vet in process: the analyzers of
+// golang.org/x/tools/go/analysis that go vet runs are applied to the
+// type-checked packages of the project.
 package govet
 
 import (
-	"bytes"
-	"os/exec"
+	"fmt"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"sort"
 	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/assign"
+	"golang.org/x/tools/go/analysis/passes/atomic"
+	"golang.org/x/tools/go/analysis/passes/composite"
+	"golang.org/x/tools/go/analysis/passes/copylock"
+	"golang.org/x/tools/go/analysis/passes/lostcancel"
+	"golang.org/x/tools/go/analysis/passes/nilfunc"
+	"golang.org/x/tools/go/analysis/passes/printf"
+	"golang.org/x/tools/go/analysis/passes/structtag"
+	"golang.org/x/tools/go/analysis/passes/unreachable"
+	"golang.org/x/tools/go/analysis/passes/unusedresult"
+	"golang.org/x/tools/go/loader"
 )
 
-// GoVet if a function that will run command go tool vet,return all result of
-// warnning issues.
-func GoVet(packagePath []string) (goVetData []string, err error) {
-	cmd := exec.Command("go", append([]string{"tool", "vet"}, packagePath...)...)
-	var out, outerr bytes.Buffer
-	cmd.Stdout = &out
-	cmd.Stderr = &outerr
-	err = cmd.Run()
+// Diagnostic is a finding of the analyzer Analyzer at Pos.
+type Diagnostic struct {
+	Pos      token.Position `json:"pos"`
+	Analyzer string         `json:"analyzer"`
+	Message  string         `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s (%s)", d.Pos, d.Message, d.Analyzer)
+}
+
+// Analyzers are all the analyzers, by name. Their names are the rule IDs of
+// the findings.
+var Analyzers = []*analysis.Analyzer{
+	assign.Analyzer,
+	atomic.Analyzer,
+	composite.Analyzer,
+	copylock.Analyzer,
+	lostcancel.Analyzer,
+	nilfunc.Analyzer,
+	printf.Analyzer,
+	Shadow,
+	structtag.Analyzer,
+	unreachable.Analyzer,
+	unusedresult.Analyzer,
+}
+
+// optional are the analyzers that are only run when enabled, as go vet does
+// not run them.
+var optional = map[*analysis.Analyzer]bool{Shadow: true}
+
+// Options configures GoVet. Enable are the names of the analyzers to run,
+// those go vet runs if empty, minus those of Disable. Tests adds the test
+// files and Tags are the build tags.
+type Options struct {
+	Enable  []string
+	Disable []string
+	Tests   bool
+	Tags    []string
+}
+
+// Select returns the analyzers enabled by opts.
+func Select(opts Options) ([]*analysis.Analyzer, error) {
+	byName := make(map[string]*analysis.Analyzer, len(Analyzers))
+	for _, a := range Analyzers {
+		byName[a.Name] = a
+	}
+	names := make(map[string]bool)
+	for _, name := range append(append([]string(nil), opts.Enable...), opts.Disable...) {
+		if byName[name] == nil {
+			return nil, fmt.Errorf("unknown analyzer %q", name)
+		}
+	}
+	for _, name := range opts.Enable {
+		names[name] = true
+	}
+	disabled := make(map[string]bool)
+	for _, name := range opts.Disable {
+		disabled[name] = true
+	}
+	analyzers := make([]*analysis.Analyzer, 0, len(Analyzers))
+	for _, a := range Analyzers {
+		enabled := names[a.Name] || (len(names) == 0 && !optional[a])
+		if enabled && !disabled[a.Name] {
+			analyzers = append(analyzers, a)
+		}
+	}
+	return analyzers, nil
+}
+
+// GoVet runs the analyzers selected by opts on the packages importPaths and
+// returns their findings by position. The packages that do not type check
+// are skipped, as go vet does not check them. The analyzers that derive
+// facts, such as printf which finds the wrappers of fmt.Printf, are first run
+// on the dependencies, so that a call to a wrapper of another package is
+// checked too.
+func GoVet(importPaths []string, opts Options) ([]Diagnostic, error) {
+	analyzers, err := Select(opts)
 	if err != nil {
-		goVetData = strings.Split(strings.TrimSuffix(outerr.String(), "\n"), "\n")
-		if len(goVetData) > 0 {
-			return goVetData, nil
+		return nil, err
+	}
+
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, opts.Tags...)
+	conf := loader.Config{Build: &ctx, ParserMode: parser.ParseComments}
+	conf.AllowErrors = true
+	conf.TypeChecker.Error = func(error) {}
+	for _, path := range importPaths {
+		if opts.Tests {
+			conf.ImportWithTests(path)
 		} else {
-			return goVetData, err
+			conf.Import(path)
+		}
+	}
+	lprog, err := conf.Load()
+	if err != nil {
+		return nil, err
+	}
+
+	initial := make(map[string]bool)
+	for _, info := range lprog.InitialPackages() {
+		initial[info.Pkg.Path()] = true
+	}
+	r := newRunner(lprog.Fset, types.SizesFor("gc", ctx.GOARCH))
+	factAnalyzers := withFacts(analyzers)
+	diagnostics := make([]Diagnostic, 0)
+	var failed []string
+	for _, pkg := range typeCheck(lprog, &ctx) {
+		if pkg.IllTyped {
+			continue
+		}
+		report := initial[pkg.Pkg.Path()]
+		run := analyzers
+		if !report {
+			run = factAnalyzers
+		}
+		for _, a := range run {
+			if err := r.run(a, pkg, report, &diagnostics); err != nil {
+				failed = append(failed, fmt.Sprintf("%s: %s: %v", pkg.Pkg.Path(), a.Name, err))
+				break
+			}
+		}
+	}
+
+	sort.Slice(diagnostics, func(i, j int) bool {
+		a, b := diagnostics[i], diagnostics[j]
+		if a.Pos.Filename != b.Pos.Filename {
+			return a.Pos.Filename < b.Pos.Filename
+		}
+		if a.Pos.Line != b.Pos.Line {
+			return a.Pos.Line < b.Pos.Line
+		}
+		if a.Pos.Column != b.Pos.Column {
+			return a.Pos.Column < b.Pos.Column
 		}
+		return a.Analyzer < b.Analyzer
+	})
+	if len(failed) > 0 {
+		return diagnostics, fmt.Errorf("%s", strings.Join(failed, "; "))
 	}
-	goVetData = strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
-	return goVetData, nil
+	return diagnostics, nil
 }
@@ -14,37 +14,132 @@
 package govet
 
 import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
 	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
+
+	"golang.org/x/tools/go/analysis/passes/printf"
 )
 
-var wantVetResult = []string{
-	`../../engine/config.go:204: struct field FilesNum repeats json tag "content" also at ../../engine/config.go:203`,
-	`../../engine/config.go:205: struct field Quality repeats json tag "content" also at ../../engine/config.go:203`,
-	`../../engine/reporter.go:128: call of strategy.Percentage copies lock value: engine.Summaries`,
-	`../../engine/strategy_copycheck.go:69: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_countcode.go:51: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_cyclo.go:76: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_deadcode.go:66: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_dependgraph.go:38: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_depth.go:74: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_importpackages.go:38: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_interfacer.go:66: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_lint.go:64: return copies lock value: engine.Summaries`,
-	`../../engine/strategy_lint.go:67: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_simplecode.go:61: return copies lock value: engine.Summaries`,
-	`../../engine/strategy_simplecode.go:64: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_spellcheck.go:64: Percentage passes lock by value: engine.Summaries`,
-	`../../engine/strategy_unittest.go:94: Percentage passes lock by value: engine.Summaries`,
+const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/govet/testdata/vet"
+
+// wants returns the regexps of the messages the want comments of the
+// testdata expect, by file and line.
+func wants(t *testing.T) map[string]*regexp.Regexp {
+	filenames, err := filepath.Glob("testdata/vet/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	wants := make(map[string]*regexp.Regexp)
+	for _, filename := range filenames {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, line := range strings.Split(string(src), "\n") {
+			j := strings.Index(line, "// want ")
+			if j < 0 {
+				continue
+			}
+			pattern, err := strconv.Unquote(line[j+len("// want "):])
+			if err != nil {
+				t.Fatalf("%s:%d: %v", filename, i+1, err)
+			}
+			wants[filepath.Base(filename)+":"+strconv.Itoa(i+1)] = regexp.MustCompile(pattern)
+		}
+	}
+	return wants
 }
 
 func Test_GoVet(t *testing.T) {
-	res, err := GoVet([]string{"../../engine"})
+	var all []string
+	for _, a := range Analyzers {
+		all = append(all, a.Name)
+	}
+	diagnostics, err := GoVet([]string{testdata}, Options{Enable: all})
 	if err != nil {
-		t.Error("go vet failed.")
-	} else {
-		if !reflect.DeepEqual(res, wantVetResult) {
-			t.Errorf("want %v, but got %v", wantVetResult, res)
+		t.Fatal(err)
+	}
+	wants := wants(t)
+	for _, d := range diagnostics {
+		key := filepath.Base(d.Pos.Filename) + ":" + strconv.Itoa(d.Pos.Line)
+		want, ok := wants[key]
+		if !ok {
+			t.Errorf("unexpected diagnostic %s", d)
+			continue
 		}
+		if !want.MatchString(d.Message) {
+			t.Errorf("%s: want %q, but got %q", key, want, d.Message)
+		}
+		delete(wants, key)
+	}
+	for key, want := range wants {
+		t.Errorf("%s: no diagnostic matching %q", key, want)
+	}
+}
+
+// Test_GoVetAsGoVet checks that the analyzers run by default find what go vet
+// finds on the testdata, with the same messages.
+func Test_GoVetAsGoVet(t *testing.T) {
+	out, err := exec.Command("go", "vet", testdata).CombinedOutput()
+	if _, ok := err.(*exec.ExitError); err != nil && !ok {
+		t.Skipf("go vet: %v", err)
+	}
+	finding := regexp.MustCompile(`^(.*\.go):(\d+):(\d+): (.*)$`)
+	var want []string
+	for _, line := range strings.Split(string(out), "\n") {
+		if m := finding.FindStringSubmatch(line); m != nil {
+			want = append(want, filepath.Base(m[1])+":"+m[2]+":"+m[3]+": "+m[4])
+		}
+	}
+	if len(want) == 0 {
+		t.Skipf("go vet found nothing:\n%s", out)
+	}
+
+	diagnostics, err := GoVet([]string{testdata}, Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, d := range diagnostics {
+		got = append(got, filepath.Base(d.Pos.Filename)+":"+strconv.Itoa(d.Pos.Line)+":"+strconv.Itoa(d.Pos.Column)+": "+d.Message)
+	}
+	sort.Strings(want)
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("want the findings of go vet\n%s\nbut got\n%s", strings.Join(want, "\n"), strings.Join(got, "\n"))
+	}
+}
+
+func Test_Select(t *testing.T) {
+	analyzers, err := Select(Options{Disable: []string{"printf"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, a := range analyzers {
+		if a == printf.Analyzer || a == Shadow {
+			t.Errorf("want %s not selected", a.Name)
+		}
+	}
+	if len(analyzers) != len(Analyzers)-2 {
+		t.Errorf("want %d analyzers, but got %d", len(Analyzers)-2, len(analyzers))
+	}
+
+	analyzers, err = Select(Options{Enable: []string{"shadow"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(analyzers) != 1 || analyzers[0] != Shadow {
+		t.Errorf("want only shadow, but got %v", analyzers)
+	}
+
+	if _, err := Select(Options{Enable: []string{"nosuchcheck"}}); err == nil {
+		t.Error("want an error for an unknown analyzer")
 	}
 }
@@ -0,0 +1,145 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package govet
+
+import (
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Shadow reports the variables declared with the type of a variable of an
+// enclosing scope they shadow while it is still used. As go vet does not run
+// it, it is only run when enabled.
+var Shadow = &analysis.Analyzer{
+	Name: "shadow",
+	Doc:  "check for possible unintended shadowing of variables",
+	Run:  runShadow,
+}
+
+// span is the extent of the declaration and the uses of an object.
+type span struct {
+	min, max token.Pos
+}
+
+func (s span) contains(pos token.Pos) bool {
+	return s.min <= pos && pos < s.max
+}
+
+func runShadow(pass *analysis.Pass) (interface{}, error) {
+	spans := make(map[types.Object]span)
+	grow := func(id *ast.Ident, obj types.Object) {
+		if obj == nil {
+			return
+		}
+		s, ok := spans[obj]
+		if !ok {
+			s = span{id.Pos(), id.End()}
+		}
+		if id.Pos() < s.min {
+			s.min = id.Pos()
+		}
+		if id.End() > s.max {
+			s.max = id.End()
+		}
+		spans[obj] = s
+	}
+	for id, obj := range pass.TypesInfo.Defs {
+		grow(id, obj)
+	}
+	for id, obj := range pass.TypesInfo.Uses {
+		grow(id, obj)
+	}
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.AssignStmt:
+				if n.Tok != token.DEFINE || isRedeclaration(n.Lhs, n.Rhs) {
+					break
+				}
+				for _, lhs := range n.Lhs {
+					if id, ok := lhs.(*ast.Ident); ok {
+						checkShadowing(pass, spans, id)
+					}
+				}
+			case *ast.DeclStmt:
+				gen, ok := n.Decl.(*ast.GenDecl)
+				if !ok || gen.Tok != token.VAR {
+					break
+				}
+				for _, spec := range gen.Specs {
+					vs := spec.(*ast.ValueSpec)
+					lhs := make([]ast.Expr, len(vs.Names))
+					for i, name := range vs.Names {
+						lhs[i] = name
+					}
+					if isRedeclaration(lhs, vs.Values) {
+						continue
+					}
+					for _, name := range vs.Names {
+						checkShadowing(pass, spans, name)
+					}
+				}
+			}
+			return true
+		})
+	}
+	return nil, nil
+}
+
+// isRedeclaration reports whether the declaration of lhs to rhs is the
+// idiomatic redeclaration x := x, that copies the variables of the
+// enclosing scope.
+func isRedeclaration(lhs, rhs []ast.Expr) bool {
+	if len(lhs) != len(rhs) {
+		return false
+	}
+	for i, x := range lhs {
+		l, ok := x.(*ast.Ident)
+		if !ok {
+			return false
+		}
+		r, ok := ast.Unparen(rhs[i]).(*ast.Ident)
+		if !ok || l.Name != r.Name {
+			return false
+		}
+	}
+	return true
+}
+
+// checkShadowing reports id if it declares a variable that shadows one of
+// the same type which is used after the declaration.
+func checkShadowing(pass *analysis.Pass, spans map[types.Object]span, id *ast.Ident) {
+	if id.Name == "_" {
+		return
+	}
+	obj, ok := pass.TypesInfo.Defs[id].(*types.Var)
+	if !ok || obj.Parent() == nil || obj.Parent().Parent() == nil {
+		return
+	}
+	_, shadowed := obj.Parent().Parent().LookupParent(obj.Name(), obj.Pos())
+	if _, ok := shadowed.(*types.Var); !ok || shadowed.Parent() == types.Universe {
+		return
+	}
+	if s, ok := spans[shadowed]; !ok || !s.contains(id.Pos()) {
+		// the shadowed variable is not used after the declaration
+		return
+	}
+	if types.Identical(obj.Type(), shadowed.Type()) {
+		pass.Reportf(id.Pos(), "declaration of %q shadows declaration at line %d", obj.Name(), pass.Fset.Position(shadowed.Pos()).Line)
+	}
+}
@@ -0,0 +1,13 @@
+package vet
+
+func assign(x, y int, s []int, m map[int]int) {
+	x = x       // want "self-assignment of x"
+	x, y = x, y // want "self-assignment of x, y"
+	s[0] = s[0] // want `self-assignment of s\[0\]`
+	m[0] = m[0]
+	s[f()] = s[f()]
+	x, y = y, x
+	_, _ = x, y
+}
+
+func f() int { return 0 }
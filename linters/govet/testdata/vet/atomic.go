@@ -0,0 +1,10 @@
+package vet
+
+import "sync/atomic"
+
+func counter(n int64) int64 {
+	n = atomic.AddInt64(&n, 1) // want "direct assignment to atomic value"
+	atomic.AddInt64(&n, 1)
+	m := atomic.AddInt64(&n, 1)
+	return m
+}
@@ -0,0 +1,18 @@
+package vet
+
+import (
+	"go/token"
+	"image"
+	"net/url"
+)
+
+type local struct{ a, b int }
+
+var (
+	_ = token.Position{"f.go", 0, 1, 1} // want "go/token.Position struct literal uses unkeyed fields"
+	_ = token.Position{Filename: "f.go", Line: 1}
+	_ = image.Point{1, 2}
+	_ = []*url.Userinfo{{}}
+	_ = []token.Position{{"f.go", 0, 1, 1}} // want "go/token.Position struct literal uses unkeyed fields"
+	_ = local{1, 2}
+)
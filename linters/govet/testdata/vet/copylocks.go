@@ -0,0 +1,38 @@
+package vet
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+type guarded struct {
+	mu    sync.Mutex
+	count int
+}
+
+type counted struct {
+	n atomic.Int64
+}
+
+func (g guarded) value() int { // want "value passes lock by value: .*guarded contains sync.Mutex"
+	return g.count
+}
+
+func copyLocks(g *guarded, gs []guarded) guarded {
+	h := *g                // want "assignment copies lock value to h: .*guarded contains sync.Mutex"
+	var i = h              // want "variable declaration copies lock value to i: .*guarded contains sync.Mutex"
+	use(i)                 // want "call of use copies lock value: .*guarded contains sync.Mutex"
+	for _, j := range gs { // want "range var j copies lock: .*guarded contains sync.Mutex"
+		_ = j.count
+	}
+	_ = []guarded{*g} // want `literal copies lock value from \*g: .*guarded contains sync.Mutex`
+	var c counted
+	d := c // want "assignment copies lock value to d: .*counted contains sync/atomic.Int64"
+	_ = d  // want "assignment copies lock value to _: .*counted contains sync/atomic.Int64"
+	k := guarded{}
+	p := &k
+	_ = p
+	return *g // want "return copies lock value: .*guarded contains sync.Mutex"
+}
+
+func use(interface{}) {}
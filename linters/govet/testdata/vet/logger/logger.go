@@ -0,0 +1,15 @@
+// Package logger wraps fmt.Printf in another package, as glog does.
+package logger
+
+import (
+	"fmt"
+	"os"
+)
+
+func Infof(format string, args ...interface{}) {
+	logf(format, args...)
+}
+
+func logf(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, format, args...)
+}
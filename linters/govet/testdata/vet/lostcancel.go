@@ -0,0 +1,27 @@
+package vet
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+func lostCancel(ctx context.Context, fail bool) error {
+	_, _ = context.WithCancel(ctx)                       // want "the cancel function returned by context.WithCancel should be called, not discarded, to avoid a context leak"
+	ctx, cancel := context.WithTimeout(ctx, time.Second) // want `the cancel function is not used on all paths \(possible context leak\)`
+	if fail {
+		return errors.New("failed") // want "this return statement may be reached without using the cancel var defined on line 11"
+	}
+	defer cancel()
+	ctx2, stop := context.WithCancel(ctx)
+	go func() {
+		defer stop()
+	}()
+	_ = ctx2
+	return nil
+}
+
+func withCancel(ctx context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(ctx)
+	return ctx, cancel
+}
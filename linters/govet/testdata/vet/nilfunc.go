@@ -0,0 +1,10 @@
+package vet
+
+import "os"
+
+func nilFunc(fn func()) bool {
+	if nilFunc == nil { // want "comparison of function nilFunc == nil is always false"
+		return false
+	}
+	return os.Exit != nil || fn != nil // want "comparison of function Exit != nil is always true"
+}
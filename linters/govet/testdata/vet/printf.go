@@ -0,0 +1,53 @@
+package vet
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/govet/testdata/vet/logger"
+)
+
+type stringer struct{}
+
+func (stringer) String() string { return "" }
+
+func printf(s string, n int, err error, p *int, st stringer) {
+	fmt.Printf("%d", s)             // want "fmt.Printf format %d has arg s of wrong type string"
+	fmt.Printf("%s %d", s)          // want "fmt.Printf format %d reads arg #2, but call has 1 arg"
+	fmt.Printf("%s", s, n)          // want "fmt.Printf call needs 1 arg but has 2 args"
+	fmt.Printf("done", n)           // want "fmt.Printf call has arguments but no formatting directives"
+	fmt.Printf("%z", n)             // want "fmt.Printf format %z has unknown verb z"
+	_ = fmt.Sprintf("%#s", s)       // want "fmt.Sprintf format %#s has unrecognized flag #"
+	fmt.Printf("%w", err)           // want "fmt.Printf does not support error-wrapping directive %w"
+	fmt.Printf("%s", printf)        // want "fmt.Printf format %s arg printf is a func value, not called"
+	fmt.Fprintf(os.Stderr, "%t", n) // want "fmt.Fprintf format %t has arg n of wrong type int"
+	log.Printf("%*d", s, n)         // want `log.Printf format %\*d uses non-int s as argument of \*`
+	fmt.Println("%d", n)            // want "fmt.Println call has possible Printf formatting directive %d"
+	fmt.Println(s, "\n")            // want "fmt.Println arg list ends with redundant newline"
+	fmt.Print(printf)               // want "fmt.Print arg printf is a func value, not called"
+
+	fmt.Printf("%d%% %s %v %x %p %q", n, st, p, s, p, err)
+	fmt.Printf("%[2]d %[1]s", s, n)
+	fmt.Printf("%-*d %.2f", n, n, 1.5)
+	fmt.Printf("%s %v", []byte(s), struct{ A, B int }{})
+	fmt.Printf("%d", []int{n})
+	names := []string{s}
+	fmt.Printf("%d", names) // want `fmt.Printf format %d has arg names of wrong type \[\]string`
+	fmt.Printf(s, n)
+	_ = fmt.Errorf("%w", errors.New(s))
+	args := []interface{}{n}
+	fmt.Printf("%d %d", args...)
+}
+
+func logf(format string, args ...interface{}) {
+	fmt.Printf(format, args...)
+}
+
+func wrappers(s string, n int) {
+	logf("%d", s)         // want "logf format %d has arg s of wrong type string"
+	logger.Infof("%d", s) // want "logger.Infof format %d has arg s of wrong type string"
+	logger.Infof(s)       // want "non-constant format string in call to .*logger.Infof"
+	logger.Infof("%d", n)
+}
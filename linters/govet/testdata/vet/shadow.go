@@ -0,0 +1,22 @@
+package vet
+
+import "os"
+
+func shadow(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Stat(); err != nil { // want "declaration of \"err\" shadows declaration at line 6"
+		return err
+	}
+	if true {
+		name := name
+		_ = name
+	}
+	if true {
+		n, err := 1, "not an error"
+		_, _ = n, err
+	}
+	return err
+}
@@ -0,0 +1,14 @@
+package vet
+
+type tagged struct {
+	A int `json:"a"`
+	B int `json:"a"`        // want "struct field B repeats json tag \"a\" also at structtag.go:4"
+	C int `json:"c"xml:"c"` // want "struct field tag `json:\"c\"xml:\"c\"` not compatible with reflect.StructTag.Get: key:\"value\" pairs not separated by spaces"
+	D int `json:d`          // want "struct field tag `json:d` not compatible with reflect.StructTag.Get: bad syntax for struct tag value"
+	E int `xml:" e"`        // want "struct field tag `xml:\" e\"` not compatible with reflect.StructTag.Get: suspicious space in struct tag value"
+	f int `json:"f"`        // want "struct field f has json tag but is not exported"
+	G int `json:"-"`
+	H int `json:"-"`
+	I int `json:",omitempty"`
+	J int `json:"a b" yaml:"j"`
+}
@@ -0,0 +1,26 @@
+package vet
+
+func unreachable(n int) int {
+	for {
+		if n > 0 {
+			break
+		}
+	}
+	switch n {
+	case 0:
+		return 0
+		n++ // want "unreachable code"
+	}
+	if n > 1 {
+		return 1
+	} else {
+		panic("negative")
+	}
+	return 2 // want "unreachable code"
+}
+
+func loop() {
+	for {
+	}
+	println() // want "unreachable code"
+}
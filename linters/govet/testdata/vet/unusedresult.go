@@ -0,0 +1,14 @@
+package vet
+
+import (
+	"errors"
+	"fmt"
+)
+
+func unusedResult(err error, st stringer) {
+	fmt.Sprintf("%d", 1) // want "result of fmt.Sprintf call not used"
+	errors.New("lost")   // want "result of errors.New call not used"
+	err.Error()          // want `result of \(error\).Error call not used`
+	st.String()          // want `result of \(.*stringer\).String call not used`
+	_ = fmt.Sprint(1)
+}
@@ -0,0 +1,319 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package halstead computes the Halstead metrics of Go functions and their
+// maintainability index.
+//
+// The operands are the identifiers and literals of a function, the operators
+// its keywords, operators and punctuation such as calls, indexes, selectors
+// and composite literals. From the number of distinct operators n1 and
+// operands n2 and their total numbers N1 and N2:
+//
+//	volume     V = (N1 + N2) * log2(n1 + n2)
+//	difficulty D = n1 / 2 * N2 / n2
+//	effort     E = D * V
+//	bugs       B = V / 3000
+//
+// The maintainability index combines the volume with the cyclomatic
+// complexity G and the lines of code L, normalized to 0-100 as Visual Studio
+// does:
+//
+//	MI = max(0, (171 - 5.2 * ln(V) - 0.23 * G - 16.2 * ln(L)) * 100 / 171)
+package halstead
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"math"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/cyclo"
+)
+
+// A maintainability index below Low means code that is hard to maintain,
+// below Moderate code that is moderately maintainable.
+const (
+	Low      = 10
+	Moderate = 20
+)
+
+// Metrics are the Halstead metrics of a piece of code.
+type Metrics struct {
+	DistinctOperators int     `json:"distinct_operators"`
+	DistinctOperands  int     `json:"distinct_operands"`
+	Operators         int     `json:"operators"`
+	Operands          int     `json:"operands"`
+	Volume            float64 `json:"volume"`
+	Difficulty        float64 `json:"difficulty"`
+	Effort            float64 `json:"effort"`
+	Bugs              float64 `json:"bugs"`
+}
+
+// Func is the measure of one function, named as in "Name" or "(*Type).Name".
+// Lines is the number of lines of the function, MI its maintainability index.
+type Func struct {
+	Name     string  `json:"name"`
+	File     string  `json:"file"`
+	Line     int     `json:"line"`
+	Lines    int     `json:"lines"`
+	Cyclo    int     `json:"cyclo"`
+	Halstead Metrics `json:"halstead"`
+	MI       float64 `json:"mi"`
+}
+
+// Analyze measures the functions of the go files, tests aside, in the
+// directory packagePath, the least maintainable first. Directories matching
+// one of the comma separated except packages are skipped.
+func Analyze(packagePath, except string) ([]Func, error) {
+	funcs := make([]Func, 0)
+	if checkExcept(packagePath, except) {
+		return funcs, nil
+	}
+	files, err := filepath.Glob(filepath.Join(packagePath, "*.go"))
+	if err != nil {
+		return funcs, err
+	}
+	fset := token.NewFileSet()
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, file, nil, 0)
+		if err != nil {
+			return funcs, err
+		}
+		for _, decl := range f.Decls {
+			if fn, ok := decl.(*ast.FuncDecl); ok && fn.Body != nil {
+				funcs = append(funcs, measure(fset, fn))
+			}
+		}
+	}
+	sort.SliceStable(funcs, func(i, j int) bool {
+		return funcs[i].MI < funcs[j].MI
+	})
+	return funcs, nil
+}
+
+func measure(fset *token.FileSet, fn *ast.FuncDecl) Func {
+	start, end := fset.Position(fn.Pos()), fset.Position(fn.End())
+	f := Func{
+		Name:     funcName(fn),
+		File:     start.Filename,
+		Line:     start.Line,
+		Lines:    end.Line - start.Line + 1,
+		Cyclo:    cyclo.Complexity(fn),
+		Halstead: Measure(fn),
+	}
+	f.MI = MaintainabilityIndex(f.Halstead.Volume, float64(f.Cyclo), float64(f.Lines))
+	return f
+}
+
+// Index returns the maintainability index of a file or a package made of
+// funcs, computed from the average volume, complexity and lines of its
+// functions. It is 100 without functions.
+func Index(funcs []Func) float64 {
+	if len(funcs) == 0 {
+		return 100
+	}
+	var volume, complexity, lines float64
+	for _, f := range funcs {
+		volume += f.Halstead.Volume
+		complexity += float64(f.Cyclo)
+		lines += float64(f.Lines)
+	}
+	n := float64(len(funcs))
+	return MaintainabilityIndex(volume/n, complexity/n, lines/n)
+}
+
+// MaintainabilityIndex returns the index of code of the given Halstead
+// volume, cyclomatic complexity and lines, between 0 and 100.
+func MaintainabilityIndex(volume, complexity, lines float64) float64 {
+	mi := 171 - 0.23*complexity
+	if volume > 0 {
+		mi -= 5.2 * math.Log(volume)
+	}
+	if lines > 0 {
+		mi -= 16.2 * math.Log(lines)
+	}
+	mi = mi * 100 / 171
+	if mi < 0 {
+		return 0
+	}
+	if mi > 100 {
+		return 100
+	}
+	return mi
+}
+
+// Measure returns the Halstead metrics of node.
+func Measure(node ast.Node) Metrics {
+	v := &counter{
+		operators: make(map[string]int),
+		operands:  make(map[string]int),
+	}
+	ast.Walk(v, node)
+
+	m := Metrics{
+		DistinctOperators: len(v.operators),
+		DistinctOperands:  len(v.operands),
+	}
+	for _, n := range v.operators {
+		m.Operators += n
+	}
+	for _, n := range v.operands {
+		m.Operands += n
+	}
+	if vocabulary := m.DistinctOperators + m.DistinctOperands; vocabulary > 0 {
+		m.Volume = float64(m.Operators+m.Operands) * math.Log2(float64(vocabulary))
+	}
+	if m.DistinctOperands > 0 {
+		m.Difficulty = float64(m.DistinctOperators) / 2 * float64(m.Operands) / float64(m.DistinctOperands)
+	}
+	m.Effort = m.Difficulty * m.Volume
+	m.Bugs = m.Volume / 3000
+	return m
+}
+
+// counter counts the occurrences of every operator and operand.
+type counter struct {
+	operators map[string]int
+	operands  map[string]int
+}
+
+// Visit implements the ast.Visitor interface.
+func (c *counter) Visit(n ast.Node) ast.Visitor {
+	switch n := n.(type) {
+	case *ast.Ident:
+		c.operands[n.Name]++
+	case *ast.BasicLit:
+		c.operands[n.Value]++
+	case *ast.BinaryExpr:
+		c.operators[n.Op.String()]++
+	case *ast.UnaryExpr:
+		c.operators[n.Op.String()]++
+	case *ast.StarExpr:
+		c.operators["*"]++
+	case *ast.AssignStmt:
+		c.operators[n.Tok.String()]++
+	case *ast.IncDecStmt:
+		c.operators[n.Tok.String()]++
+	case *ast.SendStmt:
+		c.operators["<-"]++
+	case *ast.CallExpr:
+		c.operators["()"]++
+	case *ast.ParenExpr:
+		c.operators["(...)"]++
+	case *ast.IndexExpr, *ast.IndexListExpr:
+		c.operators["[]"]++
+	case *ast.SliceExpr:
+		c.operators["[:]"]++
+	case *ast.SelectorExpr:
+		c.operators["."]++
+	case *ast.TypeAssertExpr:
+		c.operators[".()"]++
+	case *ast.CompositeLit:
+		c.operators["{}"]++
+	case *ast.KeyValueExpr:
+		c.operators[":"]++
+	case *ast.Ellipsis:
+		c.operators["..."]++
+	case *ast.FuncType:
+		c.operators["func"]++
+	case *ast.ArrayType:
+		c.operators["[]"]++
+	case *ast.MapType:
+		c.operators["map"]++
+	case *ast.ChanType:
+		c.operators["chan"]++
+	case *ast.StructType:
+		c.operators["struct"]++
+	case *ast.InterfaceType:
+		c.operators["interface"]++
+	case *ast.GenDecl:
+		c.operators[n.Tok.String()]++
+	case *ast.IfStmt:
+		c.operators["if"]++
+		if n.Else != nil {
+			c.operators["else"]++
+		}
+	case *ast.ForStmt:
+		c.operators["for"]++
+	case *ast.RangeStmt:
+		c.operators["for"]++
+		c.operators["range"]++
+	case *ast.SwitchStmt, *ast.TypeSwitchStmt:
+		c.operators["switch"]++
+	case *ast.SelectStmt:
+		c.operators["select"]++
+	case *ast.CaseClause:
+		if n.List == nil {
+			c.operators["default"]++
+		} else {
+			c.operators["case"]++
+		}
+	case *ast.CommClause:
+		if n.Comm == nil {
+			c.operators["default"]++
+		} else {
+			c.operators["case"]++
+		}
+	case *ast.ReturnStmt:
+		c.operators["return"]++
+	case *ast.GoStmt:
+		c.operators["go"]++
+	case *ast.DeferStmt:
+		c.operators["defer"]++
+	case *ast.BranchStmt:
+		c.operators[n.Tok.String()]++
+	case *ast.LabeledStmt:
+		c.operators["label"]++
+	}
+	return c
+}
+
+func checkExcept(path, except string) bool {
+	for _, val := range strings.Split(except, ",") {
+		if val = strings.TrimSpace(val); val != "" && strings.Contains(path, val) {
+			return true
+		}
+	}
+	return false
+}
+
+// funcName returns the name representation of a function or method:
+// "(Type).Name" for methods or simply "Name" for functions.
+func funcName(fn *ast.FuncDecl) string {
+	if fn.Recv != nil && fn.Recv.NumFields() > 0 {
+		return "(" + recvString(fn.Recv.List[0].Type) + ")." + fn.Name.Name
+	}
+	return fn.Name.Name
+}
+
+// recvString returns a string representation of recv of the
+// form "T", "*T", or "BADRECV" (if not a proper receiver type).
+func recvString(recv ast.Expr) string {
+	switch t := recv.(type) {
+	case *ast.Ident:
+		return t.Name
+	case *ast.StarExpr:
+		return "*" + recvString(t.X)
+	case *ast.IndexExpr:
+		return recvString(t.X)
+	case *ast.IndexListExpr:
+		return recvString(t.X)
+	}
+	return "BADRECV"
+}
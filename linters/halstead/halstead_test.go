@@ -0,0 +1,51 @@
+package halstead
+
+import (
+	"math"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	funcs, err := Analyze("testdata/p1", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(funcs) != 2 {
+		t.Fatalf("got %d functions, want 2 without the tests", len(funcs))
+	}
+	if funcs[0].Name != "classify" || funcs[1].Name != "add" {
+		t.Fatalf("got %s and %s, want the least maintainable first", funcs[0].Name, funcs[1].Name)
+	}
+
+	add := funcs[1]
+	want := Metrics{
+		DistinctOperators: 3,
+		DistinctOperands:  4,
+		Operators:         3,
+		Operands:          7,
+	}
+	got := add.Halstead
+	if got.DistinctOperators != want.DistinctOperators || got.DistinctOperands != want.DistinctOperands ||
+		got.Operators != want.Operators || got.Operands != want.Operands {
+		t.Errorf("got %+v, want %+v", got, want)
+	}
+	if math.Abs(got.Volume-10*math.Log2(7)) > 1e-9 {
+		t.Errorf("got volume %f", got.Volume)
+	}
+	if math.Abs(got.Difficulty-2.625) > 1e-9 {
+		t.Errorf("got difficulty %f, want 2.625", got.Difficulty)
+	}
+	if add.Lines != 3 || add.Cyclo != 1 {
+		t.Errorf("got %d lines and cyclo %d, want 3 and 1", add.Lines, add.Cyclo)
+	}
+	if math.Abs(add.MI-79.3) > 0.1 {
+		t.Errorf("got maintainability index %f, want 79.3", add.MI)
+	}
+
+	if mi := Index(funcs); mi <= funcs[0].MI || mi >= funcs[1].MI {
+		t.Errorf("got index %f of the package, want it between its functions", mi)
+	}
+	if mi := Index(nil); mi != 100 {
+		t.Errorf("got index %f without functions, want 100", mi)
+	}
+}
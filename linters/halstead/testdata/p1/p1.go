@@ -0,0 +1,22 @@
+package p1
+
+func add(a, b int) int {
+	return a + b
+}
+
+func classify(values []int) map[string]int {
+	counts := make(map[string]int)
+	for _, v := range values {
+		switch {
+		case v < 0:
+			counts["negative"]++
+		case v == 0:
+			counts["zero"]++
+		case v%2 == 0 && v > 100:
+			counts["big even"]++
+		default:
+			counts["other"]++
+		}
+	}
+	return counts
+}
@@ -0,0 +1,9 @@
+package p1
+
+import "testing"
+
+func TestAdd(t *testing.T) {
+	if add(1, 2) != 3 {
+		t.Fail()
+	}
+}
@@ -6,6 +6,7 @@ package interfacer
 import (
 	"fmt"
 	"go/ast"
+	"go/build"
 	"go/token"
 	"go/types"
 	"os"
@@ -64,10 +65,12 @@ type funcDecl struct {
 }
 
 // CheckArgs checks the packages specified by their import paths in
-// args.
-func CheckArgs(args []string) ([]string, error) {
+// args, built with the given build tags.
+func CheckArgs(args []string, tags ...string) ([]string, error) {
 	paths := gotool.ImportPaths(args)
-	conf := loader.Config{}
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, tags...)
+	conf := loader.Config{Build: &ctx}
 	conf.AllowErrors = true
 	rest, err := conf.FromArgs(paths, false)
 	if err != nil {
@@ -7,7 +7,7 @@ import (
 	"strings"
 )
 
-func Interfacer(packagesPath map[string]string) []string {
+func Interfacer(packagesPath map[string]string, tags ...string) []string {
 	packages := make([]string, 0)
 	for _, v := range packagesPath {
 		v = absPath(v)
@@ -16,7 +16,7 @@ func Interfacer(packagesPath map[string]string) []string {
 			packages = append(packages, v[(srcIndex+4):])
 		}
 	}
-	lines, err := CheckArgs(packages)
+	lines, err := CheckArgs(packages, tags...)
 	if err != nil {
 		l := log.New(os.Stderr, "", log.LstdFlags)
 		l.Println(err)
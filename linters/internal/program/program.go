@@ -0,0 +1,80 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package program holds the helpers shared by the linters that build the SSA
+// form of a program and follow its calls, such as untested, vuln and
+// deadcode.
+package program
+
+import (
+	"fmt"
+	"go/types"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/ssa"
+)
+
+// BuildPackage builds the SSA form of pkg. The builder panics on code it does
+// not support, such as generic functions, which is returned as an error.
+func BuildPackage(pkg *ssa.Package) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	pkg.Build()
+	return nil
+}
+
+// RuntimeTypes returns the named types of packages that can be held in an
+// interface, together with their pointer types.
+func RuntimeTypes(packages []*types.Package) []types.Type {
+	var runtime []types.Type
+	for _, pkg := range packages {
+		scope := pkg.Scope()
+		for _, name := range scope.Names() {
+			obj, ok := scope.Lookup(name).(*types.TypeName)
+			if !ok || obj.IsAlias() {
+				continue
+			}
+			named, ok := obj.Type().(*types.Named)
+			if !ok || named.TypeParams().Len() > 0 || types.IsInterface(named) {
+				continue
+			}
+			runtime = append(runtime, named, types.NewPointer(named))
+		}
+	}
+	return runtime
+}
+
+// Implementations returns the methods of the runtime types that the interface
+// method call of common may dispatch to.
+func Implementations(prog *ssa.Program, runtime []types.Type, common *ssa.CallCommon) []*ssa.Function {
+	iface, ok := common.Value.Type().Underlying().(*types.Interface)
+	if !ok {
+		return nil
+	}
+	var fns []*ssa.Function
+	for _, typ := range runtime {
+		if !types.Implements(typ, iface) {
+			continue
+		}
+		sel := prog.MethodSets.MethodSet(typ).Lookup(common.Method.Pkg(), common.Method.Name())
+		if sel == nil {
+			continue
+		}
+		if fn, ok := sel.Obj().(*types.Func); ok {
+			fns = append(fns, prog.FuncValue(fn))
+		}
+	}
+	return fns
+}
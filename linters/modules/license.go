@@ -0,0 +1,123 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// UnknownLicense is the license of the license files matching none of
+// licenses.
+const UnknownLicense = "Unknown"
+
+// licenseFile matches the names of license files, as LICENSE, LICENSE.md,
+// license, COPYING or UNLICENSE.
+var licenseFile = regexp.MustCompile(`(?i)^(un)?(licen[cs]e|copying)([.\-_].*)?$`)
+
+// licenses are the SPDX identifiers of the common licenses and phrases of
+// their texts, all of which must appear in a license file, in lower case
+// with the white space collapsed. The first phrase is the name of the
+// license, which its text starts with: as licenses name others, the GPL the
+// Affero GPL and the MPL the GNU licenses, the license named first in a file
+// is its license. Of the licenses named at the same place, the more specific
+// come first.
+var licenses = []struct {
+	id      string
+	phrases []string
+}{
+	{"AGPL-3.0", []string{"gnu affero general public license", "version 3"}},
+	{"LGPL-3.0", []string{"gnu lesser general public license", "version 3"}},
+	{"LGPL-2.1", []string{"gnu lesser general public license", "version 2.1"}},
+	{"GPL-3.0", []string{"gnu general public license", "version 3"}},
+	{"GPL-2.0", []string{"gnu general public license", "version 2"}},
+	{"MPL-2.0", []string{"mozilla public license", "2.0"}},
+	{"EPL-2.0", []string{"eclipse public license", "v 2.0"}},
+	{"EPL-1.0", []string{"eclipse public license", "v 1.0"}},
+	{"Apache-2.0", []string{"apache license", "version 2.0"}},
+	{"BSD-3-Clause", []string{"redistribution and use in source and binary forms", "neither the name"}},
+	{"BSD-3-Clause", []string{"redistribution and use in source and binary forms", "names of its contributors"}},
+	{"BSD-2-Clause", []string{"redistribution and use in source and binary forms"}},
+	{"MIT", []string{"permission is hereby granted, free of charge"}},
+	{"ISC", []string{"permission to use, copy, modify, and/or distribute this software for any purpose"}},
+	{"Unlicense", []string{"this is free and unencumbered software released into the public domain"}},
+	{"CC0-1.0", []string{"cc0 1.0 universal"}},
+	{"Zlib", []string{"this software is provided 'as-is'", "altered source versions must be plainly marked"}},
+}
+
+// DetectLicense returns the SPDX identifier of the license of the files in
+// dir and the path of the license file it was found in, or empty strings if
+// dir has no license file. A license file whose text is not recognized gives
+// UnknownLicense.
+func DetectLicense(dir string) (string, string) {
+	if dir == "" {
+		return "", ""
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", ""
+	}
+	var names []string
+	for _, f := range files {
+		if !f.IsDir() && licenseFile.MatchString(f.Name()) {
+			names = append(names, f.Name())
+		}
+	}
+	if len(names) == 0 {
+		return "", ""
+	}
+	// LICENSE before LICENSE.md and COPYING.
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) < len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	for _, name := range names {
+		data, err := ioutil.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		if id := Classify(string(data)); id != UnknownLicense {
+			return id, filepath.Join(dir, name)
+		}
+	}
+	return UnknownLicense, filepath.Join(dir, names[0])
+}
+
+// Classify returns the SPDX identifier of the license text, or
+// UnknownLicense.
+func Classify(text string) string {
+	text = strings.ToLower(strings.Join(strings.Fields(text), " "))
+	id, first := UnknownLicense, len(text)
+	for _, license := range licenses {
+		matched := true
+		for _, phrase := range license.phrases {
+			if !strings.Contains(text, phrase) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		if i := strings.Index(text, license.phrases[0]); i < first {
+			id, first = license.id, i
+		}
+	}
+	return id
+}
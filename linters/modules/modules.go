@@ -0,0 +1,578 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package modules builds the inventory of the third-party dependencies of a
+// project and its software bill of materials. The modules are those required
+// by its go.mod and listed in vendor/modules.txt, with their versions,
+// replacements and go.sum hashes, found in the vendor directory or the module
+// cache to detect their licenses. Projects without go.mod are
+// walked in GOPATH mode instead, the imported packages being grouped by
+// repository. Nothing is downloaded.
+package modules
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"go/build"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
+)
+
+// Replace is the replacement of a module by a replace directive. Version is
+// empty for a replacement by a local directory.
+type Replace struct {
+	Path    string `json:"path"`
+	Version string `json:"version"`
+}
+
+// Module is a third-party dependency. Direct tells whether the project
+// requires it directly, as opposed to a dependency of a dependency, and
+// Packages lists the project packages importing it. Hash and GoModHash are
+// the go.sum hashes of the module and of its go.mod, Dir the directory it was
+// found in and License the license detected in LicenseFile. Projects without
+// go.mod have no versions nor hashes.
+type Module struct {
+	Path        string   `json:"path"`
+	Version     string   `json:"version"`
+	Direct      bool     `json:"direct"`
+	Replace     *Replace `json:"replace,omitempty"`
+	Hash        string   `json:"hash"`
+	GoModHash   string   `json:"go_mod_hash"`
+	Dir         string   `json:"dir"`
+	License     string   `json:"license"`
+	LicenseFile string   `json:"license_file"`
+	Packages    []string `json:"packages"`
+}
+
+// Inventory returns the third-party modules of the project under
+// projectPath, sorted by path. The imports of the project packages, test
+// files included, are read with the given build tags satisfied.
+func Inventory(projectPath string, tags ...string) ([]Module, error) {
+	root, err := filepath.Abs(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, tags...)
+
+	if modRoot, ok := findGoMod(root); ok {
+		return moduleInventory(&ctx, root, modRoot)
+	}
+	return gopathInventory(&ctx, root)
+}
+
+// MainModule returns the import path of the project under projectPath in
+// module mode, the path of the module of its go.mod followed by the
+// directory of the project in the module, if it has a go.mod.
+func MainModule(projectPath string) (string, bool) {
+	root, err := filepath.Abs(projectPath)
+	if err != nil {
+		return "", false
+	}
+	modRoot, ok := findGoMod(root)
+	if !ok {
+		return "", false
+	}
+	data, err := ioutil.ReadFile(filepath.Join(modRoot, "go.mod"))
+	if err != nil {
+		return "", false
+	}
+	goMod, err := parseGoMod(data)
+	if err != nil {
+		return "", false
+	}
+	if rel, err := filepath.Rel(modRoot, root); err == nil && rel != "." {
+		return goMod.module + "/" + filepath.ToSlash(rel), true
+	}
+	return goMod.module, true
+}
+
+// findGoMod returns the directory of the go.mod of the module containing
+// dir, if any.
+func findGoMod(dir string) (string, bool) {
+	for {
+		if info, err := os.Stat(filepath.Join(dir, "go.mod")); err == nil && !info.IsDir() {
+			return dir, true
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", false
+		}
+		dir = parent
+	}
+}
+
+// packageDirs returns the directories of the packages under root, leaving
+// out vendor and testdata directories, the directories ignored by the go
+// tool and nested modules. A root that is a symbolic link is followed.
+func packageDirs(ctx *build.Context, root string) (map[string]*build.Package, error) {
+	walkRoot := root
+	if resolved, err := filepath.EvalSymlinks(root); err == nil {
+		walkRoot = resolved
+	}
+	pkgs := make(map[string]*build.Package)
+	err := filepath.Walk(walkRoot, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return nil
+		}
+		if rel, err := filepath.Rel(walkRoot, path); err == nil {
+			path = filepath.Join(root, rel)
+		}
+		if path != root {
+			name := info.Name()
+			if name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") {
+				return filepath.SkipDir
+			}
+			if _, err := os.Stat(filepath.Join(path, "go.mod")); err == nil {
+				return filepath.SkipDir
+			}
+		}
+		pkg, err := ctx.ImportDir(path, 0)
+		if err == nil {
+			pkgs[path] = pkg
+		}
+		return nil
+	})
+	return pkgs, err
+}
+
+// imports returns the imports of pkg and of its tests.
+func imports(pkg *build.Package) []string {
+	var all []string
+	all = append(all, pkg.Imports...)
+	all = append(all, pkg.TestImports...)
+	all = append(all, pkg.XTestImports...)
+	return all
+}
+
+// isStandard reports whether importPath is a package of the standard
+// library, whose first path element has no dot.
+func isStandard(importPath string) bool {
+	return importPath == "C" || !strings.Contains(strings.SplitN(importPath, "/", 2)[0], ".")
+}
+
+// within reports whether importPath is the package prefix or below it.
+func within(importPath, prefix string) bool {
+	return importPath == prefix || strings.HasPrefix(importPath, prefix+"/")
+}
+
+// addPackage adds pkgName to the sorted packages of m, once.
+func (m *Module) addPackage(pkgName string) {
+	i := sort.SearchStrings(m.Packages, pkgName)
+	if i < len(m.Packages) && m.Packages[i] == pkgName {
+		return
+	}
+	m.Packages = append(m.Packages, "")
+	copy(m.Packages[i+1:], m.Packages[i:])
+	m.Packages[i] = pkgName
+}
+
+func sortModules(modules []Module) {
+	sort.Slice(modules, func(i, j int) bool {
+		return modules[i].Path < modules[j].Path
+	})
+}
+
+// moduleInventory returns the modules required by the go.mod of modRoot,
+// used by the packages under root.
+func moduleInventory(ctx *build.Context, root, modRoot string) ([]Module, error) {
+	data, err := ioutil.ReadFile(filepath.Join(modRoot, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	goMod, err := parseGoMod(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", filepath.Join(modRoot, "go.mod"), err)
+	}
+	sums := make(map[string]string)
+	if data, err := ioutil.ReadFile(filepath.Join(modRoot, "go.sum")); err == nil {
+		sums = parseGoSum(data)
+	}
+	vendored := false
+	if data, err := ioutil.ReadFile(filepath.Join(modRoot, "vendor", "modules.txt")); err == nil {
+		vendored = true
+		goMod.addVendored(data)
+	}
+
+	modules := make([]Module, 0, len(goMod.requires))
+	for _, req := range goMod.requires {
+		m := Module{
+			Path:     req.Path,
+			Version:  req.Version,
+			Direct:   !req.Indirect,
+			Packages: make([]string, 0),
+		}
+		hashPath, hashVersion := req.Path, req.Version
+		m.Dir = moduleCacheDir(req.Path, req.Version)
+		if rep, ok := goMod.replacement(req.Path, req.Version); ok {
+			m.Replace = &Replace{Path: rep.Path, Version: rep.Version}
+			hashPath, hashVersion = rep.Path, rep.Version
+			if rep.Version == "" {
+				m.Dir = rep.Path
+				if !filepath.IsAbs(m.Dir) {
+					m.Dir = filepath.Join(modRoot, m.Dir)
+				}
+			} else {
+				m.Dir = moduleCacheDir(rep.Path, rep.Version)
+			}
+		}
+		if vendored {
+			m.Dir = filepath.Join(modRoot, "vendor", filepath.FromSlash(req.Path))
+		}
+		m.Hash = sums[hashPath+" "+hashVersion]
+		m.GoModHash = sums[hashPath+" "+hashVersion+"/go.mod"]
+		if _, err := os.Stat(m.Dir); err != nil {
+			m.Dir = ""
+		}
+		m.License, m.LicenseFile = DetectLicense(m.Dir)
+		modules = append(modules, m)
+	}
+
+	pkgs, err := packageDirs(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	for dir, pkg := range pkgs {
+		rel, err := filepath.Rel(modRoot, dir)
+		if err != nil {
+			continue
+		}
+		pkgName := goMod.module
+		if rel != "." {
+			pkgName += "/" + filepath.ToSlash(rel)
+		}
+		for _, imp := range imports(pkg) {
+			if isStandard(imp) || within(imp, goMod.module) {
+				continue
+			}
+			longest := -1
+			for i := range modules {
+				if within(imp, modules[i].Path) && (longest < 0 || len(modules[i].Path) > len(modules[longest].Path)) {
+					longest = i
+				}
+			}
+			if longest >= 0 {
+				modules[longest].addPackage(pkgName)
+			}
+		}
+	}
+	sortModules(modules)
+	return modules, nil
+}
+
+// moduleCacheDir returns the directory of the module path at version in the
+// module cache, $GOMODCACHE or the pkg/mod directory of the first GOPATH.
+func moduleCacheDir(path, version string) string {
+	cache := os.Getenv("GOMODCACHE")
+	if cache == "" {
+		gopath := filepath.SplitList(build.Default.GOPATH)
+		if len(gopath) == 0 {
+			return ""
+		}
+		cache = filepath.Join(gopath[0], "pkg", "mod")
+	}
+	return filepath.Join(cache, filepath.FromSlash(escapePath(path)+"@"+escapePath(version)))
+}
+
+// escapePath escapes the upper case letters of path as the module cache
+// does, "!" followed by the lower case letter.
+func escapePath(path string) string {
+	var buf bytes.Buffer
+	for _, r := range path {
+		if unicode.IsUpper(r) {
+			buf.WriteByte('!')
+			r = unicode.ToLower(r)
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
+
+// goMod is the part of a go.mod file the inventory needs.
+type goMod struct {
+	module   string
+	requires []require
+	replaces []replace
+}
+
+type require struct {
+	Path     string
+	Version  string
+	Indirect bool
+}
+
+// replace replaces the module Old at OldVersion, or at any version if
+// OldVersion is empty, by New.
+type replace struct {
+	Old        string
+	OldVersion string
+	New        Replace
+}
+
+// replacement returns the replacement of the module path at version.
+func (g *goMod) replacement(path, version string) (Replace, bool) {
+	found, ok := Replace{}, false
+	for _, r := range g.replaces {
+		if r.Old != path {
+			continue
+		}
+		if r.OldVersion == version {
+			return r.New, true
+		}
+		if r.OldVersion == "" {
+			found, ok = r.New, true
+		}
+	}
+	return found, ok
+}
+
+// parseGoMod parses the module, require and replace directives of a go.mod
+// file, single or in blocks.
+func parseGoMod(data []byte) (*goMod, error) {
+	g := &goMod{}
+	block := ""
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		comment := ""
+		if i := strings.Index(text, "//"); i >= 0 {
+			text, comment = text[:i], strings.TrimSpace(text[i+2:])
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+		if block != "" {
+			if fields[0] == ")" {
+				block = ""
+				continue
+			}
+			fields = append([]string{block}, fields...)
+		} else if len(fields) == 2 && fields[1] == "(" {
+			block = fields[0]
+			continue
+		}
+		for i := range fields {
+			fields[i] = strings.Trim(fields[i], `"`)
+		}
+
+		switch fields[0] {
+		case "module":
+			if len(fields) != 2 {
+				return nil, fmt.Errorf("line %d: malformed module directive", line)
+			}
+			g.module = fields[1]
+		case "require":
+			if len(fields) != 3 {
+				return nil, fmt.Errorf("line %d: malformed require directive", line)
+			}
+			g.requires = append(g.requires, require{
+				Path:     fields[1],
+				Version:  fields[2],
+				Indirect: comment == "indirect" || strings.HasPrefix(comment, "indirect;"),
+			})
+		case "replace":
+			arrow := -1
+			for i, f := range fields {
+				if f == "=>" {
+					arrow = i
+				}
+			}
+			if arrow != 2 && arrow != 3 || len(fields)-arrow < 2 || len(fields)-arrow > 3 {
+				return nil, fmt.Errorf("line %d: malformed replace directive", line)
+			}
+			r := replace{Old: fields[1], New: Replace{Path: fields[arrow+1]}}
+			if arrow == 3 {
+				r.OldVersion = fields[2]
+			}
+			if len(fields)-arrow == 3 {
+				r.New.Version = fields[arrow+2]
+			}
+			g.replaces = append(g.replaces, r)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if g.module == "" {
+		return nil, fmt.Errorf("no module directive")
+	}
+	return g, nil
+}
+
+// addVendored adds the modules listed in the vendor/modules.txt file data
+// and missing from the go.mod as indirect requirements, as well as their
+// replacements. The go.mod files of Go before 1.17 did not list all of them.
+func (g *goMod) addVendored(data []byte) {
+	required := make(map[string]bool, len(g.requires))
+	for _, req := range g.requires {
+		required[req.Path] = true
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		// # path version [=> new [version]]
+		fields := strings.Fields(line)
+		if len(fields) < 3 || fields[0] != "#" || fields[2] == "=>" || required[fields[1]] {
+			continue
+		}
+		required[fields[1]] = true
+		g.requires = append(g.requires, require{Path: fields[1], Version: fields[2], Indirect: true})
+		if len(fields) >= 5 && fields[3] == "=>" {
+			r := replace{Old: fields[1], OldVersion: fields[2], New: Replace{Path: fields[4]}}
+			if len(fields) >= 6 {
+				r.New.Version = fields[5]
+			}
+			g.replaces = append(g.replaces, r)
+		}
+	}
+}
+
+// parseGoSum returns the hashes of a go.sum file by "path version" and
+// "path version/go.mod".
+func parseGoSum(data []byte) map[string]string {
+	sums := make(map[string]string)
+	for _, line := range strings.Split(string(data), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 3 {
+			sums[fields[0]+" "+fields[1]] = fields[2]
+		}
+	}
+	return sums
+}
+
+// gopathInventory returns the packages imported by the packages under root,
+// directly or not, found in vendor directories or in the GOPATH and grouped
+// by repository. Packages that cannot be found make a module of their own,
+// without directory.
+func gopathInventory(ctx *build.Context, root string) ([]Module, error) {
+	project := utils.PackageAbsPath(root)
+	pkgs, err := packageDirs(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+
+	modules := make(map[string]*Module)
+	seen := make(map[string]bool)
+	type item struct {
+		from, imp, dir string
+	}
+	var queue []item
+	for dir, pkg := range pkgs {
+		pkgName := utils.PackageAbsPath(dir)
+		for _, imp := range imports(pkg) {
+			queue = append(queue, item{from: pkgName, imp: imp, dir: dir})
+		}
+	}
+	for len(queue) > 0 {
+		it := queue[0]
+		queue = queue[1:]
+		if isStandard(it.imp) || project != "" && within(it.imp, project) {
+			continue
+		}
+		dir, srcRoot := findPackage(ctx, it.dir, it.imp)
+		modulePath, moduleDir := repository(it.imp, dir, srcRoot)
+		m := modules[modulePath]
+		if m == nil {
+			m = &Module{Path: modulePath, Dir: moduleDir, Packages: make([]string, 0)}
+			m.License, m.LicenseFile = DetectLicense(moduleDir)
+			modules[modulePath] = m
+		}
+		if it.from != "" {
+			m.Direct = true
+			m.addPackage(it.from)
+		}
+		if dir == "" || seen[dir] {
+			continue
+		}
+		seen[dir] = true
+		if pkg, err := ctx.ImportDir(dir, 0); err == nil {
+			for _, imp := range pkg.Imports {
+				queue = append(queue, item{imp: imp, dir: dir})
+			}
+		}
+	}
+
+	inventory := make([]Module, 0, len(modules))
+	for _, m := range modules {
+		inventory = append(inventory, *m)
+	}
+	sortModules(inventory)
+	return inventory, nil
+}
+
+// findPackage returns the directory of the package imp imported from the
+// directory from, looking into the vendor directories of from and of its
+// parents first, then into the GOPATH, and the vendor or src directory it was
+// found in.
+func findPackage(ctx *build.Context, from, imp string) (dir, srcRoot string) {
+	isDir := func(dir string) bool {
+		info, err := os.Stat(dir)
+		return err == nil && info.IsDir()
+	}
+	for d := from; ; {
+		vendor := filepath.Join(d, "vendor")
+		if isDir(filepath.Join(vendor, filepath.FromSlash(imp))) {
+			return filepath.Join(vendor, filepath.FromSlash(imp)), vendor
+		}
+		parent := filepath.Dir(d)
+		if parent == d || filepath.Base(d) == "src" {
+			break
+		}
+		d = parent
+	}
+	for _, gopath := range filepath.SplitList(ctx.GOPATH) {
+		src := filepath.Join(gopath, "src")
+		if isDir(filepath.Join(src, filepath.FromSlash(imp))) {
+			return filepath.Join(src, filepath.FromSlash(imp)), src
+		}
+	}
+	return "", ""
+}
+
+// repository returns the import path and the directory of the repository of
+// the package imp found in dir below srcRoot: the closest directory with a
+// license file, or else the root guessed from the import path.
+func repository(imp, dir, srcRoot string) (string, string) {
+	if dir != "" {
+		for d := dir; d != srcRoot && strings.HasPrefix(d, srcRoot); d = filepath.Dir(d) {
+			if _, file := DetectLicense(d); file != "" {
+				rel, err := filepath.Rel(srcRoot, d)
+				if err == nil {
+					return filepath.ToSlash(rel), d
+				}
+			}
+		}
+	}
+	elems := strings.Split(imp, "/")
+	n := 3
+	if elems[0] == "gopkg.in" {
+		n = 2
+	}
+	if len(elems) < n {
+		n = len(elems)
+	}
+	path := strings.Join(elems[:n], "/")
+	if dir == "" {
+		return path, ""
+	}
+	return path, filepath.Join(srcRoot, filepath.FromSlash(path))
+}
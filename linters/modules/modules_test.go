@@ -0,0 +1,108 @@
+package modules
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestInventory(t *testing.T) {
+	cache, err := filepath.Abs("testdata/cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("GOMODCACHE", os.Getenv("GOMODCACHE"))
+	os.Setenv("GOMODCACHE", cache)
+
+	modules, err := Inventory("testdata/mod")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Module{
+		{Path: "example.com/Upper", Version: "v0.1.0", Hash: "h1:upper=", GoModHash: "h1:uppermod=", License: "Apache-2.0", LicenseFile: "COPYING", Packages: []string{}},
+		{Path: "example.com/a", Version: "v1.2.0", Direct: true, Hash: "h1:a=", GoModHash: "h1:amod=", License: "MIT", LicenseFile: "LICENSE.md", Packages: []string{"example.com/p", "example.com/p/sub"}},
+		{Path: "example.com/b", Version: "v0.0.0-00010101000000-000000000000", Direct: true, Replace: &Replace{Path: "./local/b"}, License: "BSD-2-Clause", LicenseFile: "LICENSE", Packages: []string{"example.com/p/sub"}},
+		{Path: "example.com/c", Version: "v1.0.0", Direct: true, Packages: []string{}},
+	}
+	if len(modules) != len(want) {
+		t.Fatalf("got %d modules, want %d: %+v", len(modules), len(want), modules)
+	}
+	for i, m := range modules {
+		if m.LicenseFile != "" {
+			m.LicenseFile = filepath.Base(m.LicenseFile)
+		}
+		if (m.Dir == "") != (want[i].License == "") {
+			t.Errorf("%s: unexpected directory %q", m.Path, m.Dir)
+		}
+		m.Dir = ""
+		if !reflect.DeepEqual(m, want[i]) {
+			t.Errorf("got %+v, want %+v", m, want[i])
+		}
+	}
+}
+
+func TestMainModule(t *testing.T) {
+	if path, ok := MainModule("testdata/mod/sub"); !ok || path != "example.com/p/sub" {
+		t.Errorf("got %q, want example.com/p/sub", path)
+	}
+}
+
+func TestParseGoMod(t *testing.T) {
+	goMod, err := parseGoMod([]byte(`module "example.com/p" // comment
+require example.com/a v1.0.0 // indirect; comment
+replace (
+	example.com/a v1.0.0 => example.com/fork v1.1.0
+	example.com/a => ../a
+)
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if goMod.module != "example.com/p" || len(goMod.requires) != 1 || !goMod.requires[0].Indirect {
+		t.Errorf("unexpected go.mod %+v", goMod)
+	}
+	if rep, ok := goMod.replacement("example.com/a", "v1.0.0"); !ok || rep.Path != "example.com/fork" || rep.Version != "v1.1.0" {
+		t.Errorf("got replacement %+v for v1.0.0, want example.com/fork v1.1.0", rep)
+	}
+	if rep, ok := goMod.replacement("example.com/a", "v2.0.0"); !ok || rep.Path != "../a" || rep.Version != "" {
+		t.Errorf("got replacement %+v for v2.0.0, want ../a", rep)
+	}
+	if _, err := parseGoMod([]byte("require example.com/a\n")); err == nil {
+		t.Error("want an error for a malformed go.mod")
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := map[string]string{
+		"GNU LESSER GENERAL PUBLIC LICENSE\n Version 3, 29 June 2007":                                     "LGPL-3.0",
+		"GNU GENERAL PUBLIC LICENSE\n Version 2, June 1991":                                               "GPL-2.0",
+		"Redistribution and use in source and binary forms ... Neither the name of Google":                "BSD-3-Clause",
+		"ISC License\n\nPermission to use, copy, modify, and/or distribute this software for any purpose": "ISC",
+		"All rights reserved.": UnknownLicense,
+	}
+	for text, want := range tests {
+		if got := Classify(text); got != want {
+			t.Errorf("got %s, want %s for %q", got, want, text)
+		}
+	}
+}
+
+// TestClassifyTexts classifies the full texts of licenses that name other
+// licenses, as the GPL names the Affero GPL. The files are named by the
+// identifiers of their licenses.
+func TestClassifyTexts(t *testing.T) {
+	files, err := filepath.Glob("testdata/licenses/*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got, want := Classify(string(data)), filepath.Base(file); got != want {
+			t.Errorf("got %s, want %s for the text of %s", got, want, want)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package modules
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// toolName is the name of the tool creating the bills of materials.
+const toolName = "goreporter"
+
+// noAssertion is the SPDX value of the unknown fields.
+const noAssertion = "NOASSERTION"
+
+// newUUID returns a random UUID, replaced by the tests.
+var newUUID = func() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "00000000-0000-4000-8000-000000000000"
+	}
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// PURL returns the package URL of the module path at version, version being
+// left out if empty.
+func PURL(path, version string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	purl := "pkg:golang/" + strings.Join(segments, "/")
+	if version != "" {
+		purl += "@" + url.PathEscape(version)
+	}
+	return purl
+}
+
+// knownLicense reports whether license is a detected SPDX identifier.
+func knownLicense(license string) bool {
+	return license != "" && license != UnknownLicense
+}
+
+type cdxBOM struct {
+	BOMFormat    string          `json:"bomFormat"`
+	SpecVersion  string          `json:"specVersion"`
+	SerialNumber string          `json:"serialNumber"`
+	Version      int             `json:"version"`
+	Metadata     cdxMetadata     `json:"metadata"`
+	Components   []cdxComponent  `json:"components"`
+	Dependencies []cdxDependency `json:"dependencies"`
+}
+
+type cdxMetadata struct {
+	Timestamp string       `json:"timestamp"`
+	Tools     []cdxTool    `json:"tools"`
+	Component cdxComponent `json:"component"`
+}
+
+type cdxTool struct {
+	Name string `json:"name"`
+}
+
+type cdxComponent struct {
+	Type       string        `json:"type"`
+	BOMRef     string        `json:"bom-ref"`
+	Name       string        `json:"name"`
+	Version    string        `json:"version,omitempty"`
+	Scope      string        `json:"scope,omitempty"`
+	Licenses   []cdxLicense  `json:"licenses,omitempty"`
+	PURL       string        `json:"purl"`
+	Properties []cdxProperty `json:"properties,omitempty"`
+}
+
+type cdxLicense struct {
+	License struct {
+		ID string `json:"id"`
+	} `json:"license"`
+}
+
+type cdxProperty struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type cdxDependency struct {
+	Ref       string   `json:"ref"`
+	DependsOn []string `json:"dependsOn"`
+}
+
+// CycloneDX returns the CycloneDX 1.4 bill of materials, in json, of the
+// project whose modules are given. The project depends on its direct
+// modules, the others are listed without dependencies as the module graph is
+// not known offline. The go.sum hashes and the replacements are given as
+// properties: a go.sum hash is the hash of the file tree of the module, not
+// of an artifact a consumer could check.
+func CycloneDX(project string, modules []Module, created time.Time) ([]byte, error) {
+	root := cdxComponent{
+		Type:   "application",
+		BOMRef: PURL(project, ""),
+		Name:   project,
+		PURL:   PURL(project, ""),
+	}
+	bom := cdxBOM{
+		BOMFormat:    "CycloneDX",
+		SpecVersion:  "1.4",
+		SerialNumber: "urn:uuid:" + newUUID(),
+		Version:      1,
+		Metadata: cdxMetadata{
+			Timestamp: created.UTC().Format(time.RFC3339),
+			Tools:     []cdxTool{{Name: toolName}},
+			Component: root,
+		},
+		Components:   make([]cdxComponent, 0, len(modules)),
+		Dependencies: make([]cdxDependency, 0, len(modules)+1),
+	}
+
+	direct := make([]string, 0)
+	for _, m := range modules {
+		component := cdxComponent{
+			Type:    "library",
+			BOMRef:  PURL(m.Path, m.Version),
+			Name:    m.Path,
+			Version: m.Version,
+			Scope:   "required",
+			PURL:    PURL(m.Path, m.Version),
+		}
+		if knownLicense(m.License) {
+			var license cdxLicense
+			license.License.ID = m.License
+			component.Licenses = []cdxLicense{license}
+		}
+		if m.Hash != "" {
+			component.Properties = append(component.Properties, cdxProperty{Name: toolName + ":gosum", Value: m.Hash})
+		}
+		if m.Replace != nil {
+			component.Properties = append(component.Properties, cdxProperty{Name: toolName + ":replace", Value: strings.TrimSpace(m.Replace.Path + " " + m.Replace.Version)})
+		}
+		if m.Direct {
+			direct = append(direct, component.BOMRef)
+		}
+		bom.Components = append(bom.Components, component)
+		bom.Dependencies = append(bom.Dependencies, cdxDependency{Ref: component.BOMRef, DependsOn: []string{}})
+	}
+	bom.Dependencies = append([]cdxDependency{{Ref: root.BOMRef, DependsOn: direct}}, bom.Dependencies...)
+	return json.MarshalIndent(bom, "", "  ")
+}
+
+type spdxDocument struct {
+	SPDXVersion       string             `json:"spdxVersion"`
+	DataLicense       string             `json:"dataLicense"`
+	SPDXID            string             `json:"SPDXID"`
+	Name              string             `json:"name"`
+	DocumentNamespace string             `json:"documentNamespace"`
+	CreationInfo      spdxCreationInfo   `json:"creationInfo"`
+	Packages          []spdxPackage      `json:"packages"`
+	Relationships     []spdxRelationship `json:"relationships"`
+}
+
+type spdxCreationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type spdxPackage struct {
+	Name                  string            `json:"name"`
+	SPDXID                string            `json:"SPDXID"`
+	VersionInfo           string            `json:"versionInfo,omitempty"`
+	DownloadLocation      string            `json:"downloadLocation"`
+	FilesAnalyzed         bool              `json:"filesAnalyzed"`
+	LicenseConcluded      string            `json:"licenseConcluded"`
+	LicenseDeclared       string            `json:"licenseDeclared"`
+	CopyrightText         string            `json:"copyrightText"`
+	SourceInfo            string            `json:"sourceInfo,omitempty"`
+	Comment               string            `json:"comment,omitempty"`
+	ExternalRefs          []spdxExternalRef `json:"externalRefs,omitempty"`
+	PrimaryPackagePurpose string            `json:"primaryPackagePurpose"`
+}
+
+type spdxExternalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type spdxRelationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+	Comment            string `json:"comment,omitempty"`
+}
+
+// spdxIDChars matches the characters not allowed in SPDX identifiers.
+var spdxIDChars = regexp.MustCompile(`[^A-Za-z0-9.\-]+`)
+
+// SPDX returns the SPDX 2.3 document, in json, of the project whose modules
+// are given. The project depends on every module, the indirect ones with a
+// comment saying so. The detected licenses are declared, none is concluded,
+// and the go.sum hashes are given in the comments of the packages.
+func SPDX(project string, modules []Module, created time.Time) ([]byte, error) {
+	doc := spdxDocument{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              project,
+		DocumentNamespace: "https://spdx.org/spdxdocs/" + toolName + "/" + url.PathEscape(project) + "-" + newUUID(),
+		CreationInfo: spdxCreationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Tool: " + toolName},
+		},
+		Packages: []spdxPackage{{
+			Name:                  project,
+			SPDXID:                "SPDXRef-Package-" + spdxIDChars.ReplaceAllString(project, "-"),
+			DownloadLocation:      noAssertion,
+			LicenseConcluded:      noAssertion,
+			LicenseDeclared:       noAssertion,
+			CopyrightText:         noAssertion,
+			PrimaryPackagePurpose: "APPLICATION",
+		}},
+	}
+	rootID := doc.Packages[0].SPDXID
+	doc.Relationships = []spdxRelationship{{
+		SPDXElementID:      doc.SPDXID,
+		RelationshipType:   "DESCRIBES",
+		RelatedSPDXElement: rootID,
+	}}
+
+	for i, m := range modules {
+		pkg := spdxPackage{
+			Name:                  m.Path,
+			SPDXID:                fmt.Sprintf("SPDXRef-Package-%d-%s", i+1, spdxIDChars.ReplaceAllString(m.Path+"-"+m.Version, "-")),
+			VersionInfo:           m.Version,
+			DownloadLocation:      noAssertion,
+			LicenseConcluded:      noAssertion,
+			LicenseDeclared:       noAssertion,
+			CopyrightText:         noAssertion,
+			PrimaryPackagePurpose: "LIBRARY",
+			ExternalRefs: []spdxExternalRef{{
+				ReferenceCategory: "PACKAGE-MANAGER",
+				ReferenceType:     "purl",
+				ReferenceLocator:  PURL(m.Path, m.Version),
+			}},
+		}
+		if m.Version != "" {
+			pkg.DownloadLocation = "https://proxy.golang.org/" + escapePath(m.Path) + "/@v/" + escapePath(m.Version) + ".zip"
+		}
+		if m.Hash != "" {
+			pkg.Comment = "go.sum hash " + m.Hash
+		}
+		if knownLicense(m.License) {
+			pkg.LicenseDeclared = m.License
+		}
+		if m.Replace != nil {
+			pkg.SourceInfo = "replaced by " + strings.TrimSpace(m.Replace.Path+" "+m.Replace.Version)
+		}
+		relationship := spdxRelationship{
+			SPDXElementID:      rootID,
+			RelationshipType:   "DEPENDS_ON",
+			RelatedSPDXElement: pkg.SPDXID,
+		}
+		if !m.Direct {
+			relationship.Comment = "indirect"
+		}
+		doc.Packages = append(doc.Packages, pkg)
+		doc.Relationships = append(doc.Relationships, relationship)
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
@@ -0,0 +1,92 @@
+package modules
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+// testModules are a direct MIT module and an indirect replaced one without
+// license.
+var testModules = []Module{
+	{Path: "example.com/A", Version: "v1.0.0", Direct: true, Hash: testHash, License: "MIT"},
+	{Path: "example.com/b", Version: "v0.1.0", Replace: &Replace{Path: "../b"}, License: UnknownLicense},
+}
+
+const testHash = "h1:47DEQpj8HBSa+/TImW+5JCeuQeRkm5NMpJWZG3hSuFU="
+
+func TestCycloneDX(t *testing.T) {
+	defer func(f func() string) { newUUID = f }(newUUID)
+	newUUID = func() string { return "uuid" }
+
+	data, err := CycloneDX("example.com/p", testModules, time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bom cdxBOM
+	if err := json.Unmarshal(data, &bom); err != nil {
+		t.Fatal(err)
+	}
+	if bom.SerialNumber != "urn:uuid:uuid" || bom.Metadata.Timestamp != "2017-10-01T12:00:00Z" || bom.Metadata.Component.Name != "example.com/p" {
+		t.Errorf("unexpected header %+v", bom)
+	}
+	if len(bom.Components) != 2 {
+		t.Fatalf("got %d components, want 2", len(bom.Components))
+	}
+	a, b := bom.Components[0], bom.Components[1]
+	if a.PURL != "pkg:golang/example.com/A@v1.0.0" || len(a.Properties) != 1 || a.Properties[0] != (cdxProperty{Name: "goreporter:gosum", Value: testHash}) || len(a.Licenses) != 1 || a.Licenses[0].License.ID != "MIT" {
+		t.Errorf("unexpected component %+v", a)
+	}
+	if len(b.Licenses) != 0 || len(b.Properties) != 1 || b.Properties[0].Value != "../b" {
+		t.Errorf("unexpected component %+v", b)
+	}
+	if root := bom.Dependencies[0]; root.Ref != "pkg:golang/example.com/p" || len(root.DependsOn) != 1 || root.DependsOn[0] != a.BOMRef {
+		t.Errorf("want the project to depend on example.com/A only, got %+v", root)
+	}
+}
+
+func TestSPDX(t *testing.T) {
+	defer func(f func() string) { newUUID = f }(newUUID)
+	newUUID = func() string { return "uuid" }
+
+	data, err := SPDX("example.com/p", testModules, time.Date(2017, 10, 1, 12, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var doc spdxDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Packages) != 3 || len(doc.Relationships) != 3 {
+		t.Fatalf("want the project and 2 modules, got %+v", doc)
+	}
+	a, b := doc.Packages[1], doc.Packages[2]
+	if a.SPDXID != "SPDXRef-Package-1-example.com-A-v1.0.0" || a.LicenseDeclared != "MIT" || a.Comment != "go.sum hash "+testHash {
+		t.Errorf("unexpected package %+v", a)
+	}
+	if a.DownloadLocation != "https://proxy.golang.org/example.com/!a/@v/v1.0.0.zip" {
+		t.Errorf("unexpected download location %s", a.DownloadLocation)
+	}
+	if b.LicenseDeclared != noAssertion || b.Comment != "" || b.SourceInfo != "replaced by ../b" {
+		t.Errorf("unexpected package %+v", b)
+	}
+	if r := doc.Relationships[2]; r.RelationshipType != "DEPENDS_ON" || r.RelatedSPDXElement != b.SPDXID || r.Comment != "indirect" {
+		t.Errorf("unexpected relationship %+v", r)
+	}
+}
+
+func TestAddVendored(t *testing.T) {
+	goMod := &goMod{module: "example.com/p", requires: []require{{Path: "example.com/a", Version: "v1.0.0"}}}
+	goMod.addVendored([]byte(`# example.com/a v1.0.0
+## explicit
+example.com/a
+# example.com/b v0.1.0 => example.com/fork v0.2.0
+example.com/b
+`))
+	if len(goMod.requires) != 2 || !goMod.requires[1].Indirect || goMod.requires[1].Version != "v0.1.0" {
+		t.Fatalf("want example.com/b added as indirect, got %+v", goMod.requires)
+	}
+	if rep, ok := goMod.replacement("example.com/b", "v0.1.0"); !ok || rep.Path != "example.com/fork" || rep.Version != "v0.2.0" {
+		t.Errorf("got replacement %+v, want example.com/fork v0.2.0", rep)
+	}
+}
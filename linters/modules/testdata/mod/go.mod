@@ -0,0 +1,13 @@
+module example.com/p
+
+go 1.16
+
+require (
+	example.com/a v1.2.0
+	example.com/Upper v0.1.0 // indirect
+	example.com/b v0.0.0-00010101000000-000000000000
+)
+
+require example.com/c v1.0.0
+
+replace example.com/b => ./local/b
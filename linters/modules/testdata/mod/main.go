@@ -0,0 +1,12 @@
+package main
+
+import (
+	"fmt"
+
+	"example.com/a/x"
+	"example.com/p/sub"
+)
+
+func main() {
+	fmt.Println(x.X, sub.Sub)
+}
@@ -0,0 +1,5 @@
+package sub
+
+import "example.com/b"
+
+var Sub = b.B
@@ -0,0 +1,11 @@
+package sub
+
+import (
+	"testing"
+
+	"example.com/a"
+)
+
+func TestSub(t *testing.T) {
+	_ = a.A
+}
@@ -0,0 +1,344 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package mutation measures how well the tests of a package assert its
+// behaviour. It makes small changes, mutants, to covered code and reruns
+// the tests: a mutant that does not make any test fail has survived.
+package mutation
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/json-iterator/go"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/unittest"
+)
+
+const (
+	// DefaultMaxMutants is the number of mutants tested per package.
+	DefaultMaxMutants = 30
+	// DefaultBudget bounds the time spent on one package.
+	DefaultBudget = 5 * time.Minute
+)
+
+// Mutation operators.
+const (
+	ConditionalsFlip  = "conditionals-flip"
+	ArithmeticChange  = "arithmetic-change"
+	BoundaryChange    = "boundary-change"
+	StatementRemoval  = "statement-removal"
+	IncrementDecrease = "increment-decrease"
+)
+
+var (
+	flips = map[token.Token]token.Token{
+		token.EQL: token.NEQ, token.NEQ: token.EQL,
+		token.LSS: token.GEQ, token.GEQ: token.LSS,
+		token.GTR: token.LEQ, token.LEQ: token.GTR,
+		token.LAND: token.LOR, token.LOR: token.LAND,
+	}
+	boundaries = map[token.Token]token.Token{
+		token.LSS: token.LEQ, token.LEQ: token.LSS,
+		token.GTR: token.GEQ, token.GEQ: token.GTR,
+	}
+	arithmetics = map[token.Token]token.Token{
+		token.ADD: token.SUB, token.SUB: token.ADD,
+		token.MUL: token.QUO, token.QUO: token.MUL, token.REM: token.MUL,
+		token.ADD_ASSIGN: token.SUB_ASSIGN, token.SUB_ASSIGN: token.ADD_ASSIGN,
+		token.MUL_ASSIGN: token.QUO_ASSIGN, token.QUO_ASSIGN: token.MUL_ASSIGN,
+	}
+)
+
+// Mutant is one change of the source. The bytes [Offset, Offset+Length) of
+// File are replaced by Replacement.
+type Mutant struct {
+	File        string `json:"file"`
+	Line        int    `json:"line"`
+	Operator    string `json:"operator"`
+	Diff        string `json:"diff"`
+	Offset      int    `json:"-"`
+	Length      int    `json:"-"`
+	Replacement string `json:"-"`
+}
+
+// Result of the mutation testing of one package. Mutants that do not compile
+// are counted as NotViable and left out of the score.
+type Result struct {
+	Total     int      `json:"total"`
+	Killed    int      `json:"killed"`
+	Survived  int      `json:"survived"`
+	NotViable int      `json:"not_viable"`
+	Skipped   int      `json:"skipped"`
+	Score     float64  `json:"score"`
+	Survivors []Mutant `json:"survivors"`
+}
+
+// Options of a mutation run. Test are the go test options of the package,
+// its Timeout bounds every test run. Covered holds the coverage of the
+// package, uncovered lines are not mutated.
+type Options struct {
+	Test       unittest.Options
+	Covered    unittest.CoveredLines
+	MaxMutants int
+	Budget     time.Duration
+}
+
+// Mutation tests the package in the directory packagePath.
+func Mutation(packagePath string, options Options) (result Result, err error) {
+	if options.MaxMutants <= 0 {
+		options.MaxMutants = DefaultMaxMutants
+	}
+	if options.Budget <= 0 {
+		options.Budget = DefaultBudget
+	}
+	result.Survivors = make([]Mutant, 0)
+
+	mutants, err := Mutants(packagePath, options.Covered)
+	if err != nil {
+		return result, err
+	}
+	mutants = sample(mutants, options.MaxMutants)
+
+	tmpDir, err := ioutil.TempDir("", "goreporter-mutation")
+	if err != nil {
+		return result, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	deadline := time.Now().Add(options.Budget)
+	for i, mutant := range mutants {
+		if time.Now().After(deadline) {
+			result.Skipped = len(mutants) - i
+			break
+		}
+		result.Total++
+		killed, viable, err := run(packagePath, tmpDir, mutant, options.Test)
+		if err != nil {
+			return result, err
+		}
+		switch {
+		case !viable:
+			result.NotViable++
+		case killed:
+			result.Killed++
+		default:
+			result.Survived++
+			result.Survivors = append(result.Survivors, mutant)
+		}
+	}
+	if tested := result.Killed + result.Survived; tested > 0 {
+		result.Score = float64(result.Killed) / float64(tested) * 100
+	}
+	return result, nil
+}
+
+// Mutants returns all mutants of the non-test go files in the directory
+// packagePath that lie on covered lines, in file and line order.
+func Mutants(packagePath string, covered unittest.CoveredLines) ([]Mutant, error) {
+	files, err := filepath.Glob(filepath.Join(packagePath, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	coveredByName := make(map[string]map[int]bool, 0)
+	for name, lines := range covered {
+		coveredByName[filepath.Base(name)] = lines
+	}
+
+	mutants := make([]Mutant, 0)
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+		lines := coveredByName[filepath.Base(file)]
+		if len(lines) == 0 {
+			continue
+		}
+		src, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		fileMutants, err := fileMutants(file, src, lines)
+		if err != nil {
+			return nil, err
+		}
+		mutants = append(mutants, fileMutants...)
+	}
+	return mutants, nil
+}
+
+// fileMutants finds the mutants of one file.
+func fileMutants(file string, src []byte, covered map[int]bool) ([]Mutant, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, file, src, 0)
+	if err != nil {
+		return nil, err
+	}
+	mutants := make([]Mutant, 0)
+	add := func(operator string, pos token.Pos, length int, replacement string) {
+		position := fset.Position(pos)
+		if !covered[position.Line] {
+			return
+		}
+		mutant := Mutant{
+			File:        file,
+			Line:        position.Line,
+			Operator:    operator,
+			Offset:      position.Offset,
+			Length:      length,
+			Replacement: replacement,
+		}
+		mutant.Diff = diff(src, mutant)
+		mutants = append(mutants, mutant)
+	}
+
+	ast.Inspect(f, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.GenDecl:
+			// Constant expressions are evaluated at compile time.
+			return node.Tok != token.CONST
+		case *ast.BinaryExpr:
+			op := node.Op.String()
+			if to, ok := flips[node.Op]; ok {
+				add(ConditionalsFlip, node.OpPos, len(op), to.String())
+			}
+			if to, ok := boundaries[node.Op]; ok {
+				add(BoundaryChange, node.OpPos, len(op), to.String())
+			}
+			if to, ok := arithmetics[node.Op]; ok && !isString(node) {
+				add(ArithmeticChange, node.OpPos, len(op), to.String())
+			}
+		case *ast.AssignStmt:
+			if to, ok := arithmetics[node.Tok]; ok && !isStringLit(node.Rhs[0]) {
+				add(ArithmeticChange, node.TokPos, len(node.Tok.String()), to.String())
+			}
+		case *ast.IncDecStmt:
+			to := token.DEC
+			if node.Tok == token.DEC {
+				to = token.INC
+			}
+			add(IncrementDecrease, node.TokPos, len(node.Tok.String()), to.String())
+		case *ast.ExprStmt:
+			if _, ok := node.X.(*ast.CallExpr); ok {
+				add(StatementRemoval, node.Pos(), int(node.End()-node.Pos()), "_ = 0")
+			}
+		}
+		return true
+	})
+	return mutants, nil
+}
+
+// isString reports whether a + joins string literals, which has no
+// arithmetic counterpart. Other string operands are caught by the compiler.
+func isString(expr *ast.BinaryExpr) bool {
+	return isStringLit(expr.X) || isStringLit(expr.Y)
+}
+
+func isStringLit(expr ast.Expr) bool {
+	lit, ok := expr.(*ast.BasicLit)
+	return ok && lit.Kind == token.STRING
+}
+
+// sample keeps at most max mutants, spread evenly over the package.
+func sample(mutants []Mutant, max int) []Mutant {
+	if len(mutants) <= max {
+		return mutants
+	}
+	sampled := make([]Mutant, 0, max)
+	step := float64(len(mutants)) / float64(max)
+	for i := 0; i < max; i++ {
+		sampled = append(sampled, mutants[int(float64(i)*step)])
+	}
+	return sampled
+}
+
+// apply returns src with the mutant applied.
+func apply(src []byte, mutant Mutant) []byte {
+	mutated := make([]byte, 0, len(src)+len(mutant.Replacement))
+	mutated = append(mutated, src[:mutant.Offset]...)
+	mutated = append(mutated, mutant.Replacement...)
+	return append(mutated, src[mutant.Offset+mutant.Length:]...)
+}
+
+// diff shows the lines changed by the mutant.
+func diff(src []byte, mutant Mutant) string {
+	start := bytes.LastIndexByte(src[:mutant.Offset], '\n') + 1
+	end := mutant.Offset + mutant.Length
+	if i := bytes.IndexByte(src[end:], '\n'); i >= 0 {
+		end += i
+	} else {
+		end = len(src)
+	}
+	original := src[start:end]
+	mutated := apply(src, mutant)[start : end-mutant.Length+len(mutant.Replacement)]
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "@@ %s:%d @@\n", filepath.Base(mutant.File), mutant.Line)
+	for _, line := range strings.Split(string(original), "\n") {
+		fmt.Fprintf(&buf, "-%s\n", line)
+	}
+	for _, line := range strings.Split(string(mutated), "\n") {
+		fmt.Fprintf(&buf, "+%s\n", line)
+	}
+	return buf.String()
+}
+
+// run tests the package with the mutant. The mutated file is written to
+// tmpDir and replaces the original through go test -overlay, so the source
+// tree is never modified.
+func run(packagePath, tmpDir string, mutant Mutant, options unittest.Options) (killed, viable bool, err error) {
+	src, err := ioutil.ReadFile(mutant.File)
+	if err != nil {
+		return false, false, err
+	}
+	mutatedFile := filepath.Join(tmpDir, filepath.Base(mutant.File))
+	if err = ioutil.WriteFile(mutatedFile, apply(src, mutant), 0644); err != nil {
+		return false, false, err
+	}
+	original, err := filepath.Abs(mutant.File)
+	if err != nil {
+		return false, false, err
+	}
+	overlay, err := jsoniter.Marshal(map[string]map[string]string{
+		"Replace": {original: mutatedFile},
+	})
+	if err != nil {
+		return false, false, err
+	}
+	overlayFile := filepath.Join(tmpDir, "overlay.json")
+	if err = ioutil.WriteFile(overlayFile, overlay, 0644); err != nil {
+		return false, false, err
+	}
+
+	options.Race = false
+	options.CoverProfile = ""
+	cmd := unittest.Command(packagePath, options, "-overlay", overlayFile, "-count", "1", "-failfast")
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		return false, true, nil
+	}
+	if bytes.Contains(out, []byte("[build failed]")) || bytes.Contains(out, []byte("[setup failed]")) {
+		return false, false, nil
+	}
+	return true, true, nil
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mutation
+
+import (
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/unittest"
+)
+
+func Test_Mutants(t *testing.T) {
+	// Line 5 is "if a > b {".
+	covered := unittest.CoveredLines{"p1/p1.go": {5: true}}
+	mutants, err := Mutants("testdata/p1", covered)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(mutants) != 2 {
+		t.Fatalf("want 2 mutants, but got %+v", mutants)
+	}
+	if mutants[0].Operator != ConditionalsFlip || mutants[0].Replacement != "<=" {
+		t.Errorf("want a > b flipped to a <= b, but got %+v", mutants[0])
+	}
+	if mutants[1].Operator != BoundaryChange || mutants[1].Replacement != ">=" {
+		t.Errorf("want a > b changed to a >= b, but got %+v", mutants[1])
+	}
+	want := "@@ p1.go:5 @@\n-\tif a > b {\n+\tif a <= b {\n"
+	if mutants[0].Diff != want {
+		t.Errorf("want diff %q, but got %q", want, mutants[0].Diff)
+	}
+}
+
+func Test_Mutation(t *testing.T) {
+	covered := unittest.CoveredLines{"p1/p1.go": {5: true, 15: true}}
+	result, err := Mutation("./testdata/p1", Options{Covered: covered})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a >= b is equivalent for the test and survives like the mutants of Sum.
+	if result.Killed != 1 || result.Survived != 2 {
+		t.Errorf("want 1 killed and 2 survived mutants, but got %+v", result)
+	}
+}
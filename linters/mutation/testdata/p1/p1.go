@@ -0,0 +1,23 @@
+package p1
+
+// Max returns the larger of a and b.
+func Max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Sum adds all values.
+func Sum(values ...int) int {
+	sum := 0
+	for _, v := range values {
+		sum += v
+	}
+	return sum
+}
+
+// Unused is never run by the tests.
+func Unused(a int) int {
+	return a * 2
+}
@@ -0,0 +1,14 @@
+package p1
+
+import "testing"
+
+func TestMax(t *testing.T) {
+	if Max(1, 2) != 2 || Max(2, 1) != 2 {
+		t.Error("wrong max")
+	}
+}
+
+func TestSum(t *testing.T) {
+	// Does not check the result, so every mutant of Sum survives.
+	Sum(1, 2, 3)
+}
@@ -1,14 +1,20 @@
 package simplecode // import "github.com/360EntSecGroup-Skylar/goreporter/linters/simplecode"
 
 import (
+	"strings"
+
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/simplecode/lint/lintutil"
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/simplecode/simple"
 )
 
-func Simple(path map[string]string, except string) []string {
+func Simple(path map[string]string, except string, tags ...string) []string {
 	var res []string
 	for _, p := range path {
-		res = append(res, lintutil.ProcessArgs(except, "gosimple", simple.Funcs, []string{p})...)
+		args := []string{p}
+		if len(tags) > 0 {
+			args = []string{"-tags", strings.Join(tags, " "), p}
+		}
+		res = append(res, lintutil.ProcessArgs(except, "gosimple", simple.Funcs, args)...)
 	}
 	return res
 }
@@ -0,0 +1,36 @@
+package simpler
+
+// Docs describes the checks of the Checker, by ID.
+var Docs = map[string]string{
+	"S1000": "Use plain channel send or receive instead of single-case select",
+	"S1001": "Replace for loop with call to copy",
+	"S1002": "Omit comparison with boolean constant",
+	"S1003": "Replace call to strings.Index with strings.Contains",
+	"S1004": "Replace call to bytes.Compare with bytes.Equal",
+	"S1005": "Drop unnecessary use of the blank identifier",
+	"S1006": "Use for { ... } for infinite loops",
+	"S1007": "Simplify regular expression by using raw string literal",
+	"S1008": "Simplify returning boolean expression",
+	"S1009": "Omit redundant nil check on slices",
+	"S1010": "Omit default slice index",
+	"S1011": "Use a single append to concatenate two slices",
+	"S1012": "Replace time.Now().Sub(x) with time.Since(x)",
+	"S1013": "Return the error directly instead of checking it against nil first",
+	"S1014": "Replace '_ = <-ch' with '<-ch'",
+	"S1015": "Use strconv.Itoa instead of strconv.FormatInt",
+	"S1016": "Use a type conversion instead of manually copying struct fields",
+	"S1017": "Replace manual trimming with strings.TrimPrefix",
+	"S1018": "Use copy for sliding elements",
+	"S1019": "Simplify make call by omitting redundant arguments",
+	"S1020": "Omit redundant nil check in type assertion",
+	"S1021": "Merge variable declaration and assignment",
+	"S1022": "Omit the blank identifier of an unused second value",
+	"S1023": "Omit redundant break statement",
+	"S1024": "Replace x.Sub(time.Now()) with time.Until(x)",
+	"S1025": "Don't use fmt.Sprintf(\"%s\", x) unnecessarily",
+	"S1026": "Simplify string copying",
+	"S1027": "Omit redundant return statement",
+	"S1028": "Simplify error construction with fmt.Errorf",
+	"S1029": "Range over the string directly",
+	"S1030": "Use bytes.Buffer.String or bytes.Buffer.Bytes",
+}
@@ -60,10 +60,11 @@ type Func func(*Job)
 type Problem struct {
 	Position token.Pos // position in source file
 	Text     string    // the prose that describes the problem
+	Check    string    // the ID of the check that found the problem
 }
 
 func (p *Problem) String() string {
-	return p.Text
+	return fmt.Sprintf("%s (%s)", p.Text, p.Check)
 }
 
 type Checker interface {
@@ -71,11 +72,58 @@ type Checker interface {
 	Funcs() map[string]Func
 }
 
-// A Linter lints Go source code.
+// Combine returns a checker that runs the checks of all the checkers, whose
+// IDs must differ.
+func Combine(checkers ...Checker) Checker {
+	return checkerList(checkers)
+}
+
+type checkerList []Checker
+
+func (cs checkerList) Init(prog *Program) {
+	for _, c := range cs {
+		c.Init(prog)
+	}
+}
+
+func (cs checkerList) Funcs() map[string]Func {
+	funcs := map[string]Func{}
+	for _, c := range cs {
+		for k, fn := range c.Funcs() {
+			funcs[k] = fn
+		}
+	}
+	return funcs
+}
+
+// A Linter lints Go source code. Only the checks enabled by Enable and
+// Disable are run.
 type Linter struct {
 	Checker   Checker
 	Ignores   []Ignore
 	GoVersion int
+	Enable    []string
+	Disable   []string
+}
+
+// Enabled reports whether check matches one of the patterns of enable, or
+// enable is empty, and none of those of disable. The patterns are those of
+// filepath.Match, as SA1*.
+func Enabled(check string, enable, disable []string) bool {
+	for _, pattern := range disable {
+		if m, _ := filepath.Match(pattern, check); m {
+			return false
+		}
+	}
+	if len(enable) == 0 {
+		return true
+	}
+	for _, pattern := range enable {
+		if m, _ := filepath.Match(pattern, check); m {
+			return true
+		}
+	}
+	return false
 }
 
 func (l *Linter) ignore(j *Job, p Problem) bool {
@@ -136,7 +184,8 @@ func (ps byPosition) Swap(i int, j int) {
 }
 
 func (l *Linter) Lint(lprog *loader.Program) []Problem {
-	ssaprog := ssautil.CreateProgram(lprog, ssa.GlobalDebug)
+	// built serially, so that the builder panics in the caller
+	ssaprog := ssautil.CreateProgram(lprog, ssa.GlobalDebug|ssa.BuildSerially)
 	ssaprog.Build()
 	pkgMap := map[*ssa.Package]*Pkg{}
 	var pkgs []*Pkg
@@ -229,7 +278,9 @@ func (l *Linter) Lint(lprog *loader.Program) []Problem {
 	funcs := l.Checker.Funcs()
 	var keys []string
 	for k := range funcs {
-		keys = append(keys, k)
+		if Enabled(k, l.Enable, l.Disable) {
+			keys = append(keys, k)
+		}
 	}
 	sort.Strings(keys)
 
@@ -312,7 +363,8 @@ type Positioner interface {
 func (j *Job) Errorf(n Positioner, format string, args ...interface{}) *Problem {
 	problem := Problem{
 		Position: n.Pos(),
-		Text:     fmt.Sprintf(format, args...) + fmt.Sprintf(" (%s)", j.check),
+		Text:     fmt.Sprintf(format, args...),
+		Check:    j.check,
 	}
 	j.problems = append(j.problems, problem)
 	return &j.problems[len(j.problems)-1]
@@ -43,6 +43,8 @@ type runner struct {
 	tags    []string
 	ignores []lint.Ignore
 	version int
+	enable  []string
+	disable []string
 }
 
 func (runner runner) resolveRelative(importPaths []string) (goFiles bool, err error) {
@@ -149,16 +151,49 @@ func ProcessFlagSet(c lint.Checker, fs *flag.FlagSet) (results []string) {
 
 	for _, p := range ps {
 		pos := lprog.Fset.Position(p.Position)
-		results = append(results, fmt.Sprintf("%v: %s", relativePositionString(pos), p.Text))
+		results = append(results, fmt.Sprintf("%v: %s", relativePositionString(pos), p.String()))
 	}
 	return
 }
 
+// Options configures Lint. Only the checks matching Enable, all of them if
+// empty, and not matching Disable are run.
 type Options struct {
 	Tags      []string
 	LintTests bool
 	Ignores   string
 	GoVersion int
+	Enable    []string
+	Disable   []string
+}
+
+// Diagnostic is a problem found by the check Check at Pos.
+type Diagnostic struct {
+	Pos     token.Position `json:"pos"`
+	Check   string         `json:"check"`
+	Message string         `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s (%s)", d.Pos, d.Message, d.Check)
+}
+
+// Diagnostics lints the packages pkgs with c and returns the problems found
+// by position.
+func Diagnostics(c lint.Checker, pkgs []string, opt *Options) ([]Diagnostic, error) {
+	ps, lprog, err := Lint(c, pkgs, opt)
+	if err != nil {
+		return nil, err
+	}
+	diagnostics := make([]Diagnostic, 0, len(ps))
+	for _, p := range ps {
+		diagnostics = append(diagnostics, Diagnostic{
+			Pos:     lprog.Fset.Position(p.Position),
+			Check:   p.Check,
+			Message: p.Text,
+		})
+	}
+	return diagnostics, nil
 }
 
 func Lint(c lint.Checker, pkgs []string, opt *Options) ([]lint.Problem, *loader.Program, error) {
@@ -176,6 +211,8 @@ func Lint(c lint.Checker, pkgs []string, opt *Options) ([]lint.Problem, *loader.
 		tags:    opt.Tags,
 		ignores: ignores,
 		version: opt.GoVersion,
+		enable:  opt.Enable,
+		disable: opt.Disable,
 	}
 	paths := gotool.ImportPaths(pkgs)
 	goFiles, err := runner.resolveRelative(paths)
@@ -200,7 +237,8 @@ func Lint(c lint.Checker, pkgs []string, opt *Options) ([]lint.Problem, *loader.
 	if err != nil {
 		return nil, nil, err
 	}
-	return runner.lint(lprog), lprog, nil
+	ps, err := runner.lint(lprog)
+	return ps, lprog, err
 }
 
 func shortPath(path string) string {
@@ -235,11 +273,20 @@ func ProcessArgs(name string, c lint.Checker, args []string) {
 	ProcessFlagSet(c, flags)
 }
 
-func (runner *runner) lint(lprog *loader.Program) []lint.Problem {
+func (runner *runner) lint(lprog *loader.Program) (ps []lint.Problem, err error) {
+	defer func() {
+		// the SSA builder panics on the constructs it does not know, as
+		// those of the Go versions after it
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot build the SSA form of the packages: %v", r)
+		}
+	}()
 	l := &lint.Linter{
 		Checker:   runner.checker,
 		Ignores:   runner.ignores,
 		GoVersion: runner.version,
+		Enable:    runner.enable,
+		Disable:   runner.disable,
 	}
-	return l.Lint(lprog)
+	return l.Lint(lprog), nil
 }
@@ -68,6 +68,10 @@ func TestAll(t *testing.T, c lint.Checker, dir string) {
 	}
 	sources := map[string][]byte{}
 	for _, fi := range fis {
+		if fi.IsDir() {
+			// the packages of the subdirectories are fixtures of other tests
+			continue
+		}
 		filename := path.Join(baseDir, fi.Name())
 		src, err := ioutil.ReadFile(filename)
 		if err != nil {
@@ -4,15 +4,9 @@ import (
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint/lintutil"
 )
 
-func Simpler(projectPath map[string]string) []string {
-	fs := lintutil.FlagSet("gosimple")
-	gen := fs.Bool("generated", false, "Check generated code")
-	paths := make([]string, len(projectPath))
-	for _, v := range projectPath {
-		paths = append(paths, v)
-	}
-	fs.Parse(paths)
-	c := NewChecker()
-	c.CheckGenerated = *gen
-	return lintutil.ProcessFlagSet(c, fs)
+// Simpler runs the checks of gosimple selected by opts on the packages
+// importPaths and returns their problems, by position, with the IDs of the
+// checks.
+func Simpler(importPaths []string, opts lintutil.Options) ([]lintutil.Diagnostic, error) {
+	return lintutil.Diagnostics(NewChecker(), importPaths, &opts)
 }
@@ -1,11 +1,48 @@
 package simpler
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint/lintutil"
 )
 
+const idsPkg = "github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/testdata/ids"
+
+func TestDocs(t *testing.T) {
+	funcs := NewChecker().Funcs()
+	for id, fn := range funcs {
+		if fn != nil && Docs[id] == "" {
+			t.Errorf("check %s is not documented", id)
+		}
+	}
+	for id := range Docs {
+		if funcs[id] == nil {
+			t.Errorf("documented check %s is not registered", id)
+		}
+	}
+}
+
 func TestSimpler(t *testing.T) {
-	path := make(map[string]string, 0)
-	path["../../engine"] = "../../engine"
-	Simpler(path)
+	tests := []struct {
+		opts   lintutil.Options
+		checks []string
+	}{
+		{lintutil.Options{}, []string{"S1022", "S1008", "S1002"}},
+		{lintutil.Options{Disable: []string{"S1022", "S1008"}}, []string{"S1002"}},
+		{lintutil.Options{Enable: []string{"S1002"}, Disable: []string{"S100*"}}, nil},
+	}
+	for _, test := range tests {
+		diagnostics, err := Simpler([]string{idsPkg}, test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var checks []string
+		for _, d := range diagnostics {
+			checks = append(checks, d.Check)
+		}
+		if !reflect.DeepEqual(checks, test.checks) {
+			t.Errorf("Simpler(%+v) reported %v, want %v", test.opts, checks, test.checks)
+		}
+	}
 }
@@ -66,6 +66,8 @@ func zeroConst(t types.Type) *Const {
 		return nilConst(t)
 	case *types.Named:
 		return NewConst(zeroConst(t.Underlying()).Value, t)
+	case *types.Alias:
+		return NewConst(zeroConst(types.Unalias(t)).Value, t)
 	case *types.Array, *types.Struct, *types.Tuple:
 		panic(fmt.Sprint("zeroConst applied to aggregate:", t))
 	}
@@ -144,8 +144,8 @@ func (prog *Program) declaredFunc(obj *types.Func) *Function {
 //
 func (prog *Program) needMethodsOf(T types.Type) {
 	prog.methodsMu.Lock()
+	defer prog.methodsMu.Unlock()
 	prog.needMethods(T, false)
-	prog.methodsMu.Unlock()
 }
 
 // Precondition: T is not a method signature (*Signature with Recv()!=nil).
@@ -163,6 +163,11 @@ func (prog *Program) needMethods(T types.Type, skip bool) {
 	}
 	prog.runtimeTypes.Set(T, skip)
 
+	// Instances of generic types have no concrete methods to create.
+	if isInstance(T) {
+		return
+	}
+
 	tmset := prog.MethodSets.MethodSet(T)
 
 	if !skip && !isInterface(T) && tmset.Len() > 0 {
@@ -235,7 +240,23 @@ func (prog *Program) needMethods(T types.Type, skip bool) {
 			prog.needMethods(t.At(i).Type(), false)
 		}
 
+	case *types.Alias:
+		prog.needMethods(types.Unalias(t), skip)
+
+	case *types.TypeParam:
+		// nop---type parameters have no runtime type.
+
 	default:
 		panic(T)
 	}
 }
+
+// isInstance reports whether T, or the type T points to, is an instance of a
+// generic named type.
+func isInstance(T types.Type) bool {
+	if ptr, ok := T.(*types.Pointer); ok {
+		T = ptr.Elem()
+	}
+	named, ok := types.Unalias(T).(*types.Named)
+	return ok && named.TypeArgs().Len() > 0
+}
@@ -0,0 +1,9 @@
+package ids
+
+func fn(b bool, m map[string]int) bool {
+	_, _ = m["a"]
+	if b == true {
+		return true
+	}
+	return false
+}
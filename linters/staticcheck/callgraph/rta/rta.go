@@ -338,11 +338,32 @@ func (r *rta) implementations(I *types.Interface) []types.Type {
 	return concs
 }
 
+// isGeneric reports whether T, or the type T points to, is a generic named
+// type or an instance of one.
+func isGeneric(T types.Type) bool {
+	if ptr, ok := T.(*types.Pointer); ok {
+		T = ptr.Elem()
+	}
+	n, ok := T.(*types.Named)
+	return ok && (n.TypeParams().Len() > 0 || n.TypeArgs().Len() > 0)
+}
+
 // addRuntimeType is called for each concrete type that can be the
 // dynamic type of some interface or reflect.Value.
 // Adapted from needMethods in go/ssa/builder.go
 //
 func (r *rta) addRuntimeType(T types.Type, skip bool) {
+	// An alias, such as any, denotes the type it stands for and a type
+	// parameter has no run-time type of its own. The SSA builder cannot
+	// build the methods of generic types, they are left out.
+	T = types.Unalias(T)
+	if _, ok := T.(*types.TypeParam); ok {
+		return
+	}
+	if isGeneric(T) {
+		r.result.RuntimeTypes.Set(T, skip)
+		return
+	}
 	if prev, ok := r.result.RuntimeTypes.At(T).(bool); ok {
 		if skip && !prev {
 			r.result.RuntimeTypes.Set(T, skip)
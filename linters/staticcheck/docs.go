@@ -0,0 +1,72 @@
+package staticcheck
+
+// Docs describes the checks of the Checker, by ID.
+var Docs = map[string]string{
+	"SA1000": "Invalid regular expression",
+	"SA1001": "Invalid template",
+	"SA1002": "Invalid format in time.Parse",
+	"SA1003": "Unsupported argument to functions in encoding/binary",
+	"SA1004": "Suspiciously small untyped constant in time.Sleep",
+	"SA1005": "Invalid first argument to exec.Command",
+	"SA1006": "Printf with dynamic first argument and no further arguments",
+	"SA1007": "Invalid URL in net/url.Parse",
+	"SA1008": "Non-canonical key in http.Header map",
+	"SA1010": "(*regexp.Regexp).FindAll called with n == 0, which will always return zero results",
+	"SA1011": "Various methods in the strings package expect valid UTF-8, but invalid input is provided",
+	"SA1012": "A nil context.Context is being passed to a function, consider using context.TODO instead",
+	"SA1013": "io.Seeker.Seek is being called with the whence constant as the first argument, but it should be the second",
+	"SA1014": "Non-pointer value passed to Unmarshal or Decode",
+	"SA1015": "Using time.Tick in a way that will leak, consider using time.NewTicker",
+	"SA1016": "Trapping a signal that cannot be trapped",
+	"SA1017": "Channels used with os/signal.Notify should be buffered",
+	"SA1018": "strings.Replace called with n == 0, which does nothing",
+	"SA1019": "Using a deprecated function, variable, constant or field",
+	"SA1020": "Using an invalid host:port pair with a net.Listen-related function",
+	"SA1021": "Using bytes.Equal to compare two net.IP",
+	"SA1022": "The function assigned to flag.Usage calls os.Exit",
+	"SA1023": "Modifying the buffer in an io.Writer implementation",
+	"SA1024": "A string cutset contains duplicate characters",
+
+	"SA2000": "sync.WaitGroup.Add called inside the goroutine, leading to a race condition",
+	"SA2001": "Empty critical section",
+	"SA2002": "Called testing.T.FailNow or SkipNow in a goroutine, which isn't allowed",
+	"SA2003": "Deferred Lock right after locking, likely meant to defer Unlock instead",
+
+	"SA3000": "TestMain doesn't call os.Exit, hiding test failures",
+	"SA3001": "Assigning to b.N in benchmarks distorts the results",
+
+	"SA4000": "Binary operator has identical expressions on both sides",
+	"SA4001": "&*x gets simplified to x, it does not copy x",
+	"SA4002": "Comparing strings with known different sizes has predictable results",
+	"SA4003": "Comparing unsigned values against negative values is pointless",
+	"SA4004": "The loop exits unconditionally after one iteration",
+	"SA4005": "Field assignment that will never be observed",
+	"SA4006": "A value assigned to a variable is never read before being overwritten",
+	"SA4008": "The variable in the loop condition never changes, are you incrementing the wrong variable?",
+	"SA4009": "A function argument is overwritten before its first use",
+	"SA4010": "The result of append will never be observed anywhere",
+	"SA4011": "Break statement with no effect, did you mean to break out of an outer loop?",
+	"SA4012": "Comparing a value against NaN even though no value is equal to NaN",
+	"SA4013": "Negating a boolean twice (!!b) is the same as writing b",
+	"SA4014": "An if/else if chain has repeated conditions and no side-effects",
+	"SA4015": "Calling functions like math.Ceil on floats converted from integers doesn't do anything useful",
+	"SA4016": "Certain bitwise operations, such as x ^ 0, do not do anything useful",
+	"SA4017": "Discarding the return values of a function without side effects, making the call pointless",
+
+	"SA5000": "Assignment to nil map",
+	"SA5001": "Deferring Close before checking for a possible error",
+	"SA5002": "The empty for loop (for {}) spins and can block the scheduler",
+	"SA5003": "Defers in infinite loops will never execute",
+	"SA5004": "for { select { ... } } with an empty default branch spins",
+	"SA5005": "The finalizer references the finalized object, preventing garbage collection",
+	"SA5007": "Infinite recursive call",
+
+	"SA6000": "Using regexp.Match or related in a loop, should use regexp.Compile",
+	"SA6001": "Missing an optimization opportunity when indexing maps by byte slices",
+	"SA6002": "Storing non-pointer values in sync.Pool allocates memory",
+	"SA6003": "Converting a string to a slice of runes before ranging over it",
+
+	"SA9001": "Defers in range loops may not run when you expect them to",
+	"SA9002": "Using a non-octal os.FileMode that looks like it was meant to be in octal",
+	"SA9003": "Empty body in an if or else branch",
+}
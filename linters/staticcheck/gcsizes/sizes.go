@@ -16,12 +16,16 @@ type Sizes struct {
 	MaxAlign int64
 }
 
-// ForArch returns a correct Sizes for the given architecture.
+// ForArch returns a correct Sizes for the given architecture, the default
+// one if empty.
 func ForArch(arch string) *Sizes {
 	wordSize := int64(8)
 	maxAlign := int64(8)
-	switch build.Default.GOARCH {
-	case "386", "arm":
+	if arch == "" {
+		arch = build.Default.GOARCH
+	}
+	switch arch {
+	case "386", "arm", "mips", "mipsle":
 		wordSize, maxAlign = 4, 4
 	case "amd64p32":
 		wordSize = 4
@@ -4,15 +4,9 @@ import (
 	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint/lintutil"
 )
 
-func StaticCheck(projectPath map[string]string) []string {
-	fs := lintutil.FlagSet("staticcheck")
-	gen := fs.Bool("generated", false, "Check generated code")
-	paths := make([]string, len(projectPath))
-	for _, v := range projectPath {
-		paths = append(paths, v)
-	}
-	fs.Parse(paths)
-	c := NewChecker()
-	c.CheckGenerated = *gen
-	return lintutil.ProcessFlagSet(c, fs)
+// StaticCheck runs the checks of staticcheck selected by opts on the packages
+// importPaths and returns their problems, by position, with the IDs of the
+// checks.
+func StaticCheck(importPaths []string, opts lintutil.Options) ([]lintutil.Diagnostic, error) {
+	return lintutil.Diagnostics(NewChecker(), importPaths, &opts)
 }
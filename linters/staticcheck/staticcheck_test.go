@@ -1,11 +1,48 @@
 package staticcheck
 
 import (
+	"reflect"
 	"testing"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/lint/lintutil"
 )
 
+const idsPkg = "github.com/360EntSecGroup-Skylar/goreporter/linters/staticcheck/testdata/ids"
+
+func TestDocs(t *testing.T) {
+	funcs := NewChecker().Funcs()
+	for id, fn := range funcs {
+		if fn != nil && Docs[id] == "" {
+			t.Errorf("check %s is not documented", id)
+		}
+	}
+	for id := range Docs {
+		if funcs[id] == nil {
+			t.Errorf("documented check %s is not registered", id)
+		}
+	}
+}
+
 func TestStaticCheck(t *testing.T) {
-	path := make(map[string]string, 0)
-	path["../../engine"] = "../../engine"
-	StaticCheck(path)
+	tests := []struct {
+		opts   lintutil.Options
+		checks []string
+	}{
+		{lintutil.Options{}, []string{"SA4000", "SA4013"}},
+		{lintutil.Options{Enable: []string{"SA4000"}}, []string{"SA4000"}},
+		{lintutil.Options{Disable: []string{"SA4*"}}, nil},
+	}
+	for _, test := range tests {
+		diagnostics, err := StaticCheck([]string{idsPkg}, test.opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var checks []string
+		for _, d := range diagnostics {
+			checks = append(checks, d.Check)
+		}
+		if !reflect.DeepEqual(checks, test.checks) {
+			t.Errorf("StaticCheck(%+v) reported %v, want %v", test.opts, checks, test.checks)
+		}
+	}
 }
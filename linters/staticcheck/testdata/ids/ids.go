@@ -0,0 +1,8 @@
+package ids
+
+func fn(x int, s string) bool {
+	if x == x {
+		return true
+	}
+	return !!(s == "")
+}
@@ -0,0 +1,5 @@
+package p1
+
+func Add(a, b int) int {
+	return a + b
+}
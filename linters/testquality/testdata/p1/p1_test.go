@@ -0,0 +1,43 @@
+package p1
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAdd(t *testing.T) {
+	if Add(1, 2) != 3 {
+		t.Error("1+2 != 3")
+	}
+}
+
+func TestAddTable(t *testing.T) {
+	for _, c := range []int{1, 2} {
+		t.Run(fmt.Sprint(c), func(st *testing.T) {
+			check(st, Add(c, 0), c)
+		})
+	}
+}
+
+func TestNothing(t *testing.T) {
+	Add(1, 1)
+}
+
+func TestSkipped(t *testing.T) {
+	t.Skip("not ready")
+}
+
+func check(t *testing.T, got, want int) {
+	if got != want {
+		t.Fatalf("got %d, want %d", got, want)
+	}
+}
+
+func ExampleAdd() {
+	fmt.Println(Add(1, 2))
+	// Output: 3
+}
+
+func ExampleAdd_noOutput() {
+	fmt.Println(Add(2, 2))
+}
@@ -0,0 +1,265 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package testquality inspects the _test.go files of a package and measures
+// how much the tests actually check, beyond the coverage they produce.
+package testquality
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/countcode"
+)
+
+// failureMethods are the methods of *testing.T that make a test fail.
+var failureMethods = map[string]bool{
+	"Error":   true,
+	"Errorf":  true,
+	"Fatal":   true,
+	"Fatalf":  true,
+	"Fail":    true,
+	"FailNow": true,
+}
+
+// skipMethods are the methods of *testing.T that skip a test.
+var skipMethods = map[string]bool{
+	"Skip":    true,
+	"Skipf":   true,
+	"SkipNow": true,
+}
+
+// assertPackages are the usual assertion libraries, any call into them is
+// taken as a failure path.
+var assertPackages = map[string]bool{
+	"assert":  true,
+	"require": true,
+	"convey":  true,
+	"gomega":  true,
+}
+
+// Quality is the test quality of one package. NoFailurePath lists the tests
+// that cannot fail and ExamplesNoOutput the examples that are compiled but
+// never run, because they lack an // Output: comment.
+type Quality struct {
+	TestFuncs        int
+	SubTests         int
+	Skips            int
+	NoFailurePath    []string
+	ExamplesNoOutput []string
+	TestLines        int
+	CodeLines        int
+}
+
+// TestQuality analyzes the go files in the directory packagePath.
+func TestQuality(packagePath string) (quality Quality, err error) {
+	quality.NoFailurePath = make([]string, 0)
+	quality.ExamplesNoOutput = make([]string, 0)
+
+	files, err := ioutil.ReadDir(packagePath)
+	if err != nil {
+		return quality, err
+	}
+	testFiles, codeFiles := make([]string, 0), make([]string, 0)
+	for _, file := range files {
+		name := file.Name()
+		if file.IsDir() || !strings.HasSuffix(name, ".go") {
+			continue
+		}
+		if strings.HasSuffix(name, "_test.go") {
+			testFiles = append(testFiles, filepath.Join(packagePath, name))
+		} else {
+			codeFiles = append(codeFiles, filepath.Join(packagePath, name))
+		}
+	}
+	quality.TestLines = countcode.CountLines(testFiles).CodeLines
+	quality.CodeLines = countcode.CountLines(codeFiles).CodeLines
+
+	fset := token.NewFileSet()
+	for _, fileName := range testFiles {
+		f, err := parser.ParseFile(fset, fileName, nil, parser.ParseComments)
+		if err != nil {
+			return quality, err
+		}
+		quality.addFile(f)
+	}
+	return quality, nil
+}
+
+// addFile adds the tests and examples declared in f.
+func (q *Quality) addFile(f *ast.File) {
+	testing := testingName(f)
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Body == nil {
+			continue
+		}
+		name := fn.Name.Name
+		switch {
+		case isTest(fn, testing):
+			q.TestFuncs++
+			v := &testVisitor{t: paramName(fn), testing: testing}
+			ast.Walk(v, fn.Body)
+			q.SubTests += v.subTests
+			q.Skips += v.skips
+			if !v.canFail {
+				q.NoFailurePath = append(q.NoFailurePath, name)
+			}
+		case isExample(fn) && !hasOutput(f, fn):
+			q.ExamplesNoOutput = append(q.ExamplesNoOutput, name)
+		}
+	}
+}
+
+// testingName returns the name under which f imports the testing package,
+// or "" if it does not import it.
+func testingName(f *ast.File) string {
+	for _, imp := range f.Imports {
+		if path, _ := strconv.Unquote(imp.Path.Value); path != "testing" {
+			continue
+		}
+		if imp.Name != nil {
+			return imp.Name.Name
+		}
+		return "testing"
+	}
+	return ""
+}
+
+// isTestingT reports whether expr is *testing.T.
+func isTestingT(expr ast.Expr, testing string) bool {
+	star, ok := expr.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	sel, ok := star.X.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "T" {
+		return false
+	}
+	x, ok := sel.X.(*ast.Ident)
+	return ok && testing != "" && x.Name == testing
+}
+
+// hasPrefix reports whether name is prefix followed by nothing or by a
+// character that is not a lower case letter, the rule used by go test.
+func hasPrefix(name, prefix string) bool {
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	if len(name) == len(prefix) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(name[len(prefix):])
+	return !unicode.IsLower(r)
+}
+
+// isTest reports whether fn is func TestXxx(t *testing.T).
+func isTest(fn *ast.FuncDecl, testing string) bool {
+	params := fn.Type.Params.List
+	return hasPrefix(fn.Name.Name, "Test") && len(params) == 1 && isTestingT(params[0].Type, testing)
+}
+
+// isExample reports whether fn is func ExampleXxx().
+func isExample(fn *ast.FuncDecl) bool {
+	return hasPrefix(fn.Name.Name, "Example") && fn.Type.Params.NumFields() == 0
+}
+
+// hasOutput reports whether the body of fn contains an output comment.
+func hasOutput(f *ast.File, fn *ast.FuncDecl) bool {
+	for _, group := range f.Comments {
+		if group.Pos() < fn.Body.Lbrace || group.End() > fn.Body.Rbrace {
+			continue
+		}
+		text := strings.ToLower(strings.TrimSpace(group.Text()))
+		if strings.HasPrefix(text, "output:") || strings.HasPrefix(text, "unordered output:") {
+			return true
+		}
+	}
+	return false
+}
+
+// paramName returns the name of the *testing.T parameter of fn.
+func paramName(fn *ast.FuncDecl) string {
+	names := fn.Type.Params.List[0].Names
+	if len(names) == 0 {
+		return ""
+	}
+	return names[0].Name
+}
+
+// testVisitor walks the body of a test. Subtests are followed into their
+// function literals, so a t.Error in a subtest counts for the parent test.
+type testVisitor struct {
+	t        string
+	testing  string
+	subTests int
+	skips    int
+	canFail  bool
+}
+
+func (v *testVisitor) Visit(node ast.Node) ast.Visitor {
+	switch node := node.(type) {
+	case *ast.FuncLit:
+		// t.Run(name, func(t *testing.T) {...}) may rename the parameter.
+		if params := node.Type.Params.List; len(params) == 1 && isTestingT(params[0].Type, v.testing) && len(params[0].Names) == 1 {
+			inner := &testVisitor{t: params[0].Names[0].Name, testing: v.testing}
+			ast.Walk(inner, node.Body)
+			v.subTests += inner.subTests
+			v.skips += inner.skips
+			v.canFail = v.canFail || inner.canFail
+			return nil
+		}
+	case *ast.CallExpr:
+		v.call(node)
+	}
+	return v
+}
+
+// call classifies a call made in the test body.
+func (v *testVisitor) call(call *ast.CallExpr) {
+	// Passing t to a helper lets the helper fail the test.
+	for _, arg := range call.Args {
+		if ident, ok := arg.(*ast.Ident); ok && v.t != "" && ident.Name == v.t {
+			v.canFail = true
+		}
+	}
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok {
+		if ident, ok := call.Fun.(*ast.Ident); ok && ident.Name == "So" {
+			v.canFail = true
+		}
+		return
+	}
+	x, ok := sel.X.(*ast.Ident)
+	if !ok {
+		return
+	}
+	switch {
+	case assertPackages[x.Name]:
+		v.canFail = true
+	case v.t == "" || x.Name != v.t:
+	case failureMethods[sel.Sel.Name]:
+		v.canFail = true
+	case skipMethods[sel.Sel.Name]:
+		v.skips++
+	case sel.Sel.Name == "Run":
+		v.subTests++
+	}
+}
@@ -0,0 +1,38 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package testquality
+
+import (
+	"reflect"
+	"testing"
+)
+
+func Test_TestQuality(t *testing.T) {
+	quality, err := TestQuality("testdata/p1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Quality{
+		TestFuncs:        4,
+		SubTests:         1,
+		Skips:            1,
+		NoFailurePath:    []string{"TestNothing", "TestSkipped"},
+		ExamplesNoOutput: []string{"ExampleAdd_noOutput"},
+		TestLines:        34,
+		CodeLines:        4,
+	}
+	if !reflect.DeepEqual(quality, want) {
+		t.Errorf("want %+v, but got %+v", want, quality)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package unittest
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// CoveredLines maps a file, as named in the coverage profile, to the lines
+// executed by at least one test.
+type CoveredLines map[string]map[int]bool
+
+// Covered reports whether line of file was executed.
+func (c CoveredLines) Covered(file string, line int) bool {
+	return c[file][line]
+}
+
+// ParseCoverProfile reads a profile written by go test -coverprofile. A line
+// counts as covered if one of the blocks containing it has a count above zero.
+func ParseCoverProfile(profile string) (CoveredLines, error) {
+	f, err := os.Open(profile)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	covered := make(CoveredLines, 0)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "mode:") || line == "" {
+			continue
+		}
+		// name.go:line.column,line.column numberOfStatements count
+		colon := strings.LastIndex(line, ":")
+		if colon < 0 {
+			return nil, fmt.Errorf("invalid coverage line: %s", line)
+		}
+		var startLine, startCol, endLine, endCol, stmts, count int
+		_, err := fmt.Sscanf(line[colon+1:], "%d.%d,%d.%d %d %d", &startLine, &startCol, &endLine, &endCol, &stmts, &count)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coverage line: %s", line)
+		}
+		if count == 0 {
+			continue
+		}
+		file := line[:colon]
+		if covered[file] == nil {
+			covered[file] = make(map[int]bool, 0)
+		}
+		for l := startLine; l <= endLine; l++ {
+			covered[file][l] = true
+		}
+	}
+	return covered, scanner.Err()
+}
@@ -15,6 +15,7 @@ package unittest
 
 import (
 	"bytes"
+	"os"
 	"os/exec"
 	"path/filepath"
 	"strings"
@@ -22,7 +23,27 @@ import (
 	"github.com/golang/glog"
 )
 
+// Options controls how go test runs the tests of a package. Tags are the
+// build tags, Flags extra go test flags, Env extra environment variables in
+// the form key=value and Timeout a duration passed to -timeout. If
+// CoverProfile is set, the coverage profile is written to that file.
+type Options struct {
+	Tags         []string
+	Flags        []string
+	Env          []string
+	Timeout      string
+	Race         bool
+	CoverProfile string
+}
+
+// UnitTest runs the tests of the package with coverage and race detection.
 func UnitTest(packagePath string) (packageUnitTestResults []string, packageTestRaceResults []string) {
+	return UnitTestWithOptions(packagePath, Options{Race: true})
+}
+
+// UnitTestWithOptions runs the tests of the package with coverage and the
+// given options.
+func UnitTestWithOptions(packagePath string, options Options) (packageUnitTestResults []string, packageTestRaceResults []string) {
 	packageUnitTestResults = make([]string, 0)
 	packageTestRaceResults = make([]string, 0)
 
@@ -31,7 +52,7 @@ func UnitTest(packagePath string) (packageUnitTestResults []string, packageTestR
 		packageName = packagePath
 	}
 
-	out, err := GoTestWithCoverAndRace(packagePath)
+	out, err := GoTest(packagePath, options)
 	if err != nil {
 		if !strings.Contains(out, "==================") {
 			glog.Infoln("[UnitTest] package->:", packageName, " ... ", err)
@@ -61,16 +82,31 @@ func UnitTest(packagePath string) (packageUnitTestResults []string, packageTestR
 			}
 		}
 	} else {
-		test := strings.Fields(out)
-		packageUnitTestResults = test
+		packageUnitTestResults = strings.Fields(summaryLine(out))
 	}
 
 	return packageUnitTestResults, packageTestRaceResults
 }
 
+// summaryLine returns the "ok" line of the go test output, so extra output
+// such as the one of -v does not break the parsing.
+func summaryLine(out string) string {
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "ok ") {
+			return line
+		}
+	}
+	return out
+}
+
 // run go test -cover
 func GoTestWithCoverAndRace(packagePath string) (packageUnitResult string, err error) {
-	cmd := exec.Command("go", "test", packagePath, "-cover", "-race")
+	return GoTest(packagePath, Options{Race: true})
+}
+
+// GoTest runs go test -cover on the package with the given options.
+func GoTest(packagePath string, options Options) (packageUnitResult string, err error) {
+	cmd := Command(packagePath, options, "-cover")
 	var out bytes.Buffer
 	cmd.Stdout = &out
 	// cmd.Stderr = os.Stderr
@@ -84,10 +120,38 @@ func GoTestWithCoverAndRace(packagePath string) (packageUnitResult string, err e
 	return out.String(), err
 }
 
+// Command returns the go test command for the package with the given
+// options. The flags in extra are added before the flags of the options.
+func Command(packagePath string, options Options, extra ...string) *exec.Cmd {
+	args := append([]string{"test", packagePath}, extra...)
+	if options.Race {
+		args = append(args, "-race")
+	}
+	if len(options.Tags) > 0 {
+		args = append(args, "-tags", strings.Join(options.Tags, ","))
+	}
+	if options.Timeout != "" {
+		args = append(args, "-timeout", options.Timeout)
+	}
+	if options.CoverProfile != "" {
+		args = append(args, "-coverprofile", options.CoverProfile)
+	}
+	args = append(args, options.Flags...)
+	cmd := exec.Command("go", args...)
+	if len(options.Env) > 0 {
+		cmd.Env = append(os.Environ(), options.Env...)
+	}
+	return cmd
+}
+
 // run go list -cover
-func GoListWithImportPackages(packagePath string) (importPackages []string) {
+func GoListWithImportPackages(packagePath string, tags ...string) (importPackages []string) {
 	importPackages = make([]string, 0)
-	cmd := exec.Command("go", "list", "-f", `'{{ join .Imports " " }}'`, packagePath)
+	args := []string{"list", "-f", `'{{ join .Imports " " }}'`}
+	if len(tags) > 0 {
+		args = append(args, "-tags", strings.Join(tags, ","))
+	}
+	cmd := exec.Command("go", append(args, packagePath)...)
 	var out bytes.Buffer
 	cmd.Stdout = &out
 	// cmd.Stderr = os.Stderr
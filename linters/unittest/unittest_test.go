@@ -14,6 +14,8 @@
 package unittest
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -24,3 +26,57 @@ func Test_UnitTest(t *testing.T) {
 func Test_GoListWithImportPackages(t *testing.T) {
 	GoListWithImportPackages("../copycheck")
 }
+
+func Test_UnitTestWithOptions(t *testing.T) {
+	results, _ := UnitTestWithOptions("../countcode", Options{Tags: []string{"integration"}, Timeout: "1m"})
+	if len(results) < 2 || results[0] != "ok" || !strings.HasSuffix(results[1], "linters/countcode") {
+		t.Errorf("want the ok summary of countcode, but got %q", results)
+	}
+}
+
+func Test_Command(t *testing.T) {
+	tests := []struct {
+		options Options
+		extra   []string
+		args    []string
+		env     []string
+	}{
+		{Options{}, nil, []string{"go", "test", "p"}, nil},
+		{Options{Race: true}, []string{"-cover"}, []string{"go", "test", "p", "-cover", "-race"}, nil},
+		{
+			Options{Tags: []string{"integration", "slow"}, Timeout: "1m", CoverProfile: "c.out", Flags: []string{"-v", "-short"}},
+			[]string{"-cover"},
+			[]string{"go", "test", "p", "-cover", "-tags", "integration,slow", "-timeout", "1m", "-coverprofile", "c.out", "-v", "-short"},
+			nil,
+		},
+		{Options{Env: []string{"CGO_ENABLED=0", "GOFLAGS=-mod=vendor"}}, nil, []string{"go", "test", "p"}, []string{"CGO_ENABLED=0", "GOFLAGS=-mod=vendor"}},
+	}
+	for _, test := range tests {
+		cmd := Command("p", test.options, test.extra...)
+		if !reflect.DeepEqual(cmd.Args, test.args) {
+			t.Errorf("%+v: got args %q, want %q", test.options, cmd.Args, test.args)
+		}
+		if test.env == nil {
+			if cmd.Env != nil {
+				t.Errorf("%+v: want the environment of goreporter, but got %q", test.options, cmd.Env)
+			}
+			continue
+		}
+		if len(cmd.Env) < len(test.env) || !reflect.DeepEqual(cmd.Env[len(cmd.Env)-len(test.env):], test.env) {
+			t.Errorf("%+v: want the environment to end with %q, but got %q", test.options, test.env, cmd.Env)
+		}
+	}
+}
+
+func Test_summaryLine(t *testing.T) {
+	tests := map[string]string{
+		"ok  \tp\t0.012s\tcoverage: 80.0% of statements\n": "ok  \tp\t0.012s\tcoverage: 80.0% of statements",
+		"=== RUN   TestA\n--- PASS: TestA (0.00s)\n=== RUN   TestB\n    b_test.go:3: ok \n--- PASS: TestB (0.00s)\nPASS\ncoverage: 75.0% of statements\nok  \tp\t0.020s\tcoverage: 75.0% of statements\n": "ok  \tp\t0.020s\tcoverage: 75.0% of statements",
+		"?   \tp\t[no test files]\n": "?   \tp\t[no test files]\n",
+	}
+	for out, want := range tests {
+		if got := summaryLine(out); got != want {
+			t.Errorf("summaryLine(%q) = %q, want %q", out, got, want)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package p1
+
+import "os"
+
+// Shape has an area.
+type Shape interface {
+	Area() float64
+}
+
+// Square is a Shape.
+type Square struct{ Side float64 }
+
+// Area of the square.
+func (s Square) Area() float64 { return s.Side * s.Side }
+
+// Perimeter is never tested.
+func (s Square) Perimeter() float64 { return 4 * s.Side }
+
+// Total sums the areas, calling Area through the interface.
+func Total(shapes ...Shape) float64 {
+	total := 0.
+	for _, s := range shapes {
+		total += s.Area()
+	}
+	return total
+}
+
+// Mode uses an aliased standard library type.
+func Mode() os.FileMode { return helper() }
+
+func helper() os.FileMode { return 0644 }
+
+// Untested is never called by a test.
+func Untested() {}
@@ -0,0 +1,12 @@
+package p1
+
+import "testing"
+
+func TestTotal(t *testing.T) {
+	t.Run("squares", func(t *testing.T) {
+		if Total(Square{1}, Square{2}) != 5 {
+			t.Error("wrong total")
+		}
+	})
+	Mode()
+}
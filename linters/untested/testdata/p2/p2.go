@@ -0,0 +1,16 @@
+package p2
+
+// Hello is in a package without tests.
+func Hello() string { return "hello" }
+
+// Message has methods declared through an alias.
+type Message struct{ text string }
+
+// Alias is another name of Message.
+type Alias = Message
+
+// Text returns the text of the message.
+func (m Alias) Text() string { return m.text }
+
+// Reset clears the message.
+func (m *Alias) Reset() { m.text = "" }
@@ -0,0 +1,275 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package untested finds the packages of a project that have no tests and
+// the exported functions and methods that no test calls. The calls are taken
+// from a call graph of the type-checked program, rooted at the tests, so a
+// function only used by other untested code is untested as well.
+package untested
+
+import (
+	"fmt"
+	"go/build"
+	"go/types"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/tools/go/loader"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/internal/program"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/ssa"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/ssa/ssautil"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/staticcheck/callgraph"
+)
+
+// testPrefixes are the names of the functions run by go test.
+var testPrefixes = []string{"Test", "Benchmark", "Example", "Fuzz"}
+
+// Func is an exported function or method, named as in "F", "T.M" or "(*T).M".
+type Func struct {
+	Name string `json:"name"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// Package is the result for one package. Ratio is the percentage of the
+// Exported functions and methods that are Tested.
+type Package struct {
+	HasTests bool    `json:"has_tests"`
+	Exported int     `json:"exported"`
+	Tested   int     `json:"tested"`
+	Ratio    float64 `json:"ratio"`
+	Untested []Func  `json:"untested"`
+}
+
+// Untested analyzes the packages importPaths, built with the given build
+// tags, and returns their results by import path. Packages that cannot be
+// loaded still report whether they have tests, the error tells why their
+// API is missing.
+func Untested(importPaths []string, tags ...string) (map[string]Package, error) {
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, tags...)
+
+	packages := make(map[string]Package, len(importPaths))
+	for _, path := range importPaths {
+		packages[path] = Package{
+			HasTests: hasTests(&ctx, path),
+			Untested: make([]Func, 0),
+		}
+	}
+
+	conf := loader.Config{Build: &ctx}
+	conf.AllowErrors = true
+	conf.TypeChecker.Error = func(error) {}
+	for _, path := range importPaths {
+		conf.ImportWithTests(path)
+	}
+	lprog, err := conf.Load()
+	if err != nil {
+		return packages, err
+	}
+	prog := ssautil.CreateProgram(lprog, 0)
+
+	var failed []string
+	for _, info := range lprog.InitialPackages() {
+		if err := program.BuildPackage(prog.Package(info.Pkg)); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", info.Pkg.Path(), err))
+		}
+	}
+
+	graph := testGraph(lprog, prog)
+	for _, path := range importPaths {
+		info := lprog.Imported[path]
+		if info == nil || info.Pkg.Name() == "main" {
+			continue
+		}
+		pkg := packages[path]
+		for _, fn := range exported(lprog, info.Pkg) {
+			pkg.Exported++
+			if value := prog.FuncValue(fn); value != nil && graph.Nodes[value] != nil {
+				pkg.Tested++
+				continue
+			}
+			position := lprog.Fset.Position(fn.Pos())
+			pkg.Untested = append(pkg.Untested, Func{
+				Name: funcName(fn),
+				File: position.Filename,
+				Line: position.Line,
+			})
+		}
+		if pkg.Exported > 0 {
+			pkg.Ratio = 100 * float64(pkg.Tested) / float64(pkg.Exported)
+		}
+		packages[path] = pkg
+	}
+	if len(failed) > 0 {
+		return packages, fmt.Errorf("could not build %s", strings.Join(failed, ", "))
+	}
+	return packages, nil
+}
+
+// hasTests reports whether the package path has any _test.go file.
+func hasTests(ctx *build.Context, path string) bool {
+	bp, err := ctx.Import(path, "", 0)
+	if err != nil {
+		return false
+	}
+	return len(bp.TestGoFiles)+len(bp.XTestGoFiles) > 0
+}
+
+// testGraph returns the call graph of all functions reachable from the tests
+// of the initial packages. The root calls every test, benchmark, example and
+// fuzz target and the package initializers, which also run under go test.
+func testGraph(lprog *loader.Program, prog *ssa.Program) *callgraph.Graph {
+	graph := callgraph.New(nil)
+	var initial []*types.Package
+	for _, info := range lprog.InitialPackages() {
+		initial = append(initial, info.Pkg)
+	}
+	runtime := program.RuntimeTypes(initial)
+	var queue []*callgraph.Node
+	addEdge := func(caller *callgraph.Node, site ssa.CallInstruction, fn *ssa.Function) {
+		if fn == nil {
+			return
+		}
+		_, seen := graph.Nodes[fn]
+		callee := graph.CreateNode(fn)
+		callgraph.AddEdge(caller, site, callee)
+		if !seen {
+			queue = append(queue, callee)
+		}
+	}
+
+	for _, info := range lprog.InitialPackages() {
+		pkg := prog.Package(info.Pkg)
+		if pkg == nil {
+			continue
+		}
+		addEdge(graph.Root, nil, pkg.Func("init"))
+		for name, member := range pkg.Members {
+			fn, ok := member.(*ssa.Function)
+			if ok && isTest(name) && strings.HasSuffix(lprog.Fset.Position(fn.Pos()).Filename, "_test.go") {
+				addEdge(graph.Root, nil, fn)
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, fn := range node.Func.AnonFuncs {
+			addEdge(node, nil, fn)
+		}
+		for _, block := range node.Func.Blocks {
+			for _, instr := range block.Instrs {
+				var operands []*ssa.Value
+				if site, ok := instr.(ssa.CallInstruction); ok {
+					common := site.Common()
+					if common.IsInvoke() {
+						for _, fn := range program.Implementations(prog, runtime, common) {
+							addEdge(node, site, fn)
+						}
+					} else {
+						addEdge(node, site, common.StaticCallee())
+					}
+				}
+				// Functions used as values, e.g. passed to t.Run or stored
+				// in a table, may be called later on.
+				for _, operand := range instr.Operands(operands) {
+					if fn, ok := (*operand).(*ssa.Function); ok {
+						addEdge(node, nil, fn)
+					}
+				}
+			}
+		}
+	}
+	return graph
+}
+
+// exported returns the exported functions of pkg and the exported methods of
+// its exported types, leaving out those declared in test files and generic
+// ones, in source order.
+func exported(lprog *loader.Program, pkg *types.Package) []*types.Func {
+	var fns []*types.Func
+	add := func(fn *types.Func) {
+		if !fn.Exported() || strings.HasSuffix(lprog.Fset.Position(fn.Pos()).Filename, "_test.go") {
+			return
+		}
+		if sig := fn.Type().(*types.Signature); sig.TypeParams().Len() > 0 {
+			return
+		}
+		fns = append(fns, fn)
+	}
+	scope := pkg.Scope()
+	for _, name := range scope.Names() {
+		switch obj := scope.Lookup(name).(type) {
+		case *types.Func:
+			add(obj)
+		case *types.TypeName:
+			named, ok := obj.Type().(*types.Named)
+			if !ok || obj.IsAlias() || !obj.Exported() || named.TypeParams().Len() > 0 || types.IsInterface(named) {
+				continue
+			}
+			for i := 0; i < named.NumMethods(); i++ {
+				add(named.Method(i))
+			}
+		}
+	}
+	sort.Slice(fns, func(i, j int) bool {
+		return fns[i].Pos() < fns[j].Pos()
+	})
+	return fns
+}
+
+// funcName returns the name of fn qualified by its receiver type.
+func funcName(fn *types.Func) string {
+	recv := fn.Type().(*types.Signature).Recv()
+	if recv == nil {
+		return fn.Name()
+	}
+	if ptr, ok := types.Unalias(recv.Type()).(*types.Pointer); ok {
+		return fmt.Sprintf("(*%s).%s", typeName(ptr.Elem()), fn.Name())
+	}
+	return fmt.Sprintf("%s.%s", typeName(recv.Type()), fn.Name())
+}
+
+// typeName returns the name of the receiver type t, that of the named type
+// it denotes if t is an alias.
+func typeName(t types.Type) string {
+	if named, ok := types.Unalias(t).(*types.Named); ok {
+		return named.Obj().Name()
+	}
+	return types.TypeString(t, func(*types.Package) string { return "" })
+}
+
+// isTest reports whether name is run by go test: TestMain or a prefix of
+// testPrefixes followed by nothing or by a character that is not a lower case
+// letter.
+func isTest(name string) bool {
+	if name == "TestMain" {
+		return true
+	}
+	for _, prefix := range testPrefixes {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if len(name) == len(prefix) {
+			return true
+		}
+		r, _ := utf8.DecodeRuneInString(name[len(prefix):])
+		return !unicode.IsLower(r)
+	}
+	return false
+}
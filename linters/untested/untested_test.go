@@ -0,0 +1,72 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package untested
+
+import (
+	"testing"
+)
+
+const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/untested/testdata/"
+
+func Test_Untested(t *testing.T) {
+	packages, err := Untested([]string{testdata + "p1", testdata + "p2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p1 := packages[testdata+"p1"]
+	if !p1.HasTests || p1.Exported != 5 || p1.Tested != 3 {
+		t.Errorf("p1: has tests %v, %d of %d exported tested", p1.HasTests, p1.Tested, p1.Exported)
+	}
+	if p1.Ratio != 60 {
+		t.Errorf("p1: ratio %v, want 60", p1.Ratio)
+	}
+	var names []string
+	for _, fn := range p1.Untested {
+		names = append(names, fn.Name)
+	}
+	if len(names) != 2 || names[0] != "Square.Perimeter" || names[1] != "Untested" {
+		t.Errorf("p1: untested %v, want [Square.Perimeter Untested]", names)
+	}
+
+	p2 := packages[testdata+"p2"]
+	if p2.HasTests || p2.Exported != 3 || p2.Tested != 0 || len(p2.Untested) != 3 {
+		t.Errorf("p2: has tests %v, %d of %d exported tested", p2.HasTests, p2.Tested, p2.Exported)
+	}
+	names = names[:0]
+	for _, fn := range p2.Untested {
+		names = append(names, fn.Name)
+	}
+	if len(names) != 3 || names[1] != "Message.Text" || names[2] != "(*Message).Reset" {
+		t.Errorf("p2: untested %v, want [Hello Message.Text (*Message).Reset]", names)
+	}
+}
+
+func Test_isTest(t *testing.T) {
+	for name, want := range map[string]bool{
+		"TestMain":    true,
+		"Test":        true,
+		"TestTotal":   true,
+		"Test_total":  true,
+		"Testify":     false,
+		"ExampleMode": true,
+		"Benchmarks":  false,
+		"FuzzParse":   true,
+		"helper":      false,
+	} {
+		if got := isTest(name); got != want {
+			t.Errorf("isTest(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vuln
+
+import (
+	"fmt"
+	"go/build"
+	"go/types"
+	"sort"
+	"strings"
+
+	"golang.org/x/tools/go/loader"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/internal/program"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/ssa"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/simpler/ssa/ssautil"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/staticcheck/callgraph"
+)
+
+// Reach finds which vulnerable symbols of findings are called by the packages
+// importPaths, built with the given build tags, and sets the Reachable and
+// CallPath of the findings accordingly. The call graph starts from every
+// function of the project and goes through the code of the third-party
+// packages, an interface method call may dispatch to any type of the
+// project or of its third-party packages implementing it. The packages of the
+// standard library are not followed.
+func Reach(findings []Finding, importPaths []string, tags ...string) error {
+	wanted := false
+	for _, finding := range findings {
+		wanted = wanted || finding.Imported && len(finding.Symbols) > 0
+	}
+	if !wanted || len(importPaths) == 0 {
+		return nil
+	}
+
+	ctx := build.Default
+	ctx.BuildTags = append(ctx.BuildTags, tags...)
+	conf := loader.Config{Build: &ctx}
+	conf.AllowErrors = true
+	conf.TypeChecker.Error = func(error) {}
+	for _, path := range importPaths {
+		conf.Import(path)
+	}
+	lprog, err := conf.Load()
+	if err != nil {
+		return err
+	}
+	prog := ssautil.CreateProgram(lprog, 0)
+
+	var failed []string
+	var libraries []*types.Package
+	for _, info := range lprog.AllPackages {
+		if len(info.Files) == 0 {
+			continue
+		}
+		if bp, err := ctx.Import(info.Pkg.Path(), "", build.FindOnly); err == nil && bp.Goroot {
+			continue
+		}
+		libraries = append(libraries, info.Pkg)
+		if err := program.BuildPackage(prog.Package(info.Pkg)); err != nil {
+			failed = append(failed, fmt.Sprintf("%s: %v", info.Pkg.Path(), err))
+		}
+	}
+
+	graph := projectGraph(lprog, prog, libraries)
+	for i, finding := range findings {
+		if !finding.Imported || len(finding.Symbols) == 0 {
+			continue
+		}
+		for _, symbol := range finding.Symbols {
+			fn := lookup(lprog, prog, symbol)
+			if fn == nil || graph.Nodes[fn] == nil {
+				continue
+			}
+			findings[i].Reachable = true
+			findings[i].CallPath = callPath(lprog, graph.Nodes[fn])
+			break
+		}
+	}
+	if len(failed) > 0 {
+		sort.Strings(failed)
+		return fmt.Errorf("could not build %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// projectGraph returns the call graph of all functions reachable from the
+// functions and methods of the initial packages. A function is added to the
+// graph with the call that first reached it, the first edge into a node is
+// thus on a shortest path from the root.
+func projectGraph(lprog *loader.Program, prog *ssa.Program, libraries []*types.Package) *callgraph.Graph {
+	graph := callgraph.New(nil)
+	runtime := program.RuntimeTypes(libraries)
+	var queue []*callgraph.Node
+	addEdge := func(caller *callgraph.Node, site ssa.CallInstruction, fn *ssa.Function) {
+		if fn == nil {
+			return
+		}
+		_, seen := graph.Nodes[fn]
+		callee := graph.CreateNode(fn)
+		callgraph.AddEdge(caller, site, callee)
+		if !seen {
+			queue = append(queue, callee)
+		}
+	}
+
+	for _, info := range lprog.InitialPackages() {
+		pkg := prog.Package(info.Pkg)
+		if pkg == nil {
+			continue
+		}
+		names := make([]string, 0, len(pkg.Members))
+		for name := range pkg.Members {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			switch member := pkg.Members[name].(type) {
+			case *ssa.Function:
+				addEdge(graph.Root, nil, member)
+			case *ssa.Type:
+				named, ok := member.Type().(*types.Named)
+				if !ok {
+					continue
+				}
+				for i := 0; i < named.NumMethods(); i++ {
+					addEdge(graph.Root, nil, prog.FuncValue(named.Method(i)))
+				}
+			}
+		}
+	}
+
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for _, fn := range node.Func.AnonFuncs {
+			addEdge(node, nil, fn)
+		}
+		for _, block := range node.Func.Blocks {
+			for _, instr := range block.Instrs {
+				var operands []*ssa.Value
+				if site, ok := instr.(ssa.CallInstruction); ok {
+					common := site.Common()
+					if common.IsInvoke() {
+						for _, fn := range program.Implementations(prog, runtime, common) {
+							addEdge(node, site, fn)
+						}
+					} else {
+						addEdge(node, site, common.StaticCallee())
+					}
+				}
+				// Functions used as values, e.g. callbacks, may be called
+				// later on by the function they are given to.
+				for _, operand := range instr.Operands(operands) {
+					if fn, ok := (*operand).(*ssa.Function); ok {
+						addEdge(node, callSite(instr), fn)
+					}
+				}
+			}
+		}
+	}
+	return graph
+}
+
+// callSite returns instr as a call instruction, or nil if it is not one.
+func callSite(instr ssa.Instruction) ssa.CallInstruction {
+	call, _ := instr.(ssa.CallInstruction)
+	return call
+}
+
+// lookup returns the function or method of symbol, or nil if its package
+// was not loaded or has no such symbol.
+func lookup(lprog *loader.Program, prog *ssa.Program, symbol Symbol) *ssa.Function {
+	var pkg *types.Package
+	for p := range lprog.AllPackages {
+		if p.Path() == symbol.Package {
+			pkg = p
+			break
+		}
+	}
+	if pkg == nil {
+		return nil
+	}
+	typeName, method := "", symbol.Name
+	if i := strings.IndexByte(symbol.Name, '.'); i >= 0 {
+		typeName, method = symbol.Name[:i], symbol.Name[i+1:]
+	}
+	if typeName == "" {
+		fn, ok := pkg.Scope().Lookup(method).(*types.Func)
+		if !ok {
+			return nil
+		}
+		return prog.FuncValue(fn)
+	}
+	obj, ok := pkg.Scope().Lookup(typeName).(*types.TypeName)
+	if !ok {
+		return nil
+	}
+	sel := types.NewMethodSet(types.NewPointer(obj.Type())).Lookup(pkg, method)
+	if sel == nil {
+		return nil
+	}
+	fn, ok := sel.Obj().(*types.Func)
+	if !ok {
+		return nil
+	}
+	return prog.FuncValue(fn)
+}
+
+// callPath returns the calls from a function of the project down to node,
+// following the first edges into the nodes. Every call is at the position
+// where the next function is called, the last one at the vulnerable function.
+func callPath(lprog *loader.Program, node *callgraph.Node) []Call {
+	position := lprog.Fset.Position(node.Func.Pos())
+	path := []Call{{Func: node.Func.String(), File: position.Filename, Line: position.Line}}
+	for len(node.In) > 0 && node.In[0].Caller.Func != nil {
+		edge := node.In[0]
+		pos := edge.Pos()
+		if !pos.IsValid() {
+			pos = edge.Callee.Func.Pos()
+		}
+		position := lprog.Fset.Position(pos)
+		path = append(path, Call{Func: edge.Caller.Func.String(), File: position.Filename, Line: position.Line})
+		node = edge.Caller
+	}
+	for i, j := 0, len(path)-1; i < j; i, j = i+1, j-1 {
+		path[i], path[j] = path[j], path[i]
+	}
+	return path
+}
@@ -0,0 +1,24 @@
+package lib
+
+import "io"
+
+// Unsafe is the vulnerable function, called through Parse.
+func Unsafe(s string) string {
+	return s
+}
+
+// Parse calls Unsafe.
+func Parse(s string) string {
+	return Unsafe(s)
+}
+
+// Unused is vulnerable but never called by the project.
+func Unused() {}
+
+// Decoder is vulnerable through its Read method.
+type Decoder struct{}
+
+// Read is called through the io.Reader interface.
+func (d *Decoder) Read(p []byte) (int, error) {
+	return 0, io.EOF
+}
@@ -0,0 +1,22 @@
+package p
+
+import (
+	"io"
+	"io/ioutil"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/vuln/testdata/lib"
+)
+
+func Run(s string) string {
+	return lib.Parse(s)
+}
+
+func Read() {
+	var r io.Reader = &lib.Decoder{}
+	read(r)
+}
+
+func read(r io.Reader) {
+	ioutil.ReadAll(r)
+	r.Read(nil)
+}
@@ -0,0 +1,314 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package vuln matches the modules of a project against a local copy of the
+// Go vulnerability database, in the OSV json format, and finds the call paths
+// from the project code to the vulnerable symbols. Nothing is downloaded, the
+// database is a directory of OSV entries such as the one served by
+// vuln.go.dev.
+package vuln
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/modules"
+)
+
+// Entry is an OSV entry of the database, the fields needed to match modules.
+type Entry struct {
+	ID        string     `json:"id"`
+	Aliases   []string   `json:"aliases"`
+	Summary   string     `json:"summary"`
+	Details   string     `json:"details"`
+	Withdrawn string     `json:"withdrawn"`
+	Affected  []Affected `json:"affected"`
+
+	DatabaseSpecific struct {
+		URL string `json:"url"`
+	} `json:"database_specific"`
+}
+
+// Affected is a module affected by an Entry, in the versions of Ranges. The
+// vulnerable symbols of its packages are listed in Imports, if known.
+type Affected struct {
+	Package struct {
+		Name      string `json:"name"`
+		Ecosystem string `json:"ecosystem"`
+	} `json:"package"`
+	Ranges []struct {
+		Type   string `json:"type"`
+		Events []struct {
+			Introduced string `json:"introduced"`
+			Fixed      string `json:"fixed"`
+		} `json:"events"`
+	} `json:"ranges"`
+	EcosystemSpecific struct {
+		Imports []struct {
+			Path    string   `json:"path"`
+			Symbols []string `json:"symbols"`
+		} `json:"imports"`
+	} `json:"ecosystem_specific"`
+}
+
+// Symbol is a function, "F", or a method, "T.M", of the package Package.
+type Symbol struct {
+	Package string `json:"package"`
+	Name    string `json:"name"`
+}
+
+// Call is a function of a call path and the position of the call to the
+// next one, or of the function itself for the last one.
+type Call struct {
+	Func string `json:"func"`
+	File string `json:"file"`
+	Line int    `json:"line"`
+}
+
+// Finding is a vulnerability of the database affecting the version Version
+// of the module Module of the project, fixed in Fixed, or never if empty.
+// Imported tells whether a project package imports the module and Reachable
+// whether the project code calls one of the vulnerable Symbols, through
+// CallPath; without symbols, the whole module is vulnerable and it is
+// reachable as soon as it is imported.
+type Finding struct {
+	ID        string   `json:"id"`
+	Aliases   []string `json:"aliases"`
+	Summary   string   `json:"summary"`
+	URL       string   `json:"url"`
+	Module    string   `json:"module"`
+	Version   string   `json:"version"`
+	Fixed     string   `json:"fixed"`
+	Symbols   []Symbol `json:"symbols"`
+	Imported  bool     `json:"imported"`
+	Reachable bool     `json:"reachable"`
+	CallPath  []Call   `json:"call_path"`
+}
+
+// Load reads the OSV entries of the json files under dir. The files that are
+// not entries, such as the indexes of the database, are skipped, as are the
+// withdrawn entries.
+func Load(dir string) ([]Entry, error) {
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	entries := make([]Entry, 0)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(path, ".json") {
+			return nil
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		var entry Entry
+		if json.Unmarshal(data, &entry) != nil || entry.ID == "" || entry.Withdrawn != "" {
+			return nil
+		}
+		entries = append(entries, entry)
+		return nil
+	})
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ID < entries[j].ID
+	})
+	return entries, err
+}
+
+// Match returns the findings of entries for the modules, by ID and module.
+// A replaced module is matched at the version of its replacement, local
+// replacements and modules without version are not matched.
+func Match(entries []Entry, mods []modules.Module) []Finding {
+	findings := make([]Finding, 0)
+	for _, m := range mods {
+		path, version := m.Path, m.Version
+		if m.Replace != nil {
+			path, version = m.Replace.Path, m.Replace.Version
+		}
+		if version == "" {
+			continue
+		}
+		for _, entry := range entries {
+			for _, affected := range entry.Affected {
+				if affected.Package.Ecosystem != "Go" || affected.Package.Name != path {
+					continue
+				}
+				vulnerable, fixed := affects(affected, version)
+				if !vulnerable {
+					continue
+				}
+				finding := Finding{
+					ID:       entry.ID,
+					Aliases:  entry.Aliases,
+					Summary:  entry.Summary,
+					URL:      entry.DatabaseSpecific.URL,
+					Module:   m.Path,
+					Version:  m.Version,
+					Fixed:    fixed,
+					Symbols:  make([]Symbol, 0),
+					Imported: len(m.Packages) > 0,
+					CallPath: make([]Call, 0),
+				}
+				if finding.Aliases == nil {
+					finding.Aliases = make([]string, 0)
+				}
+				for _, imp := range affected.EcosystemSpecific.Imports {
+					for _, symbol := range imp.Symbols {
+						finding.Symbols = append(finding.Symbols, Symbol{Package: imp.Path, Name: symbol})
+					}
+				}
+				finding.Reachable = finding.Imported && len(finding.Symbols) == 0
+				findings = append(findings, finding)
+			}
+		}
+	}
+	sort.Slice(findings, func(i, j int) bool {
+		if findings[i].ID != findings[j].ID {
+			return findings[i].ID < findings[j].ID
+		}
+		return findings[i].Module < findings[j].Module
+	})
+	return findings
+}
+
+// affects reports whether version is in one of the semver ranges of
+// affected, and returns the version fixing it. The events of a range are
+// ordered, a version is affected from an introduced event on and until a
+// fixed one.
+func affects(affected Affected, version string) (bool, string) {
+	for _, r := range affected.Ranges {
+		if r.Type != "SEMVER" {
+			continue
+		}
+		vulnerable, fixed := false, ""
+		for _, event := range r.Events {
+			switch {
+			case event.Introduced != "":
+				if event.Introduced == "0" || compareVersions(version, event.Introduced) >= 0 {
+					vulnerable, fixed = true, ""
+				}
+			case event.Fixed != "":
+				if compareVersions(version, event.Fixed) >= 0 {
+					vulnerable = false
+				} else if vulnerable && fixed == "" {
+					fixed = event.Fixed
+				}
+			}
+		}
+		if vulnerable {
+			return true, fixed
+		}
+	}
+	return false, ""
+}
+
+// compareVersions compares the semantic versions a and b, with or without
+// the "v" prefix of Go modules, and returns -1, 0 or 1. The build metadata,
+// such as "+incompatible", is ignored and a pre-release, a pseudo-version
+// among them, is lower than its release.
+func compareVersions(a, b string) int {
+	a, b = strings.TrimPrefix(a, "v"), strings.TrimPrefix(b, "v")
+	if i := strings.IndexByte(a, '+'); i >= 0 {
+		a = a[:i]
+	}
+	if i := strings.IndexByte(b, '+'); i >= 0 {
+		b = b[:i]
+	}
+	var preA, preB string
+	if i := strings.IndexByte(a, '-'); i >= 0 {
+		a, preA = a[:i], a[i+1:]
+	}
+	if i := strings.IndexByte(b, '-'); i >= 0 {
+		b, preB = b[:i], b[i+1:]
+	}
+	numsA, numsB := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < 3; i++ {
+		var x, y string
+		if i < len(numsA) {
+			x = numsA[i]
+		}
+		if i < len(numsB) {
+			y = numsB[i]
+		}
+		if c := compareNumbers(x, y); c != 0 {
+			return c
+		}
+	}
+	switch {
+	case preA == preB:
+		return 0
+	case preA == "":
+		return 1
+	case preB == "":
+		return -1
+	}
+	idsA, idsB := strings.Split(preA, "."), strings.Split(preB, ".")
+	for i := 0; i < len(idsA) && i < len(idsB); i++ {
+		x, y := idsA[i], idsB[i]
+		numX, numY := isNumber(x), isNumber(y)
+		switch {
+		case numX && numY:
+			if c := compareNumbers(x, y); c != 0 {
+				return c
+			}
+		case numX:
+			return -1
+		case numY:
+			return 1
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	switch {
+	case len(idsA) < len(idsB):
+		return -1
+	case len(idsA) > len(idsB):
+		return 1
+	}
+	return 0
+}
+
+// compareNumbers compares the decimal numbers x and y of any length, an empty
+// one being 0.
+func compareNumbers(x, y string) int {
+	x, y = strings.TrimLeft(x, "0"), strings.TrimLeft(y, "0")
+	switch {
+	case len(x) < len(y):
+		return -1
+	case len(x) > len(y):
+		return 1
+	case x < y:
+		return -1
+	case x > y:
+		return 1
+	}
+	return 0
+}
+
+// isNumber reports whether s is a non-empty string of digits.
+func isNumber(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,88 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package vuln
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/modules"
+)
+
+const testdata = "github.com/360EntSecGroup-Skylar/goreporter/linters/vuln/testdata/"
+
+func Test_Vuln(t *testing.T) {
+	entries, err := Load("testdata/db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 4 {
+		t.Fatalf("got %d entries, want 4 without the index and the withdrawn one", len(entries))
+	}
+
+	findings := Match(entries, []modules.Module{
+		{Path: "example.com/lib", Version: "v1.3.1", Packages: []string{testdata + "p"}},
+		{Path: "example.com/other", Version: "v0.1.0", Replace: &modules.Replace{Path: "example.com/fork", Version: "v0.3.0"}, Packages: []string{testdata + "p"}},
+		{Path: "example.com/local", Replace: &modules.Replace{Path: "../local"}},
+	})
+	if len(findings) != 3 {
+		t.Fatalf("got %d findings, want 3: %+v", len(findings), findings)
+	}
+	if err := Reach(findings, []string{testdata + "p"}); err != nil {
+		t.Fatal(err)
+	}
+
+	unsafe := findings[0]
+	if unsafe.ID != "GO-2017-0001" || unsafe.Fixed != "1.3.4" || !unsafe.Reachable || len(unsafe.Symbols) != 2 {
+		t.Errorf("unexpected finding %+v", unsafe)
+	}
+	var path []string
+	for _, call := range unsafe.CallPath {
+		path = append(path, call.Func[strings.LastIndex(call.Func, "/")+1:])
+	}
+	if strings.Join(path, " ") != "p.Run lib.Parse lib.Unsafe" {
+		t.Errorf("got call path %v, want p.Run lib.Parse lib.Unsafe", path)
+	}
+	if unsafe.CallPath[0].Line != 11 || !strings.HasSuffix(unsafe.CallPath[0].File, "p.go") {
+		t.Errorf("want the call at p.go:11, got %+v", unsafe.CallPath[0])
+	}
+
+	read := findings[1]
+	if read.ID != "GO-2017-0002" || read.Fixed != "" || !read.Reachable || len(read.CallPath) != 2 {
+		t.Errorf("want Decoder.Read reached through io.Reader, got %+v", read)
+	}
+	if unused := findings[2]; unused.ID != "GO-2017-0003" || unused.Reachable || len(unused.CallPath) != 0 {
+		t.Errorf("want Unused not reached, got %+v", unused)
+	}
+}
+
+func Test_CompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"v1.2.3", "1.2.3", 0},
+		{"v1.10.0", "v1.9.9", 1},
+		{"v1.0.0-rc.1", "v1.0.0", -1},
+		{"v1.0.0-rc.2", "v1.0.0-rc.10", -1},
+		{"v1.0.0-alpha", "v1.0.0-alpha.1", -1},
+		{"v0.0.0-20170101000000-abcdef123456", "v0.0.1", -1},
+		{"v2.0.0+incompatible", "2.0.0", 0},
+	}
+	for _, test := range tests {
+		if got := compareVersions(test.a, test.b); got != test.want {
+			t.Errorf("compareVersions(%s, %s) = %d, want %d", test.a, test.b, got, test.want)
+		}
+	}
+}
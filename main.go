@@ -29,6 +29,8 @@ import (
 
 	"github.com/360EntSecGroup-Skylar/goreporter/engine"
 	"github.com/360EntSecGroup-Skylar/goreporter/engine/processbar"
+	"github.com/360EntSecGroup-Skylar/goreporter/linters/fix"
+	"github.com/360EntSecGroup-Skylar/goreporter/utils"
 	"github.com/facebookgo/inject"
 )
 
@@ -42,7 +44,18 @@ import (
 // -t:Customize the path of the report template, not necessarily using the
 //    default report template
 // -f:Set the format to generate reports, support text, html and json,not
-//    necessarily using the default formate-html.
+//    necessarily using the default formate-html. The formats cyclonedx and
+//    spdx save the software bill of materials of the third-party modules
+//    instead, and only run the modules linter.
+// -config:Path of a json config file that enables and tunes the optional
+//    linters, by default none of them is run.
+//
+// The fix command, goreporter fix, applies the safe fixes of the linters to
+// the project instead of reporting, with the flags -p, -e and -config and:
+//
+// -rules:Comma-separated names of the fixes to apply, by default all of
+//    them.
+// -d:Print the unified diffs of the fixes instead of rewriting the files.
 
 const VERSION = "v3.0.0"
 
@@ -52,11 +65,16 @@ var (
 	reportPath     = flag.String("r", "", "path of report.")
 	exceptPackages = flag.String("e", "", "except packages.")
 	templatePath   = flag.String("t", "", "report html template path.")
-	reportFormat   = flag.String("f", "", "project report format(text/json/html).")
+	reportFormat   = flag.String("f", "", "project report format(text/json/html/cyclonedx/spdx).")
 	coresOfCPU     = flag.Int("c", -1, "cores of CPU.")
+	configPath     = flag.String("config", "", "path of json config file.")
 )
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "fix" {
+		fixProject(os.Args[2:])
+		return
+	}
 	flag.Parse()
 	if *coresOfCPU != -1 && *coresOfCPU <= runtime.NumCPU() {
 		runtime.GOMAXPROCS(*coresOfCPU)
@@ -104,6 +122,11 @@ func main() {
 		log.Println("There are no packages that are excepted, review all items of the package")
 	}
 
+	settings, err := engine.LoadSettings(*configPath)
+	if err != nil {
+		log.Fatal("config file is invalid:", err)
+	}
+
 	synchronizer := &engine.Synchronizer{
 		LintersProcessChans:   make(chan int64, 20),
 		LintersFinishedSignal: make(chan string, 10),
@@ -112,45 +135,87 @@ func main() {
 	waitGW := &engine.WaitGroupWrapper{}
 
 	reporter := engine.NewReporter(*projectPath, *reportPath, *reportFormat, templateHtml)
+	strategyAlignCheck := &engine.StrategyAlignCheck{}
+	strategyCopyCheck := &engine.StrategyCopyCheck{}
 	strategyCountCode := &engine.StrategyCountCode{}
 	strategyCyclo := &engine.StrategyCyclo{}
 	strategyDeadCode := &engine.StrategyDeadCode{}
 	strategyDependGraph := &engine.StrategyDependGraph{}
+	strategyErrorCheck := &engine.StrategyErrorCheck{}
 	strategyDepth := &engine.StrategyDepth{}
 	strategyImportPackages := &engine.StrategyImportPackages{}
 	strategyInterfacer := &engine.StrategyInterfacer{}
 	strategySimpleCode := &engine.StrategySimpleCode{}
+	strategyStaticCheck := &engine.StrategyStaticCheck{}
 	strategySpellCheck := &engine.StrategySpellCheck{}
 	strategyUnitTest := &engine.StrategyUnitTest{}
 	strategyLint := &engine.StrategyLint{}
 	strategyGoVet := &engine.StrategyGoVet{}
 	strategyGoFmt := &engine.StrategyGoFmt{}
+	strategyBenchmark := &engine.StrategyBenchmark{}
+	strategyMutation := &engine.StrategyMutation{}
+	strategyUntested := &engine.StrategyUntested{}
+	strategyFuncLen := &engine.StrategyFuncLen{}
+	strategyCognitive := &engine.StrategyCognitive{}
+	strategyMaintainability := &engine.StrategyMaintainability{}
+	strategyArchitecture := &engine.StrategyArchitecture{}
+	strategyModules := &engine.StrategyModules{}
+	strategyVuln := &engine.StrategyVuln{}
 
 	if err := inject.Populate(
 		reporter,
 		synchronizer,
+		strategyAlignCheck,
+		strategyCopyCheck,
 		strategyCountCode,
 		strategyCyclo,
 		strategyDeadCode,
 		strategyDependGraph,
+		strategyErrorCheck,
 		strategyDepth,
 		strategyImportPackages,
 		strategyInterfacer,
 		strategySimpleCode,
+		strategyStaticCheck,
 		strategySpellCheck,
 		strategyUnitTest,
 		strategyLint,
 		strategyGoVet,
 		strategyGoFmt,
+		strategyBenchmark,
+		strategyMutation,
+		strategyUntested,
+		strategyFuncLen,
+		strategyCognitive,
+		strategyMaintainability,
+		strategyArchitecture,
+		strategyModules,
+		strategyVuln,
+		settings,
 		syncRW,
 		waitGW,
 	); err != nil {
 		log.Fatal(err)
 	}
 
-	reporter.AddLinters(strategyCountCode, strategyCyclo, strategyDeadCode, strategyDependGraph,
-		strategyDepth, strategyImportPackages, strategyInterfacer, strategySimpleCode,
-		strategySpellCheck, strategyUnitTest, strategyLint, strategyGoVet, strategyGoFmt)
+	if *reportFormat == "cyclonedx" || *reportFormat == "spdx" {
+		reporter.AddLinters(strategyModules)
+	} else {
+		reporter.AddLinters(strategyCopyCheck, strategyCountCode, strategyCyclo, strategyDeadCode, strategyDependGraph,
+			strategyErrorCheck, strategyDepth, strategyImportPackages, strategyInterfacer, strategySimpleCode,
+			strategySpellCheck, strategyUnitTest, strategyLint, strategyGoVet, strategyGoFmt, strategyUntested,
+			strategyFuncLen, strategyCognitive, strategyMaintainability, strategyArchitecture, strategyModules,
+			strategyAlignCheck, strategyStaticCheck)
+		if settings.Benchmark.Enable {
+			reporter.AddLinters(strategyBenchmark)
+		}
+		if settings.Mutation.Enable {
+			reporter.AddLinters(strategyMutation)
+		}
+		if settings.Vuln.DB != "" {
+			reporter.AddLinters(strategyVuln)
+		}
+	}
 
 	go processbar.LinterProcessBar(synchronizer.LintersProcessChans, synchronizer.LintersFinishedSignal)
 
@@ -164,3 +229,50 @@ func main() {
 
 	log.Println(fmt.Sprintf("GoReporter Finished,time consuming %vs", time.Since(reporter.StartTime).Seconds()))
 }
+
+// fixProject runs the fix command with the arguments args.
+func fixProject(args []string) {
+	flags := flag.NewFlagSet("fix", flag.ExitOnError)
+	path := flags.String("p", "", "path of project.")
+	except := flags.String("e", "", "except packages.")
+	config := flags.String("config", "", "path of json config file.")
+	rules := flags.String("rules", "", "fixes to apply, all by default: "+strings.Join(fix.Rules(), ","))
+	diff := flags.Bool("d", false, "print the diffs instead of rewriting the files.")
+	flags.Parse(args)
+
+	if *path == "" {
+		log.Fatal("The project path is not specified")
+	} else if _, err := os.Stat(*path); err != nil {
+		log.Fatal("project path is invalid")
+	}
+	settings, err := engine.LoadSettings(*config)
+	if err != nil {
+		log.Fatal("config file is invalid:", err)
+	}
+	opts := settings.FixOptions()
+	if *rules != "" {
+		opts.Rules = strings.Split(*rules, ",")
+	}
+
+	dirs, err := utils.DirList(*path, ".go", *except)
+	if err != nil {
+		log.Fatal(err)
+	}
+	importPaths := engine.StrategyParameter{AllDirs: dirs}.ImportPaths()
+	changes, err := fix.Fix(importPaths, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for _, change := range changes {
+		if *diff {
+			fmt.Print(change.Diff())
+		} else {
+			log.Printf("%s: %s", change.File, strings.Join(change.Rules, ", "))
+		}
+	}
+	if !*diff {
+		if err := fix.Apply(changes); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
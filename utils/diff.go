@@ -0,0 +1,207 @@
+// Copyright 2017 The GoReporter Authors.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// diffContext is the number of unchanged lines around the changes of a hunk.
+const diffContext = 3
+
+// UnifiedDiff returns the changes from a to b in the unified format of diff
+// -u, a being named from and b to in the header. It is empty if a and b are
+// equal.
+func UnifiedDiff(from, to string, a, b []byte) string {
+	if bytes.Equal(a, b) {
+		return ""
+	}
+	x, y := splitLines(a), splitLines(b)
+	ops := diffLines(x, y)
+
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "--- %s\n+++ %s\n", from, to)
+	for start := 0; start < len(ops); {
+		// find the next change and the end of its hunk
+		for start < len(ops) && ops[start].kind == ' ' {
+			start++
+		}
+		if start == len(ops) {
+			break
+		}
+		end, unchanged := start, 0
+		for i := start; i < len(ops) && unchanged <= 2*diffContext; i++ {
+			if ops[i].kind == ' ' {
+				unchanged++
+			} else {
+				end, unchanged = i+1, 0
+			}
+		}
+		first := start - diffContext
+		if first < 0 {
+			first = 0
+		}
+		last := end + diffContext
+		if last > len(ops) {
+			last = len(ops)
+		}
+
+		lineX, lineY, countX, countY := ops[first].x+1, ops[first].y+1, 0, 0
+		for _, op := range ops[first:last] {
+			if op.kind != '+' {
+				countX++
+			}
+			if op.kind != '-' {
+				countY++
+			}
+		}
+		if countX == 0 {
+			lineX--
+		}
+		if countY == 0 {
+			lineY--
+		}
+		fmt.Fprintf(&buf, "@@ -%s +%s @@\n", hunkRange(lineX, countX), hunkRange(lineY, countY))
+		for _, op := range ops[first:last] {
+			line := op.line
+			buf.WriteByte(op.kind)
+			buf.WriteString(line)
+			if len(line) == 0 || line[len(line)-1] != '\n' {
+				buf.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		start = last
+	}
+	return buf.String()
+}
+
+// hunkRange formats the range of a hunk header, the count being omitted when
+// it is one.
+func hunkRange(line, count int) string {
+	if count == 1 {
+		return fmt.Sprint(line)
+	}
+	return fmt.Sprintf("%d,%d", line, count)
+}
+
+// splitLines splits b after each newline.
+func splitLines(b []byte) []string {
+	var lines []string
+	for len(b) > 0 {
+		i := bytes.IndexByte(b, '\n') + 1
+		if i == 0 {
+			i = len(b)
+		}
+		lines = append(lines, string(b[:i]))
+		b = b[i:]
+	}
+	return lines
+}
+
+// diffOp is a line kept (' '), deleted ('-') or inserted ('+'), x and y
+// being the indexes in the old and new lines of the lines before it.
+type diffOp struct {
+	kind byte
+	line string
+	x, y int
+}
+
+// diffLines returns the edit script from x to y that keeps a longest common
+// subsequence of their lines.
+func diffLines(x, y []string) []diffOp {
+	// the common prefix and suffix are kept as is
+	prefix := 0
+	for prefix < len(x) && prefix < len(y) && x[prefix] == y[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(x)-prefix && suffix < len(y)-prefix && x[len(x)-1-suffix] == y[len(y)-1-suffix] {
+		suffix++
+	}
+	mx, my := x[prefix:len(x)-suffix], y[prefix:len(y)-suffix]
+
+	// lcs[i][j] is the length of the longest common subsequence of mx[i:]
+	// and my[j:]
+	lcs := make([][]int32, len(mx)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(my)+1)
+	}
+	for i := len(mx) - 1; i >= 0; i-- {
+		for j := len(my) - 1; j >= 0; j-- {
+			if mx[i] == my[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	ops := make([]diffOp, 0, len(x)+len(y))
+	for i := 0; i < prefix; i++ {
+		ops = append(ops, diffOp{' ', x[i], i, i})
+	}
+	i, j := 0, 0
+	for i < len(mx) || j < len(my) {
+		switch {
+		case i < len(mx) && j < len(my) && mx[i] == my[j]:
+			ops = append(ops, diffOp{' ', mx[i], prefix + i, prefix + j})
+			i++
+			j++
+		case j == len(my) || (i < len(mx) && lcs[i+1][j] >= lcs[i][j+1]):
+			ops = append(ops, diffOp{'-', mx[i], prefix + i, prefix + j})
+			i++
+		default:
+			ops = append(ops, diffOp{'+', my[j], prefix + i, prefix + j})
+			j++
+		}
+	}
+	for k := 0; k < suffix; k++ {
+		ops = append(ops, diffOp{' ', x[len(x)-suffix+k], len(x) - suffix + k, len(y) - suffix + k})
+	}
+	return ops
+}
+
+// ChangedLines returns the numbers, from 1, of the lines of a that differ
+// from b: the lines deleted or replaced and, for the lines only inserted in
+// b, the line before which they are inserted, the last one at the end.
+func ChangedLines(a, b []byte) []int {
+	x := splitLines(a)
+	changed := make(map[int]bool)
+	replaced := false
+	for _, op := range diffLines(x, splitLines(b)) {
+		switch {
+		case op.kind == ' ':
+			replaced = false
+		case op.kind == '-':
+			changed[op.x+1] = true
+			replaced = true
+		case replaced:
+			// replaces the lines deleted before
+		case op.x < len(x):
+			changed[op.x+1] = true
+		case len(x) > 0:
+			changed[len(x)] = true
+		}
+	}
+	lines := make([]int, 0, len(changed))
+	for line := range changed {
+		lines = append(lines, line)
+	}
+	sort.Ints(lines)
+	return lines
+}
@@ -14,6 +14,7 @@
 package utils
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -64,3 +65,33 @@ func Test_ProjectName_NoPath(t *testing.T) {
 func Test_AbsPath_NoPath(t *testing.T) {
 	AbsPath("../nopath")
 }
+
+func Test_UnifiedDiff(t *testing.T) {
+	a := []byte("a\nb\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\n")
+	b := []byte("a\nB\nc\nd\ne\nf\ng\nh\ni\nj\nk\nl\nm")
+	want := `--- a/f
++++ b/f
+@@ -1,5 +1,5 @@
+ a
+-b
++B
+ c
+ d
+ e
+@@ -10,3 +10,4 @@
+ j
+ k
+ l
++m
+\ No newline at end of file
+`
+	if got := UnifiedDiff("a/f", "b/f", a, b); got != want {
+		t.Errorf("want\n%s\nbut got\n%s", want, got)
+	}
+	if got := UnifiedDiff("a/f", "b/f", a, a); got != "" {
+		t.Errorf("want no diff of equal files, but got\n%s", got)
+	}
+	if got := fmt.Sprint(ChangedLines(a, b)); got != "[2 12]" {
+		t.Errorf("want changed lines [2 12], but got %s", got)
+	}
+}